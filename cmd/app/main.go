@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"log"
 
 	"github.com/alisher-99/LomBarter/internal/app"
@@ -30,6 +31,19 @@ import (
 // @externalDocs.description  OpenAPI
 // @externalDocs.url          https://swagger.io/resources/open-api/
 func main() {
+	// Режимы массового импорта и экспорта. Без -import и -export запускается сервис.
+	var bulk app.BulkOptions
+
+	flag.StringVar(&bulk.Import, "import", "", "импортировать сущности и завершиться: users или orders")
+	flag.StringVar(&bulk.Export, "export", "", "выгрузить сущности и завершиться: users или orders")
+	flag.StringVar(&bulk.Format, "format", "", "формат строк: csv или ndjson, по умолчанию по расширению файла")
+	flag.StringVar(&bulk.File, "file", "", "файл импорта или выгрузки, по умолчанию stdin или stdout")
+	flag.StringVar(&bulk.Run, "run", "", "идентификатор прерванного запуска импорта, который нужно продолжить")
+	flag.StringVar(&bulk.Filter, "filter", "", "фильтр экспорта в виде строки запроса, например city=Алматы")
+	flag.StringVar(&bulk.Actor, "actor", "", "идентификатор администратора из ADMIN_USER_IDS")
+	flag.StringVar(&bulk.Report, "report", "", "файл отчета об импорте в NDJSON, по умолчанию stdout")
+	flag.Parse()
+
 	// Конфигурация приложения
 	cfg, err := config.NewConfig()
 	if err != nil {
@@ -39,6 +53,14 @@ func main() {
 	// Документация Swagger
 	docs.SwaggerInfo.Host = cfg.Host
 
+	if bulk.IsBulk() {
+		if err = app.RunBulk(cfg, bulk); err != nil {
+			log.Fatalf("ошибка массового импорта или экспорта: %s", err)
+		}
+
+		return
+	}
+
 	// Запуск приложения
 	err = app.Run(cfg)
 	if err != nil {
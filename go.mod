@@ -7,6 +7,7 @@ require (
 	github.com/go-chi/chi v1.5.4
 	github.com/go-chi/cors v1.2.1
 	github.com/go-chi/render v1.0.3
+	github.com/go-openapi/spec v0.20.6
 	github.com/gocql/gocql v1.5.2
 	github.com/golang-migrate/migrate/v4 v4.16.2
 	github.com/grpc-ecosystem/go-grpc-middleware v1.0.1-0.20190118093823-f849b5445de4
@@ -17,6 +18,7 @@ require (
 	github.com/prometheus/client_model v0.3.0
 	github.com/scylladb/gocqlx/v2 v2.8.0
 	github.com/sebdah/goldie/v2 v2.5.3
+	github.com/segmentio/kafka-go v0.4.40
 	github.com/stretchr/testify v1.8.4
 	github.com/swaggo/http-swagger v1.3.4
 	github.com/swaggo/swag v1.16.1
@@ -30,8 +32,8 @@ require (
 	gitlab.com/example/gophers/libs/route-registrator v0.0.7
 	gitlab.com/example/gophers/libs/trace v0.0.3
 	gitlab.com/example/gophers/libs/validate v0.0.3
-	gitlab.com/example/gophers/microservices/fcm-notify v0.0.7
 	go.mongodb.org/mongo-driver v1.12.0
+	go.opentelemetry.io/otel/trace v1.16.0
 	go.uber.org/mock v0.2.0
 	golang.org/x/sync v0.3.0
 	google.golang.org/grpc v1.56.2
@@ -64,7 +66,6 @@ require (
 	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/go-openapi/jsonpointer v0.19.5 // indirect
 	github.com/go-openapi/jsonreference v0.20.0 // indirect
-	github.com/go-openapi/spec v0.20.6 // indirect
 	github.com/go-openapi/swag v0.19.15 // indirect
 	github.com/go-playground/locales v0.14.1 // indirect
 	github.com/go-playground/universal-translator v0.18.1 // indirect
@@ -106,7 +107,6 @@ require (
 	github.com/prometheus/procfs v0.10.1 // indirect
 	github.com/redis/go-redis/v9 v9.0.5 // indirect
 	github.com/scylladb/go-reflectx v1.0.1 // indirect
-	github.com/segmentio/kafka-go v0.4.40 // indirect
 	github.com/sergi/go-diff v1.0.0 // indirect
 	github.com/sirupsen/logrus v1.9.3 // indirect
 	github.com/swaggo/files v0.0.0-20220610200504-28940afbdbfe // indirect
@@ -123,7 +123,6 @@ require (
 	go.opentelemetry.io/otel/exporters/jaeger v1.16.0 // indirect
 	go.opentelemetry.io/otel/metric v1.16.0 // indirect
 	go.opentelemetry.io/otel/sdk v1.16.0 // indirect
-	go.opentelemetry.io/otel/trace v1.16.0 // indirect
 	go.uber.org/atomic v1.7.0 // indirect
 	golang.org/x/crypto v0.13.0 // indirect
 	golang.org/x/mod v0.10.0 // indirect
@@ -12,9 +12,18 @@ import (
 
 	"github.com/alisher-99/LomBarter/internal/config"
 	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
 	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/service/matching"
+	"github.com/alisher-99/LomBarter/internal/service/moderation"
+	"github.com/alisher-99/LomBarter/internal/service/notifier"
 	"github.com/alisher-99/LomBarter/internal/storage"
+	"github.com/alisher-99/LomBarter/internal/storage/blob"
+	"github.com/alisher-99/LomBarter/internal/storage/payment"
 	"github.com/alisher-99/LomBarter/internal/transport/http"
+	"github.com/alisher-99/LomBarter/internal/transport/kafka"
+	"github.com/alisher-99/LomBarter/pkg/openapi"
+	docs "github.com/alisher-99/LomBarter/swagger"
 )
 
 const (
@@ -34,6 +43,10 @@ func Run(cfg *config.Config) error {
 
 	cfg.Version = readVersion(fileVersion)
 
+	if err := entity.ValidateEventTopics(&cfg.Kafka.Producers); err != nil {
+		return fmt.Errorf("проверка топиков событий: %w", err)
+	}
+
 	// Инициализация логгера и graylog.
 	log, err := logger.New(cfg.LogLevel, cfg.ServiceName)
 	if err != nil {
@@ -71,9 +84,99 @@ func Run(cfg *config.Config) error {
 
 	log.Infof("Подключение к базе данных %s успешно", cfg.DSName)
 
+	// Допустимые валюты.
+	currencies, err := entity.NewCurrencies(cfg.AllowedCurrencies)
+	if err != nil {
+		return fmt.Errorf("инициализация валют: %w", err)
+	}
+
+	// Доставка уведомлений.
+	notify, err := newNotifier(cfg, ds, log)
+	if err != nil {
+		return fmt.Errorf("инициализация уведомлений: %w", err)
+	}
+
 	// Инициализация сервисов.
-	userService := service.NewUserService(ds.UserRepository(), cacheData, log, tracer, producers[entity.SomeTopic], promMetrics)
-	orderService := service.NewOrdersService(ds.OrdersRepository(), log, tracer)
+	moderationService := service.NewModerationService(
+		ds.ModerationRepository(), ds.UserRepository(), ds.ListingRepository(), ds.AuditRepository(), cacheData, ds,
+		newModerationPipeline(cfg, ds), entity.NewAdmins(cfg.AdminIDs), log, tracer,
+	)
+	userService := service.NewUserService(
+		ds.UserRepository(), ds.AuditRepository(), ds, cacheData, log, tracer, producers[entity.SomeTopic], promMetrics, notify,
+		moderationService,
+	)
+	listingService := service.NewListingService(
+		ds.ListingRepository(), ds.AuditRepository(), ds, currencies, producers[entity.ListingEventTopic], moderationService,
+		log, tracer,
+	)
+
+	// Платежный провайдер для оплаты заказов и доплат к обменам.
+	payments, err := payment.New(&cfg.Payment)
+	if err != nil {
+		return fmt.Errorf("инициализация платежного провайдера: %w", err)
+	}
+
+	orderService := service.NewOrdersService(
+		ds.OrdersRepository(), ds.PaymentRepository(), ds.AuditRepository(), ds, payments, currencies, log, tracer,
+	)
+	paymentService := service.NewPaymentService(
+		ds.PaymentRepository(), ds.OrdersRepository(), ds, payments, cfg.WebhookRetention, log, tracer,
+	)
+	tradeOfferService := service.NewTradeOfferService(
+		ds.TradeOfferRepository(), ds.ListingRepository(), ds.OrdersRepository(), ds.PaymentRepository(),
+		ds.AuditRepository(), ds, payments, currencies, cfg.FairnessTolerance, producers[entity.TradeOfferTopic], log, tracer,
+	)
+	tradeCycleService := service.NewTradeCycleService(
+		ds.TradeCycleRepository(), ds.ListingRepository(), ds, matching.NewEngine(matching.Options{
+			MaxLength: cfg.MaxCycleLength,
+			Budget:    cfg.SearchBudget,
+			PoolLimit: cfg.PoolLimit,
+			MaxCycles: cfg.MaxProposals,
+		}), log, tracer,
+	)
+	wishlistService := service.NewWishlistService(
+		ds.WishlistRepository(), ds.ListingRepository(), notify, log, tracer,
+	)
+	ratingService := service.NewRatingService(
+		ds.RatingRepository(), ds.OrdersRepository(), ds.UserRepository(), cacheData, ds, log, tracer,
+	)
+	conversationService := service.NewConversationService(
+		ds.ConversationRepository(), ds.OrdersRepository(), ds.TradeOfferRepository(), notify,
+		service.ConversationOptions{RateLimit: cfg.RateLimit, RateWindow: cfg.RateWindow}, log, tracer,
+	)
+	searchService := service.NewSearchService(ds.SearchRepository(), log, tracer)
+	notificationService := service.NewNotificationService(ds.NotificationRepository(), cacheData, log, tracer)
+	walletService := service.NewWalletService(
+		ds.LedgerRepository(), ds.UserRepository(), ds, entity.NewAdmins(cfg.AdminIDs), log, tracer,
+	)
+
+	// Хранилище файлов.
+	blobs, err := blob.New(&cfg.Media)
+	if err != nil {
+		return fmt.Errorf("инициализация хранилища файлов: %w", err)
+	}
+
+	mediaService := service.NewMediaService(
+		ds.MediaRepository(), ds.UserRepository(), ds.ListingRepository(), blobs, cacheData,
+		service.MediaOptions{MaxSize: cfg.MaxUploadSize, ThumbnailSize: cfg.ThumbnailSize, URLTTL: cfg.URLTTL}, log, tracer,
+	)
+	auditService := service.NewAuditService(ds.AuditRepository(), entity.NewAdmins(cfg.AdminIDs), log, tracer)
+	disputeService := service.NewDisputeService(
+		ds.DisputeRepository(), ds.OrdersRepository(), ds.PaymentRepository(), ds.LedgerRepository(),
+		ds.UserRepository(), ds.MediaRepository(), cacheData, ds, payments, entity.NewAdmins(cfg.AdminIDs),
+		entity.DisputeSLA{Response: cfg.ResponseSLA, Resolution: cfg.ResolutionSLA},
+		producers[entity.DisputeEventTopic], log, tracer,
+	)
+	bulkService := service.NewBulkService(
+		ds.UserRepository(), ds.OrdersRepository(), ds.ImportRunRepository(), ds.AuditRepository(), ds, moderationService,
+		currencies, entity.NewAdmins(cfg.AdminIDs), cfg.BatchSize, log, tracer,
+	)
+
+	// Документ OpenAPI 3 строится из той же документации swagger, что раздается по /swagger.
+	apiDoc, err := openapi.FromSwagger([]byte(docs.SwaggerInfo.ReadDoc()))
+	if err != nil {
+		return fmt.Errorf("построение документа OpenAPI: %w", err)
+	}
 
 	g, gCtx := errgroup.WithContext(ctx)
 
@@ -82,18 +185,131 @@ func Run(cfg *config.Config) error {
 		httpOpts := []http.Option{
 			http.WithUserService(userService),
 			http.WithOrdersService(orderService),
+			http.WithListingService(listingService),
+			http.WithTradeOfferService(tradeOfferService),
+			http.WithTradeCycleService(tradeCycleService),
+			http.WithWishlistService(wishlistService),
+			http.WithRatingService(ratingService),
+			http.WithConversationService(conversationService),
+			http.WithSearchService(searchService),
+			http.WithMediaService(mediaService),
+			http.WithNotificationService(notificationService),
+			http.WithWalletService(walletService),
+			http.WithPaymentService(paymentService),
+			http.WithDisputeService(disputeService),
+			http.WithAuditService(auditService),
+			http.WithModerationService(moderationService),
+			http.WithBulkService(bulkService),
+			http.WithOpenAPI(apiDoc),
 			http.WithTracer(tracer),
 			http.WithLogger(log),
 		}
 
+		// Локальное хранилище отдает файлы через сервер, ссылки S3 ведут прямо в бакет.
+		if local, ok := blobs.(*blob.Local); ok {
+			httpOpts = append(httpOpts, http.WithBlobFiles(local))
+		}
+
 		httpServer := http.NewServer(cfg, httpOpts...)
 
 		return httpServer.Run(gCtx)
 	})
 
+	// Консюмер событий объявлений для поиска циклов обмена, совпадений со списками желаний и удаления фотографий.
+	listingConsumer, err := kafka.NewConsumer(cfg, entity.ListingEventTopic,
+		kafka.WithHandler(kafka.NewListingEventHandler(tradeCycleService, wishlistService, mediaService, log)),
+		kafka.WithLogger(log),
+	)
+	if err != nil {
+		return fmt.Errorf("инициализация консюмера %s: %w", entity.ListingEventTopic, err)
+	}
+
+	g.Go(func() error {
+		return listingConsumer.Run(gCtx)
+	})
+
+	// Консюмер обновлений профиля пользователя из внешних систем.
+	userConsumer, err := kafka.NewConsumer(cfg, entity.UserUpdateTopic,
+		kafka.WithHandler(kafka.NewUserUpdateHandler(userService, log)),
+		kafka.WithLogger(log),
+	)
+	if err != nil {
+		return fmt.Errorf("инициализация консюмера %s: %w", entity.UserUpdateTopic, err)
+	}
+
+	g.Go(func() error {
+		return userConsumer.Run(gCtx)
+	})
+
+	// Фоновая доставка уведомлений.
+	g.Go(func() error {
+		return notify.Run(gCtx)
+	})
+
+	// Отметка споров, срок рассмотрения которых истек.
+	g.Go(func() error {
+		return disputeService.WatchSLA(gCtx, cfg.SLACheckInterval)
+	})
+
+	// Повтор списаний, снятий блокировки и возвратов, которые провайдер не провел сразу.
+	g.Go(func() error {
+		return paymentService.WatchSettlements(gCtx, cfg.SettlementInterval)
+	})
+
 	if err = g.Wait(); err != nil {
 		return fmt.Errorf("работа основных горутин: %w", err)
 	}
 
 	return nil
 }
+
+// newNotifier создает фоновую доставку уведомлений по шаблонам в push, письма и входящие.
+// Письма отправляются, только если указан SMTP-сервер.
+func newNotifier(cfg *config.Config, ds repository.DataStore, log logger.Logger) (*notifier.Queue, error) {
+	templates, err := notifier.NewTemplates(notifier.DefaultTemplates)
+	if err != nil {
+		return nil, fmt.Errorf("разбор шаблонов уведомлений: %w", err)
+	}
+
+	repo := ds.NotificationRepository()
+
+	channels := []notifier.Channel{
+		notifier.NewPushChannel(notifier.NewKafkaPushSender(producers[entity.PushNotificationTopic]), repo),
+		notifier.NewInAppChannel(repo, cacheData, cfg.InboxRetention, log),
+	}
+
+	if cfg.SMTPAddr != "" {
+		channels = append(channels, notifier.NewEmailChannel(notifier.NewSMTPMailer(notifier.SMTPOptions{
+			Addr:     cfg.SMTPAddr,
+			From:     cfg.SMTPFrom,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+		})))
+	}
+
+	dispatcher := notifier.New(templates, repo, channels, notifier.Options{
+		Attempts: cfg.RetryAttempts,
+		Delay:    cfg.RetryDelay,
+	})
+
+	return notifier.NewQueue(dispatcher, notifier.QueueOptions{Workers: cfg.Workers, Size: cfg.QueueSize}, log), nil
+}
+
+// newModerationPipeline создает правила проверки пользовательских текстов из конфигурации.
+func newModerationPipeline(cfg *config.Config, ds repository.DataStore) *moderation.Pipeline {
+	rules := []moderation.Rule{moderation.NewBannedWords(cfg.BannedWords)}
+
+	if cfg.DetectLinks {
+		rules = append(rules, moderation.NewLinks())
+	}
+
+	if cfg.DetectPhones {
+		rules = append(rules, moderation.NewPhones())
+	}
+
+	if cfg.ChangeLimit > 0 {
+		rules = append(rules, moderation.NewRate(service.NewAuditChangeCounter(ds.AuditRepository()), cfg.ChangeLimit, cfg.ChangeWindow))
+	}
+
+	return moderation.NewPipeline(rules...)
+}
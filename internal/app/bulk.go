@@ -0,0 +1,290 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/config"
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/presenter"
+	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/storage"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+)
+
+// errBulkMode ошибка выбора режима массового импорта или экспорта.
+var errBulkMode = errors.New("нужно указать ровно один из режимов -import или -export: users или orders")
+
+// BulkOptions параметры массового импорта или экспорта из командной строки.
+type BulkOptions struct {
+	Import string // Вид импортируемых сущностей: users или orders
+	Export string // Вид выгружаемых сущностей: users или orders
+	Format string // Формат строк: csv или ndjson. По умолчанию определяется по расширению файла
+	File   string // Файл строк импорта или выгрузки. Пусто или "-" - стандартный ввод или вывод
+	Run    string // Идентификатор прерванного запуска импорта, который нужно продолжить
+	Filter string // Фильтр экспорта в виде строки запроса, например city=Алматы&from=2024-01-01T00:00:00Z
+	Actor  string // Идентификатор администратора из ADMIN_USER_IDS, от имени которого пишется журнал изменений
+	Report string // Файл отчета об импорте в NDJSON. Пусто или "-" - стандартный вывод
+}
+
+// IsBulk возвращает true, если выбран режим импорта или экспорта.
+func (o BulkOptions) IsBulk() bool {
+	return o.Import != "" || o.Export != ""
+}
+
+// RunBulk выполняет массовый импорт или экспорт и завершается.
+// Импорт прерывается по SIGTERM или SIGINT после сохранения текущей пачки и продолжается с параметром Run.
+func RunBulk(cfg *config.Config, opts BulkOptions) error {
+	if (opts.Import == "") == (opts.Export == "") {
+		return errBulkMode
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	ctx = entity.WithAuditActor(ctx, entity.AuditActor{ID: opts.Actor, Source: entity.AuditSourceCLI})
+
+	// Инициализация логгера. Сообщения пишутся в stderr и не смешиваются с выгрузкой.
+	log, err := logger.New(cfg.LogLevel, cfg.ServiceName)
+	if err != nil {
+		return fmt.Errorf("инициализация логгера: %w", err)
+	}
+
+	// Инициализация базы данных.
+	ds, err := storage.NewDatabase(&cfg.Database, log, tracer)
+	if err != nil {
+		return fmt.Errorf("инициализация базы данных: %w", err)
+	}
+
+	if err = ds.Connect(); err != nil {
+		return fmt.Errorf("подключение к базе данных: %w", err)
+	}
+
+	defer func() {
+		sCtx, cancel := context.WithTimeout(context.Background(), gracefulShutdownTimeout)
+		defer cancel()
+
+		if cErr := ds.Close(sCtx); cErr != nil {
+			log.Errorf("закрытие базы данных: %s", cErr)
+		}
+	}()
+
+	currencies, err := entity.NewCurrencies(cfg.AllowedCurrencies)
+	if err != nil {
+		return fmt.Errorf("инициализация валют: %w", err)
+	}
+
+	admins := entity.NewAdmins(cfg.AdminIDs)
+	moderationService := service.NewModerationService(
+		ds.ModerationRepository(), ds.UserRepository(), ds.ListingRepository(), ds.AuditRepository(), cacheData, ds,
+		newModerationPipeline(cfg, ds), admins, log, tracer,
+	)
+	bulkService := service.NewBulkService(
+		ds.UserRepository(), ds.OrdersRepository(), ds.ImportRunRepository(), ds.AuditRepository(), ds, moderationService,
+		currencies, admins, cfg.BatchSize, log, tracer,
+	)
+
+	if opts.Import != "" {
+		return runImport(ctx, bulkService, opts, log)
+	}
+
+	return runExport(ctx, bulkService, opts)
+}
+
+// runImport импортирует строки из файла и пишет отчет в NDJSON.
+func runImport(ctx context.Context, bulkService service.BulkService, opts BulkOptions, log logger.Logger) error {
+	kind := entity.BulkKind(opts.Import)
+
+	format, err := bulkFormat(opts.Format, opts.File)
+	if err != nil {
+		return err
+	}
+
+	in, err := openInput(opts.File)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := createOutput(opts.Report)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	var importRows func(context.Context, *entity.ImportRun, *form.BulkDecoder, service.ImportReporter, time.Time) error
+
+	switch kind {
+	case entity.BulkKindUsers:
+		importRows = bulkService.ImportUsers
+	case entity.BulkKindOrders:
+		importRows = bulkService.ImportOrders
+	default:
+		return fmt.Errorf("%w: %q", errBulkMode, opts.Import)
+	}
+
+	run, err := bulkService.StartImport(ctx, form.ImportStart{RequesterID: opts.Actor, RunID: opts.Run, Kind: kind}, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("запуск импорта: %w", err)
+	}
+
+	log.Infof("Импорт %s, запуск %s", kind, run.ID)
+
+	rows, err := form.NewBulkDecoder(in, format)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(out)
+
+	err = importRows(ctx, run, rows, func(result entity.ImportRowResult) error {
+		var errs []presenter.EnvelopeError
+		if result.Err != nil {
+			response := detector.ErrorResponse(result.Err)
+			response.Localize(entity.DefaultLanguage)
+			errs = response.EnvelopeErrors()
+		}
+
+		row := presenter.NewImportRow(result, errs)
+
+		return encoder.Encode(presenter.ImportReport{Result: &row})
+	}, time.Now().UTC())
+	if eErr := encoder.Encode(presenter.ImportReport{Run: presenter.NewImportRun(run)}); eErr != nil {
+		log.Errorf("запись итога импорта: %s", eErr)
+	}
+
+	if err != nil {
+		return fmt.Errorf("импорт %s, продолжить с -run %s: %w", kind, run.ID, err)
+	}
+
+	log.Infof("Импорт %s завершен: создано %d, уже было %d, с ошибками %d", kind, run.Created, run.Existed, run.Failed)
+
+	return nil
+}
+
+// runExport выгружает сущности по фильтру в файл.
+func runExport(ctx context.Context, bulkService service.BulkService, opts BulkOptions) error {
+	format := entity.BulkFormatNDJSON
+	if opts.Format != "" || (opts.File != "" && opts.File != "-") {
+		var err error
+		if format, err = bulkFormat(opts.Format, opts.File); err != nil {
+			return err
+		}
+	}
+
+	values, err := url.ParseQuery(opts.Filter)
+	if err != nil {
+		return fmt.Errorf("разбор фильтра: %w", err)
+	}
+
+	out, err := createOutput(opts.File)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	var encoder *presenter.BulkEncoder
+
+	switch entity.BulkKind(opts.Export) {
+	case entity.BulkKindUsers:
+		filter, err := form.ParseUsersExport(values)
+		if err != nil {
+			return err
+		}
+
+		filter.RequesterID = opts.Actor
+
+		if encoder, err = presenter.NewBulkEncoder(out, format, presenter.UserColumns); err != nil {
+			return err
+		}
+
+		err = bulkService.ExportUsers(ctx, filter, func(user *entity.User) error {
+			return encoder.Encode(presenter.NewUser(user))
+		})
+		if err != nil {
+			return fmt.Errorf("экспорт пользователей: %w", err)
+		}
+	case entity.BulkKindOrders:
+		filter, err := form.ParseOrdersExport(values)
+		if err != nil {
+			return err
+		}
+
+		filter.RequesterID = opts.Actor
+
+		if encoder, err = presenter.NewBulkEncoder(out, format, presenter.OrderColumns); err != nil {
+			return err
+		}
+
+		err = bulkService.ExportOrders(ctx, filter, func(order *entity.Order) error {
+			return encoder.Encode(presenter.NewOrder(order))
+		})
+		if err != nil {
+			return fmt.Errorf("экспорт заказов: %w", err)
+		}
+	default:
+		return fmt.Errorf("%w: %q", errBulkMode, opts.Export)
+	}
+
+	return encoder.Flush()
+}
+
+// bulkFormat возвращает формат строк: явно указанный или по расширению файла.
+func bulkFormat(format, file string) (entity.BulkFormat, error) {
+	if format == "" {
+		format = strings.TrimPrefix(filepath.Ext(file), ".")
+	}
+
+	return entity.ParseBulkFormat(format)
+}
+
+// nopCloser оборачивает стандартный ввод или вывод, который не нужно закрывать.
+type nopCloser struct {
+	*os.File
+}
+
+// Close ничего не делает.
+func (nopCloser) Close() error {
+	return nil
+}
+
+// openInput открывает файл для чтения. Пусто или "-" - стандартный ввод.
+func openInput(name string) (io.ReadCloser, error) {
+	if name == "" || name == "-" {
+		return nopCloser{os.Stdin}, nil
+	}
+
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("открытие файла: %w", err)
+	}
+
+	return file, nil
+}
+
+// createOutput создает файл для записи. Пусто или "-" - стандартный вывод.
+func createOutput(name string) (io.WriteCloser, error) {
+	if name == "" || name == "-" {
+		return nopCloser{os.Stdout}, nil
+	}
+
+	file, err := os.Create(name)
+	if err != nil {
+		return nil, fmt.Errorf("создание файла: %w", err)
+	}
+
+	return file, nil
+}
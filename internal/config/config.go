@@ -26,6 +26,17 @@ type (
 		Tracing     `yaml:"tracing"`
 		ServiceMesh `yaml:"service_mesh"`
 		Environment `yaml:"environment"`
+		Money       `yaml:"money"`
+		Matching    `yaml:"matching"`
+		Messaging   `yaml:"messaging"`
+		Media       `yaml:"media"`
+		Notify      `yaml:"notify"`
+		Admin       `yaml:"admin"`
+		Trade       `yaml:"trade"`
+		Payment     `yaml:"payment"`
+		Dispute     `yaml:"dispute"`
+		Moderation  `yaml:"moderation"`
+		Bulk        `yaml:"bulk"`
 		ServiceName string `env:"SERVICE_NAME" yaml:"service_name" env-default:"tmp" env-description:"Название сервиса"`
 		Version     string `env:"APP_VERSION" yaml:"version" env-default:"unknown" env-description:"Версия приложения"`
 	}
@@ -37,12 +48,104 @@ type (
 
 	// Server сервер.
 	Server struct {
-		Host           string `env:"SERVER_HOST" yaml:"host" env-default:"0.0.0.0" env-description:"Хост HTTP сервиса"`
-		HTTPListenAddr int    `env:"SERVER_PORT" yaml:"http_listen_addr" env-default:"8000" env-description:"Адрес HTTP сервера"`
-		GrpcListenAddr int    `env:"GRPC_LISTEN" yaml:"grpc_listen_addr" env-default:"4040" env-description:"Адрес GRPC сервера"`
-		PromListenAddr int    `env:"PROM_LISTEN" yaml:"prom_listen_addr" env-default:"9090" env-description:"Адрес Prometheus сервера"`
-		BasePath       string `env:"BASE_PATH" yaml:"base_path" env-default:"/" env-description:"Базовый путь сервиса"`
-		FilesDir       string `env:"FILES_DIR" yaml:"files_dir" env-default:"/swagger" env-description:"Директория с файлами"`
+		Host             string    `env:"SERVER_HOST" yaml:"host" env-default:"0.0.0.0" env-description:"Хост HTTP сервиса"`
+		HTTPListenAddr   int       `env:"SERVER_PORT" yaml:"http_listen_addr" env-default:"8000" env-description:"Адрес HTTP сервера"`
+		GrpcListenAddr   int       `env:"GRPC_LISTEN" yaml:"grpc_listen_addr" env-default:"4040" env-description:"Адрес GRPC сервера"`
+		PromListenAddr   int       `env:"PROM_LISTEN" yaml:"prom_listen_addr" env-default:"9090" env-description:"Адрес Prometheus сервера"`
+		BasePath         string    `env:"BASE_PATH" yaml:"base_path" env-default:"/" env-description:"Базовый путь сервиса"`
+		FilesDir         string    `env:"FILES_DIR" yaml:"files_dir" env-default:"/swagger" env-description:"Директория с файлами"`
+		ValidateRequests bool      `env:"SERVER_VALIDATE_REQUESTS" yaml:"validate_requests" env-default:"false" env-description:"Проверять запросы по документу OpenAPI"`
+		V1DeprecatedAt   time.Time `env:"SERVER_V1_DEPRECATED_AT" yaml:"v1_deprecated_at" env-layout:"2006-01-02" env-default:"2026-10-19" env-description:"Дата, с которой API v1 устарело, для заголовка Deprecation"`
+		V1SunsetAt       time.Time `env:"SERVER_V1_SUNSET_AT" yaml:"v1_sunset_at" env-layout:"2006-01-02" env-default:"2027-04-19" env-description:"Дата отключения API v1 для заголовка Sunset"`
+	}
+
+	// Money конфигурация денежных сумм.
+	Money struct {
+		AllowedCurrencies []string `env:"ALLOWED_CURRENCIES" yaml:"allowed_currencies" env-default:"KZT" env-description:"Допустимые валюты (ISO 4217)"`
+	}
+
+	// Matching ограничения поиска циклов обмена.
+	Matching struct {
+		MaxCycleLength int `env:"MATCHING_MAX_CYCLE_LENGTH" yaml:"max_cycle_length" env-default:"4" env-description:"Максимальное количество участников цикла обмена"`
+		SearchBudget   int `env:"MATCHING_SEARCH_BUDGET" yaml:"search_budget" env-default:"10000" env-description:"Максимальное количество просмотренных ребер графа за один поиск"`
+		PoolLimit      int `env:"MATCHING_POOL_LIMIT" yaml:"pool_limit" env-default:"500" env-description:"Максимальное количество объявлений в графе"`
+		MaxProposals   int `env:"MATCHING_MAX_PROPOSALS" yaml:"max_proposals" env-default:"5" env-description:"Максимальное количество циклов, предлагаемых за один поиск"`
+	}
+
+	// Messaging ограничения переписок по сделкам.
+	Messaging struct {
+		RateLimit  int           `env:"MESSAGING_RATE_LIMIT" yaml:"rate_limit" env-default:"10" env-description:"Максимальное количество сообщений отправителя в переписке за окно"`
+		RateWindow time.Duration `env:"MESSAGING_RATE_WINDOW" yaml:"rate_window" env-default:"1m" env-description:"Окно ограничения частоты сообщений"`
+	}
+
+	// Media загрузка и хранение медиафайлов.
+	Media struct {
+		BlobDriver    string        `env:"MEDIA_BLOB_DRIVER" yaml:"blob_driver" env-default:"local" env-description:"Хранилище файлов: local или s3"`
+		LocalDir      string        `env:"MEDIA_LOCAL_DIR" yaml:"local_dir" env-default:"./data/media" env-description:"Директория файлов локального хранилища"`
+		PublicURL     string        `env:"MEDIA_PUBLIC_URL" yaml:"public_url" env-default:"http://localhost:8000/media/files" env-description:"Адрес раздачи файлов локального хранилища"`
+		SigningKey    string        `env:"MEDIA_SIGNING_KEY" yaml:"signing_key" env-description:"Ключ подписи ссылок локального хранилища"`
+		URLTTL        time.Duration `env:"MEDIA_URL_TTL" yaml:"url_ttl" env-default:"15m" env-description:"Время действия ссылок на скачивание"`
+		MaxUploadSize int64         `env:"MEDIA_MAX_UPLOAD_SIZE" yaml:"max_upload_size" env-default:"10485760" env-description:"Максимальный размер загружаемого файла в байтах"`
+		ThumbnailSize int           `env:"MEDIA_THUMBNAIL_SIZE" yaml:"thumbnail_size" env-default:"320" env-description:"Размер большей стороны миниатюры в пикселях"`
+		S3Endpoint    string        `env:"MEDIA_S3_ENDPOINT" yaml:"s3_endpoint" env-description:"Адрес S3-совместимого хранилища"`
+		S3Region      string        `env:"MEDIA_S3_REGION" yaml:"s3_region" env-default:"us-east-1" env-description:"Регион S3"`
+		S3Bucket      string        `env:"MEDIA_S3_BUCKET" yaml:"s3_bucket" env-description:"Бакет S3"`
+		S3AccessKey   string        `env:"MEDIA_S3_ACCESS_KEY" env-description:"Ключ доступа S3"`
+		S3SecretKey   string        `env:"MEDIA_S3_SECRET_KEY" env-description:"Секретный ключ S3"`
+		S3PathStyle   bool          `env:"MEDIA_S3_PATH_STYLE" yaml:"s3_path_style" env-default:"true" env-description:"Адресация бакета в пути, а не в имени хоста"`
+	}
+
+	// Notify доставка уведомлений.
+	Notify struct {
+		RetryAttempts  int           `env:"NOTIFY_RETRY_ATTEMPTS" yaml:"retry_attempts" env-default:"3" env-description:"Количество попыток доставки уведомления в канал"`
+		RetryDelay     time.Duration `env:"NOTIFY_RETRY_DELAY" yaml:"retry_delay" env-default:"200ms" env-description:"Пауза между попытками доставки"`
+		SMTPAddr       string        `env:"NOTIFY_SMTP_ADDR" yaml:"smtp_addr" env-description:"Адрес SMTP-сервера host:port. Без адреса письма не отправляются"`
+		SMTPFrom       string        `env:"NOTIFY_SMTP_FROM" yaml:"smtp_from" env-default:"noreply@lombarter.local" env-description:"Адрес отправителя писем"`
+		SMTPUsername   string        `env:"NOTIFY_SMTP_USERNAME" env-description:"Пользователь SMTP-сервера"`
+		SMTPPassword   string        `env:"NOTIFY_SMTP_PASSWORD" env-description:"Пароль SMTP-сервера"`
+		InboxRetention time.Duration `env:"NOTIFY_INBOX_RETENTION" yaml:"inbox_retention" env-default:"2160h" env-description:"Срок хранения уведомлений во входящих"`
+		Workers        int           `env:"NOTIFY_WORKERS" yaml:"workers" env-default:"4" env-description:"Количество уведомлений, доставляемых параллельно в фоне"`
+		QueueSize      int           `env:"NOTIFY_QUEUE_SIZE" yaml:"queue_size" env-default:"1000" env-description:"Размер очереди уведомлений. Уведомления сверх нее отбрасываются"`
+	}
+
+	// Admin администрирование.
+	Admin struct {
+		AdminIDs []string `env:"ADMIN_USER_IDS" yaml:"user_ids" env-description:"Идентификаторы пользователей с правами администратора"`
+	}
+
+	// Trade обмены.
+	Trade struct {
+		FairnessTolerance int64 `env:"TRADE_FAIRNESS_TOLERANCE" yaml:"fairness_tolerance" env-default:"10" env-description:"Допустимый перевес обмена в процентах от большей стороны"`
+	}
+
+	// Payment платежи по заказам и доплаты к обменам.
+	Payment struct {
+		PaymentProvider    string        `env:"PAYMENT_PROVIDER" yaml:"provider" env-default:"fake" env-description:"Платежный провайдер: fake"`
+		WebhookSecret      string        `env:"PAYMENT_WEBHOOK_SECRET" env-description:"Секрет подписи уведомлений платежного провайдера"`
+		WebhookTolerance   time.Duration `env:"PAYMENT_WEBHOOK_TOLERANCE" yaml:"webhook_tolerance" env-default:"5m" env-description:"Допустимый возраст подписи уведомления"`
+		WebhookRetention   time.Duration `env:"PAYMENT_WEBHOOK_RETENTION" yaml:"webhook_retention" env-default:"168h" env-description:"Срок хранения обработанных уведомлений для защиты от повторов"`
+		SettlementInterval time.Duration `env:"PAYMENT_SETTLEMENT_INTERVAL" yaml:"settlement_interval" env-default:"1m" env-description:"Интервал повтора неудавшихся операций с платежами у провайдера"`
+	}
+
+	// Dispute споры по заказам.
+	Dispute struct {
+		ResponseSLA      time.Duration `env:"DISPUTE_RESPONSE_SLA" yaml:"response_sla" env-default:"24h" env-description:"Срок, за который модератор должен взять спор в работу"`
+		ResolutionSLA    time.Duration `env:"DISPUTE_RESOLUTION_SLA" yaml:"resolution_sla" env-default:"72h" env-description:"Срок решения спора с момента открытия"`
+		SLACheckInterval time.Duration `env:"DISPUTE_SLA_CHECK_INTERVAL" yaml:"sla_check_interval" env-default:"1m" env-description:"Интервал проверки просроченных споров"`
+	}
+
+	// Moderation проверка пользовательских текстов.
+	Moderation struct {
+		BannedWords  map[string][]string `yaml:"banned_words" env-description:"Запрещенные слова по языкам"`
+		DetectLinks  bool                `env:"MODERATION_DETECT_LINKS" yaml:"detect_links" env-default:"true" env-description:"Отправлять на модерацию тексты со ссылками"`
+		DetectPhones bool                `env:"MODERATION_DETECT_PHONES" yaml:"detect_phones" env-default:"true" env-description:"Отправлять на модерацию тексты с номерами телефонов"`
+		ChangeLimit  int                 `env:"MODERATION_CHANGE_LIMIT" yaml:"change_limit" env-default:"20" env-description:"Количество изменений автора за окно, после которого тексты отправляются на модерацию. 0 отключает правило"`
+		ChangeWindow time.Duration       `env:"MODERATION_CHANGE_WINDOW" yaml:"change_window" env-default:"1h" env-description:"Окно подсчета изменений автора"`
+	}
+
+	// Bulk массовый импорт и экспорт.
+	Bulk struct {
+		BatchSize int `env:"BULK_BATCH_SIZE" yaml:"batch_size" env-default:"500" env-description:"Количество строк импорта, сохраняемых одной пачкой"`
 	}
 
 	// Log логирование.
@@ -60,6 +60,15 @@ func TestConsumers_SetValue(t *testing.T) {
 				{Topic: "user.update"},
 			},
 		},
+		{
+			name:   "устанавливаем топики событий",
+			conStr: `[{"topic": "user.update"}, {"topic": "listing.event"}]`,
+			expErr: "",
+			expRes: Consumers{
+				{Topic: "user.update"},
+				{Topic: "listing.event"},
+			},
+		},
 		{
 			name:   "устанавливаем некорректное значение",
 			conStr: `[{"topic": "topic1"}, {"topic": "topic2"}`,
@@ -146,6 +155,17 @@ func TestProducers_SetValue(t *testing.T) {
 				{Topic: "some.topic"},
 			},
 		},
+		{
+			name:    "устанавливаем топики событий",
+			prodStr: `[{"topic": "some.topic"}, {"topic": "trade.offer"}, {"topic": "listing.event"}, {"topic": "dispute.event"}]`,
+			expErr:  "",
+			expRes: Producers{
+				{Topic: "some.topic"},
+				{Topic: "trade.offer"},
+				{Topic: "listing.event"},
+				{Topic: "dispute.event"},
+			},
+		},
 		{
 			name:    "устанавливаем некорректное значение",
 			prodStr: `[{"topic": "topic1"}, {"topic": "topic2"}`,
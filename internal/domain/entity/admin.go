@@ -0,0 +1,23 @@
+package entity
+
+// Admins набор идентификаторов пользователей с правами администратора.
+type Admins map[string]struct{}
+
+// NewAdmins создает набор администраторов из идентификаторов пользователей.
+func NewAdmins(ids []string) Admins {
+	admins := make(Admins, len(ids))
+	for _, id := range ids {
+		if id != "" {
+			admins[id] = struct{}{}
+		}
+	}
+
+	return admins
+}
+
+// Contains является ли пользователь администратором.
+func (a Admins) Contains(userID string) bool {
+	_, ok := a[userID]
+
+	return ok
+}
@@ -0,0 +1,197 @@
+package entity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// AuditEntityType тип сущности в журнале изменений.
+type AuditEntityType string
+
+const (
+	AuditEntityUser    AuditEntityType = "user"    // Пользователь
+	AuditEntityOrder   AuditEntityType = "order"   // Заказ
+	AuditEntityListing AuditEntityType = "listing" // Объявление
+	AuditEntityPayment AuditEntityType = "payment" // Платеж
+)
+
+// AuditAction действие над сущностью.
+type AuditAction string
+
+const (
+	AuditActionCreate AuditAction = "create" // Создание
+	AuditActionUpdate AuditAction = "update" // Изменение
+	AuditActionDelete AuditAction = "delete" // Удаление
+)
+
+// AuditSource источник изменения.
+type AuditSource string
+
+const (
+	AuditSourceHTTP   AuditSource = "http"   // Запрос к HTTP API
+	AuditSourceKafka  AuditSource = "kafka"  // Сообщение из топика Kafka
+	AuditSourceSystem AuditSource = "system" // Фоновые процессы сервиса
+	AuditSourceCLI    AuditSource = "cli"    // Команды массового импорта
+)
+
+// AuditActor автор изменения.
+type AuditActor struct {
+	ID        string      // Идентификатор пользователя. Пуст, если изменение сделал сервис
+	Source    AuditSource // Источник изменения
+	RequestID string      // Идентификатор запроса или сообщения, в рамках которого сделано изменение
+}
+
+// auditActorKey ключ автора изменения в контексте.
+type auditActorKey struct{}
+
+// WithAuditActor возвращает контекст с автором изменений.
+func WithAuditActor(ctx context.Context, actor AuditActor) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+// AuditActorFromContext возвращает автора изменений из контекста.
+// Если автор не задан, изменение считается сделанным сервисом.
+func AuditActorFromContext(ctx context.Context) AuditActor {
+	actor, ok := ctx.Value(auditActorKey{}).(AuditActor)
+	if !ok {
+		return AuditActor{Source: AuditSourceSystem}
+	}
+
+	return actor
+}
+
+// AuditChange изменение одного поля сущности. Значения хранятся в JSON, чтобы запись не зависела
+// от типа поля и читалась так же, как сущность в API.
+type AuditChange struct {
+	Field string          `json:"field" db:"field" bson:"field"`                                    // Поле сущности в JSON-представлении
+	Old   json.RawMessage `json:"old,omitempty" db:"old" bson:"old,omitempty" swaggertype:"object"` // Значение до изменения
+	New   json.RawMessage `json:"new,omitempty" db:"new" bson:"new,omitempty" swaggertype:"object"` // Значение после изменения
+}
+
+// AuditChanges список изменений полей.
+type AuditChanges []AuditChange
+
+// DiffAudit сравнивает два состояния сущности одного типа и возвращает измененные поля.
+// Для создания before равен nil, для удаления nil передается в after: тогда в изменения попадают
+// только заполненные поля. Поля с тегом audit:"-" и скрытые из JSON не сравниваются.
+func DiffAudit(before, after any) (AuditChanges, error) {
+	beforeValue, afterValue := auditStruct(before), auditStruct(after)
+
+	structValue := beforeValue
+	if !structValue.IsValid() {
+		structValue = afterValue
+	}
+
+	if structValue.Kind() != reflect.Struct {
+		return nil, nil
+	}
+
+	structType := structValue.Type()
+
+	var (
+		changes AuditChanges
+		err     error
+	)
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if !field.IsExported() || name == "-" || name == "" || field.Tag.Get("audit") == "-" {
+			continue
+		}
+
+		oldValue, newValue := auditField(beforeValue, i), auditField(afterValue, i)
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+
+		change := AuditChange{Field: name}
+		if oldValue != nil {
+			if change.Old, err = json.Marshal(oldValue); err != nil {
+				return nil, fmt.Errorf("сериализация поля %s: %w", name, err)
+			}
+		}
+
+		if newValue != nil {
+			if change.New, err = json.Marshal(newValue); err != nil {
+				return nil, fmt.Errorf("сериализация поля %s: %w", name, err)
+			}
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// auditStruct возвращает значение структуры по указателю. Для nil возвращает пустое значение.
+func auditStruct(v any) reflect.Value {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+
+		value = value.Elem()
+	}
+
+	return value
+}
+
+// auditField возвращает значение поля структуры. Пустые значения возвращаются как nil.
+func auditField(value reflect.Value, i int) any {
+	if !value.IsValid() {
+		return nil
+	}
+
+	field := value.Field(i)
+	if field.IsZero() {
+		return nil
+	}
+
+	return field.Interface()
+}
+
+// AuditEntry запись журнала изменений. Записи только добавляются и никогда не меняются.
+type AuditEntry struct {
+	ID         string          `json:"id" db:"id" bson:"_id"`                                           // Идентификатор записи
+	ActorID    string          `json:"actorID,omitempty" db:"actor_id" bson:"actor_id,omitempty"`       // Автор изменения
+	Source     AuditSource     `json:"source" db:"source" bson:"source"`                                // Источник изменения
+	RequestID  string          `json:"requestID,omitempty" db:"request_id" bson:"request_id,omitempty"` // Идентификатор запроса или сообщения
+	EntityType AuditEntityType `json:"entityType" db:"entity_type" bson:"entity_type"`                  // Тип сущности
+	EntityID   string          `json:"entityID" db:"entity_id" bson:"entity_id"`                        // Идентификатор сущности
+	Action     AuditAction     `json:"action" db:"action" bson:"action"`                                // Действие
+	Changes    AuditChanges    `json:"changes" db:"changes" bson:"changes"`                             // Измененные поля
+	CreatedAt  time.Time       `json:"createdAt" db:"created_at" bson:"created_at"`                     // Дата изменения
+}
+
+// NewAuditEntry создает запись журнала изменений от имени автора из контекста.
+func NewAuditEntry(
+	ctx context.Context,
+	entityType AuditEntityType,
+	entityID string,
+	action AuditAction,
+	changes AuditChanges,
+	currentTime time.Time,
+) *AuditEntry {
+	actor := AuditActorFromContext(ctx)
+
+	return &AuditEntry{
+		ActorID:    actor.ID,
+		Source:     actor.Source,
+		RequestID:  actor.RequestID,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Changes:    changes,
+		CreatedAt:  currentTime,
+	}
+}
+
+// AuditEntries список записей журнала изменений.
+type AuditEntries []*AuditEntry
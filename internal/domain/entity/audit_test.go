@@ -0,0 +1,101 @@
+package entity
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffAudit(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	before := &User{ID: "u1", Name: "Alice", Bio: "Programmer", UpdatedAt: now}
+
+	cases := []struct {
+		name    string
+		before  any
+		after   any
+		changes AuditChanges
+	}{
+		{
+			name:   "update changes only modified fields",
+			before: before,
+			after:  &User{ID: "u1", Name: "Alice", Bio: "Designer", City: "Алматы", UpdatedAt: now.Add(time.Hour)},
+			changes: AuditChanges{
+				{Field: "bio", Old: []byte(`"Programmer"`), New: []byte(`"Designer"`)},
+				{Field: "city", New: []byte(`"Алматы"`)},
+			},
+		},
+		{
+			name:    "unchanged entity",
+			before:  before,
+			after:   &User{ID: "u1", Name: "Alice", Bio: "Programmer", UpdatedAt: now.Add(time.Hour)},
+			changes: nil,
+		},
+		{
+			name:   "create lists filled fields",
+			before: nil,
+			after:  &Order{ID: "o1", Status: OrderStatusCreated, History: OrderHistory{{To: OrderStatusCreated}}},
+			changes: AuditChanges{
+				{Field: "id", New: []byte(`"o1"`)},
+				{Field: "status", New: []byte(`"created"`)},
+			},
+		},
+		{
+			name:   "delete lists previous fields",
+			before: &Listing{ID: "l1", Title: "Велосипед"},
+			after:  nil,
+			changes: AuditChanges{
+				{Field: "id", Old: []byte(`"l1"`)},
+				{Field: "title", Old: []byte(`"Велосипед"`)},
+			},
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			changes, err := DiffAudit(s.before, s.after)
+			require.NoError(t, err)
+			assert.Equal(t, len(s.changes), len(changes))
+
+			for i := range s.changes {
+				assert.Equal(t, s.changes[i].Field, changes[i].Field)
+				assert.JSONEq(t, orNull(s.changes[i].Old), orNull(changes[i].Old))
+				assert.JSONEq(t, orNull(s.changes[i].New), orNull(changes[i].New))
+			}
+		})
+	}
+}
+
+func TestNewAuditEntry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	entry := NewAuditEntry(context.Background(), AuditEntityUser, "u1", AuditActionUpdate, nil, now)
+	assert.Equal(t, AuditSourceSystem, entry.Source, "без автора изменение делает сервис")
+
+	ctx := WithAuditActor(context.Background(), AuditActor{ID: "admin", Source: AuditSourceHTTP, RequestID: "req-1"})
+
+	entry = NewAuditEntry(ctx, AuditEntityUser, "u1", AuditActionUpdate, nil, now)
+	assert.Equal(t, "admin", entry.ActorID)
+	assert.Equal(t, AuditSourceHTTP, entry.Source)
+	assert.Equal(t, "req-1", entry.RequestID)
+}
+
+// orNull возвращает JSON-значение или null для пустого значения.
+func orNull(value []byte) string {
+	if len(value) == 0 {
+		return "null"
+	}
+
+	return string(value)
+}
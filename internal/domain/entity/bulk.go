@@ -0,0 +1,160 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BulkFormat формат массового импорта и экспорта.
+type BulkFormat string
+
+const (
+	BulkFormatCSV    BulkFormat = "csv"    // CSV с заголовком из названий колонок
+	BulkFormatNDJSON BulkFormat = "ndjson" // JSON-объект на каждой строке
+)
+
+// ContentType возвращает MIME-тип формата.
+func (f BulkFormat) ContentType() string {
+	if f == BulkFormatCSV {
+		return "text/csv; charset=utf-8"
+	}
+
+	return "application/x-ndjson"
+}
+
+// ParseBulkFormat возвращает формат по названию или MIME-типу, например из заголовка Content-Type.
+func ParseBulkFormat(str string) (BulkFormat, error) {
+	mediaType, _, err := mime.ParseMediaType(str)
+	if err != nil {
+		mediaType = str
+	}
+
+	switch strings.ToLower(strings.TrimSpace(mediaType)) {
+	case "csv", "text/csv":
+		return BulkFormatCSV, nil
+	case "ndjson", "jsonl", "application/x-ndjson", "application/jsonl":
+		return BulkFormatNDJSON, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrBulkFormat, str)
+	}
+}
+
+// BulkKind вид сущностей массового импорта и экспорта.
+type BulkKind string
+
+const (
+	BulkKindUsers  BulkKind = "users"  // Пользователи
+	BulkKindOrders BulkKind = "orders" // Заказы
+)
+
+// ImportRunStatus статус запуска импорта.
+type ImportRunStatus string
+
+const (
+	ImportRunStatusRunning   ImportRunStatus = "running"   // Строки еще читаются или запуск прерван
+	ImportRunStatusCompleted ImportRunStatus = "completed" // Прочитаны все строки
+)
+
+// ImportRun запуск импорта. Строки сохраняются пачками, после каждой пачки запоминается номер
+// последней обработанной строки. Повторный запуск с тем же идентификатором и тем же файлом
+// пропускает обработанные строки и продолжает с первой необработанной.
+type ImportRun struct {
+	ID          string          `json:"id" db:"id" bson:"_id"`                             // Идентификатор запуска
+	Kind        BulkKind        `json:"kind" db:"kind" bson:"kind"`                        // Вид импортируемых сущностей
+	RequesterID string          `json:"requesterID" db:"requester_id" bson:"requester_id"` // Администратор, запустивший импорт
+	Checkpoint  int64           `json:"checkpoint" db:"checkpoint" bson:"checkpoint"`      // Номер последней обработанной строки
+	Created     int64           `json:"created" db:"created" bson:"created"`               // Количество созданных сущностей
+	Existed     int64           `json:"existed" db:"existed" bson:"existed"`               // Количество строк, сохраненных прерванной попыткой
+	Failed      int64           `json:"failed" db:"failed" bson:"failed"`                  // Количество строк с ошибками
+	Status      ImportRunStatus `json:"status" db:"status" bson:"status"`                  // Статус запуска
+	CreatedAt   time.Time       `json:"createdAt" db:"created_at" bson:"created_at"`       // Дата первой попытки
+	UpdatedAt   time.Time       `json:"updatedAt" db:"updated_at" bson:"updated_at"`       // Дата сохранения последней пачки
+}
+
+// NewImportRun создает запуск импорта.
+func NewImportRun(kind BulkKind, requesterID string, currentTime time.Time) *ImportRun {
+	return &ImportRun{
+		Kind:        kind,
+		RequesterID: requesterID,
+		Status:      ImportRunStatusRunning,
+		CreatedAt:   currentTime,
+		UpdatedAt:   currentTime,
+	}
+}
+
+// DocumentID возвращает идентификатор документа для строки row. Он зависит только от запуска и номера
+// строки, поэтому строка, сохраненная прерванной попыткой, при повторе натыкается на свой же документ,
+// а не создает дубль. Формат совпадает с ObjectID: 4 байта времени создания запуска и 8 байт хэша.
+func (r *ImportRun) DocumentID(row int64) string {
+	var id [12]byte
+
+	binary.BigEndian.PutUint32(id[:4], uint32(r.CreatedAt.Unix()))
+
+	sum := sha256.Sum256([]byte(r.ID + ":" + strconv.FormatInt(row, 10)))
+	copy(id[4:], sum[:8])
+
+	return hex.EncodeToString(id[:])
+}
+
+// IsProcessed обработана ли строка row предыдущими пачками.
+func (r *ImportRun) IsProcessed(row int64) bool {
+	return row <= r.Checkpoint
+}
+
+// Record учитывает результат строки и сдвигает номер последней обработанной строки.
+func (r *ImportRun) Record(result ImportRowResult, currentTime time.Time) {
+	switch result.Status {
+	case ImportRowCreated:
+		r.Created++
+	case ImportRowExists:
+		r.Existed++
+	case ImportRowFailed:
+		r.Failed++
+	}
+
+	r.Checkpoint = max(r.Checkpoint, result.Row)
+	r.UpdatedAt = currentTime
+}
+
+// Complete отмечает, что прочитаны все строки.
+func (r *ImportRun) Complete(currentTime time.Time) {
+	r.Status = ImportRunStatusCompleted
+	r.UpdatedAt = currentTime
+}
+
+// ImportRowStatus результат импорта строки.
+type ImportRowStatus string
+
+const (
+	ImportRowCreated ImportRowStatus = "created" // Сущность создана
+	ImportRowExists  ImportRowStatus = "exists"  // Сущность уже создана прерванной попыткой
+	ImportRowFailed  ImportRowStatus = "failed"  // Строка не прошла разбор, проверку или сохранение
+)
+
+// ImportRowResult результат импорта строки.
+type ImportRowResult struct {
+	Row    int64           // Номер строки данных, начиная с 1
+	ID     string          // Идентификатор сущности, если строка сохранена
+	Status ImportRowStatus // Результат
+	Err    error           // Причина ошибки
+}
+
+// NewImportRowResult возвращает результат строки по ошибке сохранения.
+// Ошибка ErrImportRowExists означает, что строка сохранена раньше.
+func NewImportRowResult(row int64, id string, err error) ImportRowResult {
+	switch {
+	case err == nil:
+		return ImportRowResult{Row: row, ID: id, Status: ImportRowCreated}
+	case errors.Is(err, ErrImportRowExists):
+		return ImportRowResult{Row: row, ID: id, Status: ImportRowExists}
+	default:
+		return ImportRowResult{Row: row, Status: ImportRowFailed, Err: err}
+	}
+}
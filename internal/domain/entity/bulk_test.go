@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBulkFormat(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		str    string
+		format BulkFormat
+		err    error
+	}{
+		{name: "csv name", str: "csv", format: BulkFormatCSV},
+		{name: "csv content type with charset", str: "text/csv; charset=utf-8", format: BulkFormatCSV},
+		{name: "ndjson name", str: "ndjson", format: BulkFormatNDJSON},
+		{name: "jsonl extension", str: "jsonl", format: BulkFormatNDJSON},
+		{name: "ndjson content type", str: "application/x-ndjson", format: BulkFormatNDJSON},
+		{name: "json is not ndjson", str: "application/json", err: ErrBulkFormat},
+		{name: "empty", str: "", err: ErrBulkFormat},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			format, err := ParseBulkFormat(s.str)
+			if s.err != nil {
+				assert.ErrorIs(t, err, s.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, s.format, format)
+		})
+	}
+}
+
+func TestImportRun_DocumentID(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	run := NewImportRun(BulkKindUsers, "admin", createdAt)
+	run.ID = "655d8a4d3afea534e56b570f"
+
+	other := NewImportRun(BulkKindUsers, "admin", createdAt)
+	other.ID = "655d8a4d3afea534e56b5710"
+
+	id := run.DocumentID(1)
+	assert.Len(t, id, 24)
+	assert.Equal(t, "65920080", id[:8], "первые 4 байта - время создания запуска")
+	assert.Equal(t, id, run.DocumentID(1), "повтор строки дает тот же идентификатор")
+	assert.NotEqual(t, id, run.DocumentID(2))
+	assert.NotEqual(t, id, other.DocumentID(1))
+}
+
+func TestImportRun_Record(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	run := NewImportRun(BulkKindOrders, "admin", start)
+
+	results := []ImportRowResult{
+		NewImportRowResult(1, "a", nil),
+		NewImportRowResult(3, "c", ErrImportRowExists),
+		NewImportRowResult(2, "b", errors.New("validation")),
+	}
+
+	for _, result := range results {
+		run.Record(result, start.Add(time.Minute))
+	}
+
+	assert.Equal(t, int64(1), run.Created)
+	assert.Equal(t, int64(1), run.Existed)
+	assert.Equal(t, int64(1), run.Failed)
+	assert.Equal(t, int64(3), run.Checkpoint)
+	assert.True(t, run.IsProcessed(3))
+	assert.False(t, run.IsProcessed(4))
+	assert.Equal(t, ImportRunStatusRunning, run.Status)
+
+	run.Complete(start.Add(time.Hour))
+	assert.Equal(t, ImportRunStatusCompleted, run.Status)
+	assert.Equal(t, start.Add(time.Hour), run.UpdatedAt)
+}
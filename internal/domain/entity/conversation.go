@@ -0,0 +1,106 @@
+package entity
+
+import (
+	"time"
+)
+
+// ConversationSubject тип сделки, к которой относится переписка.
+type ConversationSubject string
+
+// Типы сделок переписки.
+const (
+	ConversationSubjectOrder      ConversationSubject = "order"       // Заказ
+	ConversationSubjectTradeOffer ConversationSubject = "trade_offer" // Предложение обмена
+)
+
+// Conversation переписка участников сделки.
+type Conversation struct {
+	ID             string               `json:"id" db:"id" bson:"_id"`                                               // Идентификатор переписки
+	SubjectType    ConversationSubject  `json:"subjectType" db:"subject_type" bson:"subject_type"`                   // Тип сделки
+	SubjectID      string               `json:"subjectID" db:"subject_id" bson:"subject_id"`                         // Идентификатор сделки
+	ParticipantIDs []string             `json:"participantIDs" db:"participant_ids" bson:"participant_ids"`          // Участники переписки
+	ReadAt         map[string]time.Time `json:"readAt" db:"read_at" bson:"read_at"`                                  // Когда участник последний раз прочитал переписку
+	LastMessageAt  *time.Time           `json:"lastMessageAt,omitempty" db:"last_message_at" bson:"last_message_at"` // Дата последнего сообщения
+	UpdatedAt      time.Time            `json:"updatedAt" db:"updated_at" bson:"updated_at"`                         // Дата обновления
+	CreatedAt      time.Time            `json:"createdAt" db:"created_at" bson:"created_at"`                         // Дата создания
+}
+
+// NewConversation создает переписку по сделке.
+func NewConversation(subjectType ConversationSubject, subjectID string, participantIDs []string, currentTime time.Time) *Conversation {
+	return &Conversation{
+		SubjectType:    subjectType,
+		SubjectID:      subjectID,
+		ParticipantIDs: participantIDs,
+		ReadAt:         make(map[string]time.Time, len(participantIDs)),
+		UpdatedAt:      currentTime,
+		CreatedAt:      currentTime,
+	}
+}
+
+// IsParticipant является ли пользователь участником переписки.
+func (c *Conversation) IsParticipant(userID string) bool {
+	return containsString(c.ParticipantIDs, userID)
+}
+
+// Recipients возвращает участников переписки, кроме отправителя.
+func (c *Conversation) Recipients(senderID string) []string {
+	recipients := make([]string, 0, len(c.ParticipantIDs))
+
+	for _, id := range c.ParticipantIDs {
+		if id != senderID {
+			recipients = append(recipients, id)
+		}
+	}
+
+	return recipients
+}
+
+// IsReadBy прочитал ли участник сообщение, отправленное в момент sentAt.
+func (c *Conversation) IsReadBy(userID string, sentAt time.Time) bool {
+	readAt, ok := c.ReadAt[userID]
+
+	return ok && !sentAt.After(readAt)
+}
+
+// Conversations список переписок.
+type Conversations []*Conversation
+
+// Attachment вложение сообщения. Файл загружается отдельно, в сообщении хранится ссылка.
+type Attachment struct {
+	URL         string `json:"url" db:"url" bson:"url"`                           // Ссылка на файл
+	Name        string `json:"name" db:"name" bson:"name"`                        // Имя файла
+	ContentType string `json:"contentType" db:"content_type" bson:"content_type"` // MIME-тип файла
+	Size        int64  `json:"size" db:"size" bson:"size"`                        // Размер файла в байтах
+}
+
+// Message сообщение в переписке.
+type Message struct {
+	ID             string       `json:"id" db:"id" bson:"_id"`                                      // Идентификатор сообщения
+	ConversationID string       `json:"conversationID" db:"conversation_id" bson:"conversation_id"` // Идентификатор переписки
+	SenderID       string       `json:"senderID" db:"sender_id" bson:"sender_id"`                   // Идентификатор отправителя
+	Text           string       `json:"text" db:"text" bson:"text"`                                 // Текст сообщения
+	Attachments    []Attachment `json:"attachments,omitempty" db:"attachments" bson:"attachments"`  // Вложения
+	ReadBy         []string     `json:"readBy,omitempty" db:"-" bson:"-"`                           // Кто из получателей прочитал сообщение
+	CreatedAt      time.Time    `json:"createdAt" db:"created_at" bson:"created_at"`                // Дата отправки
+}
+
+// NewMessage создает сообщение.
+func NewMessage(currentTime time.Time) *Message {
+	return &Message{CreatedAt: currentTime}
+}
+
+// Messages список сообщений.
+type Messages []*Message
+
+// FillReadBy заполняет отметки о прочтении сообщений получателями.
+func (m Messages) FillReadBy(conversation *Conversation) {
+	for _, message := range m {
+		message.ReadBy = nil
+
+		for _, id := range conversation.Recipients(message.SenderID) {
+			if conversation.IsReadBy(id, message.CreatedAt) {
+				message.ReadBy = append(message.ReadBy, id)
+			}
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessages_FillReadBy(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	conversation := NewConversation(ConversationSubjectOrder, "order", []string{"alice", "bob"}, now)
+	conversation.ReadAt["bob"] = now
+
+	messages := Messages{
+		{SenderID: "alice", CreatedAt: now.Add(-time.Minute)},
+		{SenderID: "alice", CreatedAt: now},
+		{SenderID: "alice", CreatedAt: now.Add(time.Minute)},
+		{SenderID: "bob", CreatedAt: now.Add(-time.Minute)},
+	}
+
+	messages.FillReadBy(conversation)
+
+	assert.Equal(t, []string{"bob"}, messages[0].ReadBy)
+	assert.Equal(t, []string{"bob"}, messages[1].ReadBy)
+	assert.Empty(t, messages[2].ReadBy)
+	// Алиса еще не открывала переписку.
+	assert.Empty(t, messages[3].ReadBy)
+}
@@ -0,0 +1,291 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// DisputeMaxEvidence максимальное количество доказательств в споре.
+const DisputeMaxEvidence = 20
+
+// DisputeReason причина спора.
+type DisputeReason string
+
+// Причины спора.
+const (
+	DisputeReasonNotAsDescribed DisputeReason = "not_as_described" // Предмет не соответствует описанию
+	DisputeReasonNoShow         DisputeReason = "no_show"          // Вторая сторона не пришла на обмен
+	DisputeReasonDamaged        DisputeReason = "damaged"          // Предмет поврежден
+	DisputeReasonOther          DisputeReason = "other"            // Другая причина
+)
+
+// DisputeStatus статус спора.
+type DisputeStatus string
+
+// Статусы спора.
+const (
+	DisputeStatusOpen      DisputeStatus = "open"      // Ожидает модератора
+	DisputeStatusInReview  DisputeStatus = "in_review" // Рассматривается модератором
+	DisputeStatusResolved  DisputeStatus = "resolved"  // Решен в пользу автора
+	DisputeStatusRejected  DisputeStatus = "rejected"  // Отклонен модератором
+	DisputeStatusWithdrawn DisputeStatus = "withdrawn" // Отозван автором
+)
+
+// disputeStatusTransitions допустимые переходы между статусами спора.
+var disputeStatusTransitions = map[DisputeStatus][]DisputeStatus{
+	DisputeStatusOpen:     {DisputeStatusInReview, DisputeStatusWithdrawn},
+	DisputeStatusInReview: {DisputeStatusResolved, DisputeStatusRejected, DisputeStatusWithdrawn},
+}
+
+// CanTransitionTo проверяет, допустим ли переход в указанный статус.
+func (s DisputeStatus) CanTransitionTo(to DisputeStatus) bool {
+	for _, allowed := range disputeStatusTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsActive рассматривается ли еще спор.
+func (s DisputeStatus) IsActive() bool {
+	return s == DisputeStatusOpen || s == DisputeStatusInReview
+}
+
+// DisputeSLA сроки рассмотрения спора.
+type DisputeSLA struct {
+	Response   time.Duration // За сколько модератор должен взять спор в работу
+	Resolution time.Duration // За сколько спор должен быть решен
+}
+
+// DisputeEvidence доказательство по спору. Файл загружается отдельно и прикрепляется к спору.
+type DisputeEvidence struct {
+	MediaID   string    `json:"mediaID" db:"media_id" bson:"media_id"`                   // Идентификатор медиафайла
+	AuthorID  string    `json:"authorID" db:"author_id" bson:"author_id"`                // Кто приложил доказательство
+	Comment   string    `json:"comment,omitempty" db:"comment" bson:"comment,omitempty"` // Пояснение
+	CreatedAt time.Time `json:"createdAt" db:"created_at" bson:"created_at"`             // Дата добавления
+}
+
+// DisputeResolution решение модератора по спору.
+type DisputeResolution struct {
+	ModeratorID       string    `json:"moderatorID" db:"moderator_id" bson:"moderator_id"`                                       // Модератор, принявший решение
+	Comment           string    `json:"comment" db:"comment" bson:"comment"`                                                     // Обоснование решения
+	Refund            *Money    `json:"refund,omitempty" db:"refund" bson:"refund,omitempty"`                                    // Возврат оплаты заказа автору
+	CreditReversal    int64     `json:"creditReversal,omitempty" db:"credit_reversal" bson:"credit_reversal,omitempty"`          // Кредиты, списанные со второй стороны в пользу автора
+	ReputationPenalty int       `json:"reputationPenalty,omitempty" db:"reputation_penalty" bson:"reputation_penalty,omitempty"` // Штраф к репутации второй стороны
+	ResolvedAt        time.Time `json:"resolvedAt" db:"resolved_at" bson:"resolved_at"`                                          // Дата решения
+}
+
+// HasOutcomes назначены ли по решению возврат, списание кредитов или штраф.
+func (r *DisputeResolution) HasOutcomes() bool {
+	return r.Refund != nil || r.CreditReversal > 0 || r.ReputationPenalty > 0
+}
+
+// Dispute спор по заказу. Автор спора — участник заказа, вторая сторона — другой участник.
+// Спор рассматривает модератор: сначала берет его в работу, затем выносит решение.
+type Dispute struct {
+	ID              string             `json:"id" db:"id" bson:"_id"`                                                 // Идентификатор спора
+	OrderID         string             `json:"orderID" db:"order_id" bson:"order_id"`                                 // Заказ, по которому открыт спор
+	OpenerID        string             `json:"openerID" db:"opener_id" bson:"opener_id"`                              // Автор спора
+	RespondentID    string             `json:"respondentID" db:"respondent_id" bson:"respondent_id"`                  // Вторая сторона заказа
+	Reason          DisputeReason      `json:"reason" db:"reason" bson:"reason"`                                      // Причина спора
+	Description     string             `json:"description" db:"description" bson:"description"`                       // Описание проблемы
+	Status          DisputeStatus      `json:"status" db:"status" bson:"status"`                                      // Статус спора
+	ModeratorID     string             `json:"moderatorID,omitempty" db:"moderator_id" bson:"moderator_id,omitempty"` // Модератор, рассматривающий спор
+	Evidence        []DisputeEvidence  `json:"evidence,omitempty" db:"-" bson:"evidence,omitempty"`                   // Доказательства сторон
+	Resolution      *DisputeResolution `json:"resolution,omitempty" db:"-" bson:"resolution,omitempty"`               // Решение модератора
+	ResponseDueAt   time.Time          `json:"responseDueAt" db:"response_due_at" bson:"response_due_at"`             // Срок, до которого модератор должен взять спор в работу
+	ResolutionDueAt time.Time          `json:"resolutionDueAt" db:"resolution_due_at" bson:"resolution_due_at"`       // Срок, до которого спор должен быть решен
+	DueAt           *time.Time         `json:"dueAt,omitempty" db:"due_at" bson:"due_at,omitempty"`                   // Ближайший срок текущего этапа. У закрытого спора отсутствует
+	Overdue         bool               `json:"overdue" db:"overdue" bson:"overdue"`                                   // Просрочен ли срок текущего этапа
+	Version         int64              `json:"-" db:"version" bson:"version"`                                         // Версия для оптимистичной блокировки
+	UpdatedAt       time.Time          `json:"updatedAt" db:"updated_at" bson:"updated_at"`                           // Дата обновления
+	CreatedAt       time.Time          `json:"createdAt" db:"created_at" bson:"created_at"`                           // Дата создания
+}
+
+// NewDispute открывает спор по заказу от имени участника openerID. Сроки рассмотрения отсчитываются от открытия.
+func NewDispute(order *Order, openerID string, sla DisputeSLA, currentTime time.Time) (*Dispute, error) {
+	respondentID := order.CounterpartyID
+	if openerID == order.CounterpartyID {
+		respondentID = order.UserID
+	}
+
+	if respondentID == "" || respondentID == openerID {
+		return nil, fmt.Errorf("%w: у заказа %s нет второй стороны", ErrDisputeNotAllowed, order.ID)
+	}
+
+	responseDueAt := currentTime.Add(sla.Response)
+
+	return &Dispute{
+		OrderID:         order.ID,
+		OpenerID:        openerID,
+		RespondentID:    respondentID,
+		Status:          DisputeStatusOpen,
+		ResponseDueAt:   responseDueAt,
+		ResolutionDueAt: currentTime.Add(sla.Resolution),
+		DueAt:           &responseDueAt,
+		Version:         1,
+		UpdatedAt:       currentTime,
+		CreatedAt:       currentTime,
+	}, nil
+}
+
+// IsParty является ли пользователь стороной спора.
+func (d *Dispute) IsParty(userID string) bool {
+	return d.OpenerID == userID || d.RespondentID == userID
+}
+
+// Transition переводит спор в новый статус. Срок следующего этапа начинает отсчитываться заново,
+// у закрытого спора сроков нет.
+func (d *Dispute) Transition(to DisputeStatus, currentTime time.Time) error {
+	if !d.Status.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %s -> %s", ErrDisputeTransition, d.Status, to)
+	}
+
+	d.Status = to
+	d.Overdue = false
+	d.UpdatedAt = currentTime
+
+	switch to {
+	case DisputeStatusInReview:
+		resolutionDueAt := d.ResolutionDueAt
+		d.DueAt = &resolutionDueAt
+	default:
+		d.DueAt = nil
+	}
+
+	return nil
+}
+
+// Assign передает спор модератору. Спор в работе можно переназначить другому модератору.
+func (d *Dispute) Assign(moderatorID string, currentTime time.Time) error {
+	if d.Status == DisputeStatusInReview {
+		d.ModeratorID = moderatorID
+		d.UpdatedAt = currentTime
+
+		return nil
+	}
+
+	if err := d.Transition(DisputeStatusInReview, currentTime); err != nil {
+		return err
+	}
+
+	d.ModeratorID = moderatorID
+
+	return nil
+}
+
+// AddEvidence добавляет доказательство стороны спора.
+func (d *Dispute) AddEvidence(evidence DisputeEvidence) error {
+	if !d.Status.IsActive() {
+		return fmt.Errorf("%w: спор в статусе %s", ErrDisputeClosed, d.Status)
+	}
+
+	if len(d.Evidence) >= DisputeMaxEvidence {
+		return fmt.Errorf("%w: не больше %d", ErrDisputeEvidenceLimit, DisputeMaxEvidence)
+	}
+
+	for _, existing := range d.Evidence {
+		if existing.MediaID == evidence.MediaID {
+			return fmt.Errorf("%w: %s", ErrDisputeEvidenceExists, evidence.MediaID)
+		}
+	}
+
+	d.Evidence = append(d.Evidence, evidence)
+	d.UpdatedAt = evidence.CreatedAt
+
+	return nil
+}
+
+// Resolve выносит решение по спору. Решение в пользу автора переводит спор в resolved, иначе в rejected.
+// При отклонении спора возврат, списание кредитов и штраф не назначаются.
+func (d *Dispute) Resolve(to DisputeStatus, resolution DisputeResolution) error {
+	if to != DisputeStatusResolved && to != DisputeStatusRejected {
+		return fmt.Errorf("%w: %s", ErrDisputeTransition, to)
+	}
+
+	if to == DisputeStatusRejected && resolution.HasOutcomes() {
+		return fmt.Errorf("%w: у отклоненного спора не бывает последствий", ErrDisputeInvalidOutcome)
+	}
+
+	if err := d.Transition(to, resolution.ResolvedAt); err != nil {
+		return err
+	}
+
+	d.Resolution = &resolution
+
+	return nil
+}
+
+// MarkOverdue отмечает просрочку текущего этапа. Если срок не наступил или просрочка уже отмечена, возвращает false.
+func (d *Dispute) MarkOverdue(currentTime time.Time) bool {
+	if d.Overdue || d.DueAt == nil || !d.Status.IsActive() || currentTime.Before(*d.DueAt) {
+		return false
+	}
+
+	d.Overdue = true
+	d.UpdatedAt = currentTime
+
+	return true
+}
+
+// Disputes список споров.
+type Disputes []*Dispute
+
+// DisputeMessage сообщение в переписке сторон спора с модератором.
+type DisputeMessage struct {
+	ID        string    `json:"id" db:"id" bson:"_id"`                       // Идентификатор сообщения
+	DisputeID string    `json:"disputeID" db:"dispute_id" bson:"dispute_id"` // Идентификатор спора
+	AuthorID  string    `json:"authorID" db:"author_id" bson:"author_id"`    // Автор сообщения
+	Moderator bool      `json:"moderator" db:"moderator" bson:"moderator"`   // Написано ли сообщение модератором
+	Text      string    `json:"text" db:"text" bson:"text"`                  // Текст сообщения
+	CreatedAt time.Time `json:"createdAt" db:"created_at" bson:"created_at"` // Дата отправки
+}
+
+// DisputeMessages список сообщений спора.
+type DisputeMessages []*DisputeMessage
+
+// DisputeEventType тип события спора.
+type DisputeEventType string
+
+// Типы событий спора.
+const (
+	DisputeEventOpened        DisputeEventType = "opened"         // Спор открыт
+	DisputeEventEvidenceAdded DisputeEventType = "evidence_added" // Добавлено доказательство
+	DisputeEventMessagePosted DisputeEventType = "message_posted" // Отправлено сообщение
+	DisputeEventAssigned      DisputeEventType = "assigned"       // Спор взят в работу модератором
+	DisputeEventResolved      DisputeEventType = "resolved"       // Вынесено решение
+	DisputeEventWithdrawn     DisputeEventType = "withdrawn"      // Спор отозван автором
+	DisputeEventOverdue       DisputeEventType = "overdue"        // Просрочен срок рассмотрения
+)
+
+// DisputeEvent событие спора, отправляемое в Kafka.
+type DisputeEvent struct {
+	DisputeID    string             `json:"disputeID"`             // Идентификатор спора
+	OrderID      string             `json:"orderID"`               // Заказ, по которому открыт спор
+	OpenerID     string             `json:"openerID"`              // Автор спора
+	RespondentID string             `json:"respondentID"`          // Вторая сторона
+	ModeratorID  string             `json:"moderatorID,omitempty"` // Модератор
+	ActorID      string             `json:"actorID,omitempty"`     // Пользователь, совершивший действие. Для просрочки пустой
+	Type         DisputeEventType   `json:"type"`                  // Тип события
+	Status       DisputeStatus      `json:"status"`                // Статус спора после события
+	Resolution   *DisputeResolution `json:"resolution,omitempty"`  // Решение модератора
+	OccurredAt   time.Time          `json:"occurredAt"`            // Дата события
+}
+
+// NewDisputeEvent создает событие спора.
+func NewDisputeEvent(dispute *Dispute, eventType DisputeEventType, actorID string, currentTime time.Time) DisputeEvent {
+	return DisputeEvent{
+		DisputeID:    dispute.ID,
+		OrderID:      dispute.OrderID,
+		OpenerID:     dispute.OpenerID,
+		RespondentID: dispute.RespondentID,
+		ModeratorID:  dispute.ModeratorID,
+		ActorID:      actorID,
+		Type:         eventType,
+		Status:       dispute.Status,
+		Resolution:   dispute.Resolution,
+		OccurredAt:   currentTime,
+	}
+}
@@ -0,0 +1,139 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisputeStatus_CanTransitionTo(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		from DisputeStatus
+		to   DisputeStatus
+		ok   bool
+	}{
+		{from: DisputeStatusOpen, to: DisputeStatusInReview, ok: true},
+		{from: DisputeStatusOpen, to: DisputeStatusWithdrawn, ok: true},
+		{from: DisputeStatusOpen, to: DisputeStatusResolved, ok: false},
+		{from: DisputeStatusInReview, to: DisputeStatusResolved, ok: true},
+		{from: DisputeStatusInReview, to: DisputeStatusRejected, ok: true},
+		{from: DisputeStatusInReview, to: DisputeStatusWithdrawn, ok: true},
+		{from: DisputeStatusResolved, to: DisputeStatusInReview, ok: false},
+		{from: DisputeStatusWithdrawn, to: DisputeStatusOpen, ok: false},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(string(s.from)+"->"+string(s.to), func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, s.ok, s.from.CanTransitionTo(s.to))
+		})
+	}
+}
+
+func TestDispute_SLA(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	sla := DisputeSLA{Response: 24 * time.Hour, Resolution: 72 * time.Hour}
+
+	_, err := NewDispute(&Order{ID: "order", UserID: "alice"}, "alice", sla, now)
+	require.ErrorIs(t, err, ErrDisputeNotAllowed, "у заказа нет второй стороны")
+
+	dispute, err := NewDispute(&Order{ID: "order", UserID: "alice", CounterpartyID: "bob"}, "bob", sla, now)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", dispute.RespondentID)
+	assert.Equal(t, now.Add(sla.Response), *dispute.DueAt)
+
+	assert.False(t, dispute.MarkOverdue(now.Add(time.Hour)), "срок не наступил")
+	assert.True(t, dispute.MarkOverdue(now.Add(sla.Response)))
+	assert.False(t, dispute.MarkOverdue(now.Add(sla.Response)), "просрочка уже отмечена")
+
+	// Взятие в работу сбрасывает просрочку и переносит срок на решение.
+	require.NoError(t, dispute.Assign("moderator", now.Add(25*time.Hour)))
+	assert.False(t, dispute.Overdue)
+	assert.Equal(t, now.Add(sla.Resolution), *dispute.DueAt)
+
+	require.NoError(t, dispute.Resolve(DisputeStatusResolved, DisputeResolution{
+		ModeratorID: "moderator", ReputationPenalty: 1, ResolvedAt: now.Add(48 * time.Hour),
+	}))
+	assert.Nil(t, dispute.DueAt)
+	assert.False(t, dispute.MarkOverdue(now.Add(100*time.Hour)), "у решенного спора нет сроков")
+}
+
+func TestDispute_Resolve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name       string
+		status     DisputeStatus
+		resolution DisputeResolution
+		err        error
+	}{
+		{
+			name:       "resolved with outcomes",
+			status:     DisputeStatusResolved,
+			resolution: DisputeResolution{CreditReversal: 500, ReputationPenalty: 2, ResolvedAt: now},
+		},
+		{
+			name:       "rejected without outcomes",
+			status:     DisputeStatusRejected,
+			resolution: DisputeResolution{ResolvedAt: now},
+		},
+		{
+			name:       "rejected with outcomes",
+			status:     DisputeStatusRejected,
+			resolution: DisputeResolution{CreditReversal: 500, ResolvedAt: now},
+			err:        ErrDisputeInvalidOutcome,
+		},
+		{
+			name:       "withdrawn is not a resolution",
+			status:     DisputeStatusWithdrawn,
+			resolution: DisputeResolution{ResolvedAt: now},
+			err:        ErrDisputeTransition,
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			dispute := &Dispute{Status: DisputeStatusInReview}
+
+			err := dispute.Resolve(s.status, s.resolution)
+			if s.err != nil {
+				require.ErrorIs(t, err, s.err)
+				assert.Equal(t, DisputeStatusInReview, dispute.Status)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, s.status, dispute.Status)
+			assert.NotNil(t, dispute.Resolution)
+		})
+	}
+}
+
+func TestDispute_AddEvidence(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	dispute := &Dispute{Status: DisputeStatusOpen}
+
+	require.NoError(t, dispute.AddEvidence(DisputeEvidence{MediaID: "m1", CreatedAt: now}))
+	require.ErrorIs(t, dispute.AddEvidence(DisputeEvidence{MediaID: "m1", CreatedAt: now}), ErrDisputeEvidenceExists)
+
+	dispute.Status = DisputeStatusResolved
+	require.ErrorIs(t, dispute.AddEvidence(DisputeEvidence{MediaID: "m2", CreatedAt: now}), ErrDisputeClosed)
+}
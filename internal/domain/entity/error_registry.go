@@ -0,0 +1,184 @@
+package entity
+
+import (
+	"errors"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// ErrorSpec описание клиентской ошибки: код и то, как ее передают транспорты.
+type ErrorSpec struct {
+	Code       string     // Код ошибки
+	HTTPStatus int        // HTTP статус ответа
+	GRPCCode   codes.Code // Код статуса gRPC
+	Retryable  bool       // Повтор того же запроса может завершиться успешно
+	Errors     []error    // Доменные ошибки с этим кодом. Пусто, если код выставляет сам транспорт
+}
+
+// internalErrorSpec описание ошибки, которой нет в реестре.
+var internalErrorSpec = ErrorSpec{Code: InternalCode, HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal}
+
+// LookupError возвращает описание первой ошибки реестра, которую содержит err.
+// Ошибки вне реестра считаются внутренними.
+func LookupError(err error) ErrorSpec {
+	for _, spec := range errorRegistry {
+		for _, target := range spec.Errors {
+			if errors.Is(err, target) {
+				return spec
+			}
+		}
+	}
+
+	return internalErrorSpec
+}
+
+// ErrorSpecs возвращает все описания ошибок в порядке реестра.
+func ErrorSpecs() []ErrorSpec {
+	specs := make([]ErrorSpec, 0, len(errorRegistry)+1)
+	specs = append(specs, internalErrorSpec)
+
+	return append(specs, errorRegistry...)
+}
+
+// errorRegistry реестр клиентских ошибок. Ошибки проверяются по порядку,
+// поэтому общие ошибки (неверный идентификатор) стоят после ошибок сущностей.
+var errorRegistry = []ErrorSpec{
+	{Code: PageInvalidLimitCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrPageInvalidLimit}},
+	{Code: PageInvalidPageCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrPageInvalidPage}},
+	{Code: PageInvalidStateCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrPageInvalidState}},
+
+	{Code: UserNotFoundCode, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Errors: []error{ErrUserNotFound}},
+	{Code: UserIDEmptyCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrUserIDEmpty}},
+	{Code: UserDecodeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrUserDecode}},
+
+	{Code: OrderDecodeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrOrderDecode}},
+	{Code: OrderNotFoundCode, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Errors: []error{ErrOrderNotFound}},
+	{Code: OrderInvalidCostCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrOrderInvalidCost}},
+	{Code: OrderStatusTransitionCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrOrderStatusTransition}},
+	{Code: OrderStatusConflictCode, HTTPStatus: http.StatusConflict, GRPCCode: codes.Aborted, Retryable: true, Errors: []error{ErrOrderStatusConflict}},
+	{Code: OrderTradeManagedCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrOrderTradeManaged}},
+
+	{Code: ListingNotFoundCode, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Errors: []error{ErrListingNotFound}},
+	{Code: ListingDecodeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrListingDecode}},
+	{Code: ListingNotEditableCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrListingNotEditable}},
+	{Code: ListingInvalidValuationCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrListingInvalidValuation}},
+	{Code: ListingConflictCode, HTTPStatus: http.StatusConflict, GRPCCode: codes.Aborted, Retryable: true, Errors: []error{ErrListingConflict}},
+
+	{Code: TradeOfferNotFoundCode, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Errors: []error{ErrTradeOfferNotFound}},
+	{Code: TradeOfferDecodeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrTradeOfferDecode}},
+	{Code: TradeOfferTransitionCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrTradeOfferTransition}},
+	{Code: TradeOfferConflictCode, HTTPStatus: http.StatusConflict, GRPCCode: codes.Aborted, Retryable: true, Errors: []error{ErrTradeOfferConflict}},
+	{Code: TradeOfferForbiddenCode, HTTPStatus: http.StatusForbidden, GRPCCode: codes.PermissionDenied, Errors: []error{ErrTradeOfferForbidden}},
+	{Code: TradeOfferSelfCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrTradeOfferSelf}},
+	{Code: TradeOfferListingOwnerCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrTradeOfferListingOwner}},
+	{Code: TradeOfferListingUnavailableCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrTradeOfferListingUnavailable}},
+	{Code: TradeOfferRecipientsDifferentCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrTradeOfferRecipientsDifferent}},
+	{Code: TradeOfferInvalidCashCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrTradeOfferInvalidCash}},
+
+	{Code: TradeCycleNotFoundCode, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Errors: []error{ErrTradeCycleNotFound}},
+	{Code: TradeCycleTransitionCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrTradeCycleTransition}},
+	{Code: TradeCycleConflictCode, HTTPStatus: http.StatusConflict, GRPCCode: codes.Aborted, Retryable: true, Errors: []error{ErrTradeCycleConflict}},
+	{Code: TradeCycleListingUnavailableCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrTradeCycleListingUnavailable}},
+
+	{Code: WishlistItemNotFoundCode, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Errors: []error{ErrWishlistItemNotFound}},
+	{Code: WishlistItemDecodeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument},
+	{Code: WishlistForbiddenCode, HTTPStatus: http.StatusForbidden, GRPCCode: codes.PermissionDenied, Errors: []error{ErrWishlistForbidden}},
+	{Code: WishlistLimitCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.ResourceExhausted, Errors: []error{ErrWishlistLimit}},
+	{Code: WishlistQueryEmptyCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrWishlistQueryEmpty}},
+
+	{Code: RatingDecodeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument},
+	{Code: RatingExistsCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.AlreadyExists, Errors: []error{ErrRatingExists}},
+	{Code: RatingOrderNotCompletedCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrRatingOrderNotCompleted}},
+	{Code: RatingNoCounterpartyCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrRatingNoCounterparty}},
+
+	{Code: ConversationNotFoundCode, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Errors: []error{ErrConversationNotFound}},
+	{Code: ConversationDecodeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument},
+	{Code: ConversationNoPeerCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrConversationNoPeer}},
+	{Code: MessageDecodeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument},
+	{Code: MessageEmptyCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrMessageEmpty}},
+	{Code: MessageThrottledCode, HTTPStatus: http.StatusTooManyRequests, GRPCCode: codes.ResourceExhausted, Retryable: true, Errors: []error{ErrMessageThrottled}},
+
+	{Code: SearchQueryEmptyCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrSearchQueryEmpty}},
+	{Code: SearchTooDeepCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.OutOfRange, Errors: []error{ErrSearchTooDeep}},
+
+	{Code: GeoInvalidPointCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrGeoInvalidPoint}},
+	{Code: GeoInvalidRadiusCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrGeoInvalidRadius}},
+	{Code: GeoNearRequiredCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrGeoNearRequired}},
+
+	{Code: MediaNotFoundCode, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Errors: []error{ErrMediaNotFound, ErrBlobNotFound}},
+	{Code: MediaDecodeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument},
+	{Code: MediaForbiddenCode, HTTPStatus: http.StatusForbidden, GRPCCode: codes.PermissionDenied, Errors: []error{ErrMediaForbidden}},
+	{Code: MediaAttachedCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrMediaAttached}},
+	{Code: MediaTooLargeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrMediaTooLarge}},
+	{Code: MediaUnsupportedTypeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrMediaUnsupportedType}},
+	{Code: MediaInvalidImageCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrMediaInvalidImage}},
+	{Code: MediaFileRequiredCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrMediaFileRequired}},
+	{Code: SignedURLExpiredCode, HTTPStatus: http.StatusForbidden, GRPCCode: codes.PermissionDenied, Errors: []error{ErrSignedURLExpired}},
+	{Code: SignedURLInvalidCode, HTTPStatus: http.StatusForbidden, GRPCCode: codes.PermissionDenied, Errors: []error{ErrSignedURLInvalid, ErrBlobInvalidKey}},
+
+	{Code: NotificationPreferencesDecodeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument},
+	{Code: NotificationPreferencesInvalidCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrNotificationPreferencesInvalid}},
+	{Code: NotificationForbiddenCode, HTTPStatus: http.StatusForbidden, GRPCCode: codes.PermissionDenied, Errors: []error{ErrNotificationForbidden}},
+	{Code: DeviceTokenDecodeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument},
+	{Code: DeviceTokenNotFoundCode, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Errors: []error{ErrDeviceTokenNotFound}},
+	{Code: InboxNotificationNotFoundCode, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Errors: []error{ErrInboxNotificationNotFound}},
+
+	// Несбалансированная транзакция означает ошибку в коде и остается внутренней ошибкой.
+	{Code: WalletTransferDecodeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument},
+	{Code: WalletGrantDecodeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument},
+	{Code: LedgerForbiddenCode, HTTPStatus: http.StatusForbidden, GRPCCode: codes.PermissionDenied, Errors: []error{ErrLedgerForbidden}},
+	{Code: LedgerInvalidTransactionCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrLedgerInvalidTransaction}},
+	{Code: LedgerInsufficientFundsCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrLedgerInsufficientFunds}},
+	{Code: LedgerBalanceOverflowCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.OutOfRange, Errors: []error{ErrLedgerBalanceOverflow}},
+	{Code: LedgerConflictCode, HTTPStatus: http.StatusConflict, GRPCCode: codes.Aborted, Retryable: true, Errors: []error{ErrLedgerConflict}},
+
+	{Code: PaymentNotFoundCode, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Errors: []error{ErrPaymentNotFound}},
+	{Code: PaymentWebhookSignatureCode, HTTPStatus: http.StatusUnauthorized, GRPCCode: codes.Unauthenticated, Errors: []error{ErrPaymentWebhookSignature}},
+	{Code: PaymentWebhookDecodeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrPaymentWebhookDecode}},
+	{Code: PaymentDeclinedCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrPaymentDeclined}},
+	{Code: PaymentTransitionCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrPaymentTransition}},
+	{Code: PaymentRefundExceedsCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrPaymentRefundExceeds}},
+	{Code: PaymentInvalidRefundCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrPaymentInvalidRefund}},
+	{Code: PaymentConflictCode, HTTPStatus: http.StatusConflict, GRPCCode: codes.Aborted, Retryable: true, Errors: []error{ErrPaymentConflict}},
+	{Code: PaymentSettlementBusyCode, HTTPStatus: http.StatusConflict, GRPCCode: codes.Aborted, Retryable: true, Errors: []error{ErrPaymentSettlementBusy}},
+	{Code: PaymentAlreadyExistsCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.AlreadyExists, Errors: []error{ErrPaymentAlreadyExists}},
+	{Code: PaymentNotAllowedCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrPaymentNotAllowed}},
+	{Code: PaymentForbiddenCode, HTTPStatus: http.StatusForbidden, GRPCCode: codes.PermissionDenied, Errors: []error{ErrPaymentForbidden}},
+
+	{Code: DisputeDecodeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument},
+	{Code: DisputeNotFoundCode, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Errors: []error{ErrDisputeNotFound}},
+	{Code: DisputeAlreadyExistsCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.AlreadyExists, Errors: []error{ErrDisputeAlreadyExists}},
+	{Code: DisputeNotAllowedCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrDisputeNotAllowed}},
+	{Code: DisputeTransitionCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrDisputeTransition}},
+	{Code: DisputeClosedCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrDisputeClosed}},
+	{Code: DisputeForbiddenCode, HTTPStatus: http.StatusForbidden, GRPCCode: codes.PermissionDenied, Errors: []error{ErrDisputeForbidden}},
+	{Code: DisputeConflictCode, HTTPStatus: http.StatusConflict, GRPCCode: codes.Aborted, Retryable: true, Errors: []error{ErrDisputeConflict}},
+	{Code: DisputeEvidenceLimitCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.ResourceExhausted, Errors: []error{ErrDisputeEvidenceLimit}},
+	{Code: DisputeEvidenceExistsCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.AlreadyExists, Errors: []error{ErrDisputeEvidenceExists}},
+	{Code: DisputeInvalidOutcomeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrDisputeInvalidOutcome}},
+
+	{Code: AuditForbiddenCode, HTTPStatus: http.StatusForbidden, GRPCCode: codes.PermissionDenied, Errors: []error{ErrAuditForbidden}},
+	{Code: AuditInvalidPeriodCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrAuditInvalidPeriod}},
+
+	{Code: ModerationDecodeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument},
+	{Code: ModerationCaseNotFoundCode, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Errors: []error{ErrModerationCaseNotFound}},
+	{Code: ModerationCaseClosedCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrModerationCaseClosed}},
+	{Code: ModerationConflictCode, HTTPStatus: http.StatusConflict, GRPCCode: codes.Aborted, Retryable: true, Errors: []error{ErrModerationConflict}},
+	{Code: ModerationReportExistsCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.AlreadyExists, Errors: []error{ErrModerationReportExists}},
+	{Code: ModerationForbiddenCode, HTTPStatus: http.StatusForbidden, GRPCCode: codes.PermissionDenied, Errors: []error{ErrModerationForbidden}},
+	{Code: ModerationBannedCode, HTTPStatus: http.StatusForbidden, GRPCCode: codes.PermissionDenied, Errors: []error{ErrModerationBanned}},
+
+	{Code: BulkFormatCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrBulkFormat}},
+	{Code: BulkForbiddenCode, HTTPStatus: http.StatusForbidden, GRPCCode: codes.PermissionDenied, Errors: []error{ErrBulkForbidden}},
+	{Code: BulkInvalidPeriodCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrBulkInvalidPeriod}},
+	{Code: ImportRunNotFoundCode, HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound, Errors: []error{ErrImportRunNotFound}},
+	{Code: ImportRunMismatchCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition, Errors: []error{ErrImportRunMismatch}},
+	{Code: ImportRowDecodeCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrImportRowDecode}},
+
+	{Code: CurrencyNotAllowedCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrCurrencyNotAllowed, ErrCurrencyUnknown}},
+	{Code: MoneyCurrencyMismatchCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrMoneyCurrencyMismatch}},
+
+	{Code: InvalidObjectIDCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrInvalidObjectID}},
+	{Code: RequestInvalidCode, HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument, Errors: []error{ErrRequestInvalid}},
+}
@@ -0,0 +1,142 @@
+package entity
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+// TestErrorRegistry_Complete проверяет, что каждый код ошибки из errors.go описан в реестре ровно один раз.
+func TestErrorRegistry_Complete(t *testing.T) {
+	t.Parallel()
+
+	registered := make(map[string]int)
+	for _, spec := range ErrorSpecs() {
+		registered[spec.Code]++
+
+		assert.NotZero(t, spec.HTTPStatus, "нет HTTP статуса для кода %s", spec.Code)
+	}
+
+	for _, code := range errorCodes(t) {
+		assert.Equal(t, 1, registered[code], "код %s должен быть описан в реестре один раз", code)
+	}
+}
+
+// TestErrorRegistry_Transports проверяет, что HTTP статус и код gRPC каждой ошибки означают одно и то же.
+func TestErrorRegistry_Transports(t *testing.T) {
+	t.Parallel()
+
+	// Допустимые HTTP статусы для кода gRPC. Ошибки, после которых повтор может пройти,
+	// должны отдаваться статусом, который клиент HTTP тоже может повторить.
+	statuses := map[codes.Code][]int{
+		codes.InvalidArgument:    {http.StatusBadRequest},
+		codes.FailedPrecondition: {http.StatusBadRequest},
+		codes.OutOfRange:         {http.StatusBadRequest},
+		codes.AlreadyExists:      {http.StatusBadRequest, http.StatusConflict},
+		codes.ResourceExhausted:  {http.StatusBadRequest, http.StatusTooManyRequests},
+		codes.NotFound:           {http.StatusNotFound},
+		codes.Unauthenticated:    {http.StatusUnauthorized},
+		codes.PermissionDenied:   {http.StatusForbidden},
+		codes.Aborted:            {http.StatusConflict},
+		codes.Internal:           {http.StatusInternalServerError},
+	}
+
+	for _, spec := range ErrorSpecs() {
+		assert.Contains(t, statuses[spec.GRPCCode], spec.HTTPStatus,
+			"код %s: HTTP %d не соответствует gRPC %s", spec.Code, spec.HTTPStatus, spec.GRPCCode)
+
+		if spec.Retryable {
+			assert.Contains(t, []int{http.StatusConflict, http.StatusTooManyRequests}, spec.HTTPStatus,
+				"код %s: повторяемая ошибка отдается статусом %d", spec.Code, spec.HTTPStatus)
+		}
+	}
+}
+
+func TestLookupError(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		err        error
+		code       string
+		httpStatus int
+		grpcCode   codes.Code
+		retryable  bool
+	}{
+		{
+			name:       "заказ не найден",
+			err:        fmt.Errorf("получение заказа: %w", ErrOrderNotFound),
+			code:       OrderNotFoundCode,
+			httpStatus: http.StatusNotFound,
+			grpcCode:   codes.NotFound,
+		},
+		{
+			name:       "неверный идентификатор объявления",
+			err:        fmt.Errorf("%w: encoding/hex: invalid byte", ErrInvalidObjectID),
+			code:       InvalidObjectIDCode,
+			httpStatus: http.StatusBadRequest,
+			grpcCode:   codes.InvalidArgument,
+		},
+		{
+			name:       "неверная страница",
+			err:        fmt.Errorf("%w: strconv.Atoi", ErrPageInvalidPage),
+			code:       PageInvalidPageCode,
+			httpStatus: http.StatusBadRequest,
+			grpcCode:   codes.InvalidArgument,
+		},
+		{
+			name:       "параллельное изменение",
+			err:        ErrPaymentConflict,
+			code:       PaymentConflictCode,
+			httpStatus: http.StatusConflict,
+			grpcCode:   codes.Aborted,
+			retryable:  true,
+		},
+		{
+			name:       "нет прав",
+			err:        fmt.Errorf("%w: принять предложение может только получатель", ErrTradeOfferForbidden),
+			code:       TradeOfferForbiddenCode,
+			httpStatus: http.StatusForbidden,
+			grpcCode:   codes.PermissionDenied,
+		},
+		{
+			name:       "слишком частые сообщения",
+			err:        ErrMessageThrottled,
+			code:       MessageThrottledCode,
+			httpStatus: http.StatusTooManyRequests,
+			grpcCode:   codes.ResourceExhausted,
+			retryable:  true,
+		},
+		{
+			name:       "ошибка сущности важнее неверного идентификатора",
+			err:        fmt.Errorf("%w: %w", ErrListingNotFound, ErrInvalidObjectID),
+			code:       ListingNotFoundCode,
+			httpStatus: http.StatusNotFound,
+			grpcCode:   codes.NotFound,
+		},
+		{
+			name:       "ошибка вне реестра",
+			err:        ErrLedgerUnbalanced,
+			code:       InternalCode,
+			httpStatus: http.StatusInternalServerError,
+			grpcCode:   codes.Internal,
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec := LookupError(s.err)
+			assert.Equal(t, s.code, spec.Code)
+			assert.Equal(t, s.httpStatus, spec.HTTPStatus)
+			assert.Equal(t, s.grpcCode, spec.GRPCCode)
+			assert.Equal(t, s.retryable, spec.Retryable)
+		})
+	}
+}
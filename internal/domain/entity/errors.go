@@ -20,13 +20,147 @@ var (
 	ErrTopicNotFound = errors.New("топик не найден")
 	ErrTopicsLength  = errors.New("неверное количество топиков")
 
-	ErrNilPointer   = errors.New("значение не может быть nil")
-	ErrUserNotFound = errors.New("пользователь не найден")
-	ErrUserIDEmpty  = errors.New("идентификатор пуст")
-	ErrUserDecode   = errors.New("ошибка декодирования пользователя")
+	ErrConsumerNotConfigured = errors.New("консюмер не настроен")
 
-	ErrOrderDecode   = errors.New("ошибка декодирования заказа")
-	ErrOrderNotFound = errors.New("заказ не найден")
+	ErrNilPointer       = errors.New("значение не может быть nil")
+	ErrRequestInvalid   = errors.New("запрос не соответствует документации API")
+	ErrUserNotFound     = errors.New("пользователь не найден")
+	ErrUserIDEmpty      = errors.New("идентификатор пуст")
+	ErrUserDecode       = errors.New("ошибка декодирования пользователя")
+	ErrUserUpdateDecode = errors.New("ошибка декодирования обновления пользователя")
+
+	ErrOrderDecode      = errors.New("ошибка декодирования заказа")
+	ErrOrderNotFound    = errors.New("заказ не найден")
+	ErrOrderInvalidCost = errors.New("стоимость заказа должна быть больше нуля")
+
+	ErrOrderStatusTransition = errors.New("недопустимый переход статуса заказа")
+	ErrOrderStatusConflict   = errors.New("статус заказа был изменен параллельно")
+	ErrOrderTradeManaged     = errors.New("статус заказа обмена меняется через предложение обмена")
+
+	ErrListingNotFound         = errors.New("объявление не найдено")
+	ErrListingDecode           = errors.New("ошибка декодирования объявления")
+	ErrListingNotEditable      = errors.New("объявление участвует в сделке и не может быть изменено")
+	ErrListingInvalidValuation = errors.New("оценка объявления должна быть больше нуля")
+	ErrListingConflict         = errors.New("объявление было изменено параллельно")
+
+	ErrTradeOfferNotFound            = errors.New("предложение обмена не найдено")
+	ErrTradeOfferDecode              = errors.New("ошибка декодирования предложения обмена")
+	ErrTradeOfferTransition          = errors.New("недопустимый переход статуса предложения обмена")
+	ErrTradeOfferConflict            = errors.New("предложение обмена было изменено параллельно")
+	ErrTradeOfferForbidden           = errors.New("действие недоступно этому участнику обмена")
+	ErrTradeOfferSelf                = errors.New("нельзя предложить обмен самому себе")
+	ErrTradeOfferListingOwner        = errors.New("объявление принадлежит другому пользователю")
+	ErrTradeOfferListingUnavailable  = errors.New("объявление недоступно для обмена")
+	ErrTradeOfferRecipientsDifferent = errors.New("запрошенные объявления принадлежат разным пользователям")
+	ErrTradeOfferInvalidCash         = errors.New("сумма доплаты должна быть больше нуля")
+
+	ErrTradeCycleNotFound           = errors.New("цикл обмена не найден")
+	ErrTradeCycleTransition         = errors.New("недопустимый переход статуса цикла обмена")
+	ErrTradeCycleConflict           = errors.New("цикл обмена был изменен параллельно")
+	ErrTradeCycleExists             = errors.New("цикл обмена уже предложен")
+	ErrTradeCycleListingUnavailable = errors.New("объявление цикла обмена недоступно")
+	ErrListingEventDecode           = errors.New("ошибка декодирования события объявления")
+
+	ErrWishlistItemNotFound = errors.New("позиция списка желаний не найдена")
+	ErrWishlistForbidden    = errors.New("список желаний принадлежит другому пользователю")
+	ErrWishlistLimit        = errors.New("превышено количество позиций в списке желаний")
+	ErrWishlistQueryEmpty   = errors.New("запрос не содержит значимых слов")
+	ErrWishlistMatchExists  = errors.New("пользователь уже уведомлен об объявлении")
+
+	ErrRatingExists            = errors.New("заказ уже оценен")
+	ErrRatingOrderNotCompleted = errors.New("оценить можно только завершенный заказ")
+	ErrRatingNoCounterparty    = errors.New("у заказа нет второй стороны")
+
+	ErrConversationNotFound = errors.New("переписка не найдена")
+	ErrConversationExists   = errors.New("переписка по сделке уже существует")
+	ErrConversationNoPeer   = errors.New("у сделки нет второй стороны")
+	ErrMessageEmpty         = errors.New("сообщение не содержит ни текста, ни вложений")
+	ErrMessageThrottled     = errors.New("слишком много сообщений, попробуйте позже")
+
+	ErrSearchQueryEmpty = errors.New("запрос не содержит слов для поиска")
+	ErrSearchTooDeep    = errors.New("слишком далекая страница результатов поиска")
+
+	ErrGeoInvalidPoint  = errors.New("неверные координаты точки")
+	ErrGeoInvalidRadius = errors.New("неверный радиус поиска")
+	ErrGeoNearRequired  = errors.New("для сортировки по расстоянию нужна точка near")
+	ErrGeoPointDecode   = errors.New("ошибка декодирования точки")
+
+	ErrMediaNotFound        = errors.New("файл не найден")
+	ErrMediaForbidden       = errors.New("файл принадлежит другому пользователю")
+	ErrMediaAttached        = errors.New("файл уже прикреплен к другой сущности")
+	ErrMediaTooLarge        = errors.New("файл превышает допустимый размер")
+	ErrMediaUnsupportedType = errors.New("тип файла не поддерживается")
+	ErrMediaInvalidImage    = errors.New("файл не является корректным изображением")
+	ErrMediaFileRequired    = errors.New("файл не передан")
+	ErrBlobNotFound         = errors.New("файл не найден в хранилище")
+	ErrBlobInvalidKey       = errors.New("неверный ключ файла в хранилище")
+	ErrSignedURLExpired     = errors.New("срок действия ссылки истек")
+	ErrSignedURLInvalid     = errors.New("неверная подпись ссылки")
+
+	ErrNotificationTemplateNotFound    = errors.New("нет шаблона для типа уведомления")
+	ErrNotificationPreferencesNotFound = errors.New("настройки уведомлений не найдены")
+	ErrNotificationPreferencesInvalid  = errors.New("неверные настройки уведомлений")
+	ErrNotificationForbidden           = errors.New("настройки уведомлений доступны только владельцу")
+	ErrDeviceTokenNotFound             = errors.New("устройство не найдено")
+	ErrInboxNotificationNotFound       = errors.New("уведомление не найдено")
+	ErrNotificationCountNotCached      = errors.New("количество непрочитанных уведомлений не в кэше")
+	ErrNotificationQueueFull           = errors.New("очередь уведомлений заполнена")
+
+	ErrLedgerAccountNotFound    = errors.New("счет кредитов не найден")
+	ErrLedgerInvalidTransaction = errors.New("неверная транзакция кредитов")
+	ErrLedgerUnbalanced         = errors.New("сумма проводок транзакции не равна нулю")
+	ErrLedgerInsufficientFunds  = errors.New("недостаточно кредитов на счете")
+	ErrLedgerBalanceOverflow    = errors.New("переполнение баланса кредитов")
+	ErrLedgerConflict           = errors.New("счет кредитов был изменен параллельно")
+	ErrLedgerForbidden          = errors.New("начисление кредитов доступно только администраторам")
+
+	ErrPaymentDeclined         = errors.New("платеж отклонен")
+	ErrPaymentNotFound         = errors.New("платеж не найден")
+	ErrPaymentTransition       = errors.New("недопустимый переход статуса платежа")
+	ErrPaymentRefundExceeds    = errors.New("сумма возврата превышает сумму, доступную к возврату")
+	ErrPaymentInvalidRefund    = errors.New("сумма возврата должна быть больше нуля")
+	ErrPaymentConflict         = errors.New("платеж был изменен параллельно")
+	ErrPaymentAlreadyExists    = errors.New("заказ уже оплачен")
+	ErrPaymentNotAllowed       = errors.New("заказ не требует оплаты")
+	ErrPaymentForbidden        = errors.New("оплатить заказ может только покупатель")
+	ErrPaymentWebhookSignature = errors.New("неверная подпись уведомления платежного провайдера")
+	ErrPaymentWebhookDecode    = errors.New("ошибка декодирования уведомления платежного провайдера")
+	ErrPaymentSettlementBusy   = errors.New("по платежу уже проводится операция")
+
+	ErrDisputeNotFound       = errors.New("спор не найден")
+	ErrDisputeAlreadyExists  = errors.New("по заказу уже открыт спор")
+	ErrDisputeNotAllowed     = errors.New("по заказу нельзя открыть спор")
+	ErrDisputeTransition     = errors.New("недопустимый переход статуса спора")
+	ErrDisputeClosed         = errors.New("спор закрыт")
+	ErrDisputeForbidden      = errors.New("спор доступен только сторонам и модераторам")
+	ErrDisputeConflict       = errors.New("спор был изменен параллельно")
+	ErrDisputeEvidenceLimit  = errors.New("превышено количество доказательств в споре")
+	ErrDisputeEvidenceExists = errors.New("файл уже приложен к спору")
+	ErrDisputeInvalidOutcome = errors.New("неверное решение по спору")
+
+	ErrAuditForbidden     = errors.New("журнал изменений доступен только администраторам")
+	ErrAuditInvalidPeriod = errors.New("неверный период журнала изменений")
+
+	ErrModerationCaseNotFound = errors.New("дело модерации не найдено")
+	ErrModerationCaseClosed   = errors.New("по делу модерации уже принято решение")
+	ErrModerationConflict     = errors.New("дело модерации было изменено параллельно")
+	ErrModerationReportExists = errors.New("жалоба на этот текст уже отправлена")
+	ErrModerationForbidden    = errors.New("модерация доступна только администраторам")
+	ErrModerationBanned       = errors.New("автор заблокирован модератором")
+
+	ErrBulkFormat        = errors.New("неподдерживаемый формат импорта или экспорта")
+	ErrBulkForbidden     = errors.New("массовый импорт и экспорт доступны только администраторам")
+	ErrBulkInvalidPeriod = errors.New("неверный период экспорта")
+	ErrImportRunNotFound = errors.New("запуск импорта не найден")
+	ErrImportRunMismatch = errors.New("запуск импорта относится к другим сущностям или администратору")
+	ErrImportRowDecode   = errors.New("ошибка разбора строки импорта")
+	ErrImportRowExists   = errors.New("строка импорта уже сохранена")
+
+	ErrCurrencyUnknown       = errors.New("неизвестная валюта")
+	ErrCurrencyNotAllowed    = errors.New("валюта не поддерживается")
+	ErrMoneyCurrencyMismatch = errors.New("суммы в разных валютах")
+	ErrMoneyOverflow         = errors.New("переполнение денежной суммы")
+	ErrMoneyDecode           = errors.New("ошибка декодирования денежной суммы")
 
 	ErrPageInvalidLimit = errors.New("неверное значение лимита")
 	ErrPageInvalidPage  = errors.New("неверное значение страницы")
@@ -41,15 +175,142 @@ var (
 // NOT_FOUND - описание ошибки
 
 const (
-	InternalCode = "TMP_INTERNAL" // Внутренняя ошибка сервера
+	InternalCode        = "TMP_INTERNAL"          // Внутренняя ошибка сервера
+	InvalidObjectIDCode = "TMP_INVALID_OBJECT_ID" // Неверный идентификатор объекта
+	RequestInvalidCode  = "TMP_REQUEST_INVALID"   // Запрос не соответствует документации API
 
 	UserNotFoundCode = "TMP_USER_NOT_FOUND" // Пользователь не найден
 	UserIDEmptyCode  = "TMP_USER_ID_EMPTY"  // Идентификатор пуст
 	UserDecodeCode   = "TMP_USER_DECODE"    // Ошибка декодирования пользователя
 
-	OrderDecodeCode   = "TMP_ORDER_DECODE"    // Ошибка декодирования заказа
-	OrderNotFoundCode = "TMP_ORDER_NOT_FOUND" // Ошибка декодирования заказа
+	OrderDecodeCode      = "TMP_ORDER_DECODE"       // Ошибка декодирования заказа
+	OrderNotFoundCode    = "TMP_ORDER_NOT_FOUND"    // Ошибка декодирования заказа
+	OrderInvalidCostCode = "TMP_ORDER_INVALID_COST" // Стоимость заказа должна быть больше нуля
+
+	OrderStatusTransitionCode = "TMP_ORDER_STATUS_TRANSITION" // Недопустимый переход статуса заказа
+	OrderStatusConflictCode   = "TMP_ORDER_STATUS_CONFLICT"   // Статус заказа был изменен параллельно
+	OrderTradeManagedCode     = "TMP_ORDER_TRADE_MANAGED"     // Статус заказа обмена меняется через предложение обмена
+
+	ListingNotFoundCode         = "TMP_LISTING_NOT_FOUND"         // Объявление не найдено
+	ListingDecodeCode           = "TMP_LISTING_DECODE"            // Ошибка декодирования объявления
+	ListingNotEditableCode      = "TMP_LISTING_NOT_EDITABLE"      // Объявление участвует в сделке
+	ListingInvalidValuationCode = "TMP_LISTING_INVALID_VALUATION" // Оценка объявления должна быть больше нуля
+	ListingConflictCode         = "TMP_LISTING_CONFLICT"          // Объявление было изменено параллельно
+
+	TradeOfferNotFoundCode            = "TMP_TRADE_OFFER_NOT_FOUND"            // Предложение обмена не найдено
+	TradeOfferDecodeCode              = "TMP_TRADE_OFFER_DECODE"               // Ошибка декодирования предложения обмена
+	TradeOfferTransitionCode          = "TMP_TRADE_OFFER_TRANSITION"           // Недопустимый переход статуса предложения обмена
+	TradeOfferConflictCode            = "TMP_TRADE_OFFER_CONFLICT"             // Предложение обмена было изменено параллельно
+	TradeOfferForbiddenCode           = "TMP_TRADE_OFFER_FORBIDDEN"            // Действие недоступно этому участнику обмена
+	TradeOfferSelfCode                = "TMP_TRADE_OFFER_SELF"                 // Нельзя предложить обмен самому себе
+	TradeOfferListingOwnerCode        = "TMP_TRADE_OFFER_LISTING_OWNER"        // Объявление принадлежит другому пользователю
+	TradeOfferListingUnavailableCode  = "TMP_TRADE_OFFER_LISTING_UNAVAILABLE"  // Объявление недоступно для обмена
+	TradeOfferRecipientsDifferentCode = "TMP_TRADE_OFFER_RECIPIENTS_DIFFERENT" // Запрошенные объявления принадлежат разным пользователям
+	TradeOfferInvalidCashCode         = "TMP_TRADE_OFFER_INVALID_CASH"         // Сумма доплаты должна быть больше нуля
+
+	TradeCycleNotFoundCode           = "TMP_TRADE_CYCLE_NOT_FOUND"           // Цикл обмена не найден
+	TradeCycleTransitionCode         = "TMP_TRADE_CYCLE_TRANSITION"          // Недопустимый переход статуса цикла обмена
+	TradeCycleConflictCode           = "TMP_TRADE_CYCLE_CONFLICT"            // Цикл обмена был изменен параллельно
+	TradeCycleListingUnavailableCode = "TMP_TRADE_CYCLE_LISTING_UNAVAILABLE" // Объявление цикла обмена недоступно
+
+	WishlistItemNotFoundCode = "TMP_WISHLIST_ITEM_NOT_FOUND" // Позиция списка желаний не найдена
+	WishlistItemDecodeCode   = "TMP_WISHLIST_ITEM_DECODE"    // Ошибка декодирования позиции списка желаний
+	WishlistForbiddenCode    = "TMP_WISHLIST_FORBIDDEN"      // Список желаний принадлежит другому пользователю
+	WishlistLimitCode        = "TMP_WISHLIST_LIMIT"          // Превышено количество позиций в списке желаний
+	WishlistQueryEmptyCode   = "TMP_WISHLIST_QUERY_EMPTY"    // Запрос не содержит значимых слов
+
+	RatingDecodeCode            = "TMP_RATING_DECODE"              // Ошибка декодирования оценки
+	RatingExistsCode            = "TMP_RATING_EXISTS"              // Заказ уже оценен
+	RatingOrderNotCompletedCode = "TMP_RATING_ORDER_NOT_COMPLETED" // Оценить можно только завершенный заказ
+	RatingNoCounterpartyCode    = "TMP_RATING_NO_COUNTERPARTY"     // У заказа нет второй стороны
+
+	ConversationNotFoundCode = "TMP_CONVERSATION_NOT_FOUND" // Переписка не найдена
+	ConversationDecodeCode   = "TMP_CONVERSATION_DECODE"    // Ошибка декодирования переписки
+	ConversationNoPeerCode   = "TMP_CONVERSATION_NO_PEER"   // У сделки нет второй стороны
+	MessageDecodeCode        = "TMP_MESSAGE_DECODE"         // Ошибка декодирования сообщения
+	MessageEmptyCode         = "TMP_MESSAGE_EMPTY"          // Сообщение не содержит ни текста, ни вложений
+	MessageThrottledCode     = "TMP_MESSAGE_THROTTLED"      // Слишком много сообщений
+
+	SearchQueryEmptyCode = "TMP_SEARCH_QUERY_EMPTY" // Запрос не содержит слов для поиска
+	SearchTooDeepCode    = "TMP_SEARCH_TOO_DEEP"    // Слишком далекая страница результатов поиска
+
+	GeoInvalidPointCode  = "TMP_GEO_INVALID_POINT"  // Неверные координаты точки
+	GeoInvalidRadiusCode = "TMP_GEO_INVALID_RADIUS" // Неверный радиус поиска
+	GeoNearRequiredCode  = "TMP_GEO_NEAR_REQUIRED"  // Для сортировки по расстоянию нужна точка near
+
+	MediaNotFoundCode        = "TMP_MEDIA_NOT_FOUND"        // Файл не найден
+	MediaDecodeCode          = "TMP_MEDIA_DECODE"           // Ошибка декодирования формы файла
+	MediaForbiddenCode       = "TMP_MEDIA_FORBIDDEN"        // Файл принадлежит другому пользователю
+	MediaAttachedCode        = "TMP_MEDIA_ATTACHED"         // Файл уже прикреплен к другой сущности
+	MediaTooLargeCode        = "TMP_MEDIA_TOO_LARGE"        // Файл превышает допустимый размер
+	MediaUnsupportedTypeCode = "TMP_MEDIA_UNSUPPORTED_TYPE" // Тип файла не поддерживается
+	MediaInvalidImageCode    = "TMP_MEDIA_INVALID_IMAGE"    // Файл не является корректным изображением
+	MediaFileRequiredCode    = "TMP_MEDIA_FILE_REQUIRED"    // Файл не передан
+	SignedURLExpiredCode     = "TMP_SIGNED_URL_EXPIRED"     // Срок действия ссылки истек
+	SignedURLInvalidCode     = "TMP_SIGNED_URL_INVALID"     // Неверная подпись ссылки
+
+	NotificationPreferencesDecodeCode  = "TMP_NOTIFICATION_PREFERENCES_DECODE"  // Ошибка декодирования настроек уведомлений
+	NotificationPreferencesInvalidCode = "TMP_NOTIFICATION_PREFERENCES_INVALID" // Неверные настройки уведомлений
+	NotificationForbiddenCode          = "TMP_NOTIFICATION_FORBIDDEN"           // Настройки уведомлений доступны только владельцу
+	DeviceTokenDecodeCode              = "TMP_DEVICE_TOKEN_DECODE"              // Ошибка декодирования устройства
+	DeviceTokenNotFoundCode            = "TMP_DEVICE_TOKEN_NOT_FOUND"           // Устройство не найдено
+	InboxNotificationNotFoundCode      = "TMP_INBOX_NOTIFICATION_NOT_FOUND"     // Уведомление не найдено
+
+	WalletTransferDecodeCode     = "TMP_WALLET_TRANSFER_DECODE"     // Ошибка декодирования перевода кредитов
+	WalletGrantDecodeCode        = "TMP_WALLET_GRANT_DECODE"        // Ошибка декодирования начисления кредитов
+	LedgerInvalidTransactionCode = "TMP_LEDGER_INVALID_TRANSACTION" // Неверная транзакция кредитов
+	LedgerInsufficientFundsCode  = "TMP_LEDGER_INSUFFICIENT_FUNDS"  // Недостаточно кредитов на счете
+	LedgerBalanceOverflowCode    = "TMP_LEDGER_BALANCE_OVERFLOW"    // Переполнение баланса кредитов
+	LedgerConflictCode           = "TMP_LEDGER_CONFLICT"            // Счет кредитов был изменен параллельно
+	LedgerForbiddenCode          = "TMP_LEDGER_FORBIDDEN"           // Начисление кредитов доступно только администраторам
+
+	PaymentDeclinedCode         = "TMP_PAYMENT_DECLINED"          // Платеж отклонен
+	PaymentNotFoundCode         = "TMP_PAYMENT_NOT_FOUND"         // Платеж не найден
+	PaymentTransitionCode       = "TMP_PAYMENT_TRANSITION"        // Недопустимый переход статуса платежа
+	PaymentRefundExceedsCode    = "TMP_PAYMENT_REFUND_EXCEEDS"    // Сумма возврата превышает доступную
+	PaymentInvalidRefundCode    = "TMP_PAYMENT_INVALID_REFUND"    // Сумма возврата должна быть больше нуля
+	PaymentConflictCode         = "TMP_PAYMENT_CONFLICT"          // Платеж был изменен параллельно
+	PaymentAlreadyExistsCode    = "TMP_PAYMENT_ALREADY_EXISTS"    // Заказ уже оплачен
+	PaymentNotAllowedCode       = "TMP_PAYMENT_NOT_ALLOWED"       // Заказ не требует оплаты
+	PaymentForbiddenCode        = "TMP_PAYMENT_FORBIDDEN"         // Оплатить заказ может только покупатель
+	PaymentWebhookSignatureCode = "TMP_PAYMENT_WEBHOOK_SIGNATURE" // Неверная подпись уведомления
+	PaymentWebhookDecodeCode    = "TMP_PAYMENT_WEBHOOK_DECODE"    // Ошибка декодирования уведомления
+	PaymentSettlementBusyCode   = "TMP_PAYMENT_SETTLEMENT_BUSY"   // По платежу уже проводится операция
+
+	DisputeDecodeCode         = "TMP_DISPUTE_DECODE"          // Ошибка декодирования спора
+	DisputeNotFoundCode       = "TMP_DISPUTE_NOT_FOUND"       // Спор не найден
+	DisputeAlreadyExistsCode  = "TMP_DISPUTE_ALREADY_EXISTS"  // По заказу уже открыт спор
+	DisputeNotAllowedCode     = "TMP_DISPUTE_NOT_ALLOWED"     // По заказу нельзя открыть спор
+	DisputeTransitionCode     = "TMP_DISPUTE_TRANSITION"      // Недопустимый переход статуса спора
+	DisputeClosedCode         = "TMP_DISPUTE_CLOSED"          // Спор закрыт
+	DisputeForbiddenCode      = "TMP_DISPUTE_FORBIDDEN"       // Спор доступен только сторонам и модераторам
+	DisputeConflictCode       = "TMP_DISPUTE_CONFLICT"        // Спор был изменен параллельно
+	DisputeEvidenceLimitCode  = "TMP_DISPUTE_EVIDENCE_LIMIT"  // Превышено количество доказательств
+	DisputeEvidenceExistsCode = "TMP_DISPUTE_EVIDENCE_EXISTS" // Файл уже приложен к спору
+	DisputeInvalidOutcomeCode = "TMP_DISPUTE_INVALID_OUTCOME" // Неверное решение по спору
+
+	AuditForbiddenCode     = "TMP_AUDIT_FORBIDDEN"      // Журнал изменений доступен только администраторам
+	AuditInvalidPeriodCode = "TMP_AUDIT_INVALID_PERIOD" // Неверный период журнала изменений
+
+	ModerationDecodeCode       = "TMP_MODERATION_DECODE"         // Ошибка декодирования жалобы или решения
+	ModerationCaseNotFoundCode = "TMP_MODERATION_CASE_NOT_FOUND" // Дело модерации не найдено
+	ModerationCaseClosedCode   = "TMP_MODERATION_CASE_CLOSED"    // По делу модерации уже принято решение
+	ModerationConflictCode     = "TMP_MODERATION_CONFLICT"       // Дело модерации было изменено параллельно
+	ModerationReportExistsCode = "TMP_MODERATION_REPORT_EXISTS"  // Жалоба на этот текст уже отправлена
+	ModerationForbiddenCode    = "TMP_MODERATION_FORBIDDEN"      // Модерация доступна только администраторам
+	ModerationBannedCode       = "TMP_MODERATION_BANNED"         // Автор заблокирован модератором
+
+	BulkFormatCode        = "TMP_BULK_FORMAT"          // Неподдерживаемый формат импорта или экспорта
+	BulkForbiddenCode     = "TMP_BULK_FORBIDDEN"       // Массовый импорт и экспорт доступны только администраторам
+	BulkInvalidPeriodCode = "TMP_BULK_INVALID_PERIOD"  // Неверный период экспорта
+	ImportRunNotFoundCode = "TMP_IMPORT_RUN_NOT_FOUND" // Запуск импорта не найден
+	ImportRunMismatchCode = "TMP_IMPORT_RUN_MISMATCH"  // Запуск импорта относится к другим сущностям
+	ImportRowDecodeCode   = "TMP_IMPORT_ROW_DECODE"    // Ошибка разбора строки импорта
+
+	CurrencyNotAllowedCode    = "TMP_CURRENCY_NOT_ALLOWED"    // Валюта не поддерживается
+	MoneyCurrencyMismatchCode = "TMP_MONEY_CURRENCY_MISMATCH" // Суммы в разных валютах
 
 	PageInvalidLimitCode = "TMP_PAGE_INVALID_LIMIT" // Неверное значение лимита
+	PageInvalidPageCode  = "TMP_PAGE_INVALID_PAGE"  // Неверное значение страницы
 	PageInvalidStateCode = "TMP_PAGE_INVALID_STATE" // Неверное состояние страницы
 )
@@ -0,0 +1,121 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// earthRadiusKm средний радиус Земли в километрах.
+const earthRadiusKm = 6371.0
+
+// kmPerDegree длина одного градуса широты в километрах.
+const kmPerDegree = math.Pi * earthRadiusKm / 180
+
+// GeoPoint географическая точка. В MongoDB хранится точкой GeoJSON.
+type GeoPoint struct {
+	Lat float64 `json:"lat" db:"lat" validate:"min=-90,max=90" example:"43.238949"`   // Широта
+	Lon float64 `json:"lon" db:"lon" validate:"min=-180,max=180" example:"76.889709"` // Долгота
+}
+
+// ParseGeoPoint разбирает точку из строки вида «широта,долгота».
+func ParseGeoPoint(s string) (GeoPoint, error) {
+	lat, lon, ok := strings.Cut(s, ",")
+	if !ok {
+		return GeoPoint{}, fmt.Errorf("%w: ожидается «широта,долгота»", ErrGeoInvalidPoint)
+	}
+
+	var (
+		p   GeoPoint
+		err error
+	)
+
+	if p.Lat, err = strconv.ParseFloat(strings.TrimSpace(lat), 64); err != nil {
+		return GeoPoint{}, fmt.Errorf("%w: %s", ErrGeoInvalidPoint, err.Error())
+	}
+
+	if p.Lon, err = strconv.ParseFloat(strings.TrimSpace(lon), 64); err != nil {
+		return GeoPoint{}, fmt.Errorf("%w: %s", ErrGeoInvalidPoint, err.Error())
+	}
+
+	if !p.IsValid() {
+		return GeoPoint{}, fmt.Errorf("%w: координаты вне допустимого диапазона", ErrGeoInvalidPoint)
+	}
+
+	return p, nil
+}
+
+// IsValid находятся ли координаты в допустимом диапазоне.
+func (p GeoPoint) IsValid() bool {
+	return p.Lat >= -90 && p.Lat <= 90 && p.Lon >= -180 && p.Lon <= 180
+}
+
+// DistanceKm возвращает расстояние до точки по поверхности Земли в километрах (формула гаверсинусов).
+func (p GeoPoint) DistanceKm(to GeoPoint) float64 {
+	lat1 := p.Lat * math.Pi / 180
+	lat2 := to.Lat * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (to.Lon - p.Lon) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// RoundedDistanceKm возвращает расстояние до точки в километрах, округленное до 10 метров.
+func (p GeoPoint) RoundedDistanceKm(to GeoPoint) float64 {
+	return math.Round(p.DistanceKm(to)*100) / 100
+}
+
+// BSON
+
+// geoJSONPoint представление GeoPoint в MongoDB: точка GeoJSON для индекса 2dsphere.
+type geoJSONPoint struct {
+	Type        string     `bson:"type"`
+	Coordinates [2]float64 `bson:"coordinates"` // Долгота и широта, именно в таком порядке
+}
+
+// legacyGeoPoint старое представление GeoPoint в MongoDB.
+type legacyGeoPoint struct {
+	Lat float64 `bson:"lat"`
+	Lon float64 `bson:"lon"`
+}
+
+// MarshalBSONValue сериализует точку в GeoJSON.
+func (p GeoPoint) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bson.MarshalValue(geoJSONPoint{Type: "Point", Coordinates: [2]float64{p.Lon, p.Lat}})
+}
+
+// UnmarshalBSONValue десериализует точку из MongoDB.
+// Поддерживает старый формат, в котором точка хранилась документом {lat, lon}.
+func (p *GeoPoint) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if t != bsontype.EmbeddedDocument {
+		return fmt.Errorf("%w: неожиданный тип %s", ErrGeoPointDecode, t)
+	}
+
+	raw := bson.RawValue{Type: t, Value: data}
+
+	if _, err := raw.Document().LookupErr("coordinates"); err != nil {
+		var doc legacyGeoPoint
+		if err = raw.Unmarshal(&doc); err != nil {
+			return fmt.Errorf("%w: %s", ErrGeoPointDecode, err.Error())
+		}
+
+		*p = GeoPoint(doc)
+
+		return nil
+	}
+
+	var doc geoJSONPoint
+	if err := raw.Unmarshal(&doc); err != nil {
+		return fmt.Errorf("%w: %s", ErrGeoPointDecode, err.Error())
+	}
+
+	*p = GeoPoint{Lat: doc.Coordinates[1], Lon: doc.Coordinates[0]}
+
+	return nil
+}
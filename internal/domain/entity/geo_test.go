@@ -0,0 +1,124 @@
+package entity
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestParseGeoPoint(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name  string
+		input string
+		exp   GeoPoint
+		err   error
+	}{
+		{name: "valid", input: "43.238, 76.945", exp: GeoPoint{Lat: 43.238, Lon: 76.945}},
+		{name: "no comma", input: "43.238", err: ErrGeoInvalidPoint},
+		{name: "not a number", input: "north,76.945", err: ErrGeoInvalidPoint},
+		{name: "latitude out of range", input: "91,76.945", err: ErrGeoInvalidPoint},
+		{name: "longitude out of range", input: "43.238,181", err: ErrGeoInvalidPoint},
+	}
+
+	for _, s := range cases {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			point, err := ParseGeoPoint(s.input)
+			if s.err != nil {
+				assert.ErrorIs(t, err, s.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, s.exp, point)
+		})
+	}
+}
+
+func TestGeoPoint_Geohash(t *testing.T) {
+	t.Parallel()
+
+	point := GeoPoint{Lat: 57.64911, Lon: 10.40744}
+
+	assert.Equal(t, "u4pruydqqvj", point.Geohash(11))
+	assert.Equal(t, "u4pru", point.Geohash(5))
+	assert.Len(t, point.Geohash(MaxGeohashPrecision+5), MaxGeohashPrecision)
+}
+
+func TestGeohashCover(t *testing.T) {
+	t.Parallel()
+
+	centers := []GeoPoint{
+		{Lat: 43.238, Lon: 76.945},
+		{Lat: -33.868, Lon: 151.209},
+		{Lat: 0.001, Lon: 179.999}, // на стыке долгот
+		{Lat: 64.2, Lon: -51.7},
+	}
+
+	for _, center := range centers {
+		for _, radius := range []float64{0.5, 3, 25} {
+			cover := GeohashCover(center, radius, 6)
+			require.NotEmpty(t, cover)
+
+			// Точки на границе круга должны попадать в одну из ячеек покрытия.
+			for angle := 0.0; angle < 2*math.Pi; angle += math.Pi / 8 {
+				point := GeoPoint{
+					Lat: center.Lat + radius*0.999/kmPerDegree*math.Sin(angle),
+					Lon: wrapLongitude(center.Lon + radius*0.999/(kmPerDegree*math.Cos(center.Lat*math.Pi/180))*math.Cos(angle)),
+				}
+
+				assert.True(t, coveredBy(point, cover), "center %v radius %v point %v", center, radius, point)
+			}
+		}
+	}
+
+	assert.Nil(t, GeohashCover(GeoPoint{}, 10000, 6))
+}
+
+func TestGeoPoint_BSON(t *testing.T) {
+	t.Parallel()
+
+	type document struct {
+		Location *GeoPoint `bson:"location"`
+	}
+
+	point := GeoPoint{Lat: 43.238, Lon: 76.945}
+
+	data, err := bson.Marshal(document{Location: &point})
+	require.NoError(t, err)
+
+	var raw bson.M
+	require.NoError(t, bson.Unmarshal(data, &raw))
+	assert.Equal(t, bson.M{"type": "Point", "coordinates": bson.A{76.945, 43.238}}, raw["location"])
+
+	var decoded document
+	require.NoError(t, bson.Unmarshal(data, &decoded))
+	assert.Equal(t, &point, decoded.Location)
+
+	// старый формат: широта и долгота хранились отдельными полями
+	legacy, err := bson.Marshal(bson.M{"location": bson.M{"lat": 43.238, "lon": 76.945}})
+	require.NoError(t, err)
+
+	require.NoError(t, bson.Unmarshal(legacy, &decoded))
+	assert.Equal(t, &point, decoded.Location)
+}
+
+// coveredBy попадает ли точка в одну из ячеек геохэша.
+func coveredBy(point GeoPoint, cells []string) bool {
+	for _, cell := range cells {
+		if strings.HasPrefix(point.Geohash(len(cell)), cell) {
+			return true
+		}
+	}
+
+	return false
+}
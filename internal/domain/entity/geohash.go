@@ -0,0 +1,128 @@
+package entity
+
+import (
+	"math"
+	"strings"
+)
+
+// Geohash используется для поиска по расстоянию в хранилищах без геоиндексов:
+// точки в одной ячейке имеют общий префикс хэша, а круг поиска покрывается несколькими ячейками.
+
+// MaxGeohashPrecision максимальная длина геохэша. Ячейка такой длины — несколько метров.
+const MaxGeohashPrecision = 12
+
+// geohashAlphabet алфавит base32 геохэша.
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// Geohash возвращает геохэш точки длиной precision символов.
+func (p GeoPoint) Geohash(precision int) string {
+	if precision < 1 {
+		precision = 1
+	}
+
+	if precision > MaxGeohashPrecision {
+		precision = MaxGeohashPrecision
+	}
+
+	latRange := [2]float64{-90, 90}
+	lonRange := [2]float64{-180, 180}
+
+	var b strings.Builder
+
+	even, bit, ch := true, 0, 0
+
+	for b.Len() < precision {
+		// Биты долготы и широты чередуются, начиная с долготы.
+		value, rng := p.Lat, &latRange
+		if even {
+			value, rng = p.Lon, &lonRange
+		}
+
+		mid := (rng[0] + rng[1]) / 2
+		if value >= mid {
+			ch = ch<<1 | 1
+			rng[0] = mid
+		} else {
+			ch <<= 1
+			rng[1] = mid
+		}
+
+		even = !even
+
+		if bit++; bit == 5 {
+			b.WriteByte(geohashAlphabet[ch])
+			bit, ch = 0, 0
+		}
+	}
+
+	return b.String()
+}
+
+// GeohashCover возвращает ячейки геохэша, покрывающие круг радиусом radiusKm вокруг center.
+// Выбирается самая длинная, но не длиннее maxPrecision, ячейка, которая не меньше радиуса: тогда круг
+// покрывают не больше девяти ячеек. Точки внутри ячеек нужно дополнительно проверить по расстоянию.
+// Если круг больше самой крупной ячейки, возвращает nil: проверять нужно все точки.
+func GeohashCover(center GeoPoint, radiusKm float64, maxPrecision int) []string {
+	// Ширина ячейки меньше всего у самой дальней от экватора точки круга.
+	farLat := math.Min(90, math.Abs(center.Lat)+radiusKm/kmPerDegree)
+	cosLat := math.Max(math.Cos(farLat*math.Pi/180), 1e-6)
+
+	precision := 0
+
+	for p := 1; p <= maxPrecision && p <= MaxGeohashPrecision; p++ {
+		latDeg, lonDeg := geohashCellDegrees(p)
+		if latDeg*kmPerDegree < radiusKm || lonDeg*kmPerDegree*cosLat < radiusKm {
+			break
+		}
+
+		precision = p
+	}
+
+	if precision == 0 {
+		return nil
+	}
+
+	dLat := radiusKm / kmPerDegree
+	dLon := math.Min(radiusKm/(kmPerDegree*cosLat), 180)
+
+	seen := make(map[string]struct{}, 9)
+	cells := make([]string, 0, 9)
+
+	// Ячейка не меньше радиуса, поэтому каждая ячейка, задевающая квадрат вокруг круга,
+	// содержит одну из девяти точек: центр, углы и середины сторон квадрата.
+	for _, latOffset := range []float64{-dLat, 0, dLat} {
+		for _, lonOffset := range []float64{-dLon, 0, dLon} {
+			point := GeoPoint{
+				Lat: math.Max(-90, math.Min(90, center.Lat+latOffset)),
+				Lon: wrapLongitude(center.Lon + lonOffset),
+			}
+
+			cell := point.Geohash(precision)
+			if _, ok := seen[cell]; !ok {
+				seen[cell] = struct{}{}
+				cells = append(cells, cell)
+			}
+		}
+	}
+
+	return cells
+}
+
+// geohashCellDegrees возвращает размер ячейки геохэша длиной precision в градусах широты и долготы.
+func geohashCellDegrees(precision int) (latDeg, lonDeg float64) {
+	bits := 5 * precision
+	lonBits := (bits + 1) / 2
+	latBits := bits / 2
+
+	return 180 / math.Pow(2, float64(latBits)), 360 / math.Pow(2, float64(lonBits))
+}
+
+// wrapLongitude приводит долготу к диапазону [-180, 180).
+func wrapLongitude(lon float64) float64 {
+	lon = math.Mod(lon+180, 360)
+	if lon < 0 {
+		lon += 360
+	}
+
+	return lon - 180
+}
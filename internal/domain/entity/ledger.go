@@ -0,0 +1,210 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// LedgerAccountType тип счета кредитов.
+type LedgerAccountType string
+
+// Типы счетов кредитов.
+const (
+	LedgerAccountTypeUser   LedgerAccountType = "user"   // Счет пользователя
+	LedgerAccountTypeSystem LedgerAccountType = "system" // Системный счет
+)
+
+// LedgerGrantsAccountID системный счет, с которого администраторы начисляют кредиты.
+// Баланс счета уходит в минус на сумму всех начислений, поэтому сумма балансов всех счетов всегда равна нулю.
+const LedgerGrantsAccountID = "system:grants"
+
+// LedgerAccount счет кредитов. Баланс хранится снимком и меняется только вместе с проводками.
+type LedgerAccount struct {
+	ID            string            `json:"id" db:"id" bson:"_id"`                                           // Идентификатор счета. Для пользователя совпадает с его идентификатором
+	Type          LedgerAccountType `json:"type" db:"type" bson:"type"`                                      // Тип счета
+	Balance       int64             `json:"balance" db:"balance" bson:"balance" example:"1500"`              // Баланс в кредитах
+	AllowNegative bool              `json:"-" db:"allow_negative" bson:"allow_negative"`                     // Может ли баланс быть отрицательным
+	Version       int64             `json:"-" db:"version" bson:"version"`                                   // Версия для оптимистичной блокировки
+	UpdatedAt     time.Time         `json:"updatedAt,omitempty" db:"updated_at" bson:"updated_at,omitempty"` // Дата последней проводки
+}
+
+// LedgerAccounts список счетов кредитов.
+type LedgerAccounts []*LedgerAccount
+
+// NewLedgerAccount создает пустой счет. Системный счет начислений может уходить в минус, счета пользователей нет.
+func NewLedgerAccount(id string) *LedgerAccount {
+	if id == LedgerGrantsAccountID {
+		return &LedgerAccount{ID: id, Type: LedgerAccountTypeSystem, AllowNegative: true}
+	}
+
+	return &LedgerAccount{ID: id, Type: LedgerAccountTypeUser}
+}
+
+// LedgerTransactionKind тип транзакции кредитов.
+type LedgerTransactionKind string
+
+// Типы транзакций кредитов.
+const (
+	LedgerTransactionKindGrant    LedgerTransactionKind = "grant"    // Начисление администратором
+	LedgerTransactionKindTransfer LedgerTransactionKind = "transfer" // Перевод между пользователями
+	LedgerTransactionKindDispute  LedgerTransactionKind = "dispute"  // Списание по решению спора
+)
+
+// LedgerPosting проводка по счету. Проводки не изменяются и не удаляются.
+type LedgerPosting struct {
+	ID            string                `json:"id" db:"id" bson:"_id"`                                   // Идентификатор проводки
+	TransactionID string                `json:"transactionID" db:"transaction_id" bson:"transaction_id"` // Идентификатор транзакции
+	AccountID     string                `json:"accountID" db:"account_id" bson:"account_id"`             // Идентификатор счета
+	Amount        int64                 `json:"amount" db:"amount" bson:"amount" example:"-500"`         // Сумма. Положительная зачисляет, отрицательная списывает
+	BalanceAfter  int64                 `json:"balanceAfter" db:"balance_after" bson:"balance_after"`    // Баланс счета после проводки
+	Kind          LedgerTransactionKind `json:"kind" db:"kind" bson:"kind"`                              // Тип транзакции
+	Comment       string                `json:"comment,omitempty" db:"comment" bson:"comment,omitempty"` // Комментарий к транзакции
+	CreatedAt     time.Time             `json:"createdAt" db:"created_at" bson:"created_at"`             // Дата проводки
+}
+
+// LedgerPostings список проводок.
+type LedgerPostings []*LedgerPosting
+
+// LedgerTransaction транзакция кредитов из нескольких проводок. Сумма проводок всегда равна нулю.
+type LedgerTransaction struct {
+	ID        string                `json:"id"`                  // Идентификатор транзакции
+	Kind      LedgerTransactionKind `json:"kind"`                // Тип транзакции
+	Comment   string                `json:"comment,omitempty"`   // Комментарий
+	CreatedBy string                `json:"createdBy,omitempty"` // Автор транзакции
+	Postings  LedgerPostings        `json:"postings"`            // Проводки
+	CreatedAt time.Time             `json:"createdAt"`           // Дата транзакции
+}
+
+// NewLedgerTransfer создает транзакцию перевода amount кредитов со счета from на счет to.
+func NewLedgerTransfer(
+	kind LedgerTransactionKind,
+	from, to string,
+	amount int64,
+	comment, createdBy string,
+	currentTime time.Time,
+) (*LedgerTransaction, error) {
+	if from == to {
+		return nil, fmt.Errorf("%w: счета отправителя и получателя совпадают", ErrLedgerInvalidTransaction)
+	}
+
+	if amount <= 0 {
+		return nil, fmt.Errorf("%w: сумма перевода должна быть положительной", ErrLedgerInvalidTransaction)
+	}
+
+	posting := func(accountID string, amount int64) *LedgerPosting {
+		return &LedgerPosting{AccountID: accountID, Amount: amount, Kind: kind, Comment: comment, CreatedAt: currentTime}
+	}
+
+	return &LedgerTransaction{
+		Kind:      kind,
+		Comment:   comment,
+		CreatedBy: createdBy,
+		Postings:  LedgerPostings{posting(from, -amount), posting(to, amount)},
+		CreatedAt: currentTime,
+	}, nil
+}
+
+// Validate проверяет, что в транзакции не меньше двух ненулевых проводок и их сумма равна нулю.
+func (t *LedgerTransaction) Validate() error {
+	if len(t.Postings) < 2 {
+		return fmt.Errorf("%w: нужно не меньше двух проводок", ErrLedgerInvalidTransaction)
+	}
+
+	var sum int64
+
+	for _, posting := range t.Postings {
+		if posting.Amount == 0 {
+			return fmt.Errorf("%w: нулевая проводка по счету %s", ErrLedgerInvalidTransaction, posting.AccountID)
+		}
+
+		next, ok := addInt64(sum, posting.Amount)
+		if !ok {
+			return ErrLedgerBalanceOverflow
+		}
+
+		sum = next
+	}
+
+	if sum != 0 {
+		return fmt.Errorf("%w: %d", ErrLedgerUnbalanced, sum)
+	}
+
+	return nil
+}
+
+// AccountIDs возвращает счета транзакции без повторов в порядке проводок.
+func (t *LedgerTransaction) AccountIDs() []string {
+	ids := make([]string, 0, len(t.Postings))
+	seen := make(map[string]struct{}, len(t.Postings))
+
+	for _, posting := range t.Postings {
+		if _, ok := seen[posting.AccountID]; ok {
+			continue
+		}
+
+		seen[posting.AccountID] = struct{}{}
+		ids = append(ids, posting.AccountID)
+	}
+
+	return ids
+}
+
+// Apply проводит транзакцию по счетам: меняет балансы и версии счетов и заполняет баланс после каждой проводки.
+// Если транзакция нарушает инварианты, ни один счет не меняется.
+func (t *LedgerTransaction) Apply(accounts map[string]*LedgerAccount) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+
+	balances := make(map[string]int64, len(accounts))
+	after := make([]int64, len(t.Postings))
+
+	for i, posting := range t.Postings {
+		account, ok := accounts[posting.AccountID]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrLedgerAccountNotFound, posting.AccountID)
+		}
+
+		balance, ok := balances[account.ID]
+		if !ok {
+			balance = account.Balance
+		}
+
+		balance, ok = addInt64(balance, posting.Amount)
+		if !ok {
+			return ErrLedgerBalanceOverflow
+		}
+
+		balances[account.ID] = balance
+		after[i] = balance
+	}
+
+	for id, balance := range balances {
+		if balance < 0 && !accounts[id].AllowNegative {
+			return fmt.Errorf("%w: счет %s", ErrLedgerInsufficientFunds, id)
+		}
+	}
+
+	for id, balance := range balances {
+		account := accounts[id]
+		account.Balance = balance
+		account.Version++
+		account.UpdatedAt = t.CreatedAt
+	}
+
+	for i, posting := range t.Postings {
+		posting.BalanceAfter = after[i]
+	}
+
+	return nil
+}
+
+// addInt64 складывает числа и сообщает, не было ли переполнения.
+func addInt64(a, b int64) (int64, bool) {
+	if (b > 0 && a > math.MaxInt64-b) || (b < 0 && a < math.MinInt64-b) {
+		return 0, false
+	}
+
+	return a + b, true
+}
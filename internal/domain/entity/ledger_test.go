@@ -0,0 +1,232 @@
+package entity
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLedgerTransaction_Validate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		postings LedgerPostings
+		wantErr  error
+	}{
+		{
+			name:     "одна проводка",
+			postings: LedgerPostings{{AccountID: "alice", Amount: 100}},
+			wantErr:  ErrLedgerInvalidTransaction,
+		},
+		{
+			name:     "нулевая проводка",
+			postings: LedgerPostings{{AccountID: "alice", Amount: 0}, {AccountID: "bob", Amount: 0}},
+			wantErr:  ErrLedgerInvalidTransaction,
+		},
+		{
+			name:     "сумма проводок не равна нулю",
+			postings: LedgerPostings{{AccountID: "alice", Amount: -100}, {AccountID: "bob", Amount: 90}},
+			wantErr:  ErrLedgerUnbalanced,
+		},
+		{
+			name: "переполнение суммы",
+			postings: LedgerPostings{
+				{AccountID: "alice", Amount: math.MaxInt64},
+				{AccountID: "bob", Amount: 1},
+				{AccountID: "carol", Amount: math.MinInt64},
+			},
+			wantErr: ErrLedgerBalanceOverflow,
+		},
+		{
+			name: "три проводки",
+			postings: LedgerPostings{
+				{AccountID: "alice", Amount: -100},
+				{AccountID: "bob", Amount: 60},
+				{AccountID: "carol", Amount: 40},
+			},
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			transaction := LedgerTransaction{Postings: s.postings, CreatedAt: now}
+
+			err := transaction.Validate()
+			if s.wantErr != nil {
+				require.ErrorIs(t, err, s.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+
+	_, err := NewLedgerTransfer(LedgerTransactionKindTransfer, "alice", "alice", 100, "", "alice", now)
+	require.ErrorIs(t, err, ErrLedgerInvalidTransaction, "перевод самому себе")
+
+	_, err = NewLedgerTransfer(LedgerTransactionKindTransfer, "alice", "bob", -100, "", "alice", now)
+	require.ErrorIs(t, err, ErrLedgerInvalidTransaction, "отрицательная сумма")
+}
+
+func TestLedgerTransaction_Apply(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	accounts := map[string]*LedgerAccount{
+		LedgerGrantsAccountID: NewLedgerAccount(LedgerGrantsAccountID),
+		"alice":               NewLedgerAccount("alice"),
+		"bob":                 NewLedgerAccount("bob"),
+	}
+
+	grant, err := NewLedgerTransfer(LedgerTransactionKindGrant, LedgerGrantsAccountID, "alice", 100, "бонус", "admin", now)
+	require.NoError(t, err)
+	require.NoError(t, grant.Apply(accounts))
+
+	assert.Equal(t, int64(-100), accounts[LedgerGrantsAccountID].Balance, "системный счет уходит в минус")
+	assert.Equal(t, int64(100), accounts["alice"].Balance)
+	assert.Equal(t, int64(1), accounts["alice"].Version)
+	assert.Equal(t, int64(100), grant.Postings[1].BalanceAfter)
+
+	transfer, err := NewLedgerTransfer(LedgerTransactionKindTransfer, "alice", "bob", 150, "", "alice", now)
+	require.NoError(t, err)
+	require.ErrorIs(t, transfer.Apply(accounts), ErrLedgerInsufficientFunds)
+
+	assert.Equal(t, int64(100), accounts["alice"].Balance, "неудачная транзакция не меняет счета")
+	assert.Equal(t, int64(0), accounts["bob"].Balance)
+	assert.Equal(t, int64(0), accounts["bob"].Version)
+
+	delete(accounts, "bob")
+	transfer, err = NewLedgerTransfer(LedgerTransactionKindTransfer, "alice", "bob", 50, "", "alice", now)
+	require.NoError(t, err)
+	require.ErrorIs(t, transfer.Apply(accounts), ErrLedgerAccountNotFound)
+	assert.Equal(t, int64(100), accounts["alice"].Balance)
+}
+
+// TestLedger_Invariants проверяет инварианты журнала на случайных последовательностях транзакций:
+// сумма проводок каждой транзакции и сумма всех балансов равны нулю, баланс пользователя не бывает отрицательным,
+// снимок баланса совпадает с суммой проводок по счету, а отклоненная транзакция не меняет ни одного счета.
+func TestLedger_Invariants(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	ids := []string{LedgerGrantsAccountID, "alice", "bob", "carol", "dave"}
+
+	property := func(seed int64) bool {
+		rnd := rand.New(rand.NewSource(seed))
+
+		accounts := make(map[string]*LedgerAccount, len(ids))
+		for _, id := range ids {
+			accounts[id] = NewLedgerAccount(id)
+		}
+
+		derived := make(map[string]int64, len(ids))
+
+		for i := 0; i < 200; i++ {
+			transaction := randomLedgerTransaction(rnd, ids, now)
+			before := snapshotLedger(accounts)
+
+			if err := transaction.Apply(accounts); err != nil {
+				if !assert.Equal(t, before, snapshotLedger(accounts), "seed %d: отклоненная транзакция изменила счета", seed) {
+					return false
+				}
+
+				continue
+			}
+
+			var sum int64
+			for _, posting := range transaction.Postings {
+				sum += posting.Amount
+				derived[posting.AccountID] += posting.Amount
+			}
+
+			if sum != 0 {
+				t.Logf("seed %d: сумма проводок %d", seed, sum)
+
+				return false
+			}
+		}
+
+		var total int64
+
+		for id, account := range accounts {
+			total += account.Balance
+
+			if account.Balance < 0 && !account.AllowNegative {
+				t.Logf("seed %d: отрицательный баланс счета %s", seed, id)
+
+				return false
+			}
+
+			if account.Balance != derived[id] {
+				t.Logf("seed %d: баланс %s %d не совпадает с суммой проводок %d", seed, id, account.Balance, derived[id])
+
+				return false
+			}
+		}
+
+		return total == 0
+	}
+
+	require.NoError(t, quick.Check(property, &quick.Config{MaxCount: 300}))
+}
+
+// randomLedgerTransaction создает случайную транзакцию: чаще корректную, иногда с нарушением инвариантов.
+func randomLedgerTransaction(rnd *rand.Rand, ids []string, now time.Time) *LedgerTransaction {
+	pick := func() string { return ids[rnd.Intn(len(ids))] }
+	amount := func() int64 { return rnd.Int63n(1000) + 1 }
+
+	switch rnd.Intn(5) {
+	case 0:
+		// Начисление со счета начислений.
+		return &LedgerTransaction{CreatedAt: now, Postings: LedgerPostings{
+			{AccountID: LedgerGrantsAccountID, Amount: -1000},
+			{AccountID: pick(), Amount: 1000},
+		}}
+	case 1:
+		// Несбалансированная транзакция.
+		return &LedgerTransaction{CreatedAt: now, Postings: LedgerPostings{
+			{AccountID: pick(), Amount: -amount()},
+			{AccountID: pick(), Amount: amount()},
+		}}
+	case 2:
+		// Проводки по нескольким счетам, в том числе повторяющимся.
+		first, second := amount(), amount()
+
+		return &LedgerTransaction{CreatedAt: now, Postings: LedgerPostings{
+			{AccountID: pick(), Amount: -(first + second)},
+			{AccountID: pick(), Amount: first},
+			{AccountID: pick(), Amount: second},
+		}}
+	default:
+		value := amount()
+
+		return &LedgerTransaction{CreatedAt: now, Postings: LedgerPostings{
+			{AccountID: pick(), Amount: -value},
+			{AccountID: pick(), Amount: value},
+		}}
+	}
+}
+
+// snapshotLedger копирует балансы и версии счетов.
+func snapshotLedger(accounts map[string]*LedgerAccount) map[string]LedgerAccount {
+	snapshot := make(map[string]LedgerAccount, len(accounts))
+	for id, account := range accounts {
+		snapshot[id] = *account
+	}
+
+	return snapshot
+}
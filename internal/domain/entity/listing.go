@@ -0,0 +1,178 @@
+package entity
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// minTitleTagLength минимальная длина значимого слова текста.
+const minTitleTagLength = 3
+
+// ListingStatus статус объявления.
+type ListingStatus string
+
+// Статусы объявления.
+const (
+	ListingStatusActive    ListingStatus = "active"    // Объявление доступно для обмена
+	ListingStatusReserved  ListingStatus = "reserved"  // Объявление участвует в сделке
+	ListingStatusExchanged ListingStatus = "exchanged" // Обмен состоялся
+	ListingStatusArchived  ListingStatus = "archived"  // Объявление снято владельцем
+)
+
+// EditableListingStatuses статусы объявления, в которых владелец может менять статус и удалять объявление.
+// Статус объявления, участвующего в сделке, меняет только сделка.
+var EditableListingStatuses = []ListingStatus{ListingStatusActive, ListingStatusArchived}
+
+// ListingCondition состояние предмета.
+type ListingCondition string
+
+// Состояния предмета.
+const (
+	ListingConditionNew     ListingCondition = "new"      // Новый
+	ListingConditionLikeNew ListingCondition = "like_new" // Как новый
+	ListingConditionGood    ListingCondition = "good"     // Хорошее
+	ListingConditionFair    ListingCondition = "fair"     // Удовлетворительное
+	ListingConditionPoor    ListingCondition = "poor"     // Плохое
+)
+
+// Listing сущность объявления для обмена.
+type Listing struct {
+	ID          string           `json:"id" db:"id" bson:"_id"`                                            // Идентификатор объявления
+	OwnerID     string           `json:"ownerID" db:"owner_id" bson:"owner_id"`                            // Идентификатор владельца
+	Title       string           `json:"title" db:"title" bson:"title"`                                    // Заголовок
+	Description string           `json:"description" db:"description" bson:"description"`                  // Описание
+	Category    string           `json:"category" db:"category" bson:"category"`                           // Категория
+	Condition   ListingCondition `json:"condition" db:"condition" bson:"condition"`                        // Состояние предмета
+	Photos      []string         `json:"photos" db:"photos" bson:"photos"`                                 // Ссылки на фотографии
+	DesiredTags []string         `json:"desiredTags" db:"desired_tags" bson:"desired_tags"`                // Что владелец хочет получить взамен
+	Location    *GeoPoint        `json:"location,omitempty" db:"location" bson:"location,omitempty"`       // Местоположение предмета
+	City        string           `json:"city,omitempty" db:"city" bson:"city,omitempty"`                   // Город
+	Valuation   *Money           `json:"valuation,omitempty" db:"valuation" bson:"valuation,omitempty"`    // Оценка стоимости предмета владельцем
+	DistanceKm  *float64         `json:"distanceKm,omitempty" db:"-" bson:"-"`                             // Расстояние до точки поиска в километрах
+	Status      ListingStatus    `json:"status" db:"status" bson:"status"`                                 // Статус объявления
+	Moderation  ModerationStatus `json:"moderation,omitempty" db:"moderation" bson:"moderation,omitempty"` // Статус модерации текста
+	UpdatedAt   time.Time        `json:"updatedAt" db:"updated_at" bson:"updated_at" audit:"-"`            // Дата обновления
+	CreatedAt   time.Time        `json:"createdAt" db:"created_at" bson:"created_at"`                      // Дата создания
+}
+
+// NewListing создает объявление.
+func NewListing(currentTime time.Time) *Listing {
+	return &Listing{
+		Status:    ListingStatusActive,
+		UpdatedAt: currentTime,
+		CreatedAt: currentTime,
+	}
+}
+
+// ModerationContent возвращает заголовок и описание объявления для проверки.
+func (l *Listing) ModerationContent(currentTime time.Time) ModerationContent {
+	return ModerationContent{
+		SubjectType: ModerationSubjectListing,
+		SubjectID:   l.ID,
+		AuthorID:    l.OwnerID,
+		Text:        l.Title + "\n" + l.Description,
+		SubmittedAt: currentTime,
+	}
+}
+
+// IsAvailable доступно ли объявление для обмена.
+func (l *Listing) IsAvailable() bool {
+	return l.Status == ListingStatusActive
+}
+
+// IsEditable может ли владелец менять статус объявления и удалять его.
+func (l *Listing) IsEditable() bool {
+	for _, status := range EditableListingStatuses {
+		if l.Status == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// OfferedTags возвращает теги того, что предлагает объявление: категорию и значимые слова заголовка.
+// По ним объявление сопоставляется с желаемыми тегами других пользователей.
+func (l *Listing) OfferedTags() []string {
+	return NormalizeTags(append(Keywords(l.Title), l.Category))
+}
+
+// SearchTerms возвращает значимые слова заголовка, описания и категорию объявления.
+func (l *Listing) SearchTerms() []string {
+	return NormalizeTags(append(Keywords(l.Title+" "+l.Description), l.Category))
+}
+
+// Keywords разбивает текст на значимые слова в нижнем регистре без повторов.
+func Keywords(text string) []string {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	keywords := make([]string, 0, len(words))
+
+	for _, word := range words {
+		if len([]rune(word)) >= minTitleTagLength {
+			keywords = append(keywords, word)
+		}
+	}
+
+	return NormalizeTags(keywords)
+}
+
+// Listings список объявлений.
+type Listings []*Listing
+
+// FillDistance заполняет расстояние от точки from до объявлений с местоположением.
+func (l Listings) FillDistance(from GeoPoint) {
+	for _, listing := range l {
+		if listing.Location != nil {
+			distance := from.RoundedDistanceKm(*listing.Location)
+			listing.DistanceKm = &distance
+		}
+	}
+}
+
+// NormalizeTags приводит теги к нижнему регистру, убирает пустые и повторяющиеся и сортирует их.
+func NormalizeTags(tags []string) []string {
+	seen := make(map[string]struct{}, len(tags))
+	normalized := make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+
+		seen[tag] = struct{}{}
+		normalized = append(normalized, tag)
+	}
+
+	sort.Strings(normalized)
+
+	return normalized
+}
+
+// ListingEvent событие изменения объявления, отправляемое в Kafka.
+type ListingEvent struct {
+	ListingID  string        `json:"listingID"`         // Идентификатор объявления
+	OwnerID    string        `json:"ownerID"`           // Идентификатор владельца
+	Status     ListingStatus `json:"status,omitempty"`  // Статус объявления после изменения
+	Deleted    bool          `json:"deleted,omitempty"` // Объявление удалено
+	OccurredAt time.Time     `json:"occurredAt"`        // Дата события
+}
+
+// NewListingEvent создает событие изменения объявления.
+func NewListingEvent(listing *Listing, currentTime time.Time) ListingEvent {
+	return ListingEvent{
+		ListingID:  listing.ID,
+		OwnerID:    listing.OwnerID,
+		Status:     listing.Status,
+		OccurredAt: currentTime,
+	}
+}
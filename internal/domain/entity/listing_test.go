@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListing_IsEditable(t *testing.T) {
+	t.Parallel()
+
+	cases := map[ListingStatus]bool{
+		ListingStatusActive:    true,
+		ListingStatusArchived:  true,
+		ListingStatusReserved:  false,
+		ListingStatusExchanged: false,
+	}
+
+	for status, editable := range cases {
+		listing := Listing{Status: status}
+		assert.Equal(t, editable, listing.IsEditable(), status)
+	}
+}
@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"time"
+)
+
+// MediaSubject тип сущности, к которой прикреплен файл.
+type MediaSubject string
+
+// Типы сущностей медиафайлов.
+const (
+	MediaSubjectUser    MediaSubject = "user"    // Аватар пользователя
+	MediaSubjectListing MediaSubject = "listing" // Фотография объявления
+	MediaSubjectDispute MediaSubject = "dispute" // Доказательство по спору
+)
+
+// mediaContentTypes допустимые типы загружаемых файлов и расширения, с которыми они хранятся.
+var mediaContentTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+// MediaExtension возвращает расширение файла допустимого типа.
+func MediaExtension(contentType string) (string, error) {
+	ext, ok := mediaContentTypes[contentType]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrMediaUnsupportedType, contentType)
+	}
+
+	return ext, nil
+}
+
+// Media загруженный медиафайл. Сам файл и его миниатюра лежат в хранилище файлов по ключам Key и ThumbnailKey.
+type Media struct {
+	ID           string       `json:"id" db:"id" bson:"_id"`                                                 // Идентификатор файла
+	OwnerID      string       `json:"ownerID" db:"owner_id" bson:"owner_id"`                                 // Пользователь, загрузивший файл
+	ContentType  string       `json:"contentType" db:"content_type" bson:"content_type"`                     // Тип файла
+	Size         int64        `json:"size" db:"size" bson:"size"`                                            // Размер файла в байтах
+	Width        int          `json:"width" db:"width" bson:"width"`                                         // Ширина изображения
+	Height       int          `json:"height" db:"height" bson:"height"`                                      // Высота изображения
+	Key          string       `json:"-" db:"key" bson:"key"`                                                 // Ключ файла в хранилище
+	ThumbnailKey string       `json:"-" db:"thumbnail_key" bson:"thumbnail_key"`                             // Ключ миниатюры в хранилище
+	SubjectType  MediaSubject `json:"subjectType,omitempty" db:"subject_type" bson:"subject_type,omitempty"` // Тип сущности, к которой прикреплен файл
+	SubjectID    string       `json:"subjectID,omitempty" db:"subject_id" bson:"subject_id,omitempty"`       // Идентификатор сущности
+	URL          string       `json:"url,omitempty" db:"-" bson:"-"`                                         // Подписанная ссылка на файл
+	ThumbnailURL string       `json:"thumbnailUrl,omitempty" db:"-" bson:"-"`                                // Подписанная ссылка на миниатюру
+	CreatedAt    time.Time    `json:"createdAt" db:"created_at" bson:"created_at"`                           // Дата загрузки
+}
+
+// NewMedia создает медиафайл со случайными ключами в хранилище.
+func NewMedia(ownerID, contentType string, size int64, currentTime time.Time) (*Media, error) {
+	ext, err := MediaExtension(contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	token := make([]byte, 16)
+	if _, err = io.ReadFull(rand.Reader, token); err != nil {
+		return nil, fmt.Errorf("генерация ключа файла: %w", err)
+	}
+
+	prefix := "media/" + hex.EncodeToString(token)
+
+	return &Media{
+		OwnerID:      ownerID,
+		ContentType:  contentType,
+		Size:         size,
+		Key:          prefix + "/original" + ext,
+		ThumbnailKey: prefix + "/thumb.jpg",
+		CreatedAt:    currentTime,
+	}, nil
+}
+
+// IsAttached прикреплен ли файл к сущности.
+func (m *Media) IsAttached() bool {
+	return m.SubjectID != ""
+}
+
+// Keys возвращает ключи всех файлов медиафайла в хранилище.
+func (m *Media) Keys() []string {
+	return []string{m.Key, m.ThumbnailKey}
+}
+
+// MediaList список медиафайлов.
+type MediaList []*Media
+
+// Blob файл, прочитанный из хранилища. Body нужно закрыть после чтения.
+type Blob struct {
+	Body        io.ReadCloser // Содержимое файла
+	ContentType string        // Тип файла
+	Size        int64         // Размер файла в байтах
+}
@@ -0,0 +1,836 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Language язык сообщений клиенту.
+type Language string
+
+// Поддерживаемые языки.
+const (
+	LanguageRu Language = "ru" // Русский
+	LanguageKk Language = "kk" // Казахский
+	LanguageEn Language = "en" // Английский
+)
+
+// DefaultLanguage язык сообщений, если клиент не запросил ни один из поддерживаемых.
+const DefaultLanguage = LanguageRu
+
+// Languages поддерживаемые языки сообщений.
+var Languages = []Language{LanguageRu, LanguageKk, LanguageEn}
+
+// Translations переводы сообщения на поддерживаемые языки.
+type Translations map[Language]string
+
+// languageKey ключ языка сообщений в контексте.
+type languageKey struct{}
+
+// WithLanguage возвращает контекст с языком сообщений.
+func WithLanguage(ctx context.Context, lang Language) context.Context {
+	return context.WithValue(ctx, languageKey{}, lang)
+}
+
+// LanguageFromContext возвращает язык сообщений из контекста.
+// Если язык не задан, возвращает язык по умолчанию.
+func LanguageFromContext(ctx context.Context) Language {
+	lang, ok := ctx.Value(languageKey{}).(Language)
+	if !ok {
+		return DefaultLanguage
+	}
+
+	return lang
+}
+
+// Get возвращает перевод на язык lang, а при его отсутствии перевод на язык по умолчанию.
+func (t Translations) Get(lang Language) string {
+	if message, ok := t[lang]; ok {
+		return message
+	}
+
+	return t[DefaultLanguage]
+}
+
+// ParseLanguage возвращает поддерживаемый язык из заголовка Accept-Language
+// с учетом веса q. Региональные варианты (kk-KZ, en-US) сводятся к основному языку.
+func ParseLanguage(acceptLanguage string) Language {
+	type candidate struct {
+		lang   Language
+		weight float64
+	}
+
+	candidates := make([]candidate, 0, len(Languages))
+
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+
+		primary, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+		if !isLanguage(Language(primary)) {
+			continue
+		}
+
+		weight := 1.0
+
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil || parsed <= 0 {
+				continue
+			}
+
+			weight = parsed
+		}
+
+		candidates = append(candidates, candidate{lang: Language(primary), weight: weight})
+	}
+
+	if len(candidates) == 0 {
+		return DefaultLanguage
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].weight > candidates[j].weight
+	})
+
+	return candidates[0].lang
+}
+
+// isLanguage проверяет, поддерживается ли язык.
+func isLanguage(lang Language) bool {
+	for _, supported := range Languages {
+		if lang == supported {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ErrorMessage возвращает сообщение об ошибке с кодом code на языке lang.
+// Для кода вне каталога возвращается сообщение о внутренней ошибке.
+func ErrorMessage(code string, lang Language) string {
+	translations, ok := errorMessages[code]
+	if !ok {
+		translations = errorMessages[InternalCode]
+	}
+
+	return translations.Get(lang)
+}
+
+// ValidationMessage возвращает общее сообщение об ошибке валидации формы на языке lang.
+func ValidationMessage(lang Language) string {
+	return validationMessage.Get(lang)
+}
+
+// FieldErrorMessage возвращает сообщение об ошибке поля формы на языке lang.
+// tag правило валидации, param его параметр (например, 100 для max=100).
+func FieldErrorMessage(tag, param string, lang Language) string {
+	translations, ok := fieldMessages[tag]
+	if !ok {
+		return fieldMessageDefault.Get(lang)
+	}
+
+	message := translations.Get(lang)
+	if strings.Contains(message, "%s") {
+		return fmt.Sprintf(message, param)
+	}
+
+	return message
+}
+
+// validationMessage общее сообщение об ошибке валидации формы.
+var validationMessage = Translations{
+	LanguageRu: "Неверные параметры запроса",
+	LanguageKk: "Сұрау параметрлері қате",
+	LanguageEn: "Invalid request parameters",
+}
+
+// fieldMessageDefault сообщение об ошибке поля для правила вне каталога.
+var fieldMessageDefault = Translations{
+	LanguageRu: "Неверное значение",
+	LanguageKk: "Мәні қате",
+	LanguageEn: "Invalid value",
+}
+
+// fieldMessages сообщения об ошибках полей формы по правилам валидации.
+// %s заменяется параметром правила.
+var fieldMessages = map[string]Translations{
+	"required": {
+		LanguageRu: "Обязательное поле",
+		LanguageKk: "Міндетті өріс",
+		LanguageEn: "Field is required",
+	},
+	"required_with": {
+		LanguageRu: "Поле обязательно вместе с %s",
+		LanguageKk: "Өріс %s өрісімен бірге міндетті",
+		LanguageEn: "Field is required together with %s",
+	},
+	"oneof": {
+		LanguageRu: "Допустимые значения: %s",
+		LanguageKk: "Рұқсат етілген мәндер: %s",
+		LanguageEn: "Allowed values: %s",
+	},
+	"max": {
+		LanguageRu: "Значение превышает допустимый предел %s",
+		LanguageKk: "Мәні рұқсат етілген %s шегінен асады",
+		LanguageEn: "Value exceeds the limit of %s",
+	},
+	"min": {
+		LanguageRu: "Значение меньше допустимого предела %s",
+		LanguageKk: "Мәні рұқсат етілген %s шегінен кем",
+		LanguageEn: "Value is below the limit of %s",
+	},
+	"gt": {
+		LanguageRu: "Значение должно быть больше %s",
+		LanguageKk: "Мәні %s мәнінен үлкен болуы керек",
+		LanguageEn: "Value must be greater than %s",
+	},
+	"ne": {
+		LanguageRu: "Значение не должно быть равно %s",
+		LanguageKk: "Мәні %s мәніне тең болмауы керек",
+		LanguageEn: "Value must not be equal to %s",
+	},
+	"nefield": {
+		LanguageRu: "Значение должно отличаться от поля %s",
+		LanguageKk: "Мәні %s өрісінен өзгеше болуы керек",
+		LanguageEn: "Value must differ from field %s",
+	},
+	"unique": {
+		LanguageRu: "Значения не должны повторяться",
+		LanguageKk: "Мәндер қайталанбауы керек",
+		LanguageEn: "Values must be unique",
+	},
+	"mongodb": {
+		LanguageRu: "Неверный идентификатор",
+		LanguageKk: "Идентификатор қате",
+		LanguageEn: "Invalid identifier",
+	},
+	"email": {
+		LanguageRu: "Неверный адрес электронной почты",
+		LanguageKk: "Электрондық пошта мекенжайы қате",
+		LanguageEn: "Invalid email address",
+	},
+	"url": {
+		LanguageRu: "Неверный URL",
+		LanguageKk: "URL қате",
+		LanguageEn: "Invalid URL",
+	},
+	"type": {
+		LanguageRu: "Ожидается значение типа %s",
+		LanguageKk: "%s түріндегі мән күтіледі",
+		LanguageEn: "Expected a value of type %s",
+	},
+}
+
+// errorMessages сообщения об ошибках по кодам.
+// Каждый код из errors.go должен иметь перевод на все поддерживаемые языки.
+var errorMessages = map[string]Translations{
+	InternalCode: {
+		LanguageRu: "Внутренняя ошибка сервера",
+		LanguageKk: "Сервердің ішкі қатесі",
+		LanguageEn: "Internal server error",
+	},
+	InvalidObjectIDCode: {
+		LanguageRu: "Неверный идентификатор",
+		LanguageKk: "Идентификатор қате",
+		LanguageEn: "Invalid identifier",
+	},
+	RequestInvalidCode: {
+		LanguageRu: "Запрос не соответствует документации API",
+		LanguageKk: "Сұрау API құжаттамасына сәйкес келмейді",
+		LanguageEn: "Request does not match the API documentation",
+	},
+
+	UserNotFoundCode: {
+		LanguageRu: "Пользователь не найден",
+		LanguageKk: "Пайдаланушы табылмады",
+		LanguageEn: "User not found",
+	},
+	UserIDEmptyCode: {
+		LanguageRu: "Идентификатор пользователя не указан",
+		LanguageKk: "Пайдаланушы идентификаторы көрсетілмеген",
+		LanguageEn: "User ID is empty",
+	},
+	UserDecodeCode: {
+		LanguageRu: "Неверный формат данных пользователя",
+		LanguageKk: "Пайдаланушы деректерінің пішімі қате",
+		LanguageEn: "Invalid user data format",
+	},
+
+	OrderDecodeCode: {
+		LanguageRu: "Неверный формат данных заказа",
+		LanguageKk: "Тапсырыс деректерінің пішімі қате",
+		LanguageEn: "Invalid order data format",
+	},
+	OrderNotFoundCode: {
+		LanguageRu: "Заказ не найден",
+		LanguageKk: "Тапсырыс табылмады",
+		LanguageEn: "Order not found",
+	},
+	OrderInvalidCostCode: {
+		LanguageRu: "Стоимость заказа должна быть больше нуля",
+		LanguageKk: "Тапсырыс құны нөлден үлкен болуы керек",
+		LanguageEn: "Order cost must be greater than zero",
+	},
+	OrderStatusTransitionCode: {
+		LanguageRu: "Недопустимая смена статуса заказа",
+		LanguageKk: "Тапсырыс мәртебесін бұлай өзгертуге болмайды",
+		LanguageEn: "Order status transition is not allowed",
+	},
+	OrderStatusConflictCode: {
+		LanguageRu: "Статус заказа был изменен параллельно, повторите запрос",
+		LanguageKk: "Тапсырыс мәртебесі қатар өзгертілді, сұрауды қайталаңыз",
+		LanguageEn: "Order status was changed concurrently, please retry",
+	},
+	OrderTradeManagedCode: {
+		LanguageRu: "Статус заказа обмена меняется завершением или отменой предложения обмена",
+		LanguageKk: "Айырбас тапсырысының мәртебесі айырбас ұсынысын аяқтау немесе болдырмау арқылы өзгереді",
+		LanguageEn: "Trade order status is changed by completing or cancelling the trade offer",
+	},
+
+	ListingNotFoundCode: {
+		LanguageRu: "Объявление не найдено",
+		LanguageKk: "Хабарландыру табылмады",
+		LanguageEn: "Listing not found",
+	},
+	ListingDecodeCode: {
+		LanguageRu: "Неверный формат данных объявления",
+		LanguageKk: "Хабарландыру деректерінің пішімі қате",
+		LanguageEn: "Invalid listing data format",
+	},
+	ListingNotEditableCode: {
+		LanguageRu: "Объявление участвует в сделке и не может быть изменено",
+		LanguageKk: "Хабарландыру мәмілеге қатысады, оны өзгертуге болмайды",
+		LanguageEn: "Listing is part of a deal and cannot be changed",
+	},
+	ListingInvalidValuationCode: {
+		LanguageRu: "Оценка объявления должна быть больше нуля",
+		LanguageKk: "Хабарландыру бағасы нөлден үлкен болуы керек",
+		LanguageEn: "Listing valuation must be greater than zero",
+	},
+	ListingConflictCode: {
+		LanguageRu: "Объявление было изменено параллельно, повторите запрос",
+		LanguageKk: "Хабарландыру қатар өзгертілді, сұрауды қайталаңыз",
+		LanguageEn: "Listing was changed concurrently, please retry",
+	},
+
+	TradeOfferNotFoundCode: {
+		LanguageRu: "Предложение обмена не найдено",
+		LanguageKk: "Айырбас ұсынысы табылмады",
+		LanguageEn: "Trade offer not found",
+	},
+	TradeOfferDecodeCode: {
+		LanguageRu: "Неверный формат предложения обмена",
+		LanguageKk: "Айырбас ұсынысының пішімі қате",
+		LanguageEn: "Invalid trade offer format",
+	},
+	TradeOfferTransitionCode: {
+		LanguageRu: "Недопустимая смена статуса предложения обмена",
+		LanguageKk: "Айырбас ұсынысының мәртебесін бұлай өзгертуге болмайды",
+		LanguageEn: "Trade offer status transition is not allowed",
+	},
+	TradeOfferConflictCode: {
+		LanguageRu: "Предложение обмена было изменено параллельно, повторите запрос",
+		LanguageKk: "Айырбас ұсынысы қатар өзгертілді, сұрауды қайталаңыз",
+		LanguageEn: "Trade offer was changed concurrently, please retry",
+	},
+	TradeOfferForbiddenCode: {
+		LanguageRu: "Действие недоступно этому участнику обмена",
+		LanguageKk: "Бұл әрекет айырбастың осы қатысушысына қолжетімсіз",
+		LanguageEn: "Action is not available to this trade participant",
+	},
+	TradeOfferSelfCode: {
+		LanguageRu: "Нельзя предложить обмен самому себе",
+		LanguageKk: "Өзіңізге айырбас ұсынуға болмайды",
+		LanguageEn: "You cannot offer a trade to yourself",
+	},
+	TradeOfferListingOwnerCode: {
+		LanguageRu: "Объявление принадлежит другому пользователю",
+		LanguageKk: "Хабарландыру басқа пайдаланушыға тиесілі",
+		LanguageEn: "Listing belongs to another user",
+	},
+	TradeOfferListingUnavailableCode: {
+		LanguageRu: "Объявление недоступно для обмена",
+		LanguageKk: "Хабарландыру айырбасқа қолжетімсіз",
+		LanguageEn: "Listing is not available for trade",
+	},
+	TradeOfferRecipientsDifferentCode: {
+		LanguageRu: "Запрошенные объявления принадлежат разным пользователям",
+		LanguageKk: "Сұралған хабарландырулар әртүрлі пайдаланушыларға тиесілі",
+		LanguageEn: "Requested listings belong to different users",
+	},
+	TradeOfferInvalidCashCode: {
+		LanguageRu: "Сумма доплаты должна быть больше нуля",
+		LanguageKk: "Қосымша төлем сомасы нөлден үлкен болуы керек",
+		LanguageEn: "Cash top-up must be greater than zero",
+	},
+
+	TradeCycleNotFoundCode: {
+		LanguageRu: "Цикл обмена не найден",
+		LanguageKk: "Айырбас циклі табылмады",
+		LanguageEn: "Trade cycle not found",
+	},
+	TradeCycleTransitionCode: {
+		LanguageRu: "Недопустимая смена статуса цикла обмена",
+		LanguageKk: "Айырбас циклінің мәртебесін бұлай өзгертуге болмайды",
+		LanguageEn: "Trade cycle status transition is not allowed",
+	},
+	TradeCycleConflictCode: {
+		LanguageRu: "Цикл обмена был изменен параллельно, повторите запрос",
+		LanguageKk: "Айырбас циклі қатар өзгертілді, сұрауды қайталаңыз",
+		LanguageEn: "Trade cycle was changed concurrently, please retry",
+	},
+	TradeCycleListingUnavailableCode: {
+		LanguageRu: "Объявление цикла обмена недоступно",
+		LanguageKk: "Айырбас циклінің хабарландыруы қолжетімсіз",
+		LanguageEn: "Trade cycle listing is not available",
+	},
+
+	WishlistItemNotFoundCode: {
+		LanguageRu: "Позиция списка желаний не найдена",
+		LanguageKk: "Тілектер тізіміндегі позиция табылмады",
+		LanguageEn: "Wishlist item not found",
+	},
+	WishlistItemDecodeCode: {
+		LanguageRu: "Неверный формат позиции списка желаний",
+		LanguageKk: "Тілектер тізіміндегі позицияның пішімі қате",
+		LanguageEn: "Invalid wishlist item format",
+	},
+	WishlistForbiddenCode: {
+		LanguageRu: "Список желаний принадлежит другому пользователю",
+		LanguageKk: "Тілектер тізімі басқа пайдаланушыға тиесілі",
+		LanguageEn: "Wishlist belongs to another user",
+	},
+	WishlistLimitCode: {
+		LanguageRu: "Превышено количество позиций в списке желаний",
+		LanguageKk: "Тілектер тізіміндегі позициялар саны шектен асты",
+		LanguageEn: "Wishlist item limit exceeded",
+	},
+	WishlistQueryEmptyCode: {
+		LanguageRu: "Запрос не содержит значимых слов",
+		LanguageKk: "Сұрауда мағыналы сөздер жоқ",
+		LanguageEn: "Query contains no meaningful words",
+	},
+
+	RatingDecodeCode: {
+		LanguageRu: "Неверный формат оценки",
+		LanguageKk: "Бағаның пішімі қате",
+		LanguageEn: "Invalid rating format",
+	},
+	RatingExistsCode: {
+		LanguageRu: "Заказ уже оценен",
+		LanguageKk: "Тапсырыс бағаланып қойған",
+		LanguageEn: "Order has already been rated",
+	},
+	RatingOrderNotCompletedCode: {
+		LanguageRu: "Оценить можно только завершенный заказ",
+		LanguageKk: "Тек аяқталған тапсырысты бағалауға болады",
+		LanguageEn: "Only a completed order can be rated",
+	},
+	RatingNoCounterpartyCode: {
+		LanguageRu: "У заказа нет второй стороны",
+		LanguageKk: "Тапсырыстың екінші тарапы жоқ",
+		LanguageEn: "Order has no counterparty",
+	},
+
+	ConversationNotFoundCode: {
+		LanguageRu: "Переписка не найдена",
+		LanguageKk: "Хат алмасу табылмады",
+		LanguageEn: "Conversation not found",
+	},
+	ConversationDecodeCode: {
+		LanguageRu: "Неверный формат переписки",
+		LanguageKk: "Хат алмасудың пішімі қате",
+		LanguageEn: "Invalid conversation format",
+	},
+	ConversationNoPeerCode: {
+		LanguageRu: "У сделки нет второй стороны",
+		LanguageKk: "Мәміленің екінші тарапы жоқ",
+		LanguageEn: "Deal has no counterparty",
+	},
+	MessageDecodeCode: {
+		LanguageRu: "Неверный формат сообщения",
+		LanguageKk: "Хабарламаның пішімі қате",
+		LanguageEn: "Invalid message format",
+	},
+	MessageEmptyCode: {
+		LanguageRu: "Сообщение не содержит ни текста, ни вложений",
+		LanguageKk: "Хабарламада мәтін де, тіркеме де жоқ",
+		LanguageEn: "Message has neither text nor attachments",
+	},
+	MessageThrottledCode: {
+		LanguageRu: "Слишком много сообщений, повторите позже",
+		LanguageKk: "Хабарламалар тым көп, кейінірек қайталаңыз",
+		LanguageEn: "Too many messages, please try again later",
+	},
+
+	SearchQueryEmptyCode: {
+		LanguageRu: "Запрос не содержит слов для поиска",
+		LanguageKk: "Сұрауда іздеуге арналған сөздер жоқ",
+		LanguageEn: "Query contains no words to search for",
+	},
+	SearchTooDeepCode: {
+		LanguageRu: "Слишком далекая страница результатов поиска",
+		LanguageKk: "Іздеу нәтижелерінің беті тым алыс",
+		LanguageEn: "Search results page is too deep",
+	},
+
+	GeoInvalidPointCode: {
+		LanguageRu: "Неверные координаты точки",
+		LanguageKk: "Нүкте координаттары қате",
+		LanguageEn: "Invalid point coordinates",
+	},
+	GeoInvalidRadiusCode: {
+		LanguageRu: "Неверный радиус поиска",
+		LanguageKk: "Іздеу радиусы қате",
+		LanguageEn: "Invalid search radius",
+	},
+	GeoNearRequiredCode: {
+		LanguageRu: "Для сортировки по расстоянию нужна точка near",
+		LanguageKk: "Қашықтық бойынша сұрыптау үшін near нүктесі қажет",
+		LanguageEn: "Sorting by distance requires the near point",
+	},
+
+	MediaNotFoundCode: {
+		LanguageRu: "Файл не найден",
+		LanguageKk: "Файл табылмады",
+		LanguageEn: "File not found",
+	},
+	MediaDecodeCode: {
+		LanguageRu: "Неверный формат формы файла",
+		LanguageKk: "Файл формасының пішімі қате",
+		LanguageEn: "Invalid file form format",
+	},
+	MediaForbiddenCode: {
+		LanguageRu: "Файл принадлежит другому пользователю",
+		LanguageKk: "Файл басқа пайдаланушыға тиесілі",
+		LanguageEn: "File belongs to another user",
+	},
+	MediaAttachedCode: {
+		LanguageRu: "Файл уже прикреплен к другой записи",
+		LanguageKk: "Файл басқа жазбаға тіркеліп қойған",
+		LanguageEn: "File is already attached to another record",
+	},
+	MediaTooLargeCode: {
+		LanguageRu: "Файл превышает допустимый размер",
+		LanguageKk: "Файл рұқсат етілген өлшемнен асады",
+		LanguageEn: "File exceeds the maximum size",
+	},
+	MediaUnsupportedTypeCode: {
+		LanguageRu: "Тип файла не поддерживается",
+		LanguageKk: "Файл түріне қолдау көрсетілмейді",
+		LanguageEn: "File type is not supported",
+	},
+	MediaInvalidImageCode: {
+		LanguageRu: "Файл не является корректным изображением",
+		LanguageKk: "Файл дұрыс сурет емес",
+		LanguageEn: "File is not a valid image",
+	},
+	MediaFileRequiredCode: {
+		LanguageRu: "Файл не передан",
+		LanguageKk: "Файл жіберілмеген",
+		LanguageEn: "File is required",
+	},
+	SignedURLExpiredCode: {
+		LanguageRu: "Срок действия ссылки истек",
+		LanguageKk: "Сілтеменің әрекет ету мерзімі өтті",
+		LanguageEn: "Link has expired",
+	},
+	SignedURLInvalidCode: {
+		LanguageRu: "Неверная подпись ссылки",
+		LanguageKk: "Сілтеме қолтаңбасы қате",
+		LanguageEn: "Invalid link signature",
+	},
+
+	NotificationPreferencesDecodeCode: {
+		LanguageRu: "Неверный формат настроек уведомлений",
+		LanguageKk: "Хабарландыру баптауларының пішімі қате",
+		LanguageEn: "Invalid notification settings format",
+	},
+	NotificationPreferencesInvalidCode: {
+		LanguageRu: "Неверные настройки уведомлений",
+		LanguageKk: "Хабарландыру баптаулары қате",
+		LanguageEn: "Invalid notification settings",
+	},
+	NotificationForbiddenCode: {
+		LanguageRu: "Настройки уведомлений доступны только владельцу",
+		LanguageKk: "Хабарландыру баптаулары тек иесіне қолжетімді",
+		LanguageEn: "Notification settings are available to the owner only",
+	},
+	DeviceTokenDecodeCode: {
+		LanguageRu: "Неверный формат данных устройства",
+		LanguageKk: "Құрылғы деректерінің пішімі қате",
+		LanguageEn: "Invalid device data format",
+	},
+	DeviceTokenNotFoundCode: {
+		LanguageRu: "Устройство не найдено",
+		LanguageKk: "Құрылғы табылмады",
+		LanguageEn: "Device not found",
+	},
+	InboxNotificationNotFoundCode: {
+		LanguageRu: "Уведомление не найдено",
+		LanguageKk: "Хабарландыру табылмады",
+		LanguageEn: "Notification not found",
+	},
+
+	WalletTransferDecodeCode: {
+		LanguageRu: "Неверный формат перевода кредитов",
+		LanguageKk: "Кредит аударымының пішімі қате",
+		LanguageEn: "Invalid credit transfer format",
+	},
+	WalletGrantDecodeCode: {
+		LanguageRu: "Неверный формат начисления кредитов",
+		LanguageKk: "Кредит есептеуінің пішімі қате",
+		LanguageEn: "Invalid credit grant format",
+	},
+	LedgerInvalidTransactionCode: {
+		LanguageRu: "Неверная транзакция кредитов",
+		LanguageKk: "Кредит транзакциясы қате",
+		LanguageEn: "Invalid credit transaction",
+	},
+	LedgerInsufficientFundsCode: {
+		LanguageRu: "Недостаточно кредитов на счете",
+		LanguageKk: "Шотта кредит жеткіліксіз",
+		LanguageEn: "Insufficient credits",
+	},
+	LedgerBalanceOverflowCode: {
+		LanguageRu: "Превышен допустимый баланс кредитов",
+		LanguageKk: "Кредит балансы рұқсат етілген шектен асты",
+		LanguageEn: "Credit balance limit exceeded",
+	},
+	LedgerConflictCode: {
+		LanguageRu: "Счет кредитов был изменен параллельно, повторите запрос",
+		LanguageKk: "Кредит шоты қатар өзгертілді, сұрауды қайталаңыз",
+		LanguageEn: "Credit account was changed concurrently, please retry",
+	},
+	LedgerForbiddenCode: {
+		LanguageRu: "Начисление кредитов доступно только администраторам",
+		LanguageKk: "Кредит есептеу тек әкімшілерге қолжетімді",
+		LanguageEn: "Granting credits is available to administrators only",
+	},
+
+	PaymentDeclinedCode: {
+		LanguageRu: "Платеж отклонен",
+		LanguageKk: "Төлем қабылданбады",
+		LanguageEn: "Payment declined",
+	},
+	PaymentNotFoundCode: {
+		LanguageRu: "Платеж не найден",
+		LanguageKk: "Төлем табылмады",
+		LanguageEn: "Payment not found",
+	},
+	PaymentTransitionCode: {
+		LanguageRu: "Недопустимая смена статуса платежа",
+		LanguageKk: "Төлем мәртебесін бұлай өзгертуге болмайды",
+		LanguageEn: "Payment status transition is not allowed",
+	},
+	PaymentRefundExceedsCode: {
+		LanguageRu: "Сумма возврата превышает доступную",
+		LanguageKk: "Қайтару сомасы қолжетімді сомадан асады",
+		LanguageEn: "Refund amount exceeds the available amount",
+	},
+	PaymentInvalidRefundCode: {
+		LanguageRu: "Сумма возврата должна быть больше нуля",
+		LanguageKk: "Қайтару сомасы нөлден үлкен болуы керек",
+		LanguageEn: "Refund amount must be greater than zero",
+	},
+	PaymentConflictCode: {
+		LanguageRu: "Платеж был изменен параллельно, повторите запрос",
+		LanguageKk: "Төлем қатар өзгертілді, сұрауды қайталаңыз",
+		LanguageEn: "Payment was changed concurrently, please retry",
+	},
+	PaymentSettlementBusyCode: {
+		LanguageRu: "По платежу уже проводится операция, повторите запрос позже",
+		LanguageKk: "Төлем бойынша операция жүргізілуде, сұрауды кейінірек қайталаңыз",
+		LanguageEn: "A payment operation is already in progress, please retry later",
+	},
+	PaymentAlreadyExistsCode: {
+		LanguageRu: "Заказ уже оплачен",
+		LanguageKk: "Тапсырыс төленіп қойған",
+		LanguageEn: "Order has already been paid",
+	},
+	PaymentNotAllowedCode: {
+		LanguageRu: "Заказ не требует оплаты",
+		LanguageKk: "Тапсырысқа төлем қажет емес",
+		LanguageEn: "Order does not require payment",
+	},
+	PaymentForbiddenCode: {
+		LanguageRu: "Оплатить заказ может только покупатель",
+		LanguageKk: "Тапсырысты тек сатып алушы төлей алады",
+		LanguageEn: "Only the buyer can pay for the order",
+	},
+	PaymentWebhookSignatureCode: {
+		LanguageRu: "Неверная подпись уведомления",
+		LanguageKk: "Хабарлама қолтаңбасы қате",
+		LanguageEn: "Invalid notification signature",
+	},
+	PaymentWebhookDecodeCode: {
+		LanguageRu: "Неверный формат уведомления",
+		LanguageKk: "Хабарламаның пішімі қате",
+		LanguageEn: "Invalid notification format",
+	},
+
+	DisputeDecodeCode: {
+		LanguageRu: "Неверный формат данных спора",
+		LanguageKk: "Дау деректерінің пішімі қате",
+		LanguageEn: "Invalid dispute data format",
+	},
+	DisputeNotFoundCode: {
+		LanguageRu: "Спор не найден",
+		LanguageKk: "Дау табылмады",
+		LanguageEn: "Dispute not found",
+	},
+	DisputeAlreadyExistsCode: {
+		LanguageRu: "По заказу уже открыт спор",
+		LanguageKk: "Тапсырыс бойынша дау ашылып қойған",
+		LanguageEn: "A dispute is already open for this order",
+	},
+	DisputeNotAllowedCode: {
+		LanguageRu: "По заказу нельзя открыть спор",
+		LanguageKk: "Тапсырыс бойынша дау ашуға болмайды",
+		LanguageEn: "A dispute cannot be opened for this order",
+	},
+	DisputeTransitionCode: {
+		LanguageRu: "Недопустимая смена статуса спора",
+		LanguageKk: "Дау мәртебесін бұлай өзгертуге болмайды",
+		LanguageEn: "Dispute status transition is not allowed",
+	},
+	DisputeClosedCode: {
+		LanguageRu: "Спор закрыт",
+		LanguageKk: "Дау жабылған",
+		LanguageEn: "Dispute is closed",
+	},
+	DisputeForbiddenCode: {
+		LanguageRu: "Спор доступен только сторонам и модераторам",
+		LanguageKk: "Дау тек тараптар мен модераторларға қолжетімді",
+		LanguageEn: "Dispute is available to its parties and moderators only",
+	},
+	DisputeConflictCode: {
+		LanguageRu: "Спор был изменен параллельно, повторите запрос",
+		LanguageKk: "Дау қатар өзгертілді, сұрауды қайталаңыз",
+		LanguageEn: "Dispute was changed concurrently, please retry",
+	},
+	DisputeEvidenceLimitCode: {
+		LanguageRu: "Превышено количество доказательств",
+		LanguageKk: "Дәлелдер саны шектен асты",
+		LanguageEn: "Evidence limit exceeded",
+	},
+	DisputeEvidenceExistsCode: {
+		LanguageRu: "Файл уже приложен к спору",
+		LanguageKk: "Файл дауға тіркеліп қойған",
+		LanguageEn: "File is already attached to the dispute",
+	},
+	DisputeInvalidOutcomeCode: {
+		LanguageRu: "Неверное решение по спору",
+		LanguageKk: "Дау бойынша шешім қате",
+		LanguageEn: "Invalid dispute outcome",
+	},
+
+	AuditForbiddenCode: {
+		LanguageRu: "Журнал изменений доступен только администраторам",
+		LanguageKk: "Өзгерістер журналы тек әкімшілерге қолжетімді",
+		LanguageEn: "Audit log is available to administrators only",
+	},
+	AuditInvalidPeriodCode: {
+		LanguageRu: "Неверный период журнала изменений",
+		LanguageKk: "Өзгерістер журналының кезеңі қате",
+		LanguageEn: "Invalid audit log period",
+	},
+
+	ModerationDecodeCode: {
+		LanguageRu: "Неверный формат жалобы или решения",
+		LanguageKk: "Шағымның немесе шешімнің пішімі қате",
+		LanguageEn: "Invalid report or decision format",
+	},
+	ModerationCaseNotFoundCode: {
+		LanguageRu: "Дело модерации не найдено",
+		LanguageKk: "Модерация ісі табылмады",
+		LanguageEn: "Moderation case not found",
+	},
+	ModerationCaseClosedCode: {
+		LanguageRu: "По делу модерации уже принято решение",
+		LanguageKk: "Модерация ісі бойынша шешім қабылданып қойған",
+		LanguageEn: "Moderation case has already been decided",
+	},
+	ModerationConflictCode: {
+		LanguageRu: "Дело модерации было изменено параллельно, повторите запрос",
+		LanguageKk: "Модерация ісі қатар өзгертілді, сұрауды қайталаңыз",
+		LanguageEn: "Moderation case was changed concurrently, please retry",
+	},
+	ModerationReportExistsCode: {
+		LanguageRu: "Жалоба на этот текст уже отправлена",
+		LanguageKk: "Бұл мәтінге шағым жіберіліп қойған",
+		LanguageEn: "You have already reported this text",
+	},
+	ModerationForbiddenCode: {
+		LanguageRu: "Модерация доступна только администраторам",
+		LanguageKk: "Модерация тек әкімшілерге қолжетімді",
+		LanguageEn: "Moderation is available to administrators only",
+	},
+	ModerationBannedCode: {
+		LanguageRu: "Автор заблокирован модератором",
+		LanguageKk: "Автор модератормен бұғатталған",
+		LanguageEn: "Author is banned by a moderator",
+	},
+
+	BulkFormatCode: {
+		LanguageRu: "Формат не поддерживается, используйте CSV или NDJSON",
+		LanguageKk: "Пішімге қолдау көрсетілмейді, CSV немесе NDJSON қолданыңыз",
+		LanguageEn: "Unsupported format, use CSV or NDJSON",
+	},
+	BulkForbiddenCode: {
+		LanguageRu: "Массовый импорт и экспорт доступны только администраторам",
+		LanguageKk: "Жаппай импорт пен экспорт тек әкімшілерге қолжетімді",
+		LanguageEn: "Bulk import and export are available to administrators only",
+	},
+	BulkInvalidPeriodCode: {
+		LanguageRu: "Неверный период экспорта",
+		LanguageKk: "Экспорт кезеңі қате",
+		LanguageEn: "Invalid export period",
+	},
+	ImportRunNotFoundCode: {
+		LanguageRu: "Запуск импорта не найден",
+		LanguageKk: "Импорт іске қосылымы табылмады",
+		LanguageEn: "Import run not found",
+	},
+	ImportRunMismatchCode: {
+		LanguageRu: "Запуск импорта относится к другим данным или администратору",
+		LanguageKk: "Импорт іске қосылымы басқа деректерге немесе әкімшіге тиесілі",
+		LanguageEn: "Import run belongs to other data or administrator",
+	},
+	ImportRowDecodeCode: {
+		LanguageRu: "Не удалось разобрать строку",
+		LanguageKk: "Жолды талдау мүмкін болмады",
+		LanguageEn: "Row could not be parsed",
+	},
+
+	CurrencyNotAllowedCode: {
+		LanguageRu: "Валюта не поддерживается",
+		LanguageKk: "Валютаға қолдау көрсетілмейді",
+		LanguageEn: "Currency is not supported",
+	},
+	MoneyCurrencyMismatchCode: {
+		LanguageRu: "Суммы указаны в разных валютах",
+		LanguageKk: "Сомалар әртүрлі валютада көрсетілген",
+		LanguageEn: "Amounts are in different currencies",
+	},
+
+	PageInvalidLimitCode: {
+		LanguageRu: "Неверное значение лимита",
+		LanguageKk: "Шектеу мәні қате",
+		LanguageEn: "Invalid limit value",
+	},
+	PageInvalidPageCode: {
+		LanguageRu: "Неверный номер страницы",
+		LanguageKk: "Бет нөмірі қате",
+		LanguageEn: "Invalid page number",
+	},
+	PageInvalidStateCode: {
+		LanguageRu: "Неверное состояние страницы",
+		LanguageKk: "Бет күйі қате",
+		LanguageEn: "Invalid page state",
+	},
+}
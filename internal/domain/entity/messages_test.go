@@ -0,0 +1,147 @@
+package entity
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestErrorMessages_Complete проверяет, что каждый код ошибки из errors.go переведен на все языки.
+func TestErrorMessages_Complete(t *testing.T) {
+	t.Parallel()
+
+	for _, code := range errorCodes(t) {
+		for _, lang := range Languages {
+			assert.NotEmpty(t, errorMessages[code][lang], "нет перевода %s для кода %s", lang, code)
+		}
+	}
+}
+
+// TestFieldMessages_Complete проверяет, что каждое правило валидации форм переведено на все языки.
+func TestFieldMessages_Complete(t *testing.T) {
+	t.Parallel()
+
+	files, err := filepath.Glob(filepath.Join("..", "form", "*.go"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	// Служебные правила не порождают ошибок полей.
+	skip := map[string]bool{"omitempty": true, "dive": true}
+
+	for _, name := range files {
+		src, err := os.ReadFile(name)
+		require.NoError(t, err)
+
+		file, err := parser.ParseFile(token.NewFileSet(), name, src, 0)
+		require.NoError(t, err)
+
+		ast.Inspect(file, func(node ast.Node) bool {
+			field, ok := node.(*ast.Field)
+			if !ok || field.Tag == nil {
+				return true
+			}
+
+			tag, err := strconv.Unquote(field.Tag.Value)
+			require.NoError(t, err)
+
+			for _, rule := range strings.Split(reflect.StructTag(tag).Get("validate"), ",") {
+				rule, _, _ = strings.Cut(rule, "=")
+				if rule == "" || skip[rule] {
+					continue
+				}
+
+				for _, lang := range Languages {
+					assert.NotEmpty(t, fieldMessages[rule][lang], "нет перевода %s для правила %s в %s", lang, rule, name)
+				}
+			}
+
+			return true
+		})
+	}
+}
+
+func TestParseLanguage(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		header string
+		exp    Language
+	}{
+		{name: "пустой заголовок", header: "", exp: DefaultLanguage},
+		{name: "один язык", header: "en", exp: LanguageEn},
+		{name: "региональный вариант", header: "kk-KZ", exp: LanguageKk},
+		{name: "вес", header: "ru;q=0.5, en;q=0.9", exp: LanguageEn},
+		{name: "неподдерживаемый язык", header: "de-DE, kk;q=0.3", exp: LanguageKk},
+		{name: "нулевой вес", header: "en;q=0", exp: DefaultLanguage},
+		{name: "регистр", header: "EN-us", exp: LanguageEn},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, s.exp, ParseLanguage(s.header))
+		})
+	}
+}
+
+func TestFieldErrorMessage(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "Допустимые значения: spam abuse", FieldErrorMessage("oneof", "spam abuse", LanguageRu))
+	assert.Equal(t, "Field is required", FieldErrorMessage("required", "", LanguageEn))
+	assert.Equal(t, "Мәні қате", FieldErrorMessage("unknown", "", LanguageKk))
+	assert.Equal(t, "Пользователь не найден", ErrorMessage(UserNotFoundCode, Language("de")))
+	assert.Equal(t, "Internal server error", ErrorMessage("TMP_UNKNOWN", LanguageEn))
+}
+
+// errorCodes возвращает значения всех констант *Code из errors.go.
+func errorCodes(t *testing.T) []string {
+	t.Helper()
+
+	file, err := parser.ParseFile(token.NewFileSet(), "errors.go", nil, 0)
+	require.NoError(t, err)
+
+	var codes []string
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.ValueSpec)
+		if !ok {
+			return true
+		}
+
+		for i, name := range spec.Names {
+			if !strings.HasSuffix(name.Name, "Code") || i >= len(spec.Values) {
+				continue
+			}
+
+			lit, ok := spec.Values[i].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				continue
+			}
+
+			code, err := strconv.Unquote(lit.Value)
+			require.NoError(t, err)
+
+			codes = append(codes, code)
+		}
+
+		return true
+	})
+
+	require.NotEmpty(t, codes)
+
+	return codes
+}
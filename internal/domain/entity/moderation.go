@@ -0,0 +1,171 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// ModerationStatus статус модерации пользовательского текста.
+type ModerationStatus string
+
+const (
+	ModerationStatusApproved ModerationStatus = "approved" // Проверен или не вызвал подозрений
+	ModerationStatusPending  ModerationStatus = "pending"  // Ожидает решения модератора, пока виден всем
+	ModerationStatusRejected ModerationStatus = "rejected" // Отклонен модератором и скрыт из публичных ответов
+)
+
+// IsPublic виден ли текст в публичных ответах. Тексты без статуса сохранены до появления модерации.
+func (s ModerationStatus) IsPublic() bool {
+	return s != ModerationStatusRejected
+}
+
+// ModerationSubjectType тип проверяемой сущности.
+type ModerationSubjectType string
+
+const (
+	ModerationSubjectUser    ModerationSubjectType = "user"    // Профиль пользователя
+	ModerationSubjectListing ModerationSubjectType = "listing" // Объявление
+)
+
+// ModerationContent текст сущности, переданный на проверку.
+type ModerationContent struct {
+	SubjectType ModerationSubjectType // Тип сущности
+	SubjectID   string                // Идентификатор сущности. Пуст, пока сущность не сохранена
+	AuthorID    string                // Автор текста
+	Text        string                // Проверяемый текст
+	SubmittedAt time.Time             // Время отправки текста
+}
+
+// ModerationViolation нарушение, найденное правилом проверки.
+type ModerationViolation struct {
+	Rule   string `json:"rule" db:"rule" bson:"rule"`                 // Название правила
+	Detail string `json:"detail,omitempty" db:"detail" bson:"detail"` // Что именно нашло правило
+}
+
+// ModerationViolations список нарушений.
+type ModerationViolations []ModerationViolation
+
+// ModerationCaseStatus статус дела в очереди модерации.
+type ModerationCaseStatus string
+
+const (
+	ModerationCaseStatusOpen     ModerationCaseStatus = "open"     // Ожидает решения
+	ModerationCaseStatusApproved ModerationCaseStatus = "approved" // Текст одобрен
+	ModerationCaseStatusRejected ModerationCaseStatus = "rejected" // Текст отклонен
+)
+
+// ModerationAction решение модератора.
+type ModerationAction string
+
+const (
+	ModerationActionApprove ModerationAction = "approve" // Одобрить текст
+	ModerationActionReject  ModerationAction = "reject"  // Скрыть текст
+	ModerationActionBan     ModerationAction = "ban"     // Скрыть текст и заблокировать автора
+)
+
+// ModerationReportReason причина жалобы.
+type ModerationReportReason string
+
+const (
+	ModerationReportReasonSpam  ModerationReportReason = "spam"  // Спам или реклама
+	ModerationReportReasonAbuse ModerationReportReason = "abuse" // Оскорбления
+	ModerationReportReasonFraud ModerationReportReason = "fraud" // Мошенничество
+	ModerationReportReasonOther ModerationReportReason = "other" // Другое
+)
+
+// ModerationReport жалоба пользователя на текст.
+type ModerationReport struct {
+	ReporterID string                 `json:"reporterID" db:"reporter_id" bson:"reporter_id"` // Автор жалобы
+	Reason     ModerationReportReason `json:"reason" db:"reason" bson:"reason"`               // Причина
+	Comment    string                 `json:"comment,omitempty" db:"comment" bson:"comment"`  // Пояснение
+	CreatedAt  time.Time              `json:"createdAt" db:"created_at" bson:"created_at"`    // Дата жалобы
+}
+
+// ModerationDecision решение модератора по делу.
+type ModerationDecision struct {
+	ModeratorID string           `json:"moderatorID" db:"moderator_id" bson:"moderator_id"` // Модератор
+	Action      ModerationAction `json:"action" db:"action" bson:"action"`                  // Решение
+	Comment     string           `json:"comment,omitempty" db:"comment" bson:"comment"`     // Обоснование
+	DecidedAt   time.Time        `json:"decidedAt" db:"decided_at" bson:"decided_at"`       // Дата решения
+}
+
+// ContentStatus возвращает статус модерации текста после решения.
+func (d ModerationDecision) ContentStatus() ModerationStatus {
+	if d.Action == ModerationActionApprove {
+		return ModerationStatusApproved
+	}
+
+	return ModerationStatusRejected
+}
+
+// ModerationCase дело в очереди модерации. По сущности открыто не больше одного дела:
+// новые нарушения и жалобы добавляются в него до решения модератора.
+type ModerationCase struct {
+	ID          string                `json:"id" db:"id" bson:"_id"`                                      // Идентификатор дела
+	SubjectType ModerationSubjectType `json:"subjectType" db:"subject_type" bson:"subject_type"`          // Тип сущности
+	SubjectID   string                `json:"subjectID" db:"subject_id" bson:"subject_id"`                // Идентификатор сущности
+	AuthorID    string                `json:"authorID" db:"author_id" bson:"author_id"`                   // Автор текста
+	Text        string                `json:"text" db:"text" bson:"text"`                                 // Текст на момент последней проверки или жалобы
+	Violations  ModerationViolations  `json:"violations,omitempty" db:"violations" bson:"violations"`     // Нарушения, найденные правилами
+	Reports     []ModerationReport    `json:"reports,omitempty" db:"reports" bson:"reports"`              // Жалобы пользователей
+	Status      ModerationCaseStatus  `json:"status" db:"status" bson:"status"`                           // Статус дела
+	Decision    *ModerationDecision   `json:"decision,omitempty" db:"decision" bson:"decision,omitempty"` // Решение модератора
+	Version     int64                 `json:"-" db:"version" bson:"version"`                              // Версия для оптимистичной блокировки
+	UpdatedAt   time.Time             `json:"updatedAt" db:"updated_at" bson:"updated_at"`                // Дата обновления
+	CreatedAt   time.Time             `json:"createdAt" db:"created_at" bson:"created_at"`                // Дата создания
+}
+
+// NewModerationCase открывает дело по тексту.
+func NewModerationCase(content ModerationContent, currentTime time.Time) *ModerationCase {
+	return &ModerationCase{
+		SubjectType: content.SubjectType,
+		SubjectID:   content.SubjectID,
+		AuthorID:    content.AuthorID,
+		Text:        content.Text,
+		Status:      ModerationCaseStatusOpen,
+		Version:     1,
+		UpdatedAt:   currentTime,
+		CreatedAt:   currentTime,
+	}
+}
+
+// Flag добавляет в дело нарушения, найденные в новой версии текста.
+func (c *ModerationCase) Flag(content ModerationContent, violations ModerationViolations, currentTime time.Time) {
+	c.Text = content.Text
+	c.Violations = append(c.Violations, violations...)
+	c.UpdatedAt = currentTime
+}
+
+// AddReport добавляет жалобу. Повторная жалоба того же пользователя не принимается.
+func (c *ModerationCase) AddReport(report ModerationReport) error {
+	for _, existing := range c.Reports {
+		if existing.ReporterID == report.ReporterID {
+			return fmt.Errorf("%w: %s", ErrModerationReportExists, report.ReporterID)
+		}
+	}
+
+	c.Reports = append(c.Reports, report)
+	c.UpdatedAt = report.CreatedAt
+
+	return nil
+}
+
+// Decide закрывает дело решением модератора.
+func (c *ModerationCase) Decide(decision ModerationDecision) error {
+	if c.Status != ModerationCaseStatusOpen {
+		return fmt.Errorf("%w: дело в статусе %s", ErrModerationCaseClosed, c.Status)
+	}
+
+	c.Status = ModerationCaseStatusRejected
+	if decision.Action == ModerationActionApprove {
+		c.Status = ModerationCaseStatusApproved
+	}
+
+	c.Decision = &decision
+	c.UpdatedAt = decision.DecidedAt
+
+	return nil
+}
+
+// ModerationCases список дел модерации.
+type ModerationCases []*ModerationCase
@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModerationCase_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	listing := &Listing{ID: "listing", OwnerID: "alice", Title: "Велосипед", Description: "звоните +77011234567"}
+
+	moderationCase := NewModerationCase(listing.ModerationContent(now), now)
+	assert.Equal(t, ModerationSubjectListing, moderationCase.SubjectType)
+	assert.Equal(t, "alice", moderationCase.AuthorID)
+	assert.Equal(t, "Велосипед\nзвоните +77011234567", moderationCase.Text)
+	assert.Equal(t, ModerationCaseStatusOpen, moderationCase.Status)
+
+	// Новая версия текста заменяет текст дела и дополняет нарушения.
+	listing.Description = "пишите на example.com"
+	moderationCase.Flag(listing.ModerationContent(now.Add(time.Minute)), ModerationViolations{{Rule: "link"}}, now.Add(time.Minute))
+	assert.Equal(t, "Велосипед\nпишите на example.com", moderationCase.Text)
+	assert.Equal(t, ModerationViolations{{Rule: "link"}}, moderationCase.Violations)
+	assert.Equal(t, now.Add(time.Minute), moderationCase.UpdatedAt)
+
+	report := ModerationReport{ReporterID: "bob", Reason: ModerationReportReasonSpam, CreatedAt: now.Add(time.Hour)}
+	require.NoError(t, moderationCase.AddReport(report))
+	require.ErrorIs(t, moderationCase.AddReport(report), ErrModerationReportExists, "повторная жалоба")
+	assert.Len(t, moderationCase.Reports, 1)
+
+	decision := ModerationDecision{ModeratorID: "moderator", Action: ModerationActionBan, DecidedAt: now.Add(2 * time.Hour)}
+	require.NoError(t, moderationCase.Decide(decision))
+	assert.Equal(t, ModerationCaseStatusRejected, moderationCase.Status)
+	assert.Equal(t, ModerationStatusRejected, moderationCase.Decision.ContentStatus())
+
+	require.ErrorIs(t, moderationCase.Decide(decision), ErrModerationCaseClosed, "решение уже принято")
+}
+
+func TestModerationDecision_ContentStatus(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		action ModerationAction
+		exp    ModerationStatus
+	}{
+		{action: ModerationActionApprove, exp: ModerationStatusApproved},
+		{action: ModerationActionReject, exp: ModerationStatusRejected},
+		{action: ModerationActionBan, exp: ModerationStatusRejected},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(string(s.action), func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, s.exp, ModerationDecision{Action: s.action}.ContentStatus())
+		})
+	}
+}
+
+func TestUser_Public(t *testing.T) {
+	t.Parallel()
+
+	user := &User{ID: "alice", Name: "Алиса", Bio: "текст", Moderation: ModerationStatusPending}
+	assert.Equal(t, "текст", user.Public().Bio, "ожидающая решения биография видна")
+
+	user.Moderation = ModerationStatusRejected
+	assert.Empty(t, user.Public().Bio)
+	assert.Equal(t, "текст", user.Bio, "исходный пользователь не меняется")
+	assert.Equal(t, "Алиса", user.Public().Name)
+}
@@ -0,0 +1,258 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/gocql/gocql"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// Currency код валюты по ISO 4217.
+type Currency string
+
+// Поддерживаемые валюты.
+const (
+	CurrencyKZT Currency = "KZT" // Казахстанский тенге
+	CurrencyRUB Currency = "RUB" // Российский рубль
+	CurrencyUSD Currency = "USD" // Доллар США
+	CurrencyEUR Currency = "EUR" // Евро
+)
+
+// LegacyCurrency валюта, в которой хранились суммы до появления Money.
+// Используется при чтении документов, которые еще не прошли миграцию.
+const LegacyCurrency = CurrencyKZT
+
+// currencyExponents количество знаков дробной части (минорных единиц) для валют.
+var currencyExponents = map[Currency]int{
+	CurrencyKZT: 2,
+	CurrencyRUB: 2,
+	CurrencyUSD: 2,
+	CurrencyEUR: 2,
+}
+
+// ParseCurrency возвращает валюту по коду ISO 4217.
+func ParseCurrency(code string) (Currency, error) {
+	currency := Currency(strings.ToUpper(strings.TrimSpace(code)))
+	if _, ok := currencyExponents[currency]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrCurrencyUnknown, code)
+	}
+
+	return currency, nil
+}
+
+// Exponent возвращает количество минорных единиц валюты.
+func (c Currency) Exponent() int {
+	return currencyExponents[c]
+}
+
+// Currencies набор допустимых валют.
+type Currencies map[Currency]struct{}
+
+// NewCurrencies создает набор допустимых валют из кодов ISO 4217.
+func NewCurrencies(codes []string) (Currencies, error) {
+	currencies := make(Currencies, len(codes))
+
+	for _, code := range codes {
+		currency, err := ParseCurrency(code)
+		if err != nil {
+			return nil, err
+		}
+
+		currencies[currency] = struct{}{}
+	}
+
+	return currencies, nil
+}
+
+// Validate проверяет, что валюта входит в набор допустимых.
+func (c Currencies) Validate(currency Currency) error {
+	if _, ok := c[currency]; !ok {
+		return fmt.Errorf("%w: %s", ErrCurrencyNotAllowed, currency)
+	}
+
+	return nil
+}
+
+// Money денежная сумма в минорных единицах валюты.
+type Money struct {
+	Amount   int64    `json:"amount" db:"amount" bson:"amount" validate:"gte=0" example:"39900"`              // Сумма в минорных единицах (тиын, копейки, центы)
+	Currency Currency `json:"currency" db:"currency" bson:"currency" validate:"required,len=3" example:"KZT"` // Код валюты ISO 4217
+}
+
+// NewMoney создает денежную сумму.
+func NewMoney(amount int64, currency Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// IsZero является ли сумма нулевой.
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// IsNegative является ли сумма отрицательной.
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// Add возвращает сумму двух денежных значений.
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.sameCurrency(other); err != nil {
+		return Money{}, err
+	}
+
+	if (other.Amount > 0 && m.Amount > math.MaxInt64-other.Amount) ||
+		(other.Amount < 0 && m.Amount < math.MinInt64-other.Amount) {
+		return Money{}, ErrMoneyOverflow
+	}
+
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+// Sub возвращает разность двух денежных значений.
+func (m Money) Sub(other Money) (Money, error) {
+	if other.Amount == math.MinInt64 {
+		return Money{}, ErrMoneyOverflow
+	}
+
+	return m.Add(other.Negate())
+}
+
+// Mul умножает сумму на целое число.
+func (m Money) Mul(factor int64) (Money, error) {
+	if m.Amount == 0 || factor == 0 {
+		return Money{Currency: m.Currency}, nil
+	}
+
+	result := m.Amount * factor
+	if result/factor != m.Amount || (m.Amount == -1 && factor == math.MinInt64) ||
+		(factor == -1 && m.Amount == math.MinInt64) {
+		return Money{}, ErrMoneyOverflow
+	}
+
+	return Money{Amount: result, Currency: m.Currency}, nil
+}
+
+// Negate возвращает сумму с противоположным знаком.
+func (m Money) Negate() Money {
+	return Money{Amount: -m.Amount, Currency: m.Currency}
+}
+
+// Cmp сравнивает суммы: -1 если m < other, 0 если равны, 1 если m > other.
+func (m Money) Cmp(other Money) (int, error) {
+	if err := m.sameCurrency(other); err != nil {
+		return 0, err
+	}
+
+	switch {
+	case m.Amount < other.Amount:
+		return -1, nil
+	case m.Amount > other.Amount:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// String возвращает сумму в виде строки, например "399.00 KZT".
+func (m Money) String() string {
+	exp := m.Currency.Exponent()
+	if exp == 0 {
+		return fmt.Sprintf("%d %s", m.Amount, m.Currency)
+	}
+
+	sign := ""
+	amount := uint64(m.Amount)
+
+	if m.Amount < 0 {
+		sign = "-"
+		amount = uint64(-(m.Amount + 1)) + 1
+	}
+
+	scale := uint64(math.Pow10(exp))
+
+	return fmt.Sprintf("%s%d.%0*d %s", sign, amount/scale, exp, amount%scale, m.Currency)
+}
+
+// sameCurrency проверяет, что суммы в одной валюте.
+func (m Money) sameCurrency(other Money) error {
+	if m.Currency != other.Currency {
+		return fmt.Errorf("%w: %s != %s", ErrMoneyCurrencyMismatch, m.Currency, other.Currency)
+	}
+
+	return nil
+}
+
+// BSON
+
+// moneyDocument представление Money в MongoDB.
+type moneyDocument struct {
+	Amount   int64    `bson:"amount"`
+	Currency Currency `bson:"currency"`
+}
+
+// MarshalBSONValue сериализует сумму во вложенный документ MongoDB.
+func (m Money) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bson.MarshalValue(moneyDocument(m))
+}
+
+// UnmarshalBSONValue десериализует сумму из MongoDB.
+// Поддерживает старый формат, в котором стоимость хранилась целым числом без валюты.
+func (m *Money) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	raw := bson.RawValue{Type: t, Value: data}
+
+	switch t {
+	case bsontype.Int32:
+		*m = Money{Amount: int64(raw.Int32()), Currency: LegacyCurrency}
+	case bsontype.Int64:
+		*m = Money{Amount: raw.Int64(), Currency: LegacyCurrency}
+	case bsontype.EmbeddedDocument:
+		var doc moneyDocument
+		if err := raw.Unmarshal(&doc); err != nil {
+			return fmt.Errorf("%w: %s", ErrMoneyDecode, err.Error())
+		}
+
+		*m = Money(doc)
+	case bsontype.Null:
+		*m = Money{}
+	default:
+		return fmt.Errorf("%w: неожиданный тип %s", ErrMoneyDecode, t)
+	}
+
+	return nil
+}
+
+// CQL
+
+// MarshalUDT сериализует сумму в пользовательский тип Cassandra money(amount bigint, currency text).
+func (m Money) MarshalUDT(name string, info gocql.TypeInfo) ([]byte, error) {
+	switch name {
+	case "amount":
+		return gocql.Marshal(info, m.Amount)
+	case "currency":
+		return gocql.Marshal(info, string(m.Currency))
+	default:
+		return nil, fmt.Errorf("%w: неизвестное поле %q", ErrMoneyDecode, name)
+	}
+}
+
+// UnmarshalUDT десериализует сумму из пользовательского типа Cassandra.
+func (m *Money) UnmarshalUDT(name string, info gocql.TypeInfo, data []byte) error {
+	switch name {
+	case "amount":
+		return gocql.Unmarshal(info, data, &m.Amount)
+	case "currency":
+		var currency string
+		if err := gocql.Unmarshal(info, data, &currency); err != nil {
+			return err
+		}
+
+		m.Currency = Currency(currency)
+
+		return nil
+	default:
+		return fmt.Errorf("%w: неизвестное поле %q", ErrMoneyDecode, name)
+	}
+}
@@ -0,0 +1,132 @@
+package entity
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestMoney_Add(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		a, b Money
+		exp  Money
+		err  error
+	}{
+		{
+			name: "same currency",
+			a:    NewMoney(100, CurrencyKZT),
+			b:    NewMoney(250, CurrencyKZT),
+			exp:  NewMoney(350, CurrencyKZT),
+		},
+		{
+			name: "currency mismatch",
+			a:    NewMoney(100, CurrencyKZT),
+			b:    NewMoney(100, CurrencyUSD),
+			err:  ErrMoneyCurrencyMismatch,
+		},
+		{
+			name: "overflow",
+			a:    NewMoney(math.MaxInt64, CurrencyKZT),
+			b:    NewMoney(1, CurrencyKZT),
+			err:  ErrMoneyOverflow,
+		},
+		{
+			name: "negative overflow",
+			a:    NewMoney(math.MinInt64, CurrencyKZT),
+			b:    NewMoney(-1, CurrencyKZT),
+			err:  ErrMoneyOverflow,
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := s.a.Add(s.b)
+			if s.err != nil {
+				assert.ErrorIs(t, err, s.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, s.exp, res)
+		})
+	}
+}
+
+func TestMoney_Sub(t *testing.T) {
+	t.Parallel()
+
+	res, err := NewMoney(100, CurrencyKZT).Sub(NewMoney(250, CurrencyKZT))
+	require.NoError(t, err)
+	assert.Equal(t, NewMoney(-150, CurrencyKZT), res)
+
+	_, err = NewMoney(0, CurrencyKZT).Sub(NewMoney(math.MinInt64, CurrencyKZT))
+	assert.ErrorIs(t, err, ErrMoneyOverflow)
+}
+
+func TestMoney_Mul(t *testing.T) {
+	t.Parallel()
+
+	res, err := NewMoney(150, CurrencyKZT).Mul(3)
+	require.NoError(t, err)
+	assert.Equal(t, NewMoney(450, CurrencyKZT), res)
+
+	_, err = NewMoney(math.MaxInt64/2+1, CurrencyKZT).Mul(2)
+	assert.ErrorIs(t, err, ErrMoneyOverflow)
+
+	_, err = NewMoney(math.MinInt64, CurrencyKZT).Mul(-1)
+	assert.ErrorIs(t, err, ErrMoneyOverflow)
+}
+
+func TestMoney_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "399.00 KZT", NewMoney(39900, CurrencyKZT).String())
+	assert.Equal(t, "-0.05 USD", NewMoney(-5, CurrencyUSD).String())
+}
+
+func TestMoney_BSON(t *testing.T) {
+	t.Parallel()
+
+	type document struct {
+		Cost Money `bson:"cost"`
+	}
+
+	data, err := bson.Marshal(document{Cost: NewMoney(39900, CurrencyUSD)})
+	require.NoError(t, err)
+
+	var decoded document
+	require.NoError(t, bson.Unmarshal(data, &decoded))
+	assert.Equal(t, NewMoney(39900, CurrencyUSD), decoded.Cost)
+
+	// старый формат: стоимость хранилась целым числом
+	legacy, err := bson.Marshal(bson.M{"cost": 39900})
+	require.NoError(t, err)
+
+	require.NoError(t, bson.Unmarshal(legacy, &decoded))
+	assert.Equal(t, NewMoney(39900, LegacyCurrency), decoded.Cost)
+}
+
+func TestCurrencies_Validate(t *testing.T) {
+	t.Parallel()
+
+	currencies, err := NewCurrencies([]string{"kzt", "USD"})
+	require.NoError(t, err)
+
+	assert.NoError(t, currencies.Validate(CurrencyKZT))
+	assert.NoError(t, currencies.Validate(CurrencyUSD))
+	assert.ErrorIs(t, currencies.Validate(CurrencyEUR), ErrCurrencyNotAllowed)
+
+	_, err = NewCurrencies([]string{"XXX"})
+	assert.ErrorIs(t, err, ErrCurrencyUnknown)
+}
@@ -0,0 +1,220 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// NotificationKind тип уведомления.
+type NotificationKind string
+
+// Типы уведомлений.
+const (
+	NotificationKindWishlistMatch  NotificationKind = "wishlist_match"  // Появилось объявление из списка желаний
+	NotificationKindMessage        NotificationKind = "message"         // Новое сообщение в переписке
+	NotificationKindProfileUpdated NotificationKind = "profile_updated" // Профиль пользователя изменен
+)
+
+// NotificationKinds все типы уведомлений. Для каждого типа должен быть шаблон.
+var NotificationKinds = []NotificationKind{
+	NotificationKindWishlistMatch,
+	NotificationKindMessage,
+	NotificationKindProfileUpdated,
+}
+
+// IsValid известен ли тип уведомления.
+func (k NotificationKind) IsValid() bool {
+	for _, kind := range NotificationKinds {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NotificationCategory категория уведомления во входящих.
+type NotificationCategory string
+
+// Категории уведомлений.
+const (
+	NotificationCategoryListings NotificationCategory = "listings" // Объявления
+	NotificationCategoryMessages NotificationCategory = "messages" // Переписки
+	NotificationCategoryAccount  NotificationCategory = "account"  // Профиль пользователя
+)
+
+// Category возвращает категорию уведомления во входящих.
+func (k NotificationKind) Category() NotificationCategory {
+	switch k {
+	case NotificationKindWishlistMatch:
+		return NotificationCategoryListings
+	case NotificationKindMessage:
+		return NotificationCategoryMessages
+	default:
+		return NotificationCategoryAccount
+	}
+}
+
+// Notification уведомление пользователю. Заголовок и текст заполняются по шаблону типа уведомления из Data.
+type Notification struct {
+	UserID    string            `json:"userID"`         // Идентификатор получателя
+	Kind      NotificationKind  `json:"kind"`           // Тип уведомления
+	Title     string            `json:"title"`          // Заголовок
+	Body      string            `json:"body"`           // Текст
+	Data      map[string]string `json:"data,omitempty"` // Данные для шаблона и перехода в приложении
+	CreatedAt time.Time         `json:"createdAt"`      // Дата создания
+}
+
+// NotificationChannel канал доставки уведомлений.
+type NotificationChannel string
+
+// Каналы доставки уведомлений.
+const (
+	NotificationChannelPush  NotificationChannel = "push"  // Push-уведомление на устройства пользователя
+	NotificationChannelEmail NotificationChannel = "email" // Письмо на адрес из настроек
+	NotificationChannelInApp NotificationChannel = "inapp" // Входящие уведомления в приложении
+)
+
+// NotificationChannels список каналов доставки.
+type NotificationChannels []NotificationChannel
+
+// AllNotificationChannels все каналы доставки. Используются для типов уведомлений без настройки.
+var AllNotificationChannels = NotificationChannels{
+	NotificationChannelPush,
+	NotificationChannelEmail,
+	NotificationChannelInApp,
+}
+
+// Contains входит ли канал в список.
+func (c NotificationChannels) Contains(channel NotificationChannel) bool {
+	for _, ch := range c {
+		if ch == channel {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NotificationPreferences настройки уведомлений пользователя.
+type NotificationPreferences struct {
+	UserID    string                                    `json:"userID" db:"user_id" bson:"_id"`                        // Идентификатор пользователя
+	Email     string                                    `json:"email,omitempty" db:"email" bson:"email,omitempty"`     // Адрес для писем. Без адреса письма не отправляются
+	Channels  map[NotificationKind]NotificationChannels `json:"channels" db:"channels" bson:"channels"`                // Каналы по типам уведомлений. Для типов без настройки используются все каналы
+	UpdatedAt time.Time                                 `json:"updatedAt,omitempty" db:"updated_at" bson:"updated_at"` // Дата обновления настроек
+}
+
+// DefaultNotificationPreferences возвращает настройки пользователя, который их не менял: все уведомления во все каналы.
+func DefaultNotificationPreferences(userID string) *NotificationPreferences {
+	return &NotificationPreferences{
+		UserID:   userID,
+		Channels: map[NotificationKind]NotificationChannels{},
+	}
+}
+
+// Validate проверяет, что в настройках только известные типы уведомлений и каналы.
+func (p *NotificationPreferences) Validate() error {
+	for kind, channels := range p.Channels {
+		if !kind.IsValid() {
+			return fmt.Errorf("%w: неизвестный тип уведомления %s", ErrNotificationPreferencesInvalid, kind)
+		}
+
+		for _, channel := range channels {
+			if !AllNotificationChannels.Contains(channel) {
+				return fmt.Errorf("%w: неизвестный канал %s", ErrNotificationPreferencesInvalid, channel)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ChannelsFor возвращает каналы, в которые пользователь получает уведомления типа kind.
+// Письма не отправляются, пока не указан адрес.
+func (p *NotificationPreferences) ChannelsFor(kind NotificationKind) NotificationChannels {
+	channels, ok := p.Channels[kind]
+	if !ok {
+		channels = AllNotificationChannels
+	}
+
+	enabled := make(NotificationChannels, 0, len(channels))
+
+	for _, channel := range channels {
+		if channel == NotificationChannelEmail && p.Email == "" {
+			continue
+		}
+
+		enabled = append(enabled, channel)
+	}
+
+	return enabled
+}
+
+// DevicePlatform платформа устройства.
+type DevicePlatform string
+
+// Платформы устройств.
+const (
+	DevicePlatformAndroid DevicePlatform = "android" // Android
+	DevicePlatformIOS     DevicePlatform = "ios"     // iOS
+	DevicePlatformWeb     DevicePlatform = "web"     // Браузер
+)
+
+// DeviceToken токен устройства для push-уведомлений. Токен принадлежит одному пользователю:
+// при повторной регистрации на другого пользователя он переходит к нему.
+type DeviceToken struct {
+	Token     string         `json:"token" db:"token" bson:"_id"`                 // Токен устройства
+	UserID    string         `json:"userID" db:"user_id" bson:"user_id"`          // Владелец устройства
+	Platform  DevicePlatform `json:"platform" db:"platform" bson:"platform"`      // Платформа устройства
+	UpdatedAt time.Time      `json:"updatedAt" db:"updated_at" bson:"updated_at"` // Дата последней регистрации
+}
+
+// DeviceTokens список токенов устройств.
+type DeviceTokens []*DeviceToken
+
+// Tokens возвращает значения токенов.
+func (d DeviceTokens) Tokens() []string {
+	tokens := make([]string, 0, len(d))
+	for _, device := range d {
+		tokens = append(tokens, device.Token)
+	}
+
+	return tokens
+}
+
+// InboxNotification уведомление во входящих пользователя.
+type InboxNotification struct {
+	ID        string               `json:"id" db:"id" bson:"_id"`                                  // Идентификатор уведомления
+	UserID    string               `json:"userID" db:"user_id" bson:"user_id"`                     // Идентификатор получателя
+	Kind      NotificationKind     `json:"kind" db:"kind" bson:"kind"`                             // Тип уведомления
+	Category  NotificationCategory `json:"category" db:"category" bson:"category"`                 // Категория уведомления
+	Title     string               `json:"title" db:"title" bson:"title"`                          // Заголовок
+	Body      string               `json:"body" db:"body" bson:"body"`                             // Текст
+	Data      map[string]string    `json:"data,omitempty" db:"data" bson:"data,omitempty"`         // Данные для перехода в приложении
+	ReadAt    *time.Time           `json:"readAt,omitempty" db:"read_at" bson:"read_at,omitempty"` // Дата прочтения
+	CreatedAt time.Time            `json:"createdAt" db:"created_at" bson:"created_at"`            // Дата создания
+	ExpiresAt time.Time            `json:"-" db:"expires_at" bson:"expires_at"`                    // Дата удаления из входящих
+}
+
+// InboxNotifications список уведомлений во входящих.
+type InboxNotifications []*InboxNotification
+
+// NewInboxNotification создает уведомление во входящих из доставляемого уведомления.
+// Уведомление хранится retention с момента создания.
+func NewInboxNotification(notification Notification, retention time.Duration) *InboxNotification {
+	return &InboxNotification{
+		UserID:    notification.UserID,
+		Kind:      notification.Kind,
+		Category:  notification.Kind.Category(),
+		Title:     notification.Title,
+		Body:      notification.Body,
+		Data:      notification.Data,
+		CreatedAt: notification.CreatedAt,
+		ExpiresAt: notification.CreatedAt.Add(retention),
+	}
+}
+
+// UnreadCount количество непрочитанных уведомлений.
+type UnreadCount struct {
+	Unread int64 `json:"unread" example:"3"` // Количество непрочитанных уведомлений
+}
@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInboxNotification(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	retention := 90 * 24 * time.Hour
+
+	cases := []struct {
+		kind     NotificationKind
+		category NotificationCategory
+	}{
+		{kind: NotificationKindWishlistMatch, category: NotificationCategoryListings},
+		{kind: NotificationKindMessage, category: NotificationCategoryMessages},
+		{kind: NotificationKindProfileUpdated, category: NotificationCategoryAccount},
+	}
+
+	for _, s := range cases {
+		inbox := NewInboxNotification(Notification{UserID: "user", Kind: s.kind, CreatedAt: now}, retention)
+
+		assert.Equal(t, s.category, inbox.Category, s.kind)
+		assert.Equal(t, now.Add(retention), inbox.ExpiresAt, s.kind)
+		assert.Nil(t, inbox.ReadAt, s.kind)
+	}
+
+	assert.Len(t, cases, len(NotificationKinds), "у каждого типа уведомления есть категория")
+}
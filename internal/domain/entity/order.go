@@ -1,21 +1,166 @@
 package entity
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
+
+// OrderStatus статус заказа.
+type OrderStatus string
+
+// Статусы заказа.
+const (
+	OrderStatusCreated   OrderStatus = "created"   // Заказ создан
+	OrderStatusConfirmed OrderStatus = "confirmed" // Заказ подтвержден
+	OrderStatusCompleted OrderStatus = "completed" // Заказ завершен
+	OrderStatusCancelled OrderStatus = "cancelled" // Заказ отменен
+)
+
+// orderStatusTransitions допустимые переходы между статусами заказа.
+// Заказам, созданным до появления статусов, статус created задает миграция 000002_order_status_created.
+var orderStatusTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusCreated:   {OrderStatusConfirmed, OrderStatusCancelled},
+	OrderStatusConfirmed: {OrderStatusCompleted, OrderStatusCancelled},
+}
+
+// CanTransitionTo проверяет, допустим ли переход в указанный статус.
+func (s OrderStatus) CanTransitionTo(to OrderStatus) bool {
+	for _, allowed := range orderStatusTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsTerminal является ли статус конечным.
+func (s OrderStatus) IsTerminal() bool {
+	return s == OrderStatusCompleted || s == OrderStatusCancelled
+}
 
 // Order сущность заказа.
 type Order struct {
-	ID        string    `json:"id" db:"id" bson:"_id"`                       // Идентификатор заказа
-	UserID    string    `json:"userID" db:"user_id" bson:"user_id"`          // Идентификатор пользователя
-	Cost      int       `json:"cost" db:"cost" bson:"cost"`                  // Стоимость заказа
-	CreatedAt time.Time `json:"createdAt" db:"created_at" bson:"created_at"` // Дата создания заказа
+	ID             string       `json:"id" db:"id" bson:"_id"`                                                          // Идентификатор заказа
+	UserID         string       `json:"userID" db:"user_id" bson:"user_id"`                                             // Идентификатор пользователя
+	CounterpartyID string       `json:"counterpartyID,omitempty" db:"counterparty_id" bson:"counterparty_id,omitempty"` // Идентификатор второй стороны сделки
+	Cost           Money        `json:"cost" db:"cost" bson:"cost"`                                                     // Стоимость заказа
+	Status         OrderStatus  `json:"status" db:"status" bson:"status"`                                               // Статус заказа
+	History        OrderHistory `json:"history,omitempty" db:"-" bson:"history,omitempty" audit:"-"`                    // История изменения статуса заказа
+	TradeOfferID   string       `json:"tradeOfferID,omitempty" db:"trade_offer_id" bson:"trade_offer_id,omitempty"`     // Предложение обмена, по которому создан заказ
+	Legs           OrderLegs    `json:"legs,omitempty" db:"-" bson:"legs,omitempty"`                                    // Части сделки обмена: передаваемые предметы и доплата
+	CreatedAt      time.Time    `json:"createdAt" db:"created_at" bson:"created_at"`                                    // Дата создания заказа
 }
 
 // NewOrder создает заказ.
 func NewOrder(currentTime time.Time) *Order {
 	return &Order{
+		Status:    OrderStatusCreated,
 		CreatedAt: currentTime,
 	}
 }
 
+// NewTradeOrder создает заказ по принятому предложению обмена. Стоимость заказа равна доплате.
+// Предметы берутся из listings по идентификаторам объявлений предложения.
+func NewTradeOrder(offer *TradeOffer, listings map[string]*Listing, currentTime time.Time) *Order {
+	order := NewOrder(currentTime)
+	order.UserID = offer.ProposerID
+	order.CounterpartyID = offer.RecipientID
+	order.TradeOfferID = offer.ID
+	order.Cost = NewMoney(0, LegacyCurrency)
+
+	if offer.Fairness != nil {
+		order.Cost = NewMoney(0, offer.Fairness.Currency)
+	}
+
+	goods := func(ids []string, from, to string) {
+		for _, id := range ids {
+			leg := OrderLeg{Kind: OrderLegKindGoods, FromUserID: from, ToUserID: to, ListingID: id}
+
+			if listing, ok := listings[id]; ok {
+				leg.Title = listing.Title
+				leg.Amount = listing.Valuation
+			}
+
+			order.Legs = append(order.Legs, leg)
+		}
+	}
+
+	goods(offer.OfferedListingIDs, offer.ProposerID, offer.RecipientID)
+	goods(offer.RequestedListingIDs, offer.RecipientID, offer.ProposerID)
+
+	if payerID, payeeID, ok := offer.CashParties(); ok {
+		amount := offer.Cash.Amount
+		order.Cost = amount
+		order.Legs = append(order.Legs, OrderLeg{
+			Kind:       OrderLegKindCash,
+			FromUserID: payerID,
+			ToUserID:   payeeID,
+			Amount:     &amount,
+			PaymentID:  offer.Cash.PaymentID,
+		})
+	}
+
+	return order
+}
+
+// ChangeStatus переводит заказ в новый статус и возвращает запись для истории.
+func (o *Order) ChangeStatus(actorID string, to OrderStatus, reason string, currentTime time.Time) (OrderStatusChange, error) {
+	if !o.Status.CanTransitionTo(to) {
+		return OrderStatusChange{}, fmt.Errorf("%w: %s -> %s", ErrOrderStatusTransition, o.Status, to)
+	}
+
+	change := NewOrderStatusChange(actorID, o.Status, to, reason, currentTime)
+
+	o.Status = to
+
+	return change, nil
+}
+
+// IsParticipant является ли пользователь стороной заказа.
+func (o *Order) IsParticipant(userID string) bool {
+	return userID != "" && (o.UserID == userID || o.CounterpartyID == userID)
+}
+
+// Counterparty возвращает вторую сторону заказа для участника.
+// Если у заказа нет второй стороны или пользователь не участник, возвращает false.
+func (o *Order) Counterparty(userID string) (string, bool) {
+	if o.CounterpartyID == "" {
+		return "", false
+	}
+
+	switch userID {
+	case o.UserID:
+		return o.CounterpartyID, true
+	case o.CounterpartyID:
+		return o.UserID, true
+	default:
+		return "", false
+	}
+}
+
+// OrderLegKind тип части сделки.
+type OrderLegKind string
+
+// Типы частей сделки.
+const (
+	OrderLegKindGoods OrderLegKind = "goods" // Передача предмета
+	OrderLegKindCash  OrderLegKind = "cash"  // Денежная доплата
+)
+
+// OrderLeg часть сделки обмена: передача предмета или денежная доплата от одной стороны другой.
+type OrderLeg struct {
+	Kind       OrderLegKind `json:"kind" bson:"kind"`                                // Тип части сделки
+	FromUserID string       `json:"fromUserID" bson:"from_user_id"`                  // Кто отдает
+	ToUserID   string       `json:"toUserID" bson:"to_user_id"`                      // Кто получает
+	ListingID  string       `json:"listingID,omitempty" bson:"listing_id,omitempty"` // Объявление передаваемого предмета
+	Title      string       `json:"title,omitempty" bson:"title,omitempty"`          // Заголовок объявления на момент сделки
+	Amount     *Money       `json:"amount,omitempty" bson:"amount,omitempty"`        // Оценка предмета или сумма доплаты
+	PaymentID  string       `json:"paymentID,omitempty" bson:"payment_id,omitempty"` // Платеж доплаты
+}
+
+// OrderLegs части сделки обмена.
+type OrderLegs []OrderLeg
+
 // Orders список заказов.
 type Orders []*Order
@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+// OrderStatusChange запись об изменении статуса заказа.
+type OrderStatusChange struct {
+	ActorID   string      `json:"actorID" db:"actor_id" bson:"actor_id"`       // Идентификатор пользователя, изменившего статус
+	From      OrderStatus `json:"from" db:"from_status" bson:"from"`           // Предыдущий статус
+	To        OrderStatus `json:"to" db:"to_status" bson:"to"`                 // Новый статус
+	Reason    string      `json:"reason,omitempty" db:"reason" bson:"reason"`  // Причина изменения
+	CreatedAt time.Time   `json:"createdAt" db:"created_at" bson:"created_at"` // Дата изменения
+}
+
+// NewOrderStatusChange создает запись об изменении статуса заказа.
+func NewOrderStatusChange(actorID string, from, to OrderStatus, reason string, currentTime time.Time) OrderStatusChange {
+	return OrderStatusChange{
+		ActorID:   actorID,
+		From:      from,
+		To:        to,
+		Reason:    reason,
+		CreatedAt: currentTime,
+	}
+}
+
+// OrderHistory история изменения статуса заказа.
+type OrderHistory []OrderStatusChange
@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderStatus_CanTransitionTo(t *testing.T) {
+	t.Parallel()
+
+	statuses := []OrderStatus{
+		OrderStatusCreated,
+		OrderStatusConfirmed,
+		OrderStatusCompleted,
+		OrderStatusCancelled,
+	}
+
+	allowed := map[OrderStatus][]OrderStatus{
+		OrderStatusCreated:   {OrderStatusConfirmed, OrderStatusCancelled},
+		OrderStatusConfirmed: {OrderStatusCompleted, OrderStatusCancelled},
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			want := false
+
+			for _, status := range allowed[from] {
+				if status == to {
+					want = true
+				}
+			}
+
+			assert.Equal(t, want, from.CanTransitionTo(to), "%s -> %s", from, to)
+		}
+
+		assert.Equal(t, len(allowed[from]) == 0, from.IsTerminal(), from)
+	}
+}
+
+func TestOrder_ChangeStatus(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := created.Add(time.Hour)
+
+	cases := []struct {
+		name string
+		from OrderStatus
+		to   OrderStatus
+		err  error
+	}{
+		{name: "подтверждение созданного заказа", from: OrderStatusCreated, to: OrderStatusConfirmed},
+		{name: "отмена созданного заказа", from: OrderStatusCreated, to: OrderStatusCancelled},
+		{name: "завершение подтвержденного заказа", from: OrderStatusConfirmed, to: OrderStatusCompleted},
+		{name: "завершение без подтверждения", from: OrderStatusCreated, to: OrderStatusCompleted, err: ErrOrderStatusTransition},
+		{name: "отмена завершенного заказа", from: OrderStatusCompleted, to: OrderStatusCancelled, err: ErrOrderStatusTransition},
+		{name: "заказ без статуса", from: "", to: OrderStatusConfirmed, err: ErrOrderStatusTransition},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			order := NewOrder(created)
+			order.Status = s.from
+
+			change, err := order.ChangeStatus("655d8a4d3afea534e56b570e", s.to, "причина", now)
+			if s.err != nil {
+				assert.ErrorIs(t, err, s.err)
+				assert.Equal(t, s.from, order.Status)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, s.to, order.Status)
+			assert.Equal(t, NewOrderStatusChange("655d8a4d3afea534e56b570e", s.from, s.to, "причина", now), change)
+		})
+	}
+}
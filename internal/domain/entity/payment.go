@@ -0,0 +1,326 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// PaymentStatus статус платежа.
+type PaymentStatus string
+
+// Статусы платежа.
+const (
+	PaymentStatusAuthorized        PaymentStatus = "authorized"         // Сумма заблокирована на счете плательщика
+	PaymentStatusCaptured          PaymentStatus = "captured"           // Деньги списаны с плательщика
+	PaymentStatusPartiallyRefunded PaymentStatus = "partially_refunded" // Часть денег возвращена плательщику
+	PaymentStatusRefunded          PaymentStatus = "refunded"           // Деньги возвращены плательщику полностью
+	PaymentStatusVoided            PaymentStatus = "voided"             // Блокировка снята без списания
+	PaymentStatusFailed            PaymentStatus = "failed"             // Провайдер не смог провести платеж
+)
+
+// paymentStatusTransitions допустимые переходы между статусами платежа.
+var paymentStatusTransitions = map[PaymentStatus][]PaymentStatus{
+	PaymentStatusAuthorized:        {PaymentStatusCaptured, PaymentStatusVoided, PaymentStatusFailed},
+	PaymentStatusCaptured:          {PaymentStatusPartiallyRefunded, PaymentStatusRefunded},
+	PaymentStatusPartiallyRefunded: {PaymentStatusPartiallyRefunded, PaymentStatusRefunded},
+}
+
+// CanTransitionTo проверяет, допустим ли переход в указанный статус.
+func (s PaymentStatus) CanTransitionTo(to PaymentStatus) bool {
+	for _, allowed := range paymentStatusTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsRefundable можно ли вернуть деньги платежа.
+func (s PaymentStatus) IsRefundable() bool {
+	return s == PaymentStatusCaptured || s == PaymentStatusPartiallyRefunded
+}
+
+// PaymentCharge запрос на блокировку суммы плательщика в пользу получателя.
+type PaymentCharge struct {
+	IdempotencyKey string // Ключ идемпотентности. Повторный запрос с тем же ключом не блокирует деньги второй раз
+	PayerID        string // Идентификатор плательщика
+	PayeeID        string // Идентификатор получателя
+	Amount         Money  // Сумма
+	Description    string // Назначение платежа
+}
+
+// ProviderPayment состояние платежа у платежного провайдера.
+type ProviderPayment struct {
+	ID       string        // Идентификатор платежа у провайдера
+	Status   PaymentStatus // Статус платежа у провайдера
+	Amount   Money         // Сумма платежа
+	Refunded Money         // Сумма возвратов
+}
+
+// PaymentRefund возврат денег по платежу.
+type PaymentRefund struct {
+	ID        string    `json:"id" bson:"id"`                             // Идентификатор возврата у провайдера
+	Amount    Money     `json:"amount" bson:"amount"`                     // Сумма возврата
+	Reason    string    `json:"reason,omitempty" bson:"reason,omitempty"` // Причина возврата
+	CreatedAt time.Time `json:"createdAt" bson:"created_at"`              // Дата возврата
+}
+
+// PaymentRefunds список возвратов.
+type PaymentRefunds []PaymentRefund
+
+// Границы паузы между попытками провести операцию с платежом у провайдера.
+const (
+	paymentSettlementMinBackoff = time.Minute
+	paymentSettlementMaxBackoff = time.Hour
+)
+
+// PaymentSettlement операция с платежом, которую нужно провести у провайдера.
+// Сохраняется в одной транзакции с изменением, которое ее требует, и повторяется, пока провайдер ее не проведет.
+type PaymentSettlement struct {
+	Status         PaymentStatus `json:"status" bson:"status"`                            // Статус, к которому ведет операция: списание, снятие блокировки или возврат
+	Refund         Money         `json:"refund,omitempty" bson:"refund,omitempty"`        // Сумма возврата
+	Refunded       Money         `json:"-" bson:"refunded,omitempty"`                     // Сумма всех возвратов после проведения возврата
+	Reason         string        `json:"reason,omitempty" bson:"reason,omitempty"`        // Причина операции
+	IdempotencyKey string        `json:"-" bson:"idempotency_key"`                        // Ключ идемпотентности. Повтор с тем же ключом не возвращает деньги дважды
+	Attempts       int           `json:"attempts" bson:"attempts"`                        // Количество неудачных попыток
+	LastError      string        `json:"lastError,omitempty" bson:"last_error,omitempty"` // Ошибка последней попытки
+	NextAttemptAt  time.Time     `json:"nextAttemptAt" bson:"next_attempt_at"`            // Время следующей попытки
+}
+
+// Payment платеж по заказу. Хранит состояние платежа у провайдера и историю возвратов.
+type Payment struct {
+	ID                string             `json:"id" db:"id" bson:"_id"`                                                 // Идентификатор платежа
+	OrderID           string             `json:"orderID" db:"order_id" bson:"order_id"`                                 // Заказ, который оплачивается
+	ProviderPaymentID string             `json:"providerPaymentID" db:"provider_payment_id" bson:"provider_payment_id"` // Идентификатор платежа у провайдера
+	PayerID           string             `json:"payerID" db:"payer_id" bson:"payer_id"`                                 // Идентификатор плательщика
+	PayeeID           string             `json:"payeeID" db:"payee_id" bson:"payee_id"`                                 // Идентификатор получателя
+	Amount            Money              `json:"amount" db:"amount" bson:"amount"`                                      // Сумма платежа
+	Refunded          Money              `json:"refunded" db:"refunded" bson:"refunded"`                                // Сумма возвратов
+	Status            PaymentStatus      `json:"status" db:"status" bson:"status"`                                      // Статус платежа
+	Refunds           PaymentRefunds     `json:"refunds,omitempty" db:"-" bson:"refunds,omitempty"`                     // Возвраты
+	Settlement        *PaymentSettlement `json:"settlement,omitempty" db:"-" bson:"settlement,omitempty"`               // Операция, ожидающая проведения у провайдера
+	Version           int64              `json:"-" db:"version" bson:"version"`                                         // Версия для оптимистичной блокировки
+	UpdatedAt         time.Time          `json:"updatedAt" db:"updated_at" bson:"updated_at"`                           // Дата обновления
+	CreatedAt         time.Time          `json:"createdAt" db:"created_at" bson:"created_at"`                           // Дата создания
+}
+
+// Payments список платежей.
+type Payments []*Payment
+
+// NewPayment создает платеж по заказу из ответа провайдера.
+func NewPayment(orderID, payerID, payeeID string, provider *ProviderPayment, currentTime time.Time) *Payment {
+	return &Payment{
+		OrderID:           orderID,
+		ProviderPaymentID: provider.ID,
+		PayerID:           payerID,
+		PayeeID:           payeeID,
+		Amount:            provider.Amount,
+		Refunded:          NewMoney(0, provider.Amount.Currency),
+		Status:            provider.Status,
+		Version:           1,
+		UpdatedAt:         currentTime,
+		CreatedAt:         currentTime,
+	}
+}
+
+// Transition переводит платеж в новый статус.
+func (p *Payment) Transition(to PaymentStatus, currentTime time.Time) error {
+	if !p.Status.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %s -> %s", ErrPaymentTransition, p.Status, to)
+	}
+
+	p.Status = to
+	p.UpdatedAt = currentTime
+
+	return nil
+}
+
+// Refundable возвращает сумму, которую еще можно вернуть плательщику.
+func (p *Payment) Refundable() Money {
+	if !p.Status.IsRefundable() {
+		return NewMoney(0, p.Amount.Currency)
+	}
+
+	return NewMoney(p.Amount.Amount-p.Refunded.Amount, p.Amount.Currency)
+}
+
+// AddRefund записывает возврат и переводит платеж в статус частичного или полного возврата.
+func (p *Payment) AddRefund(refund PaymentRefund, currentTime time.Time) error {
+	if refund.Amount.Currency != p.Amount.Currency {
+		return fmt.Errorf("%w: %s и %s", ErrMoneyCurrencyMismatch, refund.Amount.Currency, p.Amount.Currency)
+	}
+
+	if refund.Amount.Amount <= 0 || refund.Amount.Amount > p.Refundable().Amount {
+		return fmt.Errorf("%w: %d из %d", ErrPaymentRefundExceeds, refund.Amount.Amount, p.Refundable().Amount)
+	}
+
+	to := PaymentStatusPartiallyRefunded
+	if refund.Amount.Amount == p.Refundable().Amount {
+		to = PaymentStatusRefunded
+	}
+
+	if err := p.Transition(to, currentTime); err != nil {
+		return err
+	}
+
+	p.Refunded.Amount += refund.Amount.Amount
+	p.Refunds = append(p.Refunds, refund)
+
+	return nil
+}
+
+// RequestSettlement ставит платеж в очередь на списание, снятие блокировки или возврат amount.
+// Операция проверяется сразу, а проводится у провайдера после сохранения платежа.
+// Пока предыдущая операция не проведена, новая не принимается.
+func (p *Payment) RequestSettlement(
+	to PaymentStatus,
+	amount Money,
+	reason, idempotencyKey string,
+	currentTime time.Time,
+) error {
+	if p.Settlement != nil {
+		return fmt.Errorf("%w: %s", ErrPaymentSettlementBusy, p.Settlement.Status)
+	}
+
+	settlement := &PaymentSettlement{
+		Status:         to,
+		Reason:         reason,
+		IdempotencyKey: idempotencyKey,
+		NextAttemptAt:  currentTime,
+	}
+
+	switch to {
+	case PaymentStatusCaptured, PaymentStatusVoided:
+		if !p.Status.CanTransitionTo(to) {
+			return fmt.Errorf("%w: %s -> %s", ErrPaymentTransition, p.Status, to)
+		}
+	case PaymentStatusRefunded:
+		if amount.Currency != p.Amount.Currency || amount.Amount <= 0 || amount.Amount > p.Refundable().Amount {
+			return fmt.Errorf("%w: %d %s", ErrPaymentRefundExceeds, amount.Amount, amount.Currency)
+		}
+
+		settlement.Refund = amount
+		settlement.Refunded = NewMoney(p.Refunded.Amount+amount.Amount, p.Amount.Currency)
+	default:
+		return fmt.Errorf("%w: %s -> %s", ErrPaymentTransition, p.Status, to)
+	}
+
+	p.Settlement = settlement
+	p.UpdatedAt = currentTime
+
+	return nil
+}
+
+// CompleteSettlement применяет к платежу операцию, которую провел провайдер, и снимает ее из очереди.
+// refund передается для возврата. Если уведомление провайдера уже применило операцию, платеж не меняется.
+func (p *Payment) CompleteSettlement(refund *PaymentRefund, currentTime time.Time) error {
+	if p.Settlement == nil {
+		return nil
+	}
+
+	if !p.settled() {
+		var err error
+
+		switch {
+		case p.Settlement.Status != PaymentStatusRefunded:
+			err = p.Transition(p.Settlement.Status, currentTime)
+		case refund == nil:
+			err = fmt.Errorf("%w: провайдер не вернул возврат", ErrPaymentInvalidRefund)
+		default:
+			refund.Reason = p.Settlement.Reason
+			err = p.AddRefund(*refund, currentTime)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	p.Settlement = nil
+	p.UpdatedAt = currentTime
+
+	return nil
+}
+
+// FailSettlement запоминает неудачную попытку провести операцию и откладывает следующую.
+// Пауза удваивается с каждой попыткой от минуты до часа.
+func (p *Payment) FailSettlement(cause error, currentTime time.Time) {
+	if p.Settlement == nil {
+		return
+	}
+
+	// С седьмой попытки пауза уже больше часа, поэтому дальше не сдвигаем, чтобы не переполнить Duration.
+	backoff := paymentSettlementMaxBackoff
+	if p.Settlement.Attempts < 6 {
+		backoff = min(paymentSettlementMinBackoff<<p.Settlement.Attempts, paymentSettlementMaxBackoff)
+	}
+
+	p.Settlement.Attempts++
+	p.Settlement.LastError = cause.Error()
+	p.Settlement.NextAttemptAt = currentTime.Add(backoff)
+	p.UpdatedAt = currentTime
+}
+
+// settled проведена ли операция из очереди: статус уже ушел из блокировки или возврат уже учтен.
+func (p *Payment) settled() bool {
+	if p.Settlement.Status == PaymentStatusRefunded {
+		return p.Refunded.Amount >= p.Settlement.Refunded.Amount
+	}
+
+	return p.Status != PaymentStatusAuthorized
+}
+
+// Sync приводит платеж к состоянию у провайдера из уведомления.
+// Повторное или устаревшее уведомление ничего не меняет, тогда возвращается false.
+// Операция из очереди, которую уведомление уже отразило, снимается.
+func (p *Payment) Sync(event PaymentWebhookEvent) (bool, error) {
+	changed, err := p.sync(event)
+	if err != nil || !changed {
+		return changed, err
+	}
+
+	if p.Settlement != nil && p.settled() {
+		p.Settlement = nil
+	}
+
+	return true, nil
+}
+
+// sync применяет уведомление провайдера к статусу и возвратам платежа.
+func (p *Payment) sync(event PaymentWebhookEvent) (bool, error) {
+	if event.Status == p.Status && event.Refunded.Amount <= p.Refunded.Amount {
+		return false, nil
+	}
+
+	// Возврат, сделанный в кабинете провайдера, дописывается без идентификатора нашего запроса.
+	if event.Status == PaymentStatusRefunded || event.Status == PaymentStatusPartiallyRefunded {
+		if event.Refunded.Amount <= p.Refunded.Amount {
+			return false, nil
+		}
+
+		refund := PaymentRefund{
+			ID:        event.ID,
+			Amount:    NewMoney(event.Refunded.Amount-p.Refunded.Amount, p.Amount.Currency),
+			Reason:    "возврат у провайдера",
+			CreatedAt: event.OccurredAt,
+		}
+
+		return true, p.AddRefund(refund, event.OccurredAt)
+	}
+
+	if !p.Status.CanTransitionTo(event.Status) {
+		return false, nil
+	}
+
+	return true, p.Transition(event.Status, event.OccurredAt)
+}
+
+// PaymentWebhookEvent уведомление провайдера об изменении платежа.
+type PaymentWebhookEvent struct {
+	ID                string        `json:"id"`                 // Идентификатор уведомления у провайдера
+	ProviderPaymentID string        `json:"paymentID"`          // Идентификатор платежа у провайдера
+	Status            PaymentStatus `json:"status"`             // Статус платежа у провайдера
+	Refunded          Money         `json:"refunded,omitempty"` // Сумма всех возвратов по платежу
+	OccurredAt        time.Time     `json:"occurredAt"`         // Дата изменения
+}
@@ -0,0 +1,146 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayment_AddRefund(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	kzt := func(amount int64) Money { return NewMoney(amount, CurrencyKZT) }
+
+	payment := NewPayment("order", "alice", "bob", &ProviderPayment{
+		ID: "pay", Status: PaymentStatusAuthorized, Amount: kzt(10000),
+	}, now)
+
+	require.ErrorIs(t, payment.AddRefund(PaymentRefund{Amount: kzt(100)}, now), ErrPaymentRefundExceeds, "до списания")
+	require.NoError(t, payment.Transition(PaymentStatusCaptured, now))
+
+	require.NoError(t, payment.AddRefund(PaymentRefund{ID: "r1", Amount: kzt(3000)}, now))
+	assert.Equal(t, PaymentStatusPartiallyRefunded, payment.Status)
+	assert.Equal(t, kzt(7000), payment.Refundable())
+
+	require.ErrorIs(t, payment.AddRefund(PaymentRefund{Amount: kzt(7001)}, now), ErrPaymentRefundExceeds)
+	require.ErrorIs(t, payment.AddRefund(PaymentRefund{Amount: NewMoney(100, CurrencyUSD)}, now), ErrMoneyCurrencyMismatch)
+
+	require.NoError(t, payment.AddRefund(PaymentRefund{ID: "r2", Amount: kzt(7000)}, now))
+	assert.Equal(t, PaymentStatusRefunded, payment.Status)
+	assert.Equal(t, kzt(10000), payment.Refunded)
+	assert.Len(t, payment.Refunds, 2)
+	assert.Equal(t, kzt(0), payment.Refundable())
+}
+
+func TestPayment_Sync(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	kzt := func(amount int64) Money { return NewMoney(amount, CurrencyKZT) }
+
+	payment := NewPayment("order", "alice", "bob", &ProviderPayment{
+		ID: "pay", Status: PaymentStatusAuthorized, Amount: kzt(10000),
+	}, now)
+
+	captured := PaymentWebhookEvent{ID: "evt1", ProviderPaymentID: "pay", Status: PaymentStatusCaptured, OccurredAt: now}
+
+	changed, err := payment.Sync(captured)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, PaymentStatusCaptured, payment.Status)
+
+	changed, err = payment.Sync(captured)
+	require.NoError(t, err)
+	assert.False(t, changed, "повторное уведомление")
+
+	// Возврат в кабинете провайдера.
+	refunded := PaymentWebhookEvent{
+		ID: "evt2", ProviderPaymentID: "pay", Status: PaymentStatusPartiallyRefunded, Refunded: kzt(2500), OccurredAt: now,
+	}
+
+	changed, err = payment.Sync(refunded)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, kzt(2500), payment.Refunded)
+	require.Len(t, payment.Refunds, 1)
+	assert.Equal(t, "evt2", payment.Refunds[0].ID)
+
+	// Устаревшее уведомление о списании не откатывает возврат.
+	changed, err = payment.Sync(captured)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, PaymentStatusPartiallyRefunded, payment.Status)
+}
+
+func TestPayment_Settlement(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	kzt := func(amount int64) Money { return NewMoney(amount, CurrencyKZT) }
+
+	payment := NewPayment("order", "alice", "bob", &ProviderPayment{
+		ID: "pay", Status: PaymentStatusCaptured, Amount: kzt(10000),
+	}, now)
+
+	require.ErrorIs(t, payment.RequestSettlement(PaymentStatusVoided, Money{}, "", "", now), ErrPaymentTransition)
+	require.ErrorIs(t, payment.RequestSettlement(PaymentStatusRefunded, kzt(10001), "", "", now), ErrPaymentRefundExceeds)
+	require.Nil(t, payment.Settlement)
+
+	require.NoError(t, payment.RequestSettlement(PaymentStatusRefunded, kzt(4000), "отмена", "order-cancel:order", now))
+	assert.Equal(t, now, payment.Settlement.NextAttemptAt)
+	require.ErrorIs(t, payment.RequestSettlement(PaymentStatusRefunded, kzt(1000), "", "", now), ErrPaymentSettlementBusy)
+
+	// Пауза между попытками растет и упирается в час.
+	payment.FailSettlement(ErrPaymentDeclined, now)
+	assert.Equal(t, now.Add(time.Minute), payment.Settlement.NextAttemptAt)
+	payment.FailSettlement(ErrPaymentDeclined, now)
+	assert.Equal(t, now.Add(2*time.Minute), payment.Settlement.NextAttemptAt)
+
+	for range 10 {
+		payment.FailSettlement(ErrPaymentDeclined, now)
+	}
+
+	assert.Equal(t, now.Add(time.Hour), payment.Settlement.NextAttemptAt)
+	assert.Equal(t, 12, payment.Settlement.Attempts)
+	assert.Equal(t, ErrPaymentDeclined.Error(), payment.Settlement.LastError)
+
+	require.ErrorIs(t, payment.CompleteSettlement(nil, now), ErrPaymentInvalidRefund, "возврат без ответа провайдера")
+	require.NoError(t, payment.CompleteSettlement(&PaymentRefund{ID: "r1", Amount: kzt(4000)}, now))
+	assert.Nil(t, payment.Settlement)
+	assert.Equal(t, kzt(4000), payment.Refunded)
+	assert.Equal(t, "отмена", payment.Refunds[0].Reason)
+}
+
+func TestPayment_Settlement_SyncedByWebhook(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	kzt := func(amount int64) Money { return NewMoney(amount, CurrencyKZT) }
+
+	payment := NewPayment("order", "alice", "bob", &ProviderPayment{
+		ID: "pay", Status: PaymentStatusAuthorized, Amount: kzt(10000),
+	}, now)
+
+	require.NoError(t, payment.RequestSettlement(PaymentStatusCaptured, Money{}, "", "", now))
+
+	// Уведомление о списании пришло раньше, чем сохранился ответ провайдера.
+	changed, err := payment.Sync(PaymentWebhookEvent{ID: "evt1", Status: PaymentStatusCaptured, OccurredAt: now})
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Nil(t, payment.Settlement, "уведомление снимает проведенную операцию из очереди")
+
+	require.NoError(t, payment.RequestSettlement(PaymentStatusRefunded, kzt(3000), "", "", now))
+
+	// Ответ провайдера после уведомления не учитывает возврат второй раз.
+	_, err = payment.Sync(PaymentWebhookEvent{
+		ID: "evt2", Status: PaymentStatusPartiallyRefunded, Refunded: kzt(3000), OccurredAt: now,
+	})
+	require.NoError(t, err)
+	require.NoError(t, payment.CompleteSettlement(&PaymentRefund{ID: "r1", Amount: kzt(3000)}, now))
+	assert.Equal(t, kzt(3000), payment.Refunded)
+	assert.Len(t, payment.Refunds, 1)
+	assert.Nil(t, payment.Settlement)
+}
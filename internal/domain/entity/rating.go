@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// reputationHalfLife период, за который вес оценки в репутации уменьшается вдвое.
+	reputationHalfLife = 180 * 24 * time.Hour
+	// reputationRecentHalfLife период полураспада веса оценки для недавнего тренда.
+	reputationRecentHalfLife = 30 * 24 * time.Hour
+	// reputationPenaltyScore оценка, которой учитывается штраф к репутации.
+	reputationPenaltyScore = 1
+)
+
+// Rating оценка второй стороны по завершенному заказу.
+type Rating struct {
+	ID        string    `json:"id" db:"id" bson:"_id"`                       // Идентификатор оценки
+	OrderID   string    `json:"orderID" db:"order_id" bson:"order_id"`       // Идентификатор заказа
+	RaterID   string    `json:"raterID" db:"rater_id" bson:"rater_id"`       // Кто оценил
+	RateeID   string    `json:"rateeID" db:"ratee_id" bson:"ratee_id"`       // Кого оценили
+	Score     int       `json:"score" db:"score" bson:"score"`               // Оценка от 1 до 5
+	Comment   string    `json:"comment" db:"comment" bson:"comment"`         // Комментарий
+	CreatedAt time.Time `json:"createdAt" db:"created_at" bson:"created_at"` // Дата оценки
+}
+
+// NewRating создает оценку.
+func NewRating(currentTime time.Time) *Rating {
+	return &Rating{CreatedAt: currentTime}
+}
+
+// Ratings список оценок.
+type Ratings []*Rating
+
+// Reputation репутация пользователя по полученным оценкам.
+// Старые оценки весят меньше новых: вес оценки убывает вдвое за reputationHalfLife.
+// Суммы хранятся вместе с профилем, поэтому новая оценка учитывается без пересчета всей истории.
+type Reputation struct {
+	Score             float64   `json:"score" db:"score" bson:"score"`                         // Взвешенная средняя оценка
+	Count             int64     `json:"count" db:"count" bson:"count"`                         // Количество оценок
+	Penalties         int64     `json:"penalties" db:"penalties" bson:"penalties"`             // Сумма штрафов по решениям споров
+	Trend             float64   `json:"trend" db:"trend" bson:"trend"`                         // Разница между недавней и общей средней оценкой
+	WeightSum         float64   `json:"-" db:"weight_sum" bson:"weight_sum"`                   // Сумма весов оценок
+	WeightedSum       float64   `json:"-" db:"weighted_sum" bson:"weighted_sum"`               // Сумма взвешенных оценок
+	RecentWeightSum   float64   `json:"-" db:"recent_weight_sum" bson:"recent_weight_sum"`     // Сумма весов оценок для тренда
+	RecentWeightedSum float64   `json:"-" db:"recent_weighted_sum" bson:"recent_weighted_sum"` // Сумма взвешенных оценок для тренда
+	UpdatedAt         time.Time `json:"updatedAt,omitempty" db:"updated_at" bson:"updated_at"` // Дата последней оценки
+}
+
+// Add учитывает новую оценку в репутации.
+func (r *Reputation) Add(score int, currentTime time.Time) {
+	r.add(float64(score), 1, currentTime)
+	r.Count++
+}
+
+// Penalize снижает репутацию по решению спора. Штраф weight учитывается как weight минимальных оценок:
+// он не меняет количество оценок и со временем теряет вес так же, как оценки.
+func (r *Reputation) Penalize(weight int, currentTime time.Time) {
+	r.add(float64(weight*reputationPenaltyScore), float64(weight), currentTime)
+	r.Penalties += int64(weight)
+}
+
+// add учитывает оценки с общим весом weight и суммой weighted и пересчитывает среднюю оценку и тренд.
+func (r *Reputation) add(weighted, weight float64, currentTime time.Time) {
+	elapsed := currentTime.Sub(r.UpdatedAt)
+	if r.WeightSum == 0 || elapsed < 0 {
+		elapsed = 0
+	}
+
+	decay := decayFactor(elapsed, reputationHalfLife)
+	recentDecay := decayFactor(elapsed, reputationRecentHalfLife)
+
+	r.WeightSum = r.WeightSum*decay + weight
+	r.WeightedSum = r.WeightedSum*decay + weighted
+	r.RecentWeightSum = r.RecentWeightSum*recentDecay + weight
+	r.RecentWeightedSum = r.RecentWeightedSum*recentDecay + weighted
+
+	if currentTime.After(r.UpdatedAt) {
+		r.UpdatedAt = currentTime
+	}
+
+	average := r.WeightedSum / r.WeightSum
+	r.Score = roundScore(average)
+	r.Trend = roundScore(r.RecentWeightedSum/r.RecentWeightSum - average)
+}
+
+// decayFactor возвращает множитель веса оценки спустя elapsed при периоде полураспада halfLife.
+func decayFactor(elapsed, halfLife time.Duration) float64 {
+	return math.Pow(0.5, float64(elapsed)/float64(halfLife))
+}
+
+// roundScore округляет оценку до сотых.
+func roundScore(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,102 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReputation_Add(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	cases := []struct {
+		name   string
+		scores []int
+		step   time.Duration
+		score  float64
+		trend  float64
+	}{
+		{
+			name:   "single rating",
+			scores: []int{4},
+			score:  4,
+			trend:  0,
+		},
+		{
+			name:   "same day ratings are a plain average",
+			scores: []int{5, 4, 3},
+			score:  4,
+			trend:  0,
+		},
+		{
+			name:   "half-life halves old weight",
+			scores: []int{2, 5},
+			step:   reputationHalfLife,
+			score:  4, // (2*0.5 + 5) / 1.5
+			trend:  0.95,
+		},
+		{
+			name:   "declining trend",
+			scores: []int{5, 5, 5, 1, 1},
+			step:   30 * day,
+			score:  3.12,
+			trend:  -1.22,
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			var r Reputation
+
+			at := start
+			for _, score := range s.scores {
+				r.Add(score, at)
+				at = at.Add(s.step)
+			}
+
+			assert.Equal(t, int64(len(s.scores)), r.Count)
+			assert.InDelta(t, s.score, r.Score, 0.01)
+			assert.InDelta(t, s.trend, r.Trend, 0.01)
+		})
+	}
+}
+
+func TestReputation_Add_OutOfOrder(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var r Reputation
+
+	r.Add(5, now)
+	// Оценка с более ранней датой не должна увеличивать вес старых оценок.
+	r.Add(1, now.Add(-time.Hour))
+
+	assert.InDelta(t, 3, r.Score, 0.01)
+	assert.Equal(t, now, r.UpdatedAt)
+}
+
+func TestReputation_Penalize(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var r Reputation
+
+	r.Add(5, now)
+	r.Add(5, now)
+	// Штраф весом 2 равен двум минимальным оценкам, но не считается оценкой.
+	r.Penalize(2, now)
+
+	assert.InDelta(t, 3, r.Score, 0.01)
+	assert.Equal(t, int64(2), r.Count)
+	assert.Equal(t, int64(2), r.Penalties)
+}
@@ -0,0 +1,72 @@
+package entity
+
+// MaxSearchResults сколько первых результатов поиска можно пролистать.
+const MaxSearchResults = 1000
+
+// SearchKind тип найденного документа.
+type SearchKind string
+
+// Типы документов поиска.
+const (
+	SearchKindUser    SearchKind = "user"    // Пользователь
+	SearchKindListing SearchKind = "listing" // Объявление
+)
+
+// SearchHit найденный документ.
+type SearchHit struct {
+	Kind       SearchKind        `json:"kind"`                 // Тип документа
+	ID         string            `json:"id"`                   // Идентификатор документа
+	Score      float64           `json:"score"`                // Релевантность, чем больше, тем выше в выдаче
+	Highlights map[string]string `json:"highlights,omitempty"` // Фрагменты полей с выделенными найденными словами
+	DistanceKm *float64          `json:"distanceKm,omitempty"` // Расстояние до точки near в километрах
+	User       *User             `json:"user,omitempty"`       // Пользователь, если документ - пользователь
+	Listing    *Listing          `json:"listing,omitempty"`    // Объявление, если документ - объявление
+}
+
+// Location возвращает местоположение найденного документа или nil, если оно не указано.
+func (h *SearchHit) Location() *GeoPoint {
+	switch {
+	case h.User != nil:
+		return h.User.Location
+	case h.Listing != nil:
+		return h.Listing.Location
+	default:
+		return nil
+	}
+}
+
+// FillDistance заполняет расстояние от точки from до документа, если у него есть местоположение.
+func (h *SearchHit) FillDistance(from GeoPoint) {
+	location := h.Location()
+	if location == nil {
+		return
+	}
+
+	distance := from.RoundedDistanceKm(*location)
+	h.DistanceKm = &distance
+
+	if h.Listing != nil {
+		h.Listing.DistanceKm = &distance
+	}
+}
+
+// SearchFacets количество найденных документов по группам.
+type SearchFacets struct {
+	Kinds      map[SearchKind]int64 `json:"kinds"`      // По типам документов
+	Categories map[string]int64     `json:"categories"` // Объявления по категориям
+}
+
+// NewSearchFacets создает пустые фасеты.
+func NewSearchFacets() SearchFacets {
+	return SearchFacets{
+		Kinds:      make(map[SearchKind]int64, 2),
+		Categories: make(map[string]int64),
+	}
+}
+
+// SearchResult результат поиска.
+type SearchResult struct {
+	Items  []*SearchHit `json:"items"`  // Страница найденных документов
+	Count  int64        `json:"count"`  // Общее количество найденных документов с учетом фильтров
+	Facets SearchFacets `json:"facets"` // Фасеты
+}
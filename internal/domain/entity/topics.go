@@ -8,12 +8,24 @@ import (
 const (
 	// UserUpdateTopic топик для обновления пользователя.
 	UserUpdateTopic = "user.update"
+
+	// ListingEventTopic топик событий изменения объявлений. Слушается движком поиска циклов обмена.
+	ListingEventTopic = "listing.event"
 )
 
 // Топики для продюсера.
 const (
 	// SomeTopic тестовый топик для продюсера.
 	SomeTopic = "some.topic"
+
+	// TradeOfferTopic топик событий изменения статуса предложений обмена.
+	TradeOfferTopic = "trade.offer"
+
+	// DisputeEventTopic топик событий споров по заказам.
+	DisputeEventTopic = "dispute.event"
+
+	// PushNotificationTopic топик push-уведомлений для доставки на устройства пользователей.
+	PushNotificationTopic = "push.notification"
 )
 
 // KafkaConfig интерфейс для работы с конфигурацией Kafka.
@@ -22,18 +34,31 @@ type KafkaConfig interface {
 	GetTopics() []string
 }
 
+// EventProducerTopics топики событий, которые публикуют сервисы. Нужны только приложению,
+// поэтому при разборе конфигурации необязательны и проверяются при запуске приложения.
+var EventProducerTopics = []string{TradeOfferTopic, ListingEventTopic, DisputeEventTopic, PushNotificationTopic}
+
+// eventConsumerTopics топики событий, которые слушает приложение. Наличие топика проверяет консюмер при создании.
+var eventConsumerTopics = []string{ListingEventTopic}
+
 // ValidateConsumerTopics проверяет топики на валидность.
 func ValidateConsumerTopics(cfgs KafkaConfig) error {
-	return validateTopics(cfgs, []string{UserUpdateTopic})
+	return validateTopics(cfgs, []string{UserUpdateTopic}, eventConsumerTopics)
 }
 
 // ValidateProducerTopics проверяет топики на валидность.
 func ValidateProducerTopics(cfgs KafkaConfig) error {
-	return validateTopics(cfgs, []string{SomeTopic})
+	return validateTopics(cfgs, []string{SomeTopic}, EventProducerTopics)
 }
 
-// validateTopics проверяет, что все переданные топики присутствуют в конфигурации.
-func validateTopics(cfgs KafkaConfig, availableTopics []string) error {
+// ValidateEventTopics проверяет, что для всех топиков событий настроены продюсеры.
+func ValidateEventTopics(cfgs KafkaConfig) error {
+	return validateTopics(cfgs, EventProducerTopics, []string{SomeTopic})
+}
+
+// validateTopics проверяет, что все переданные топики присутствуют в конфигурации,
+// а кроме них в конфигурации есть только необязательные топики optionalTopics.
+func validateTopics(cfgs KafkaConfig, availableTopics, optionalTopics []string) error {
 	// создаем мапу топиков для быстрой проверки наличия
 	cfgTopics := cfgs.GetTopics()
 
@@ -50,8 +75,15 @@ func validateTopics(cfgs KafkaConfig, availableTopics []string) error {
 		}
 	}
 
-	if len(availableTopics) != len(topicMap) {
-		return fmt.Errorf("%w: %d!=%d", ErrTopicsLength, len(availableTopics), len(topicMap))
+	expected := len(availableTopics)
+	for _, topic := range optionalTopics {
+		if _, ok := topicMap[topic]; ok {
+			expected++
+		}
+	}
+
+	if expected != len(topicMap) {
+		return fmt.Errorf("%w: %d!=%d", ErrTopicsLength, expected, len(topicMap))
 	}
 
 	return nil
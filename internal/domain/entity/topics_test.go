@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// topics конфигурация Kafka из списка топиков.
+type topics []string
+
+func (t topics) GetTopics() []string { return t }
+
+func TestValidateEventTopics(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		topics topics
+		err    error
+	}{
+		{
+			name:   "все топики событий",
+			topics: topics{SomeTopic, TradeOfferTopic, ListingEventTopic, DisputeEventTopic, PushNotificationTopic},
+		},
+		{
+			name:   "нет топика споров",
+			topics: topics{SomeTopic, TradeOfferTopic, ListingEventTopic, PushNotificationTopic},
+			err:    ErrTopicNotFound,
+		},
+		{
+			name:   "неизвестный топик",
+			topics: topics{TradeOfferTopic, ListingEventTopic, DisputeEventTopic, PushNotificationTopic, "unknown.topic"},
+			err:    ErrTopicsLength,
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateEventTopics(s.topics)
+			if s.err != nil {
+				assert.ErrorIs(t, err, s.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// tradeCycleKeySeparator разделитель идентификаторов объявлений в ключе цикла.
+const tradeCycleKeySeparator = ">"
+
+// TradeCycleStatus статус цикла обмена.
+type TradeCycleStatus string
+
+// Статусы цикла обмена.
+const (
+	TradeCycleStatusProposed TradeCycleStatus = "proposed" // Предложен участникам и ожидает согласия всех
+	TradeCycleStatusAccepted TradeCycleStatus = "accepted" // Все участники согласились, объявления зарезервированы
+	TradeCycleStatusDeclined TradeCycleStatus = "declined" // Один из участников отказался
+	TradeCycleStatusExpired  TradeCycleStatus = "expired"  // Одно из объявлений стало недоступно
+)
+
+// tradeCycleTransitions допустимые переходы между статусами цикла обмена.
+var tradeCycleTransitions = map[TradeCycleStatus][]TradeCycleStatus{
+	TradeCycleStatusProposed: {
+		TradeCycleStatusAccepted,
+		TradeCycleStatusDeclined,
+		TradeCycleStatusExpired,
+	},
+}
+
+// CanTransitionTo проверяет, допустим ли переход в указанный статус.
+func (s TradeCycleStatus) CanTransitionTo(to TradeCycleStatus) bool {
+	for _, allowed := range tradeCycleTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TradeCycleLeg звено цикла обмена: владелец передает объявление следующему участнику.
+type TradeCycleLeg struct {
+	ListingID   string   `json:"listingID" db:"listing_id" bson:"listing_id"`       // Передаваемое объявление
+	GiverID     string   `json:"giverID" db:"giver_id" bson:"giver_id"`             // Владелец объявления
+	ReceiverID  string   `json:"receiverID" db:"receiver_id" bson:"receiver_id"`    // Получатель объявления
+	MatchedTags []string `json:"matchedTags" db:"matched_tags" bson:"matched_tags"` // Желаемые теги получателя, которым соответствует объявление
+	Score       float64  `json:"score" db:"score" bson:"score"`                     // Качество совпадения звена от 0 до 1
+}
+
+// TradeCycle сущность цикла обмена между несколькими участниками, например A→B→C→A.
+// Цикл предлагается всем участникам сразу и исполняется только после согласия каждого.
+type TradeCycle struct {
+	ID             string           `json:"id" db:"id" bson:"_id"`                                      // Идентификатор цикла
+	Key            string           `json:"key" db:"key" bson:"key"`                                    // Канонический ключ цикла для исключения повторов
+	Legs           []TradeCycleLeg  `json:"legs" db:"legs" bson:"legs"`                                 // Звенья цикла в порядке передачи
+	ParticipantIDs []string         `json:"participantIDs" db:"participant_ids" bson:"participant_ids"` // Участники цикла
+	AcceptedBy     []string         `json:"acceptedBy" db:"accepted_by" bson:"accepted_by"`             // Участники, давшие согласие
+	Score          float64          `json:"score" db:"score" bson:"score"`                              // Качество цикла от 0 до 1
+	Status         TradeCycleStatus `json:"status" db:"status" bson:"status"`                           // Статус цикла
+	Version        int64            `json:"-" db:"version" bson:"version"`                              // Версия для оптимистичной блокировки
+	UpdatedAt      time.Time        `json:"updatedAt" db:"updated_at" bson:"updated_at"`                // Дата обновления
+	CreatedAt      time.Time        `json:"createdAt" db:"created_at" bson:"created_at"`                // Дата создания
+}
+
+// NewTradeCycle создает предложенный цикл обмена из звеньев.
+func NewTradeCycle(legs []TradeCycleLeg, score float64, currentTime time.Time) *TradeCycle {
+	listingIDs := make([]string, 0, len(legs))
+	participantIDs := make([]string, 0, len(legs))
+
+	for _, leg := range legs {
+		listingIDs = append(listingIDs, leg.ListingID)
+		participantIDs = append(participantIDs, leg.GiverID)
+	}
+
+	return &TradeCycle{
+		Key:            TradeCycleKey(listingIDs),
+		Legs:           legs,
+		ParticipantIDs: participantIDs,
+		AcceptedBy:     []string{},
+		Score:          score,
+		Status:         TradeCycleStatusProposed,
+		UpdatedAt:      currentTime,
+		CreatedAt:      currentTime,
+	}
+}
+
+// TradeCycleKey возвращает канонический ключ цикла.
+// Цикл поворачивается так, чтобы первым шел наименьший идентификатор, направление обхода сохраняется.
+func TradeCycleKey(listingIDs []string) string {
+	if len(listingIDs) == 0 {
+		return ""
+	}
+
+	start := 0
+
+	for i, id := range listingIDs {
+		if id < listingIDs[start] {
+			start = i
+		}
+	}
+
+	rotated := make([]string, 0, len(listingIDs))
+	rotated = append(rotated, listingIDs[start:]...)
+	rotated = append(rotated, listingIDs[:start]...)
+
+	return strings.Join(rotated, tradeCycleKeySeparator)
+}
+
+// IsParticipant является ли пользователь участником цикла.
+func (c *TradeCycle) IsParticipant(userID string) bool {
+	return containsString(c.ParticipantIDs, userID)
+}
+
+// HasAccepted дал ли пользователь согласие на цикл.
+func (c *TradeCycle) HasAccepted(userID string) bool {
+	return containsString(c.AcceptedBy, userID)
+}
+
+// ListingIDs возвращает идентификаторы объявлений цикла.
+func (c *TradeCycle) ListingIDs() []string {
+	ids := make([]string, 0, len(c.Legs))
+
+	for _, leg := range c.Legs {
+		ids = append(ids, leg.ListingID)
+	}
+
+	return ids
+}
+
+// Accept фиксирует согласие участника. Когда согласны все, цикл переходит в статус accepted.
+func (c *TradeCycle) Accept(userID string, currentTime time.Time) error {
+	if c.Status != TradeCycleStatusProposed {
+		return fmt.Errorf("%w: %s -> %s", ErrTradeCycleTransition, c.Status, TradeCycleStatusAccepted)
+	}
+
+	if !c.HasAccepted(userID) {
+		c.AcceptedBy = append(c.AcceptedBy, userID)
+	}
+
+	c.UpdatedAt = currentTime
+
+	if len(c.AcceptedBy) == len(c.ParticipantIDs) {
+		c.Status = TradeCycleStatusAccepted
+	}
+
+	return nil
+}
+
+// Transition переводит цикл в новый статус.
+func (c *TradeCycle) Transition(to TradeCycleStatus, currentTime time.Time) error {
+	if !c.Status.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %s -> %s", ErrTradeCycleTransition, c.Status, to)
+	}
+
+	c.Status = to
+	c.UpdatedAt = currentTime
+
+	return nil
+}
+
+// TradeCycles список циклов обмена.
+type TradeCycles []*TradeCycle
+
+// containsString проверяет наличие строки в срезе.
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,102 @@
+package entity
+
+// TradeFairness оценка равноценности обмена по оценкам объявлений и доплате.
+// Суммы считаются в одной валюте: валюте доплаты, а без нее в валюте первой оценки.
+type TradeFairness struct {
+	Currency           Currency `json:"currency" db:"currency" bson:"currency"`                                                       // Валюта оценки
+	ProposerGives      Money    `json:"proposerGives" db:"proposer_gives" bson:"proposer_gives"`                                      // Оценка объявлений автора вместе с его доплатой
+	RecipientGives     Money    `json:"recipientGives" db:"recipient_gives" bson:"recipient_gives"`                                   // Оценка объявлений получателя вместе с его доплатой
+	Balance            Money    `json:"balance" db:"balance" bson:"balance"`                                                          // Перевес автора: положительный, если автор отдает больше
+	Fair               bool     `json:"fair" db:"fair" bson:"fair"`                                                                   // Укладывается ли перевес в допустимое отклонение
+	UnvaluedListingIDs []string `json:"unvaluedListingIDs,omitempty" db:"unvalued_listing_ids" bson:"unvalued_listing_ids,omitempty"` // Объявления без оценки в валюте обмена
+}
+
+// NewTradeFairness оценивает обмен объявлений offered автора на объявления requested получателя с доплатой cash.
+// Обмен равноценен, если все объявления оценены и перевес не больше tolerancePercent процентов от большей стороны.
+func NewTradeFairness(offered, requested Listings, cash *TradeCash, tolerancePercent int64) (*TradeFairness, error) {
+	currency := tradeCurrency(offered, requested, cash)
+
+	fairness := &TradeFairness{
+		Currency:       currency,
+		ProposerGives:  NewMoney(0, currency),
+		RecipientGives: NewMoney(0, currency),
+	}
+
+	var err error
+
+	if fairness.ProposerGives, err = fairness.sum(offered); err != nil {
+		return nil, err
+	}
+
+	if fairness.RecipientGives, err = fairness.sum(requested); err != nil {
+		return nil, err
+	}
+
+	if cash != nil {
+		if cash.Payer == TradeSideRecipient {
+			fairness.RecipientGives, err = fairness.RecipientGives.Add(cash.Amount)
+		} else {
+			fairness.ProposerGives, err = fairness.ProposerGives.Add(cash.Amount)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if fairness.Balance, err = fairness.ProposerGives.Sub(fairness.RecipientGives); err != nil {
+		return nil, err
+	}
+
+	larger := fairness.ProposerGives.Amount
+	if fairness.RecipientGives.Amount > larger {
+		larger = fairness.RecipientGives.Amount
+	}
+
+	deviation := fairness.Balance.Amount
+	if deviation < 0 {
+		deviation = -deviation
+	}
+
+	// Сравниваем через деление, чтобы не переполнить произведение больших сумм.
+	fairness.Fair = len(fairness.UnvaluedListingIDs) == 0 && deviation <= larger/100*tolerancePercent+larger%100*tolerancePercent/100
+
+	return fairness, nil
+}
+
+// sum складывает оценки объявлений в валюте обмена. Объявления без такой оценки запоминаются.
+func (f *TradeFairness) sum(listings Listings) (Money, error) {
+	total := NewMoney(0, f.Currency)
+
+	for _, listing := range listings {
+		if listing.Valuation == nil || listing.Valuation.Currency != f.Currency {
+			f.UnvaluedListingIDs = append(f.UnvaluedListingIDs, listing.ID)
+
+			continue
+		}
+
+		var err error
+		if total, err = total.Add(*listing.Valuation); err != nil {
+			return Money{}, err
+		}
+	}
+
+	return total, nil
+}
+
+// tradeCurrency выбирает валюту оценки обмена.
+func tradeCurrency(offered, requested Listings, cash *TradeCash) Currency {
+	if cash != nil {
+		return cash.Amount.Currency
+	}
+
+	for _, listings := range []Listings{offered, requested} {
+		for _, listing := range listings {
+			if listing.Valuation != nil {
+				return listing.Valuation.Currency
+			}
+		}
+	}
+
+	return LegacyCurrency
+}
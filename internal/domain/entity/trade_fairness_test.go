@@ -0,0 +1,139 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTradeFairness(t *testing.T) {
+	t.Parallel()
+
+	valued := func(id string, amount int64) *Listing {
+		valuation := NewMoney(amount, CurrencyKZT)
+
+		return &Listing{ID: id, Valuation: &valuation}
+	}
+
+	cases := []struct {
+		name         string
+		offered      Listings
+		requested    Listings
+		cash         *TradeCash
+		wantBalance  int64
+		wantFair     bool
+		wantUnvalued []string
+	}{
+		{
+			name:        "равные оценки",
+			offered:     Listings{valued("a", 10000)},
+			requested:   Listings{valued("b", 10000)},
+			wantBalance: 0,
+			wantFair:    true,
+		},
+		{
+			name:        "перевес в пределах допуска",
+			offered:     Listings{valued("a", 10000)},
+			requested:   Listings{valued("b", 10900)},
+			wantBalance: -900,
+			wantFair:    true,
+		},
+		{
+			name:        "перевес больше допуска",
+			offered:     Listings{valued("a", 10000)},
+			requested:   Listings{valued("b", 15000)},
+			wantBalance: -5000,
+			wantFair:    false,
+		},
+		{
+			name:        "доплата автора выравнивает обмен",
+			offered:     Listings{valued("a", 10000)},
+			requested:   Listings{valued("b", 15000)},
+			cash:        &TradeCash{Payer: TradeSideProposer, Amount: NewMoney(5000, CurrencyKZT)},
+			wantBalance: 0,
+			wantFair:    true,
+		},
+		{
+			name:        "доплата получателя",
+			offered:     Listings{valued("a", 15000), valued("c", 5000)},
+			requested:   Listings{valued("b", 15000)},
+			cash:        &TradeCash{Payer: TradeSideRecipient, Amount: NewMoney(5000, CurrencyKZT)},
+			wantBalance: 0,
+			wantFair:    true,
+		},
+		{
+			name:         "объявление без оценки",
+			offered:      Listings{valued("a", 10000)},
+			requested:    Listings{{ID: "b"}},
+			wantBalance:  10000,
+			wantFair:     false,
+			wantUnvalued: []string{"b"},
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			fairness, err := NewTradeFairness(s.offered, s.requested, s.cash, 10)
+			require.NoError(t, err)
+
+			assert.Equal(t, CurrencyKZT, fairness.Currency)
+			assert.Equal(t, s.wantBalance, fairness.Balance.Amount)
+			assert.Equal(t, s.wantFair, fairness.Fair)
+			assert.Equal(t, s.wantUnvalued, fairness.UnvaluedListingIDs)
+		})
+	}
+
+	// Оценка в другой валюте не учитывается.
+	usd := NewMoney(100, CurrencyUSD)
+	fairness, err := NewTradeFairness(
+		Listings{{ID: "a", Valuation: &usd}}, Listings{valued("b", 10000)},
+		&TradeCash{Payer: TradeSideProposer, Amount: NewMoney(10000, CurrencyKZT)}, 10,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, fairness.UnvaluedListingIDs)
+	assert.False(t, fairness.Fair)
+}
+
+func TestNewTradeOrder(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	valuation := NewMoney(10000, CurrencyKZT)
+
+	offer := &TradeOffer{
+		ID:                  "offer",
+		ProposerID:          "alice",
+		RecipientID:         "bob",
+		OfferedListingIDs:   []string{"a"},
+		RequestedListingIDs: []string{"b"},
+		Cash:                &TradeCash{Payer: TradeSideRecipient, Amount: NewMoney(2500, CurrencyKZT), PaymentID: "pay"},
+	}
+
+	listings := map[string]*Listing{
+		"a": {ID: "a", Title: "Велосипед", Valuation: &valuation},
+		"b": {ID: "b", Title: "Самокат"},
+	}
+
+	order := NewTradeOrder(offer, listings, now)
+
+	assert.Equal(t, "alice", order.UserID)
+	assert.Equal(t, "bob", order.CounterpartyID)
+	assert.Equal(t, "offer", order.TradeOfferID)
+	assert.Equal(t, NewMoney(2500, CurrencyKZT), order.Cost)
+
+	require.Len(t, order.Legs, 3)
+	assert.Equal(t, OrderLeg{
+		Kind: OrderLegKindGoods, FromUserID: "alice", ToUserID: "bob", ListingID: "a", Title: "Велосипед", Amount: &valuation,
+	}, order.Legs[0])
+	assert.Equal(t, OrderLeg{Kind: OrderLegKindGoods, FromUserID: "bob", ToUserID: "alice", ListingID: "b", Title: "Самокат"}, order.Legs[1])
+	assert.Equal(t, OrderLegKindCash, order.Legs[2].Kind)
+	assert.Equal(t, "bob", order.Legs[2].FromUserID)
+	assert.Equal(t, "alice", order.Legs[2].ToUserID)
+	assert.Equal(t, "pay", order.Legs[2].PaymentID)
+}
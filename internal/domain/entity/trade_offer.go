@@ -0,0 +1,155 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// TradeOfferStatus статус предложения обмена.
+type TradeOfferStatus string
+
+// Статусы предложения обмена.
+const (
+	TradeOfferStatusPending   TradeOfferStatus = "pending"   // Ожидает ответа получателя
+	TradeOfferStatusAccepted  TradeOfferStatus = "accepted"  // Принято получателем
+	TradeOfferStatusRejected  TradeOfferStatus = "rejected"  // Отклонено получателем
+	TradeOfferStatusCountered TradeOfferStatus = "countered" // Получатель предложил встречный обмен
+	TradeOfferStatusCancelled TradeOfferStatus = "cancelled" // Отозвано автором или отменено участником после принятия
+	TradeOfferStatusCompleted TradeOfferStatus = "completed" // Обмен состоялся
+)
+
+// TradeSide сторона обмена.
+type TradeSide string
+
+// Стороны обмена.
+const (
+	TradeSideProposer  TradeSide = "proposer"  // Автор предложения
+	TradeSideRecipient TradeSide = "recipient" // Получатель предложения
+)
+
+// TradeCash денежная доплата одной из сторон обмена.
+type TradeCash struct {
+	Payer     TradeSide `json:"payer" db:"payer" bson:"payer"`                                   // Сторона, которая доплачивает
+	Amount    Money     `json:"amount" db:"amount" bson:"amount"`                                // Сумма доплаты
+	PaymentID string    `json:"paymentID,omitempty" db:"payment_id" bson:"payment_id,omitempty"` // Платеж, которым заблокирована доплата
+}
+
+// tradeOfferTransitions допустимые переходы между статусами предложения обмена.
+var tradeOfferTransitions = map[TradeOfferStatus][]TradeOfferStatus{
+	TradeOfferStatusPending: {
+		TradeOfferStatusAccepted,
+		TradeOfferStatusRejected,
+		TradeOfferStatusCountered,
+		TradeOfferStatusCancelled,
+	},
+	TradeOfferStatusAccepted: {
+		TradeOfferStatusCompleted,
+		TradeOfferStatusCancelled,
+	},
+}
+
+// CanTransitionTo проверяет, допустим ли переход в указанный статус.
+func (s TradeOfferStatus) CanTransitionTo(to TradeOfferStatus) bool {
+	for _, allowed := range tradeOfferTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TradeOffer сущность предложения обмена.
+// Автор предлагает свои объявления в обмен на объявления получателя.
+type TradeOffer struct {
+	ID                  string           `json:"id" db:"id" bson:"_id"`                                                            // Идентификатор предложения
+	ProposerID          string           `json:"proposerID" db:"proposer_id" bson:"proposer_id"`                                   // Идентификатор автора предложения
+	RecipientID         string           `json:"recipientID" db:"recipient_id" bson:"recipient_id"`                                // Идентификатор получателя предложения
+	OfferedListingIDs   []string         `json:"offeredListingIDs" db:"offered_listing_ids" bson:"offered_listing_ids"`            // Объявления автора
+	RequestedListingIDs []string         `json:"requestedListingIDs" db:"requested_listing_ids" bson:"requested_listing_ids"`      // Объявления получателя
+	Message             string           `json:"message,omitempty" db:"message" bson:"message"`                                    // Сообщение автора
+	Status              TradeOfferStatus `json:"status" db:"status" bson:"status"`                                                 // Статус предложения
+	ParentID            string           `json:"parentID,omitempty" db:"parent_id" bson:"parent_id,omitempty"`                     // Предложение, на которое это является встречным
+	CounterOfferID      string           `json:"counterOfferID,omitempty" db:"counter_offer_id" bson:"counter_offer_id,omitempty"` // Встречное предложение
+	Cash                *TradeCash       `json:"cash,omitempty" db:"cash" bson:"cash,omitempty"`                                   // Денежная доплата к обмену
+	Fairness            *TradeFairness   `json:"fairness,omitempty" db:"fairness" bson:"fairness,omitempty"`                       // Оценка равноценности обмена на момент создания
+	OrderID             string           `json:"orderID,omitempty" db:"order_id" bson:"order_id,omitempty"`                        // Заказ, созданный при принятии предложения
+	UpdatedAt           time.Time        `json:"updatedAt" db:"updated_at" bson:"updated_at"`                                      // Дата обновления
+	CreatedAt           time.Time        `json:"createdAt" db:"created_at" bson:"created_at"`                                      // Дата создания
+}
+
+// NewTradeOffer создает предложение обмена.
+func NewTradeOffer(currentTime time.Time) *TradeOffer {
+	return &TradeOffer{
+		Status:    TradeOfferStatusPending,
+		UpdatedAt: currentTime,
+		CreatedAt: currentTime,
+	}
+}
+
+// IsParticipant является ли пользователь участником предложения.
+func (o *TradeOffer) IsParticipant(userID string) bool {
+	return o.ProposerID == userID || o.RecipientID == userID
+}
+
+// ListingIDs возвращает идентификаторы всех объявлений, участвующих в обмене.
+func (o *TradeOffer) ListingIDs() []string {
+	ids := make([]string, 0, len(o.OfferedListingIDs)+len(o.RequestedListingIDs))
+	ids = append(ids, o.OfferedListingIDs...)
+
+	return append(ids, o.RequestedListingIDs...)
+}
+
+// Transition переводит предложение в новый статус.
+func (o *TradeOffer) Transition(to TradeOfferStatus, currentTime time.Time) error {
+	if !o.Status.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %s -> %s", ErrTradeOfferTransition, o.Status, to)
+	}
+
+	o.Status = to
+	o.UpdatedAt = currentTime
+
+	return nil
+}
+
+// CashParties возвращает плательщика и получателя доплаты. Если доплаты нет, возвращает false.
+func (o *TradeOffer) CashParties() (payerID, payeeID string, ok bool) {
+	if o.Cash == nil {
+		return "", "", false
+	}
+
+	if o.Cash.Payer == TradeSideRecipient {
+		return o.RecipientID, o.ProposerID, true
+	}
+
+	return o.ProposerID, o.RecipientID, true
+}
+
+// TradeOffers список предложений обмена.
+type TradeOffers []*TradeOffer
+
+// TradeOfferEvent событие изменения статуса предложения обмена, отправляемое в Kafka.
+type TradeOfferEvent struct {
+	OfferID     string           `json:"offerID"`            // Идентификатор предложения
+	ParentID    string           `json:"parentID,omitempty"` // Предложение, на которое это является встречным
+	ProposerID  string           `json:"proposerID"`         // Идентификатор автора предложения
+	RecipientID string           `json:"recipientID"`        // Идентификатор получателя предложения
+	ActorID     string           `json:"actorID"`            // Пользователь, изменивший статус
+	Status      TradeOfferStatus `json:"status"`             // Новый статус
+	ListingIDs  []string         `json:"listingIDs"`         // Объявления, участвующие в обмене
+	OccurredAt  time.Time        `json:"occurredAt"`         // Дата события
+}
+
+// NewTradeOfferEvent создает событие изменения статуса предложения обмена.
+func NewTradeOfferEvent(offer *TradeOffer, actorID string, currentTime time.Time) TradeOfferEvent {
+	return TradeOfferEvent{
+		OfferID:     offer.ID,
+		ParentID:    offer.ParentID,
+		ProposerID:  offer.ProposerID,
+		RecipientID: offer.RecipientID,
+		ActorID:     actorID,
+		Status:      offer.Status,
+		ListingIDs:  offer.ListingIDs(),
+		OccurredAt:  currentTime,
+	}
+}
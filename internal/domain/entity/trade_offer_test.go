@@ -0,0 +1,107 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTradeOfferStatus_CanTransitionTo(t *testing.T) {
+	t.Parallel()
+
+	statuses := []TradeOfferStatus{
+		TradeOfferStatusPending,
+		TradeOfferStatusAccepted,
+		TradeOfferStatusRejected,
+		TradeOfferStatusCountered,
+		TradeOfferStatusCancelled,
+		TradeOfferStatusCompleted,
+	}
+
+	allowed := map[TradeOfferStatus][]TradeOfferStatus{
+		TradeOfferStatusPending: {
+			TradeOfferStatusAccepted, TradeOfferStatusRejected, TradeOfferStatusCountered, TradeOfferStatusCancelled,
+		},
+		TradeOfferStatusAccepted: {TradeOfferStatusCompleted, TradeOfferStatusCancelled},
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			want := false
+
+			for _, status := range allowed[from] {
+				if status == to {
+					want = true
+				}
+			}
+
+			assert.Equal(t, want, from.CanTransitionTo(to), "%s -> %s", from, to)
+		}
+	}
+}
+
+func TestTradeOffer_Transition(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := created.Add(time.Hour)
+
+	cases := []struct {
+		name string
+		path []TradeOfferStatus
+		err  error
+	}{
+		{
+			name: "принятие и завершение",
+			path: []TradeOfferStatus{TradeOfferStatusAccepted, TradeOfferStatusCompleted},
+		},
+		{
+			name: "отмена после принятия",
+			path: []TradeOfferStatus{TradeOfferStatusAccepted, TradeOfferStatusCancelled},
+		},
+		{
+			name: "завершение без принятия",
+			path: []TradeOfferStatus{TradeOfferStatusCompleted},
+			err:  ErrTradeOfferTransition,
+		},
+		{
+			name: "повторное принятие",
+			path: []TradeOfferStatus{TradeOfferStatusAccepted, TradeOfferStatusAccepted},
+			err:  ErrTradeOfferTransition,
+		},
+		{
+			name: "отмена завершенного обмена",
+			path: []TradeOfferStatus{TradeOfferStatusAccepted, TradeOfferStatusCompleted, TradeOfferStatusCancelled},
+			err:  ErrTradeOfferTransition,
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			offer := NewTradeOffer(created)
+
+			var err error
+			for _, to := range s.path {
+				if err = offer.Transition(to, now); err != nil {
+					break
+				}
+			}
+
+			if s.err != nil {
+				assert.ErrorIs(t, err, s.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, s.path[len(s.path)-1], offer.Status)
+			assert.Equal(t, now, offer.UpdatedAt)
+		})
+	}
+}
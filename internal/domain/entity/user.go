@@ -10,11 +10,17 @@ import (
 
 // User сущность пользователя.
 type User struct {
-	ID        string    `json:"id" db:"id" bson:"_id"`                       // Идентификатор пользователя
-	Name      string    `json:"name" db:"name" bson:"name"`                  // Имя пользователя
-	Bio       string    `json:"bio" db:"bio" bson:"bio"`                     // Биография пользователя
-	UpdatedAt time.Time `json:"updatedAt" db:"updated_at" bson:"updated_at"` // Дата обновления пользователя
-	CreatedAt time.Time `json:"createdAt" db:"created_at" bson:"created_at"` // Дата создания пользователя
+	ID         string           `json:"id" db:"id" bson:"_id"`                                            // Идентификатор пользователя
+	Name       string           `json:"name" db:"name" bson:"name"`                                       // Имя пользователя
+	Bio        string           `json:"bio" db:"bio" bson:"bio"`                                          // Биография пользователя
+	Reputation Reputation       `json:"reputation" db:"-" bson:"reputation"`                              // Репутация по оценкам после сделок
+	City       string           `json:"city,omitempty" db:"city" bson:"city,omitempty"`                   // Город
+	Location   *GeoPoint        `json:"location,omitempty" db:"location" bson:"location,omitempty"`       // Местоположение
+	AvatarID   string           `json:"avatarID,omitempty" db:"avatar_id" bson:"avatar_id,omitempty"`     // Идентификатор медиафайла аватара
+	Moderation ModerationStatus `json:"moderation,omitempty" db:"moderation" bson:"moderation,omitempty"` // Статус модерации биографии
+	BannedAt   *time.Time       `json:"-" db:"banned_at" bson:"banned_at,omitempty"`                      // Дата блокировки модератором
+	UpdatedAt  time.Time        `json:"updatedAt" db:"updated_at" bson:"updated_at" audit:"-"`            // Дата обновления пользователя
+	CreatedAt  time.Time        `json:"createdAt" db:"created_at" bson:"created_at"`                      // Дата создания пользователя
 }
 
 // NewUser возвращает нового пользователя.
@@ -25,6 +31,32 @@ func NewUser(currentTime time.Time) *User {
 	}
 }
 
+// IsBanned заблокирован ли пользователь модератором.
+func (u *User) IsBanned() bool {
+	return u.BannedAt != nil
+}
+
+// Public возвращает копию пользователя для публичных ответов: отклоненная модератором биография скрыта.
+func (u *User) Public() *User {
+	public := *u
+	if !public.Moderation.IsPublic() {
+		public.Bio = ""
+	}
+
+	return &public
+}
+
+// ModerationContent возвращает биографию пользователя для проверки.
+func (u *User) ModerationContent(currentTime time.Time) ModerationContent {
+	return ModerationContent{
+		SubjectType: ModerationSubjectUser,
+		SubjectID:   u.ID,
+		AuthorID:    u.ID,
+		Text:        u.Bio,
+		SubmittedAt: currentTime,
+	}
+}
+
 // Columns возвращает список колонок.
 func (u *User) Columns() []string {
 	return []string{"id", "name", "bio", "updated_at", "created_at"}
@@ -39,6 +71,10 @@ func GetUserCacheKey(id string) string {
 type Users []User
 
 // PROTOBUF
+//
+// proto.User из gitlab.com/example/gophers/grpcclients/template v0.0.1 не содержит репутации,
+// поэтому через gRPC она не передается. Поле Reputation появится в proto.User после выпуска
+// новой версии шаблона с сообщением Reputation, тогда его нужно заполнять в обе стороны.
 
 // FromProtoUser преобразует из proto в User.
 func FromProtoUser(user *proto.User) *User {
@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"time"
+)
+
+// MaxWishlistItems максимальное количество позиций в списке желаний пользователя.
+const MaxWishlistItems = 50
+
+// WishlistItem позиция списка желаний пользователя.
+type WishlistItem struct {
+	ID         string             `json:"id" db:"id" bson:"_id"`                                        // Идентификатор позиции
+	UserID     string             `json:"userID" db:"user_id" bson:"user_id"`                           // Идентификатор пользователя
+	Query      string             `json:"query" db:"query" bson:"query"`                                // Свободный текст: что пользователь ищет
+	Keywords   []string           `json:"-" db:"keywords" bson:"keywords"`                              // Значимые слова запроса. Все должны встретиться в объявлении
+	Category   string             `json:"category,omitempty" db:"category" bson:"category"`             // Категория. Пустая - любая
+	Conditions []ListingCondition `json:"conditions,omitempty" db:"conditions" bson:"conditions"`       // Допустимые состояния предмета. Пустой список - любое
+	Location   *GeoPoint          `json:"location,omitempty" db:"location" bson:"location,omitempty"`   // Точка поиска
+	RadiusKm   float64            `json:"radiusKm,omitempty" db:"radius_km" bson:"radius_km,omitempty"` // Радиус поиска вокруг точки в километрах
+	UpdatedAt  time.Time          `json:"updatedAt" db:"updated_at" bson:"updated_at"`                  // Дата обновления
+	CreatedAt  time.Time          `json:"createdAt" db:"created_at" bson:"created_at"`                  // Дата создания
+}
+
+// NewWishlistItem создает позицию списка желаний.
+func NewWishlistItem(currentTime time.Time) *WishlistItem {
+	return &WishlistItem{
+		UpdatedAt: currentTime,
+		CreatedAt: currentTime,
+	}
+}
+
+// Matches подходит ли объявление под позицию списка желаний.
+// Собственные и недоступные объявления не подходят никогда.
+func (w *WishlistItem) Matches(listing *Listing) bool {
+	if listing.OwnerID == w.UserID || !listing.IsAvailable() {
+		return false
+	}
+
+	if w.Category != "" && w.Category != listing.Category {
+		return false
+	}
+
+	if len(w.Conditions) > 0 && !w.allowsCondition(listing.Condition) {
+		return false
+	}
+
+	if w.Location != nil && w.RadiusKm > 0 {
+		if listing.Location == nil || w.Location.DistanceKm(*listing.Location) > w.RadiusKm {
+			return false
+		}
+	}
+
+	terms := listing.SearchTerms()
+	for _, keyword := range w.Keywords {
+		if !containsString(terms, keyword) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// allowsCondition допустимо ли состояние предмета.
+func (w *WishlistItem) allowsCondition(condition ListingCondition) bool {
+	for _, c := range w.Conditions {
+		if c == condition {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WishlistItems список позиций списка желаний.
+type WishlistItems []*WishlistItem
+
+// WishlistMatch совпадение объявления со списком желаний пользователя.
+// Пользователь получает не больше одного уведомления об одном объявлении, сколько бы позиций ни совпало.
+type WishlistMatch struct {
+	ID        string    `json:"id" db:"id" bson:"_id"`                       // Идентификатор совпадения
+	UserID    string    `json:"userID" db:"user_id" bson:"user_id"`          // Идентификатор пользователя
+	ListingID string    `json:"listingID" db:"listing_id" bson:"listing_id"` // Идентификатор объявления
+	ItemIDs   []string  `json:"itemIDs" db:"item_ids" bson:"item_ids"`       // Совпавшие позиции списка желаний
+	CreatedAt time.Time `json:"createdAt" db:"created_at" bson:"created_at"` // Дата совпадения
+}
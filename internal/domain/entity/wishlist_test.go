@@ -0,0 +1,125 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWishlistItem_Matches(t *testing.T) {
+	t.Parallel()
+
+	almaty := &GeoPoint{Lat: 43.238949, Lon: 76.889709}
+	astana := &GeoPoint{Lat: 51.128207, Lon: 71.430411}
+
+	listing := func() *Listing {
+		return &Listing{
+			OwnerID:     "owner",
+			Title:       "Горный велосипед Stels",
+			Description: "21 скорость, алюминиевая рама",
+			Category:    "sports",
+			Condition:   ListingConditionGood,
+			Status:      ListingStatusActive,
+			Location:    almaty,
+		}
+	}
+
+	cases := []struct {
+		name    string
+		item    WishlistItem
+		listing func(l *Listing)
+		exp     bool
+	}{
+		{
+			name: "all keywords found",
+			item: WishlistItem{UserID: "user", Keywords: Keywords("велосипед stels")},
+			exp:  true,
+		},
+		{
+			name: "keyword from description",
+			item: WishlistItem{UserID: "user", Keywords: Keywords("алюминиевая рама")},
+			exp:  true,
+		},
+		{
+			name: "missing keyword",
+			item: WishlistItem{UserID: "user", Keywords: Keywords("велосипед детский")},
+			exp:  false,
+		},
+		{
+			name: "own listing",
+			item: WishlistItem{UserID: "owner", Keywords: Keywords("велосипед")},
+			exp:  false,
+		},
+		{
+			name:    "listing reserved",
+			item:    WishlistItem{UserID: "user", Keywords: Keywords("велосипед")},
+			listing: func(l *Listing) { l.Status = ListingStatusReserved },
+			exp:     false,
+		},
+		{
+			name: "other category",
+			item: WishlistItem{UserID: "user", Keywords: Keywords("велосипед"), Category: "kids"},
+			exp:  false,
+		},
+		{
+			name: "condition allowed",
+			item: WishlistItem{
+				UserID:     "user",
+				Keywords:   Keywords("велосипед"),
+				Conditions: []ListingCondition{ListingConditionNew, ListingConditionGood},
+			},
+			exp: true,
+		},
+		{
+			name: "condition not allowed",
+			item: WishlistItem{
+				UserID:     "user",
+				Keywords:   Keywords("велосипед"),
+				Conditions: []ListingCondition{ListingConditionNew},
+			},
+			exp: false,
+		},
+		{
+			name: "within radius",
+			item: WishlistItem{UserID: "user", Keywords: Keywords("велосипед"), Location: almaty, RadiusKm: 5},
+			exp:  true,
+		},
+		{
+			name: "out of radius",
+			item: WishlistItem{UserID: "user", Keywords: Keywords("велосипед"), Location: astana, RadiusKm: 100},
+			exp:  false,
+		},
+		{
+			name:    "listing without location",
+			item:    WishlistItem{UserID: "user", Keywords: Keywords("велосипед"), Location: almaty, RadiusKm: 5},
+			listing: func(l *Listing) { l.Location = nil },
+			exp:     false,
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			l := listing()
+			if s.listing != nil {
+				s.listing(l)
+			}
+
+			assert.Equal(t, s.exp, s.item.Matches(l))
+		})
+	}
+}
+
+func TestGeoPoint_DistanceKm(t *testing.T) {
+	t.Parallel()
+
+	almaty := GeoPoint{Lat: 43.238949, Lon: 76.889709}
+	astana := GeoPoint{Lat: 51.128207, Lon: 71.430411}
+
+	assert.InDelta(t, 0, almaty.DistanceKm(almaty), 1e-9)
+	assert.InDelta(t, 970, almaty.DistanceKm(astana), 10)
+	assert.InDelta(t, almaty.DistanceKm(astana), astana.DistanceKm(almaty), 1e-9)
+}
@@ -0,0 +1,77 @@
+package form
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"gitlab.com/example/gophers/libs/validate"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// AuditEntriesGet форма получения журнала изменений администратором.
+type AuditEntriesGet struct {
+	RequesterID string                 `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                        // Идентификатор администратора. Передается в заголовке X-User-Id
+	EntityType  entity.AuditEntityType `json:"entityType" validate:"omitempty,oneof=user order listing payment" example:"user"` // Тип сущности
+	EntityID    string                 `json:"entityID" validate:"omitempty" example:"655d8a4d3afea534e56b570e"`                // Идентификатор сущности
+	ActorID     string                 `json:"actorID" validate:"omitempty" example:"655d8a4d3afea534e56b570f"`                 // Автор изменений
+	RequestID   string                 `json:"requestID" validate:"omitempty" example:"host/abcdef-000001"`                     // Идентификатор запроса
+	From        *time.Time             `json:"from" validate:"omitempty" example:"2024-01-01T00:00:00Z"`                        // Начало периода в RFC 3339
+	To          *time.Time             `json:"to" validate:"omitempty" example:"2024-02-01T00:00:00Z"`                          // Конец периода в RFC 3339, не включается
+
+	Pagination Pagination `json:"-"` // Пагинация
+}
+
+// ParseAuditEntriesGet возвращает фильтр журнала изменений из параметров запроса.
+func ParseAuditEntriesGet(values url.Values) (AuditEntriesGet, error) {
+	pagination, err := ParsePagination(values)
+	if err != nil {
+		return AuditEntriesGet{}, err
+	}
+
+	filter := AuditEntriesGet{
+		EntityType: entity.AuditEntityType(values.Get("entityType")),
+		EntityID:   values.Get("entityID"),
+		ActorID:    values.Get("actorID"),
+		RequestID:  values.Get("requestID"),
+		Pagination: pagination,
+	}
+
+	if filter.From, err = parseAuditTime(values.Get("from")); err != nil {
+		return AuditEntriesGet{}, err
+	}
+
+	if filter.To, err = parseAuditTime(values.Get("to")); err != nil {
+		return AuditEntriesGet{}, err
+	}
+
+	return filter, nil
+}
+
+// parseAuditTime разбирает границу периода. Пустая строка означает отсутствие границы.
+func parseAuditTime(str string) (*time.Time, error) {
+	if str == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, str)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", entity.ErrAuditInvalidPeriod, err.Error())
+	}
+
+	return &t, nil
+}
+
+// Validate валидирует форму получения журнала изменений.
+func (f AuditEntriesGet) Validate() error {
+	if err := validate.New(shortServiceName).Validate(f); err != nil {
+		return err
+	}
+
+	if f.From != nil && f.To != nil && !f.From.Before(*f.To) {
+		return fmt.Errorf("%w: начало периода должно быть раньше конца", entity.ErrAuditInvalidPeriod)
+	}
+
+	return nil
+}
@@ -0,0 +1,317 @@
+package form
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitlab.com/example/gophers/libs/validate"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// ImportStart форма запуска или продолжения импорта.
+type ImportStart struct {
+	RequesterID string          `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`            // Идентификатор администратора. Передается в заголовке X-User-Id
+	RunID       string          `json:"run" validate:"omitempty,mongodb" example:"655d8a4d3afea534e56b570f"` // Идентификатор прерванного запуска, который нужно продолжить
+	Kind        entity.BulkKind `json:"-" validate:"required,oneof=users orders"`                            // Вид импортируемых сущностей
+}
+
+// Validate валидирует форму запуска импорта.
+func (f ImportStart) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// UsersExport фильтр экспорта пользователей.
+type UsersExport struct {
+	RequesterID string     `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"` // Идентификатор администратора. Передается в заголовке X-User-Id
+	City        string     `json:"city" validate:"omitempty,max=100" example:"Алматы"`       // Город
+	From        *time.Time `json:"from" validate:"omitempty" example:"2024-01-01T00:00:00Z"` // Начало периода создания в RFC 3339
+	To          *time.Time `json:"to" validate:"omitempty" example:"2024-02-01T00:00:00Z"`   // Конец периода создания в RFC 3339, не включается
+}
+
+// ParseUsersExport возвращает фильтр экспорта пользователей из параметров запроса.
+func ParseUsersExport(values url.Values) (UsersExport, error) {
+	from, to, err := parseBulkPeriod(values)
+	if err != nil {
+		return UsersExport{}, err
+	}
+
+	return UsersExport{City: values.Get("city"), From: from, To: to}, nil
+}
+
+// Validate валидирует фильтр экспорта пользователей.
+func (f UsersExport) Validate() error {
+	if err := validate.New(shortServiceName).Validate(f); err != nil {
+		return err
+	}
+
+	return validateBulkPeriod(f.From, f.To)
+}
+
+// OrdersExport фильтр экспорта заказов.
+type OrdersExport struct {
+	RequesterID string             `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                                    // Идентификатор администратора. Передается в заголовке X-User-Id
+	UserID      string             `json:"userID" validate:"omitempty,mongodb" example:"655d8a4d3afea534e56b570f"`                      // Покупатель
+	Status      entity.OrderStatus `json:"status" validate:"omitempty,oneof=created confirmed completed cancelled" example:"confirmed"` // Статус заказа
+	From        *time.Time         `json:"from" validate:"omitempty" example:"2024-01-01T00:00:00Z"`                                    // Начало периода создания в RFC 3339
+	To          *time.Time         `json:"to" validate:"omitempty" example:"2024-02-01T00:00:00Z"`                                      // Конец периода создания в RFC 3339, не включается
+}
+
+// ParseOrdersExport возвращает фильтр экспорта заказов из параметров запроса.
+func ParseOrdersExport(values url.Values) (OrdersExport, error) {
+	from, to, err := parseBulkPeriod(values)
+	if err != nil {
+		return OrdersExport{}, err
+	}
+
+	return OrdersExport{
+		UserID: values.Get("userID"),
+		Status: entity.OrderStatus(values.Get("status")),
+		From:   from,
+		To:     to,
+	}, nil
+}
+
+// Validate валидирует фильтр экспорта заказов.
+func (f OrdersExport) Validate() error {
+	if err := validate.New(shortServiceName).Validate(f); err != nil {
+		return err
+	}
+
+	return validateBulkPeriod(f.From, f.To)
+}
+
+// parseBulkPeriod разбирает границы периода экспорта. Пустая граница означает ее отсутствие.
+func parseBulkPeriod(values url.Values) (from, to *time.Time, err error) {
+	parse := func(str string) (*time.Time, error) {
+		if str == "" {
+			return nil, nil
+		}
+
+		t, err := time.Parse(time.RFC3339, str)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", entity.ErrBulkInvalidPeriod, err.Error())
+		}
+
+		return &t, nil
+	}
+
+	if from, err = parse(values.Get("from")); err != nil {
+		return nil, nil, err
+	}
+
+	if to, err = parse(values.Get("to")); err != nil {
+		return nil, nil, err
+	}
+
+	return from, to, nil
+}
+
+// validateBulkPeriod проверяет, что начало периода раньше конца.
+func validateBulkPeriod(from, to *time.Time) error {
+	if from != nil && to != nil && !from.Before(*to) {
+		return entity.ErrBulkInvalidPeriod
+	}
+
+	return nil
+}
+
+// OrderImport строка импорта заказа. Покупатель указывается в самой строке, а не в заголовке X-User-Id.
+type OrderImport struct {
+	UserID string `json:"userID" example:"655d8a4d3afea534e56b570e"` // Идентификатор покупателя
+
+	OrderCreate
+}
+
+// Form возвращает форму создания заказа от имени покупателя из строки.
+func (f *OrderImport) Form() OrderCreate {
+	createForm := f.OrderCreate
+	createForm.UserID = f.UserID
+
+	return createForm
+}
+
+// FromCSV заполняет форму из колонок userID, counterpartyID, amount и currency.
+func (f *OrderImport) FromCSV(record CSVRecord) error {
+	f.UserID = record["userID"]
+	f.CounterpartyID = record["counterpartyID"]
+	f.Cost.Currency = entity.Currency(record["currency"])
+
+	if amount := record["amount"]; amount != "" {
+		value, err := strconv.ParseInt(amount, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: amount: %s", entity.ErrImportRowDecode, err.Error())
+		}
+
+		f.Cost.Amount = value
+	}
+
+	return nil
+}
+
+// FromCSV заполняет форму из колонок name, bio, city, lat и lon.
+// Местоположение заполняется, только если указана хотя бы одна координата.
+func (c *UserCreate) FromCSV(record CSVRecord) error {
+	c.Name = record["name"]
+	c.Bio = record["bio"]
+	c.City = record["city"]
+
+	lat, lon := record["lat"], record["lon"]
+	if lat == "" && lon == "" {
+		return nil
+	}
+
+	var (
+		point entity.GeoPoint
+		err   error
+	)
+
+	if point.Lat, err = strconv.ParseFloat(lat, 64); err != nil {
+		return fmt.Errorf("%w: lat: %s", entity.ErrImportRowDecode, err.Error())
+	}
+
+	if point.Lon, err = strconv.ParseFloat(lon, 64); err != nil {
+		return fmt.Errorf("%w: lon: %s", entity.ErrImportRowDecode, err.Error())
+	}
+
+	c.Location = &point
+
+	return nil
+}
+
+// CSVRecord значения строки CSV по названиям колонок из заголовка.
+type CSVRecord map[string]string
+
+// BulkRow форма строки импорта. В NDJSON строка разбирается как JSON формы, в CSV - по названиям колонок.
+type BulkRow interface {
+	// FromCSV заполняет форму из строки CSV. Значения отсутствующих колонок пусты.
+	FromCSV(record CSVRecord) error
+}
+
+// utf8BOM метка порядка байтов, которую табличные редакторы пишут в начало CSV.
+const utf8BOM = "\ufeff"
+
+// BulkDecoder читает строки импорта в формате CSV или NDJSON по одной, не загружая файл в память.
+// Колонки и поля, которых нет в форме, пропускаются, поэтому файл экспорта можно импортировать обратно.
+type BulkDecoder struct {
+	format entity.BulkFormat // Формат строк
+	csv    *csv.Reader       // Чтение CSV
+	header []string          // Названия колонок CSV
+	lines  *bufio.Reader     // Чтение NDJSON
+	row    int64             // Номер последней прочитанной строки данных
+}
+
+// NewBulkDecoder создает чтение строк. Для CSV сразу читается заголовок.
+func NewBulkDecoder(r io.Reader, format entity.BulkFormat) (*BulkDecoder, error) {
+	decoder := &BulkDecoder{format: format}
+
+	switch format {
+	case entity.BulkFormatCSV:
+		decoder.csv = csv.NewReader(r)
+		decoder.csv.FieldsPerRecord = -1
+		decoder.csv.TrimLeadingSpace = true
+
+		header, err := decoder.csv.Read()
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: заголовок: %s", entity.ErrImportRowDecode, err.Error())
+		}
+
+		for i := range header {
+			header[i] = strings.TrimSpace(strings.TrimPrefix(header[i], utf8BOM))
+		}
+
+		decoder.header = header
+	case entity.BulkFormatNDJSON:
+		decoder.lines = bufio.NewReader(r)
+	default:
+		return nil, fmt.Errorf("%w: %q", entity.ErrBulkFormat, format)
+	}
+
+	return decoder, nil
+}
+
+// Row возвращает номер последней прочитанной строки данных, начиная с 1.
+// Заголовок CSV и пустые строки NDJSON не считаются.
+func (d *BulkDecoder) Row() int64 {
+	return d.row
+}
+
+// Next читает следующую строку в dst. После последней строки возвращает io.EOF.
+// Ошибка разбора строки оборачивает entity.ErrImportRowDecode: строку можно пропустить и читать дальше.
+func (d *BulkDecoder) Next(dst BulkRow) error {
+	if d.format == entity.BulkFormatCSV {
+		return d.nextCSV(dst)
+	}
+
+	return d.nextNDJSON(dst)
+}
+
+// nextCSV читает следующую строку CSV.
+func (d *BulkDecoder) nextCSV(dst BulkRow) error {
+	if d.header == nil {
+		return io.EOF
+	}
+
+	values, err := d.csv.Read()
+	if errors.Is(err, io.EOF) {
+		return io.EOF
+	}
+
+	d.row++
+
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return fmt.Errorf("%w: %s", entity.ErrImportRowDecode, parseErr.Err.Error())
+	}
+
+	if err != nil {
+		return fmt.Errorf("чтение строки %d: %w", d.row, err)
+	}
+
+	if len(values) > len(d.header) {
+		return fmt.Errorf("%w: колонок %d, в заголовке %d", entity.ErrImportRowDecode, len(values), len(d.header))
+	}
+
+	record := make(CSVRecord, len(d.header))
+	for i, value := range values {
+		record[d.header[i]] = strings.TrimSpace(value)
+	}
+
+	return dst.FromCSV(record)
+}
+
+// nextNDJSON читает следующую непустую строку NDJSON.
+func (d *BulkDecoder) nextNDJSON(dst BulkRow) error {
+	for {
+		line, err := d.lines.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("чтение строки %d: %w", d.row+1, err)
+		}
+
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			if err != nil {
+				return io.EOF
+			}
+
+			continue
+		}
+
+		d.row++
+
+		if uErr := json.Unmarshal(line, dst); uErr != nil {
+			return fmt.Errorf("%w: %s", entity.ErrImportRowDecode, uErr.Error())
+		}
+
+		return nil
+	}
+}
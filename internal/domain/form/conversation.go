@@ -0,0 +1,134 @@
+package form
+
+import (
+	"strings"
+
+	"gitlab.com/example/gophers/libs/validate"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// ConversationCreate форма открытия переписки по сделке.
+// Если переписка по сделке уже есть, возвращается она.
+type ConversationCreate struct {
+	UserID      string                     `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                 // Идентификатор пользователя. Передается в заголовке X-User-Id
+	SubjectType entity.ConversationSubject `json:"subjectType" validate:"required,oneof=order trade_offer" example:"order"`  // Тип сделки
+	SubjectID   string                     `json:"subjectID" validate:"required,mongodb" example:"5f8b9b1b3afea534e56b570e"` // Идентификатор сделки
+}
+
+// Validate валидирует форму открытия переписки.
+func (f *ConversationCreate) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// ConversationGet форма получения переписки участником.
+type ConversationGet struct {
+	ConversationID string `json:"-" validate:"required,mongodb" example:"655d8a3577a0a79c69a7cdfc"` // Идентификатор переписки. Передается в пути запроса
+	UserID         string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`         // Идентификатор пользователя. Передается в заголовке X-User-Id
+}
+
+// Validate валидирует форму получения переписки.
+func (f ConversationGet) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// ConversationsGet форма получения списка переписок пользователя.
+type ConversationsGet struct {
+	UserID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"` // Идентификатор пользователя. Передается в заголовке X-User-Id
+
+	Pagination Pagination `json:"-"` // Пагинация
+}
+
+// Validate валидирует форму получения списка переписок.
+func (f ConversationsGet) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// AttachmentCreate форма вложения сообщения.
+type AttachmentCreate struct {
+	URL         string `json:"url" validate:"required,url,max=2048" example:"https://cdn.example.com/1.jpg"` // Ссылка на загруженный файл
+	Name        string `json:"name" validate:"required,max=255" example:"фото.jpg"`                          // Имя файла
+	ContentType string `json:"contentType" validate:"required,max=100" example:"image/jpeg"`                 // MIME-тип файла
+	Size        int64  `json:"size" validate:"required,min=1,max=10485760" example:"204800"`                 // Размер файла в байтах, не больше 10 МБ
+}
+
+// MessageCreate форма отправки сообщения.
+type MessageCreate struct {
+	ConversationID string             `json:"-" validate:"required,mongodb" example:"655d8a3577a0a79c69a7cdfc"` // Идентификатор переписки. Передается в пути запроса
+	SenderID       string             `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`         // Идентификатор отправителя. Передается в заголовке X-User-Id
+	Text           string             `json:"text" validate:"max=4000" example:"Когда удобно встретиться?"`     // Текст сообщения
+	Attachments    []AttachmentCreate `json:"attachments" validate:"omitempty,max=5,dive"`                      // Вложения
+}
+
+// Validate валидирует форму отправки сообщения.
+func (f *MessageCreate) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	if err := validate.New(shortServiceName).Validate(f); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(f.Text) == "" && len(f.Attachments) == 0 {
+		return entity.ErrMessageEmpty
+	}
+
+	return nil
+}
+
+// Fill заполняет сущность сообщения.
+func (f *MessageCreate) Fill(message *entity.Message) error {
+	if f == nil || message == nil {
+		return entity.ErrNilPointer
+	}
+
+	message.ConversationID = f.ConversationID
+	message.SenderID = f.SenderID
+	message.Text = strings.TrimSpace(f.Text)
+
+	for _, a := range f.Attachments {
+		message.Attachments = append(message.Attachments, entity.Attachment{
+			URL:         a.URL,
+			Name:        a.Name,
+			ContentType: a.ContentType,
+			Size:        a.Size,
+		})
+	}
+
+	return nil
+}
+
+// ToConversationGet возвращает форму получения переписки отправителем.
+func (f *MessageCreate) ToConversationGet() ConversationGet {
+	return ConversationGet{
+		ConversationID: f.ConversationID,
+		UserID:         f.SenderID,
+	}
+}
+
+// MessagesGet форма получения истории сообщений.
+// Страницы листаются курсором: page_state из ответа передается в следующий запрос.
+type MessagesGet struct {
+	ConversationID string `json:"-" validate:"required,mongodb" example:"655d8a3577a0a79c69a7cdfc"` // Идентификатор переписки. Передается в пути запроса
+	UserID         string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`         // Идентификатор пользователя. Передается в заголовке X-User-Id
+
+	Pagination Pagination `json:"-"` // Пагинация
+}
+
+// Validate валидирует форму получения истории сообщений.
+func (f MessagesGet) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// ToConversationGet возвращает форму получения переписки.
+func (f MessagesGet) ToConversationGet() ConversationGet {
+	return ConversationGet{
+		ConversationID: f.ConversationID,
+		UserID:         f.UserID,
+	}
+}
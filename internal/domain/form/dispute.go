@@ -0,0 +1,173 @@
+package form
+
+import (
+	"gitlab.com/example/gophers/libs/validate"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// DisputeCreate форма открытия спора по заказу.
+type DisputeCreate struct {
+	UserID      string               `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                                                       // Идентификатор автора спора. Передается в заголовке X-User-Id
+	OrderID     string               `json:"orderID" validate:"required,mongodb" example:"5f8b9b1b3afea534e56b570e"`                                         // Идентификатор заказа
+	Reason      entity.DisputeReason `json:"reason" validate:"required,oneof=not_as_described no_show damaged other" example:"not_as_described"`             // Причина спора
+	Description string               `json:"description" validate:"required,max=2000" example:"Велосипед оказался с погнутой рамой, в объявлении этого нет"` // Описание проблемы
+}
+
+// Validate валидирует форму открытия спора.
+func (f *DisputeCreate) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// ToOrderGetForClient возвращает форму получения заказа, по которому открывается спор.
+func (f *DisputeCreate) ToOrderGetForClient() OrderGetForClient {
+	return OrderGetForClient{
+		OrderID: f.OrderID,
+		UserID:  f.UserID,
+	}
+}
+
+// DisputeGet форма получения спора стороной или модератором.
+type DisputeGet struct {
+	DisputeID string `json:"-" validate:"required,mongodb" example:"665d8a4d3afea534e56b5710"` // Идентификатор спора. Передается в пути запроса
+	UserID    string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`         // Идентификатор пользователя. Передается в заголовке X-User-Id
+}
+
+// Validate валидирует форму получения спора.
+func (f DisputeGet) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// DisputesGet форма получения споров, в которых пользователь является стороной.
+type DisputesGet struct {
+	UserID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"` // Идентификатор пользователя. Передается в заголовке X-User-Id
+
+	Pagination Pagination `json:"-"` // Пагинация
+}
+
+// Validate валидирует форму получения споров пользователя.
+func (f DisputesGet) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// DisputeQueueGet форма получения очереди споров модератором.
+type DisputeQueueGet struct {
+	RequesterID string               `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`            // Идентификатор модератора. Передается в заголовке X-User-Id
+	Status      entity.DisputeStatus `json:"status" validate:"omitempty,oneof=open in_review" example:"open"`     // Статус споров. Без него возвращаются все нерешенные споры
+	ModeratorID string               `json:"moderatorID" validate:"omitempty" example:"655d8a4d3afea534e56b570e"` // Модератор, которому назначены споры
+	Overdue     bool                 `json:"overdue" example:"true"`                                              // Только споры с просроченным сроком
+
+	Pagination Pagination `json:"-"` // Пагинация
+}
+
+// Validate валидирует форму получения очереди споров.
+func (f DisputeQueueGet) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// DisputeEvidenceAdd форма добавления доказательства к спору. Файл загружается заранее через медиафайлы.
+type DisputeEvidenceAdd struct {
+	DisputeID string `json:"-" validate:"required,mongodb" example:"665d8a4d3afea534e56b5710"`       // Идентификатор спора. Передается в пути запроса
+	UserID    string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`               // Идентификатор стороны спора. Передается в заголовке X-User-Id
+	MediaID   string `json:"mediaID" validate:"required,mongodb" example:"665d8a4d3afea534e56b5711"` // Идентификатор загруженного медиафайла
+	Comment   string `json:"comment" validate:"omitempty,max=500" example:"Фото рамы при получении"` // Пояснение
+}
+
+// Validate валидирует форму добавления доказательства.
+func (f *DisputeEvidenceAdd) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// DisputeMessageCreate форма отправки сообщения в переписку по спору.
+type DisputeMessageCreate struct {
+	DisputeID string `json:"-" validate:"required,mongodb" example:"665d8a4d3afea534e56b5710"`                // Идентификатор спора. Передается в пути запроса
+	UserID    string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                        // Идентификатор отправителя. Передается в заголовке X-User-Id
+	Text      string `json:"text" validate:"required,max=2000" example:"Прикладываю фото рамы при получении"` // Текст сообщения
+}
+
+// Validate валидирует форму отправки сообщения.
+func (f *DisputeMessageCreate) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// DisputeMessagesGet форма получения переписки по спору.
+type DisputeMessagesGet struct {
+	DisputeID string `json:"-" validate:"required,mongodb" example:"665d8a4d3afea534e56b5710"` // Идентификатор спора. Передается в пути запроса
+	UserID    string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`         // Идентификатор пользователя. Передается в заголовке X-User-Id
+
+	Pagination Pagination `json:"-"` // Пагинация
+}
+
+// Validate валидирует форму получения переписки.
+func (f DisputeMessagesGet) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// ToDisputeGet возвращает форму получения спора, к которому относится переписка.
+func (f DisputeMessagesGet) ToDisputeGet() DisputeGet {
+	return DisputeGet{DisputeID: f.DisputeID, UserID: f.UserID}
+}
+
+// DisputeAssign форма передачи спора модератору.
+type DisputeAssign struct {
+	DisputeID   string `json:"-" validate:"required,mongodb" example:"665d8a4d3afea534e56b5710"`    // Идентификатор спора. Передается в пути запроса
+	RequesterID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`            // Идентификатор модератора. Передается в заголовке X-User-Id
+	ModeratorID string `json:"moderatorID" validate:"omitempty" example:"655d8a4d3afea534e56b570f"` // Модератор, которому передается спор. Без него спор берет автор запроса
+}
+
+// Validate валидирует форму передачи спора.
+func (f *DisputeAssign) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	if err := validate.New(shortServiceName).Validate(f); err != nil {
+		return err
+	}
+
+	if f.ModeratorID == "" {
+		f.ModeratorID = f.RequesterID
+	}
+
+	return nil
+}
+
+// DisputeResolve форма решения спора модератором.
+type DisputeResolve struct {
+	DisputeID         string               `json:"-" validate:"required,mongodb" example:"665d8a4d3afea534e56b5710"`                     // Идентификатор спора. Передается в пути запроса
+	RequesterID       string               `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                             // Идентификатор модератора. Передается в заголовке X-User-Id
+	Status            entity.DisputeStatus `json:"status" validate:"required,oneof=resolved rejected" example:"resolved"`                // Решение: resolved в пользу автора, rejected отклонить
+	Comment           string               `json:"comment" validate:"required,max=2000" example:"Повреждение подтверждено фотографиями"` // Обоснование решения
+	Refund            *entity.Money        `json:"refund" validate:"omitempty"`                                                          // Возврат оплаты заказа автору спора
+	CreditReversal    int64                `json:"creditReversal" validate:"omitempty,min=0" example:"500"`                              // Кредиты, которые списываются со второй стороны в пользу автора
+	ReputationPenalty int                  `json:"reputationPenalty" validate:"omitempty,min=0,max=5" example:"2"`                       // Штраф к репутации второй стороны в минимальных оценках
+}
+
+// Validate валидирует форму решения спора.
+func (f *DisputeResolve) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	if err := validate.New(shortServiceName).Validate(f); err != nil {
+		return err
+	}
+
+	if f.Refund != nil && f.Refund.Amount <= 0 {
+		return entity.ErrPaymentInvalidRefund
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package form
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// SortByDistance сортировка по расстоянию до точки near.
+const SortByDistance = "distance"
+
+const (
+	// defaultRadiusKm радиус поиска по умолчанию в километрах.
+	defaultRadiusKm = 10
+	// maxRadiusKm максимальный радиус поиска в километрах.
+	maxRadiusKm = 500
+)
+
+// GeoNear фильтр по расстоянию до точки.
+type GeoNear struct {
+	Point    entity.GeoPoint // Центр поиска
+	RadiusKm float64         // Радиус поиска в километрах
+}
+
+// ParseGeoNear парсит фильтр по расстоянию из параметров near=широта,долгота и radius_km.
+// Если near не передан, возвращает nil.
+func ParseGeoNear(values url.Values) (*GeoNear, error) {
+	near := values.Get("near")
+	if near == "" {
+		return nil, nil
+	}
+
+	point, err := entity.ParseGeoPoint(near)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &GeoNear{Point: point, RadiusKm: defaultRadiusKm}
+
+	if str := values.Get("radius_km"); str != "" {
+		radius, pErr := strconv.ParseFloat(str, 64)
+		if pErr != nil {
+			return nil, fmt.Errorf("%w: %s", entity.ErrGeoInvalidRadius, pErr.Error())
+		}
+
+		// NaN не проходит ни одно сравнение, поэтому проверяется отдельно.
+		if math.IsNaN(radius) || math.IsInf(radius, 0) || radius <= 0 || radius > maxRadiusKm {
+			return nil, fmt.Errorf("%w: допустимо от 0 до %d км", entity.ErrGeoInvalidRadius, maxRadiusKm)
+		}
+
+		filter.RadiusKm = radius
+	}
+
+	return filter, nil
+}
+
+// Contains находится ли точка в радиусе поиска.
+func (f GeoNear) Contains(point *entity.GeoPoint) bool {
+	return point != nil && f.Point.DistanceKm(*point) <= f.RadiusKm
+}
@@ -0,0 +1,64 @@
+package form
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+func TestParseGeoNear(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		query  string
+		radius float64
+		empty  bool
+		err    error
+	}{
+		{name: "без точки", query: "radius_km=5", empty: true},
+		{name: "радиус по умолчанию", query: "near=43.238,76.945", radius: defaultRadiusKm},
+		{name: "дробный радиус", query: "near=43.238,76.945&radius_km=2.5", radius: 2.5},
+		{name: "максимальный радиус", query: "near=43.238,76.945&radius_km=500", radius: maxRadiusKm},
+		{name: "нулевой радиус", query: "near=43.238,76.945&radius_km=0", err: entity.ErrGeoInvalidRadius},
+		{name: "радиус больше максимального", query: "near=43.238,76.945&radius_km=501", err: entity.ErrGeoInvalidRadius},
+		{name: "радиус не число", query: "near=43.238,76.945&radius_km=far", err: entity.ErrGeoInvalidRadius},
+		{name: "радиус NaN", query: "near=43.238,76.945&radius_km=NaN", err: entity.ErrGeoInvalidRadius},
+		{name: "бесконечный радиус", query: "near=43.238,76.945&radius_km=Inf", err: entity.ErrGeoInvalidRadius},
+		{name: "отрицательная бесконечность", query: "near=43.238,76.945&radius_km=-Inf", err: entity.ErrGeoInvalidRadius},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			values, err := url.ParseQuery(s.query)
+			require.NoError(t, err)
+
+			filter, err := ParseGeoNear(values)
+			if s.err != nil {
+				assert.ErrorIs(t, err, s.err)
+				assert.Nil(t, filter)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			if s.empty {
+				assert.Nil(t, filter)
+
+				return
+			}
+
+			require.NotNil(t, filter)
+			assert.Equal(t, s.radius, filter.RadiusKm)
+		})
+	}
+}
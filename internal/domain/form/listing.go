@@ -0,0 +1,179 @@
+package form
+
+import (
+	"time"
+
+	"gitlab.com/example/gophers/libs/validate"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// ListingCreate форма создания объявления.
+type ListingCreate struct {
+	OwnerID     string                  `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                                                    // Идентификатор владельца. Передается в заголовке X-User-Id
+	Title       string                  `json:"title" validate:"required,min=3,max=120" example:"Велосипед Stels"`                                           // Заголовок
+	Description string                  `json:"description" validate:"omitempty,max=2000" example:"Горный велосипед, 21 скорость"`                           // Описание
+	Category    string                  `json:"category" validate:"required,oneof=electronics clothing home books sports kids hobby other" example:"sports"` // Категория
+	Condition   entity.ListingCondition `json:"condition" validate:"required,oneof=new like_new good fair poor" example:"good"`                              // Состояние предмета
+	Photos      []string                `json:"photos" validate:"omitempty,max=10,dive,url" example:"https://cdn.example.com/1.jpg"`                         // Ссылки на фотографии
+	DesiredTags []string                `json:"desiredTags" validate:"omitempty,max=20,dive,min=2,max=50" example:"ноутбук"`                                 // Что владелец хочет получить взамен
+	Location    *entity.GeoPoint        `json:"location" validate:"omitempty"`                                                                               // Местоположение предмета
+	City        string                  `json:"city" validate:"omitempty,max=100" example:"Алматы"`                                                          // Город
+	Valuation   *entity.Money           `json:"valuation" validate:"omitempty"`                                                                              // Оценка предмета владельцем
+}
+
+// Validate валидирует форму создания объявления.
+func (f *ListingCreate) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	if err := validate.New(shortServiceName).Validate(f); err != nil {
+		return err
+	}
+
+	return validateValuation(f.Valuation)
+}
+
+// Fill заполняет сущность объявления.
+func (f *ListingCreate) Fill(listing *entity.Listing) error {
+	if f == nil || listing == nil {
+		return entity.ErrNilPointer
+	}
+
+	listing.OwnerID = f.OwnerID
+	listing.Title = f.Title
+	listing.Description = f.Description
+	listing.Category = f.Category
+	listing.Condition = f.Condition
+	listing.Photos = f.Photos
+	listing.DesiredTags = entity.NormalizeTags(f.DesiredTags)
+	listing.Location = f.Location
+	listing.City = f.City
+	listing.Valuation = f.Valuation
+
+	return nil
+}
+
+// ListingUpdate форма обновления объявления.
+type ListingUpdate struct {
+	ID          string                   `json:"-" validate:"required,mongodb" example:"655d8a3577a0a79c69a7cdfc"`                                             // Идентификатор объявления. Передается в пути запроса
+	OwnerID     string                   `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                                                     // Идентификатор владельца. Передается в заголовке X-User-Id
+	Title       *string                  `json:"title" validate:"omitempty,min=3,max=120" example:"Велосипед Stels"`                                           // Заголовок
+	Description *string                  `json:"description" validate:"omitempty,max=2000" example:"Горный велосипед, 21 скорость"`                            // Описание
+	Category    *string                  `json:"category" validate:"omitempty,oneof=electronics clothing home books sports kids hobby other" example:"sports"` // Категория
+	Condition   *entity.ListingCondition `json:"condition" validate:"omitempty,oneof=new like_new good fair poor" example:"good"`                              // Состояние предмета
+	Photos      []string                 `json:"photos" validate:"omitempty,max=10,dive,url" example:"https://cdn.example.com/1.jpg"`                          // Ссылки на фотографии
+	DesiredTags []string                 `json:"desiredTags" validate:"omitempty,max=20,dive,min=2,max=50" example:"ноутбук"`                                  // Что владелец хочет получить взамен
+	Location    *entity.GeoPoint         `json:"location" validate:"omitempty"`                                                                                // Местоположение предмета
+	City        *string                  `json:"city" validate:"omitempty,max=100" example:"Алматы"`                                                           // Город
+	Status      *entity.ListingStatus    `json:"status" validate:"omitempty,oneof=active archived" example:"archived"`                                         // Статус объявления. Владелец может только снять или вернуть объявление
+	Valuation   *entity.Money            `json:"valuation" validate:"omitempty"`                                                                               // Оценка предмета владельцем
+}
+
+// Validate валидирует форму обновления объявления.
+func (f *ListingUpdate) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	if err := validate.New(shortServiceName).Validate(f); err != nil {
+		return err
+	}
+
+	return validateValuation(f.Valuation)
+}
+
+// Fill заполняет сущность объявления обновленными данными.
+func (f *ListingUpdate) Fill(listing *entity.Listing, currentTime time.Time) error {
+	if f == nil || listing == nil {
+		return entity.ErrNilPointer
+	}
+
+	if f.Title != nil {
+		listing.Title = *f.Title
+	}
+
+	if f.Description != nil {
+		listing.Description = *f.Description
+	}
+
+	if f.Category != nil {
+		listing.Category = *f.Category
+	}
+
+	if f.Condition != nil {
+		listing.Condition = *f.Condition
+	}
+
+	if f.Photos != nil {
+		listing.Photos = f.Photos
+	}
+
+	if f.DesiredTags != nil {
+		listing.DesiredTags = entity.NormalizeTags(f.DesiredTags)
+	}
+
+	if f.Location != nil {
+		listing.Location = f.Location
+	}
+
+	if f.City != nil {
+		listing.City = *f.City
+	}
+
+	if f.Status != nil {
+		listing.Status = *f.Status
+	}
+
+	if f.Valuation != nil {
+		listing.Valuation = f.Valuation
+	}
+
+	listing.UpdatedAt = currentTime
+
+	return nil
+}
+
+// validateValuation проверяет, что оценка предмета положительная. Оценки может не быть.
+func validateValuation(valuation *entity.Money) error {
+	if valuation != nil && valuation.Amount <= 0 {
+		return entity.ErrListingInvalidValuation
+	}
+
+	return nil
+}
+
+// ListingsGet форма получения списка объявлений.
+type ListingsGet struct {
+	OwnerID  string `json:"ownerID" validate:"omitempty,mongodb" example:"655d8a4d3afea534e56b570e"`   // Идентификатор владельца
+	Category string `json:"category" validate:"omitempty,max=50" example:"sports"`                     // Категория
+	Status   string `json:"status" validate:"omitempty,oneof=active reserved exchanged archived"`      // Статус объявления
+	Tag      string `json:"tag" validate:"omitempty,max=50" example:"ноутбук"`                         // Желаемый в обмен тег
+	City     string `json:"city" validate:"omitempty,max=100" example:"Алматы"`                        // Город
+	SortBy   string `json:"sort_by" validate:"omitempty,oneof=created_at distance" example:"distance"` // Поле сортировки: дата создания или расстояние до near
+
+	Near *GeoNear `json:"-"` // Только объявления в радиусе от точки
+
+	Pagination Pagination `json:"-"` // Пагинация
+}
+
+// Validate валидирует форму получения списка объявлений.
+func (f ListingsGet) Validate() error {
+	if f.SortBy == SortByDistance && f.Near == nil {
+		return entity.ErrGeoNearRequired
+	}
+
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// ListingDelete форма удаления объявления.
+type ListingDelete struct {
+	ID      string `json:"-" validate:"required,mongodb" example:"655d8a3577a0a79c69a7cdfc"` // Идентификатор объявления
+	OwnerID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`         // Идентификатор владельца. Передается в заголовке X-User-Id
+}
+
+// Validate валидирует форму удаления объявления.
+func (f ListingDelete) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
@@ -0,0 +1,90 @@
+package form
+
+import (
+	"io"
+
+	"gitlab.com/example/gophers/libs/validate"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// MediaUpload форма загрузки медиафайла. Тип файла определяется по содержимому, а не по заголовкам клиента.
+type MediaUpload struct {
+	OwnerID string    `validate:"required" example:"655d8a4d3afea534e56b570e"` // Идентификатор пользователя. Передается в заголовке X-User-Id
+	File    io.Reader // Содержимое файла из поля file multipart-формы
+}
+
+// Validate валидирует форму загрузки медиафайла.
+func (f MediaUpload) Validate() error {
+	if f.File == nil {
+		return entity.ErrMediaFileRequired
+	}
+
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// MediaGet форма получения медиафайлов, прикрепленных к сущности.
+type MediaGet struct {
+	SubjectType entity.MediaSubject `json:"subjectType" validate:"required,oneof=user listing" example:"listing"`     // Тип сущности
+	SubjectID   string              `json:"subjectID" validate:"required,mongodb" example:"5f8b9b1b3afea534e56b570e"` // Идентификатор сущности
+}
+
+// Validate валидирует форму получения медиафайлов.
+func (f MediaGet) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// MediaAttach форма прикрепления медиафайла к объявлению. Аватар устанавливается формой AvatarSet.
+type MediaAttach struct {
+	ID          string              `json:"-" validate:"required,mongodb" example:"655d8a3577a0a79c69a7cdfc"`         // Идентификатор медиафайла. Передается в пути запроса
+	OwnerID     string              `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                 // Идентификатор пользователя. Передается в заголовке X-User-Id
+	SubjectType entity.MediaSubject `json:"subjectType" validate:"required,oneof=listing" example:"listing"`          // Тип сущности
+	SubjectID   string              `json:"subjectID" validate:"required,mongodb" example:"5f8b9b1b3afea534e56b570e"` // Идентификатор сущности
+}
+
+// Validate валидирует форму прикрепления медиафайла.
+func (f *MediaAttach) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// MediaDelete форма удаления медиафайла владельцем.
+type MediaDelete struct {
+	ID      string `json:"-" validate:"required,mongodb" example:"655d8a3577a0a79c69a7cdfc"` // Идентификатор медиафайла. Передается в пути запроса
+	OwnerID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`         // Идентификатор пользователя. Передается в заголовке X-User-Id
+}
+
+// Validate валидирует форму удаления медиафайла.
+func (f MediaDelete) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// AvatarSet форма установки аватара пользователя из загруженного им медиафайла. Доступно только самому пользователю.
+type AvatarSet struct {
+	UserID      string `json:"-" validate:"required,mongodb" example:"655d8a4d3afea534e56b570e"`       // Идентификатор пользователя. Передается в пути запроса
+	RequesterID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`               // Идентификатор пользователя, выполняющего запрос. Передается в заголовке X-User-Id
+	MediaID     string `json:"mediaID" validate:"required,mongodb" example:"655d8a3577a0a79c69a7cdfc"` // Идентификатор медиафайла
+}
+
+// Validate валидирует форму установки аватара.
+func (f *AvatarSet) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// AvatarDelete форма удаления аватара пользователя. Доступно только самому пользователю.
+type AvatarDelete struct {
+	UserID      string `json:"-" validate:"required,mongodb" example:"655d8a4d3afea534e56b570e"` // Идентификатор пользователя. Передается в пути запроса
+	RequesterID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`         // Идентификатор пользователя, выполняющего запрос. Передается в заголовке X-User-Id
+}
+
+// Validate валидирует форму удаления аватара.
+func (f AvatarDelete) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
@@ -0,0 +1,69 @@
+package form
+
+import (
+	"gitlab.com/example/gophers/libs/validate"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// ModerationReportCreate форма жалобы на профиль или объявление.
+type ModerationReportCreate struct {
+	ReporterID  string                        `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                            // Идентификатор автора жалобы. Передается в заголовке X-User-Id
+	SubjectType entity.ModerationSubjectType  `json:"subjectType" validate:"required,oneof=user listing" example:"listing"`                // Тип сущности
+	SubjectID   string                        `json:"subjectID" validate:"required,mongodb" example:"5f8b9b1b3afea534e56b570e"`            // Идентификатор сущности
+	Reason      entity.ModerationReportReason `json:"reason" validate:"required,oneof=spam abuse fraud other" example:"spam"`              // Причина жалобы
+	Comment     string                        `json:"comment" validate:"omitempty,max=1000" example:"В описании ссылка на сторонний сайт"` // Пояснение
+}
+
+// Validate валидирует форму жалобы.
+func (f *ModerationReportCreate) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// ModerationQueueGet форма получения очереди модерации.
+type ModerationQueueGet struct {
+	RequesterID string                       `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                // Идентификатор модератора. Передается в заголовке X-User-Id
+	Status      entity.ModerationCaseStatus  `json:"status" validate:"omitempty,oneof=open approved rejected" example:"open"` // Статус дел. Без него возвращаются открытые дела
+	SubjectType entity.ModerationSubjectType `json:"subjectType" validate:"omitempty,oneof=user listing" example:"listing"`   // Тип сущности
+	Reported    bool                         `json:"reported" example:"true"`                                                 // Только дела с жалобами пользователей
+
+	Pagination Pagination `json:"-"` // Пагинация
+}
+
+// Validate валидирует форму получения очереди модерации.
+func (f *ModerationQueueGet) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	if err := validate.New(shortServiceName).Validate(f); err != nil {
+		return err
+	}
+
+	if f.Status == "" {
+		f.Status = entity.ModerationCaseStatusOpen
+	}
+
+	return nil
+}
+
+// ModerationDecide форма решения модератора по делу.
+type ModerationDecide struct {
+	CaseID      string                  `json:"-" validate:"required,mongodb" example:"665d8a4d3afea534e56b5712"`                 // Идентификатор дела. Передается в пути запроса
+	RequesterID string                  `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                         // Идентификатор модератора. Передается в заголовке X-User-Id
+	Action      entity.ModerationAction `json:"action" validate:"required,oneof=approve reject ban" example:"reject"`             // Решение: approve одобрить, reject скрыть, ban скрыть и заблокировать автора
+	Comment     string                  `json:"comment" validate:"omitempty,max=1000" example:"Контакты для сделки вне площадки"` // Обоснование
+}
+
+// Validate валидирует форму решения модератора.
+func (f *ModerationDecide) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	return validate.New(shortServiceName).Validate(f)
+}
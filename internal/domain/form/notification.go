@@ -0,0 +1,119 @@
+package form
+
+import (
+	"time"
+
+	"gitlab.com/example/gophers/libs/validate"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// NotificationPreferencesGet форма получения настроек уведомлений. Доступно только самому пользователю.
+type NotificationPreferencesGet struct {
+	UserID      string `json:"-" validate:"required,mongodb" example:"655d8a4d3afea534e56b570e"` // Идентификатор пользователя. Передается в пути запроса
+	RequesterID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`         // Идентификатор автора запроса. Передается в заголовке X-User-Id
+}
+
+// Validate валидирует форму получения настроек уведомлений.
+func (f NotificationPreferencesGet) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// NotificationPreferencesUpdate форма сохранения настроек уведомлений. Настройки заменяются целиком.
+type NotificationPreferencesUpdate struct {
+	UserID      string                                                  `json:"-" validate:"required,mongodb" example:"655d8a4d3afea534e56b570e"`    // Идентификатор пользователя. Передается в пути запроса
+	RequesterID string                                                  `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`            // Идентификатор автора запроса. Передается в заголовке X-User-Id
+	Email       string                                                  `json:"email" validate:"omitempty,email,max=254" example:"user@example.com"` // Адрес для писем. Пустой адрес отключает письма
+	Channels    map[entity.NotificationKind]entity.NotificationChannels `json:"channels" validate:"omitempty,max=20"`                                // Каналы по типам уведомлений. Пустой список отключает уведомления типа
+}
+
+// Validate валидирует форму сохранения настроек уведомлений.
+func (f *NotificationPreferencesUpdate) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// Fill заполняет настройки уведомлений и проверяет типы уведомлений и каналы.
+func (f *NotificationPreferencesUpdate) Fill(preferences *entity.NotificationPreferences, currentTime time.Time) error {
+	if f == nil || preferences == nil {
+		return entity.ErrNilPointer
+	}
+
+	preferences.UserID = f.UserID
+	preferences.Email = f.Email
+	preferences.Channels = f.Channels
+	preferences.UpdatedAt = currentTime
+
+	if preferences.Channels == nil {
+		preferences.Channels = map[entity.NotificationKind]entity.NotificationChannels{}
+	}
+
+	return preferences.Validate()
+}
+
+// DeviceRegister форма регистрации устройства для push-уведомлений.
+type DeviceRegister struct {
+	UserID      string                `json:"-" validate:"required,mongodb" example:"655d8a4d3afea534e56b570e"`     // Идентификатор пользователя. Передается в пути запроса
+	RequesterID string                `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`             // Идентификатор автора запроса. Передается в заголовке X-User-Id
+	Token       string                `json:"token" validate:"required,max=4096" example:"fcm-token"`               // Токен устройства
+	Platform    entity.DevicePlatform `json:"platform" validate:"required,oneof=android ios web" example:"android"` // Платформа устройства
+}
+
+// Validate валидирует форму регистрации устройства.
+func (f *DeviceRegister) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// DeviceUnregister форма удаления устройства.
+type DeviceUnregister struct {
+	UserID      string `json:"-" validate:"required,mongodb" example:"655d8a4d3afea534e56b570e"` // Идентификатор пользователя. Передается в пути запроса
+	RequesterID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`         // Идентификатор автора запроса. Передается в заголовке X-User-Id
+	Token       string `json:"-" validate:"required,max=4096" example:"fcm-token"`               // Токен устройства. Передается в пути запроса
+}
+
+// Validate валидирует форму удаления устройства.
+func (f DeviceUnregister) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// InboxGet форма получения входящих уведомлений пользователя.
+type InboxGet struct {
+	UserID     string                      `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                  // Идентификатор пользователя. Передается в заголовке X-User-Id
+	Category   entity.NotificationCategory `json:"-" validate:"omitempty,oneof=listings messages account" example:"messages"` // Категория уведомлений. Передается в параметре category
+	UnreadOnly bool                        `json:"-" example:"true"`                                                          // Только непрочитанные. Передается в параметре unread
+
+	Pagination Pagination `json:"-"` // Пагинация
+}
+
+// Validate валидирует форму получения входящих уведомлений.
+func (f InboxGet) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// InboxUser форма действий над всеми входящими пользователя.
+type InboxUser struct {
+	UserID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"` // Идентификатор пользователя. Передается в заголовке X-User-Id
+}
+
+// Validate валидирует форму действий над входящими пользователя.
+func (f InboxUser) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// InboxMarkRead форма отметки уведомления прочитанным.
+type InboxMarkRead struct {
+	UserID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`         // Идентификатор пользователя. Передается в заголовке X-User-Id
+	ID     string `json:"-" validate:"required,mongodb" example:"655d8a4d3afea534e56b570f"` // Идентификатор уведомления. Передается в пути запроса
+}
+
+// Validate валидирует форму отметки уведомления прочитанным.
+func (f InboxMarkRead) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
@@ -1,6 +1,9 @@
 package form
 
 import (
+	"net/url"
+	"strings"
+
 	"gitlab.com/example/gophers/libs/validate"
 
 	"github.com/alisher-99/LomBarter/internal/domain/entity"
@@ -8,8 +11,9 @@ import (
 
 // OrderCreate форма создания заказа.
 type OrderCreate struct {
-	UserID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"` // Идентификатор пользователя. Передается в заголовке X-User-Id
-	Cost   int    `json:"cost" validate:"required,gt=0" example:"39900"`            // Стоимость заказа
+	UserID         string       `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                                      // Идентификатор пользователя. Передается в заголовке X-User-Id
+	CounterpartyID string       `json:"counterpartyID" validate:"omitempty,mongodb,nefield=UserID" example:"655d8a4d3afea534e56b570f"` // Идентификатор второй стороны сделки
+	Cost           entity.Money `json:"cost" validate:"required"`                                                                      // Стоимость заказа
 }
 
 // Validate валидирует форму создания заказа.
@@ -18,7 +22,15 @@ func (f *OrderCreate) Validate() error {
 		return entity.ErrNilPointer
 	}
 
-	return validate.New(shortServiceName).Validate(f)
+	if err := validate.New(shortServiceName).Validate(f); err != nil {
+		return err
+	}
+
+	if f.Cost.Amount <= 0 {
+		return entity.ErrOrderInvalidCost
+	}
+
+	return nil
 }
 
 // Fill заполняет сущность заказа.
@@ -28,6 +40,7 @@ func (f *OrderCreate) Fill(order *entity.Order) error {
 	}
 
 	order.UserID = f.UserID
+	order.CounterpartyID = f.CounterpartyID
 	order.Cost = f.Cost
 
 	return nil
@@ -43,13 +56,78 @@ func (f OrdersGetForClient) Validate() error {
 	return validate.New(shortServiceName).Validate(f)
 }
 
+// ExpandHistory раскрытие истории изменения статуса заказа.
+const ExpandHistory = "history"
+
 // OrderGetForClient форма получения заказа для клиента.
 type OrderGetForClient struct {
-	OrderID string `json:"orderID" validate:"required,mongodb" example:"5f8b9b1b3afea534e56b570e"` // Идентификатор заказа
-	UserID  string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`               // Идентификатор пользователя. Передается в заголовке X-User-Id
+	OrderID string   `json:"orderID" validate:"required,mongodb" example:"5f8b9b1b3afea534e56b570e"` // Идентификатор заказа
+	UserID  string   `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`               // Идентификатор пользователя. Передается в заголовке X-User-Id
+	Expand  []string `json:"expand" validate:"omitempty,dive,oneof=history" example:"history"`       // Связанные данные, которые нужно вернуть вместе с заказом
 }
 
 // Validate валидирует форму получения заказа для клиента.
 func (f OrderGetForClient) Validate() error {
 	return validate.New(shortServiceName).Validate(f)
 }
+
+// IsExpanded проверяет, запрошено ли раскрытие связанных данных.
+func (f OrderGetForClient) IsExpanded(name string) bool {
+	for _, expand := range f.Expand {
+		if expand == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseExpand парсит список раскрываемых данных из url. Значения передаются через запятую.
+func ParseExpand(values url.Values) []string {
+	str := values.Get("expand")
+	if str == "" {
+		return nil
+	}
+
+	expand := strings.Split(str, ",")
+	for i := range expand {
+		expand[i] = strings.TrimSpace(expand[i])
+	}
+
+	return expand
+}
+
+// OrderStatusUpdate форма изменения статуса заказа.
+type OrderStatusUpdate struct {
+	OrderID string             `json:"-" validate:"required,mongodb" example:"5f8b9b1b3afea534e56b570e"`                           // Идентификатор заказа. Передается в пути запроса
+	UserID  string             `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                                   // Идентификатор пользователя. Передается в заголовке X-User-Id
+	Status  entity.OrderStatus `json:"status" validate:"required,oneof=created confirmed completed cancelled" example:"confirmed"` // Новый статус заказа
+	Reason  string             `json:"reason" validate:"omitempty,max=500" example:"Покупатель подтвердил обмен"`                  // Причина изменения статуса
+	Refund  *entity.Money      `json:"refund" validate:"omitempty"`                                                                // Сумма возврата при отмене оплаченного заказа. Без нее возвращается вся оплата
+}
+
+// Validate валидирует форму изменения статуса заказа.
+func (f *OrderStatusUpdate) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	if err := validate.New(shortServiceName).Validate(f); err != nil {
+		return err
+	}
+
+	// Вернуть деньги можно только при отмене.
+	if f.Refund != nil && (f.Refund.Amount <= 0 || f.Status != entity.OrderStatusCancelled) {
+		return entity.ErrPaymentInvalidRefund
+	}
+
+	return nil
+}
+
+// ToOrderGetForClient возвращает форму получения заказа, к которому относится изменение.
+func (f *OrderStatusUpdate) ToOrderGetForClient() OrderGetForClient {
+	return OrderGetForClient{
+		OrderID: f.OrderID,
+		UserID:  f.UserID,
+	}
+}
@@ -0,0 +1,41 @@
+package form
+
+import (
+	"gitlab.com/example/gophers/libs/validate"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// OrderPay форма оплаты заказа.
+type OrderPay struct {
+	OrderID string `json:"-" validate:"required,mongodb" example:"5f8b9b1b3afea534e56b570e"` // Идентификатор заказа. Передается в пути запроса
+	UserID  string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`         // Идентификатор покупателя. Передается в заголовке X-User-Id
+}
+
+// Validate валидирует форму оплаты заказа.
+func (f OrderPay) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// ToOrderGetForClient возвращает форму получения оплачиваемого заказа.
+func (f OrderPay) ToOrderGetForClient() OrderGetForClient {
+	return OrderGetForClient{
+		OrderID: f.OrderID,
+		UserID:  f.UserID,
+	}
+}
+
+// PaymentWebhook уведомление платежного провайдера.
+type PaymentWebhook struct {
+	Payload   []byte // Тело уведомления без изменений, по нему считается подпись
+	Signature string // Подпись уведомления. Передается в заголовке X-Payment-Signature
+}
+
+// Validate проверяет, что уведомление подписано.
+func (f PaymentWebhook) Validate() error {
+	if len(f.Payload) == 0 || f.Signature == "" {
+		return entity.ErrPaymentWebhookSignature
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package form
+
+import (
+	"gitlab.com/example/gophers/libs/validate"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// RatingCreate форма оценки второй стороны заказа.
+type RatingCreate struct {
+	OrderID string `json:"orderID" validate:"required,mongodb" example:"5f8b9b1b3afea534e56b570e"`  // Идентификатор завершенного заказа
+	RaterID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                // Идентификатор автора оценки. Передается в заголовке X-User-Id
+	Score   int    `json:"score" validate:"required,min=1,max=5" example:"5"`                       // Оценка от 1 до 5
+	Comment string `json:"comment" validate:"omitempty,max=1000" example:"Быстрый и честный обмен"` // Комментарий
+}
+
+// Validate валидирует форму оценки.
+func (f *RatingCreate) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// Fill заполняет сущность оценки.
+func (f *RatingCreate) Fill(rating *entity.Rating, rateeID string) error {
+	if f == nil || rating == nil {
+		return entity.ErrNilPointer
+	}
+
+	rating.OrderID = f.OrderID
+	rating.RaterID = f.RaterID
+	rating.RateeID = rateeID
+	rating.Score = f.Score
+	rating.Comment = f.Comment
+
+	return nil
+}
+
+// ToOrderGetForClient возвращает форму получения оцениваемого заказа.
+func (f *RatingCreate) ToOrderGetForClient() OrderGetForClient {
+	return OrderGetForClient{
+		OrderID: f.OrderID,
+		UserID:  f.RaterID,
+	}
+}
+
+// RatingsGet форма получения оценок, полученных пользователем.
+type RatingsGet struct {
+	UserID string `json:"userID" validate:"required,mongodb" example:"655d8a4d3afea534e56b570e"` // Идентификатор оцененного пользователя
+
+	Pagination Pagination `json:"-"` // Пагинация
+}
+
+// Validate валидирует форму получения оценок.
+func (f RatingsGet) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
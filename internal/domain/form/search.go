@@ -0,0 +1,33 @@
+package form
+
+import (
+	"gitlab.com/example/gophers/libs/validate"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// Search форма полнотекстового поиска по пользователям и объявлениям.
+type Search struct {
+	Query    string            `json:"q" validate:"required,max=200" example:"горный велосипед"`       // Поисковый запрос
+	Kind     entity.SearchKind `json:"kind" validate:"omitempty,oneof=user listing" example:"listing"` // Искать только документы этого типа
+	Category string            `json:"category" validate:"omitempty,max=100" example:"sport"`          // Искать объявления только этой категории
+
+	Near *GeoNear `json:"-"` // Только документы в радиусе от точки
+
+	Pagination Pagination `json:"-"` // Пагинация
+}
+
+// Validate валидирует форму поиска.
+func (f Search) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// IncludesKind входят ли документы типа kind в поиск.
+func (f Search) IncludesKind(kind entity.SearchKind) bool {
+	if f.Kind != "" && f.Kind != kind {
+		return false
+	}
+
+	// Фильтр по категории есть только у объявлений.
+	return kind == entity.SearchKindListing || f.Category == ""
+}
@@ -0,0 +1,29 @@
+package form
+
+import (
+	"gitlab.com/example/gophers/libs/validate"
+)
+
+// TradeCycleAction форма действия над циклом обмена: согласиться или отказаться.
+type TradeCycleAction struct {
+	CycleID string `json:"-" validate:"required,mongodb" example:"655d8a3577a0a79c69a7cdfc"` // Идентификатор цикла. Передается в пути запроса
+	UserID  string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`         // Идентификатор пользователя. Передается в заголовке X-User-Id
+}
+
+// Validate валидирует форму действия над циклом обмена.
+func (f TradeCycleAction) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// TradeCyclesGet форма получения списка циклов обмена пользователя.
+type TradeCyclesGet struct {
+	UserID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                                // Идентификатор пользователя. Передается в заголовке X-User-Id
+	Status string `json:"status" validate:"omitempty,oneof=proposed accepted declined expired" example:"proposed"` // Статус цикла
+
+	Pagination Pagination `json:"-"` // Пагинация
+}
+
+// Validate валидирует форму получения списка циклов обмена.
+func (f TradeCyclesGet) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
@@ -0,0 +1,132 @@
+package form
+
+import (
+	"gitlab.com/example/gophers/libs/validate"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// Роли пользователя в предложении обмена.
+const (
+	TradeOfferRoleIncoming = "incoming" // Предложения, адресованные пользователю
+	TradeOfferRoleOutgoing = "outgoing" // Предложения, созданные пользователем
+)
+
+// TradeOfferCreate форма создания предложения обмена.
+type TradeOfferCreate struct {
+	ProposerID          string     `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                                                    // Идентификатор автора. Передается в заголовке X-User-Id
+	OfferedListingIDs   []string   `json:"offeredListingIDs" validate:"required,min=1,max=10,unique,dive,mongodb" example:"655d8a3577a0a79c69a7cdfc"`   // Объявления автора
+	RequestedListingIDs []string   `json:"requestedListingIDs" validate:"required,min=1,max=10,unique,dive,mongodb" example:"655d8a3577a0a79c69a7cdfd"` // Объявления получателя
+	Message             string     `json:"message" validate:"omitempty,max=1000" example:"Меняю на ваш велосипед"`                                      // Сообщение получателю
+	Cash                *TradeCash `json:"cash" validate:"omitempty"`                                                                                   // Денежная доплата к обмену
+}
+
+// Validate валидирует форму создания предложения обмена.
+func (f *TradeOfferCreate) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	if err := validate.New(shortServiceName).Validate(f); err != nil {
+		return err
+	}
+
+	return f.Cash.validate()
+}
+
+// Fill заполняет сущность предложения обмена.
+func (f *TradeOfferCreate) Fill(offer *entity.TradeOffer, recipientID string) error {
+	if f == nil || offer == nil {
+		return entity.ErrNilPointer
+	}
+
+	offer.ProposerID = f.ProposerID
+	offer.RecipientID = recipientID
+	offer.OfferedListingIDs = f.OfferedListingIDs
+	offer.RequestedListingIDs = f.RequestedListingIDs
+	offer.Message = f.Message
+
+	if f.Cash != nil {
+		offer.Cash = &entity.TradeCash{Payer: f.Cash.Payer, Amount: f.Cash.Amount}
+	}
+
+	return nil
+}
+
+// TradeCash форма денежной доплаты к обмену.
+type TradeCash struct {
+	Payer  entity.TradeSide `json:"payer" validate:"required,oneof=proposer recipient" example:"proposer"` // Сторона, которая доплачивает
+	Amount entity.Money     `json:"amount" validate:"required"`                                            // Сумма доплаты
+}
+
+// validate проверяет сумму доплаты. Доплаты может не быть.
+func (f *TradeCash) validate() error {
+	if f == nil {
+		return nil
+	}
+
+	if f.Amount.Amount <= 0 {
+		return entity.ErrTradeOfferInvalidCash
+	}
+
+	return nil
+}
+
+// TradeOfferCounter форма встречного предложения обмена.
+type TradeOfferCounter struct {
+	OfferID             string     `json:"-" validate:"required,mongodb" example:"655d8a3577a0a79c69a7cdfc"`                                            // Идентификатор исходного предложения. Передается в пути запроса
+	UserID              string     `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                                                    // Идентификатор получателя исходного предложения. Передается в заголовке X-User-Id
+	OfferedListingIDs   []string   `json:"offeredListingIDs" validate:"required,min=1,max=10,unique,dive,mongodb" example:"655d8a3577a0a79c69a7cdfd"`   // Объявления, которые получатель готов отдать
+	RequestedListingIDs []string   `json:"requestedListingIDs" validate:"required,min=1,max=10,unique,dive,mongodb" example:"655d8a3577a0a79c69a7cdfe"` // Объявления автора исходного предложения
+	Message             string     `json:"message" validate:"omitempty,max=1000" example:"Могу предложить другое"`                                      // Сообщение автору исходного предложения
+	Cash                *TradeCash `json:"cash" validate:"omitempty"`                                                                                   // Денежная доплата к встречному обмену
+}
+
+// Validate валидирует форму встречного предложения обмена.
+func (f *TradeOfferCounter) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	if err := validate.New(shortServiceName).Validate(f); err != nil {
+		return err
+	}
+
+	return f.Cash.validate()
+}
+
+// ToTradeOfferCreate возвращает форму создания встречного предложения.
+func (f *TradeOfferCounter) ToTradeOfferCreate() TradeOfferCreate {
+	return TradeOfferCreate{
+		ProposerID:          f.UserID,
+		OfferedListingIDs:   f.OfferedListingIDs,
+		RequestedListingIDs: f.RequestedListingIDs,
+		Message:             f.Message,
+		Cash:                f.Cash,
+	}
+}
+
+// TradeOfferAction форма действия над предложением обмена: принять, отклонить или отозвать.
+type TradeOfferAction struct {
+	OfferID string `json:"-" validate:"required,mongodb" example:"655d8a3577a0a79c69a7cdfc"` // Идентификатор предложения. Передается в пути запроса
+	UserID  string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`         // Идентификатор пользователя. Передается в заголовке X-User-Id
+}
+
+// Validate валидирует форму действия над предложением обмена.
+func (f TradeOfferAction) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// TradeOffersGet форма получения списка предложений обмена пользователя.
+type TradeOffersGet struct {
+	UserID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                                  // Идентификатор пользователя. Передается в заголовке X-User-Id
+	Role   string `json:"role" validate:"omitempty,oneof=incoming outgoing" example:"incoming"`                      // Роль пользователя в предложении
+	Status string `json:"status" validate:"omitempty,oneof=pending accepted rejected countered cancelled completed"` // Статус предложения
+
+	Pagination Pagination `json:"-"` // Пагинация
+}
+
+// Validate валидирует форму получения списка предложений обмена.
+func (f TradeOffersGet) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
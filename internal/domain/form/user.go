@@ -13,8 +13,10 @@ const shortServiceName = "TMP" // Короткое название сервис
 
 // UserCreate форма создания пользователя.
 type UserCreate struct {
-	Name string `json:"name" validate:"required,min=3,max=255"` // Имя пользователя
-	Bio  string `json:"bio" validate:"omitempty,min=3,max=500"` // Биография пользователя
+	Name     string           `json:"name" validate:"required,min=3,max=255"`             // Имя пользователя
+	Bio      string           `json:"bio" validate:"omitempty,min=3,max=500"`             // Биография пользователя
+	City     string           `json:"city" validate:"omitempty,max=100" example:"Алматы"` // Город
+	Location *entity.GeoPoint `json:"location" validate:"omitempty"`                      // Местоположение
 }
 
 // Validate валидирует форму.
@@ -34,6 +36,8 @@ func (c *UserCreate) Fill(user *entity.User) error {
 
 	user.Name = c.Name
 	user.Bio = c.Bio
+	user.City = c.City
+	user.Location = c.Location
 
 	return nil
 }
@@ -69,6 +73,9 @@ type UserUpdate struct {
 	ID   string  `json:"id" validate:"required" example:"655d8a4d3afea534e56b570e"`   // Идентификатор пользователя
 	Name *string `json:"name" validate:"omitempty,min=3,max=255" example:"John"`      // Имя пользователя
 	Bio  *string `json:"bio" validate:"omitempty,min=3,max=500" example:"Programmer"` // Биография пользователя
+	City *string `json:"city" validate:"omitempty,max=100" example:"Алматы"`          // Город
+
+	Location *entity.GeoPoint `json:"location" validate:"omitempty"` // Местоположение
 }
 
 // Validate валидирует форму.
@@ -90,6 +97,14 @@ func (u UserUpdate) Fill(user *entity.User, currentTime time.Time) error {
 		user.Bio = *u.Bio
 	}
 
+	if u.City != nil {
+		user.City = *u.City
+	}
+
+	if u.Location != nil {
+		user.Location = u.Location
+	}
+
 	user.UpdatedAt = currentTime
 
 	return nil
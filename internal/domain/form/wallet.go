@@ -0,0 +1,63 @@
+package form
+
+import (
+	"gitlab.com/example/gophers/libs/validate"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// WalletGet форма получения кошелька пользователя.
+type WalletGet struct {
+	UserID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"` // Идентификатор пользователя. Передается в заголовке X-User-Id
+}
+
+// Validate валидирует форму получения кошелька.
+func (f WalletGet) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// WalletStatementGet форма получения выписки по кошельку пользователя.
+type WalletStatementGet struct {
+	UserID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"` // Идентификатор пользователя. Передается в заголовке X-User-Id
+
+	Pagination Pagination `json:"-"` // Пагинация
+}
+
+// Validate валидирует форму получения выписки.
+func (f WalletStatementGet) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// WalletTransfer форма перевода кредитов другому пользователю.
+type WalletTransfer struct {
+	FromUserID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                   // Идентификатор отправителя. Передается в заголовке X-User-Id
+	ToUserID   string `json:"toUserID" validate:"required,mongodb" example:"655d8a4d3afea534e56b570f"`    // Идентификатор получателя
+	Amount     int64  `json:"amount" validate:"required,gt=0" example:"500"`                              // Сумма перевода в кредитах
+	Comment    string `json:"comment" validate:"omitempty,max=500" example:"Доплата за обмен велосипеда"` // Комментарий
+}
+
+// Validate валидирует форму перевода кредитов.
+func (f *WalletTransfer) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// WalletGrant форма начисления кредитов администратором. Отрицательная сумма списывает кредиты.
+type WalletGrant struct {
+	RequesterID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                     // Идентификатор администратора. Передается в заголовке X-User-Id
+	UserID      string `json:"userID" validate:"required,mongodb" example:"655d8a4d3afea534e56b570f"`        // Идентификатор пользователя
+	Amount      int64  `json:"amount" validate:"required,ne=0" example:"1000"`                               // Сумма начисления в кредитах
+	Comment     string `json:"comment" validate:"required,max=500" example:"Компенсация за сорванный обмен"` // Причина начисления
+}
+
+// Validate валидирует форму начисления кредитов.
+func (f *WalletGrant) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	return validate.New(shortServiceName).Validate(f)
+}
@@ -0,0 +1,81 @@
+package form
+
+import (
+	"gitlab.com/example/gophers/libs/validate"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// WishlistItemCreate форма добавления позиции в список желаний.
+type WishlistItemCreate struct {
+	UserID      string                    `json:"-" validate:"required,mongodb" example:"655d8a4d3afea534e56b570e"`                                             // Идентификатор владельца списка. Передается в пути запроса
+	RequesterID string                    `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`                                                     // Идентификатор автора запроса. Передается в заголовке X-User-Id
+	Query       string                    `json:"query" validate:"required,min=3,max=200" example:"велосипед горный"`                                           // Что пользователь ищет
+	Category    string                    `json:"category" validate:"omitempty,oneof=electronics clothing home books sports kids hobby other" example:"sports"` // Категория
+	Conditions  []entity.ListingCondition `json:"conditions" validate:"omitempty,max=5,unique,dive,oneof=new like_new good fair poor" example:"good"`           // Допустимые состояния предмета
+	Location    *entity.GeoPoint          `json:"location" validate:"omitempty"`                                                                                // Точка поиска
+	RadiusKm    float64                   `json:"radiusKm" validate:"required_with=Location,omitempty,gt=0,max=500" example:"10"`                               // Радиус поиска вокруг точки в километрах
+}
+
+// Validate валидирует форму добавления позиции в список желаний.
+func (f *WishlistItemCreate) Validate() error {
+	if f == nil {
+		return entity.ErrNilPointer
+	}
+
+	if err := validate.New(shortServiceName).Validate(f); err != nil {
+		return err
+	}
+
+	// Запрос из одних коротких слов совпадал бы с любым объявлением.
+	if len(entity.Keywords(f.Query)) == 0 {
+		return entity.ErrWishlistQueryEmpty
+	}
+
+	return nil
+}
+
+// Fill заполняет позицию списка желаний.
+func (f *WishlistItemCreate) Fill(item *entity.WishlistItem) error {
+	if f == nil || item == nil {
+		return entity.ErrNilPointer
+	}
+
+	item.UserID = f.UserID
+	item.Query = f.Query
+	item.Keywords = entity.Keywords(f.Query)
+	item.Category = f.Category
+	item.Conditions = f.Conditions
+	item.Location = f.Location
+
+	if f.Location != nil {
+		item.RadiusKm = f.RadiusKm
+	}
+
+	return nil
+}
+
+// WishlistItemDelete форма удаления позиции из списка желаний.
+type WishlistItemDelete struct {
+	UserID      string `json:"-" validate:"required,mongodb" example:"655d8a4d3afea534e56b570e"` // Идентификатор владельца списка. Передается в пути запроса
+	RequesterID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`         // Идентификатор автора запроса. Передается в заголовке X-User-Id
+	ItemID      string `json:"-" validate:"required,mongodb" example:"655d8a3577a0a79c69a7cdfc"` // Идентификатор позиции. Передается в пути запроса
+}
+
+// Validate валидирует форму удаления позиции из списка желаний.
+func (f WishlistItemDelete) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
+
+// WishlistGet форма получения списка желаний пользователя.
+type WishlistGet struct {
+	UserID      string `json:"-" validate:"required,mongodb" example:"655d8a4d3afea534e56b570e"` // Идентификатор владельца списка. Передается в пути запроса
+	RequesterID string `json:"-" validate:"required" example:"655d8a4d3afea534e56b570e"`         // Идентификатор автора запроса. Передается в заголовке X-User-Id
+
+	Pagination Pagination `json:"-"` // Пагинация
+}
+
+// Validate валидирует форму получения списка желаний.
+func (f WishlistGet) Validate() error {
+	return validate.New(shortServiceName).Validate(f)
+}
@@ -0,0 +1,147 @@
+package presenter
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// UserColumns колонки CSV экспорта пользователей. Колонки name, bio, city, lat и lon читает импорт.
+var UserColumns = []string{"id", "name", "bio", "city", "lat", "lon", "moderation", "createdAt"}
+
+// CSVRecord возвращает пользователя в порядке колонок UserColumns.
+func (u User) CSVRecord() []string {
+	var lat, lon string
+	if u.Location != nil {
+		lat = strconv.FormatFloat(u.Location.Lat, 'f', -1, 64)
+		lon = strconv.FormatFloat(u.Location.Lon, 'f', -1, 64)
+	}
+
+	return []string{
+		u.ID, u.Name, u.Bio, u.City, lat, lon, string(u.Moderation), u.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// OrderColumns колонки CSV экспорта заказов. Колонки userID, counterpartyID, amount и currency читает импорт.
+var OrderColumns = []string{"id", "userID", "counterpartyID", "amount", "currency", "status", "createdAt"}
+
+// CSVRecord возвращает заказ в порядке колонок OrderColumns.
+func (o Order) CSVRecord() []string {
+	return []string{
+		o.ID, o.UserID, o.CounterpartyID, strconv.FormatInt(o.Cost.Amount, 10), string(o.Cost.Currency),
+		string(o.Status), o.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// BulkRecord запись экспорта. В NDJSON запись пишется как JSON, в CSV - значениями колонок.
+type BulkRecord interface {
+	// CSVRecord возвращает значения в порядке колонок заголовка.
+	CSVRecord() []string
+}
+
+// BulkEncoder пишет записи экспорта в формате CSV или NDJSON по одной, не собирая выгрузку в памяти.
+type BulkEncoder struct {
+	csv  *csv.Writer   // Запись CSV
+	json *json.Encoder // Запись NDJSON
+}
+
+// NewBulkEncoder создает запись экспорта. Для CSV сразу пишется заголовок из columns.
+func NewBulkEncoder(w io.Writer, format entity.BulkFormat, columns []string) (*BulkEncoder, error) {
+	switch format {
+	case entity.BulkFormatCSV:
+		encoder := &BulkEncoder{csv: csv.NewWriter(w)}
+		if err := encoder.csv.Write(columns); err != nil {
+			return nil, fmt.Errorf("запись заголовка: %w", err)
+		}
+
+		return encoder, nil
+	case entity.BulkFormatNDJSON:
+		return &BulkEncoder{json: json.NewEncoder(w)}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", entity.ErrBulkFormat, format)
+	}
+}
+
+// Encode пишет запись. Записи CSV буферизуются до вызова Flush.
+func (e *BulkEncoder) Encode(record BulkRecord) error {
+	if e.csv != nil {
+		return e.csv.Write(record.CSVRecord())
+	}
+
+	return e.json.Encode(record)
+}
+
+// Flush отправляет буферизованные записи.
+func (e *BulkEncoder) Flush() error {
+	if e.csv == nil {
+		return nil
+	}
+
+	e.csv.Flush()
+
+	return e.csv.Error()
+}
+
+// ImportReport строка отчета об импорте в NDJSON: результат строки файла, итог запуска
+// в последней строке отчета или ошибка, прервавшая импорт.
+type ImportReport struct {
+	Result *ImportRow      `json:"result,omitempty"` // Результат строки файла
+	Run    *ImportRun      `json:"run,omitempty"`    // Итог запуска
+	Errors []EnvelopeError `json:"errors,omitempty"` // Ошибка, прервавшая импорт
+}
+
+// ImportRow результат импорта строки файла.
+type ImportRow struct {
+	Row    int64                  `json:"row" example:"3"`                                 // Номер строки данных, начиная с 1
+	Status entity.ImportRowStatus `json:"status" example:"created"`                        // Результат
+	ID     string                 `json:"id,omitempty" example:"655d8a4d3afea534e56b570e"` // Идентификатор созданной сущности
+	Errors []EnvelopeError        `json:"errors,omitempty"`                                // Ошибки строки
+}
+
+// NewImportRow возвращает результат строки с уже переведенными ошибками.
+func NewImportRow(result entity.ImportRowResult, errs []EnvelopeError) ImportRow {
+	return ImportRow{
+		Row:    result.Row,
+		Status: result.Status,
+		ID:     result.ID,
+		Errors: errs,
+	}
+}
+
+// ImportRun итог запуска импорта. Прерванный запуск продолжается повторной отправкой того же файла
+// с идентификатором запуска.
+type ImportRun struct {
+	ID         string                 `json:"id" example:"655d8a4d3afea534e56b570f"` // Идентификатор запуска
+	Kind       entity.BulkKind        `json:"kind" example:"users"`                  // Вид импортируемых сущностей
+	Checkpoint int64                  `json:"checkpoint" example:"1500"`             // Номер последней обработанной строки
+	Created    int64                  `json:"created" example:"1480"`                // Количество созданных сущностей
+	Existed    int64                  `json:"existed" example:"0"`                   // Количество строк, сохраненных прерванной попыткой
+	Failed     int64                  `json:"failed" example:"20"`                   // Количество строк с ошибками
+	Status     entity.ImportRunStatus `json:"status" example:"completed"`            // Статус запуска
+	CreatedAt  time.Time              `json:"createdAt"`                             // Дата первой попытки
+	UpdatedAt  time.Time              `json:"updatedAt"`                             // Дата сохранения последней пачки
+}
+
+// NewImportRun возвращает итог запуска импорта.
+func NewImportRun(run *entity.ImportRun) *ImportRun {
+	if run == nil {
+		return nil
+	}
+
+	return &ImportRun{
+		ID:         run.ID,
+		Kind:       run.Kind,
+		Checkpoint: run.Checkpoint,
+		Created:    run.Created,
+		Existed:    run.Existed,
+		Failed:     run.Failed,
+		Status:     run.Status,
+		CreatedAt:  run.CreatedAt,
+		UpdatedAt:  run.UpdatedAt,
+	}
+}
@@ -0,0 +1,48 @@
+package presenter
+
+// Envelope ответ API v2. Успешный ответ содержит data, список - еще и meta, ответ с ошибкой - errors.
+type Envelope struct {
+	Data   interface{}     `json:"data"`             // Данные ответа, null в ответе с ошибкой
+	Meta   *Meta           `json:"meta,omitempty"`   // Состояние пагинации списка
+	Errors []EnvelopeError `json:"errors,omitempty"` // Ошибки запроса
+}
+
+// Meta состояние пагинации списка.
+type Meta struct {
+	Count int64  `json:"count" example:"42"`                 // Общее количество элементов
+	Page  uint64 `json:"page,omitempty" example:"1"`         // Номер страницы
+	Limit uint64 `json:"limit,omitempty" example:"10"`       // Количество элементов на странице
+	State string `json:"state,omitempty" example:"AAAAAQ=="` // Состояние следующей страницы для курсорной пагинации
+}
+
+// EnvelopeError ошибка в ответе API v2.
+type EnvelopeError struct {
+	Code    string `json:"code" example:"TMP_USER_NOT_FOUND"`        // Код ошибки
+	Message string `json:"message" example:"Пользователь не найден"` // Сообщение на языке запроса
+	Field   string `json:"field,omitempty" example:"name"`           // Поле формы, к которому относится ошибка
+}
+
+// Resource ссылка на созданный или удаленный ресурс.
+type Resource struct {
+	ID string `json:"id" example:"655d8a3577a0a79c69a7cdfc"` // Идентификатор ресурса
+}
+
+// NewData возвращает ответ с данными.
+func NewData(data interface{}) Envelope {
+	return Envelope{Data: data}
+}
+
+// NewList возвращает ответ со списком и состоянием пагинации.
+func NewList(items interface{}, meta Meta) Envelope {
+	return Envelope{Data: items, Meta: &meta}
+}
+
+// NewErrors возвращает ответ с ошибками.
+func NewErrors(errs ...EnvelopeError) Envelope {
+	return Envelope{Errors: errs}
+}
+
+// NewResource возвращает ссылку на ресурс.
+func NewResource(id string) Resource {
+	return Resource{ID: id}
+}
@@ -0,0 +1,73 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// CreatedListing информация о созданном объявлении.
+type CreatedListing struct {
+	ID string `json:"id" example:"655d8a3577a0a79c69a7cdfc"` // Идентификатор объявления
+}
+
+// NewCreatedListing возвращает информацию о созданном объявлении.
+func NewCreatedListing(id string) CreatedListing {
+	return CreatedListing{ID: id}
+}
+
+// Listing объявление для обмена.
+type Listing struct {
+	ID          string                  `json:"id" example:"655d8a3577a0a79c69a7cdfc"`      // Идентификатор объявления
+	OwnerID     string                  `json:"ownerID" example:"655d8a4d3afea534e56b570e"` // Идентификатор владельца
+	Title       string                  `json:"title" example:"Велосипед"`                  // Заголовок
+	Description string                  `json:"description"`                                // Описание
+	Category    string                  `json:"category" example:"sport"`                   // Категория
+	Condition   entity.ListingCondition `json:"condition" example:"good"`                   // Состояние предмета
+	Photos      []string                `json:"photos"`                                     // Ссылки на фотографии
+	DesiredTags []string                `json:"desiredTags"`                                // Что владелец хочет получить взамен
+	Location    *entity.GeoPoint        `json:"location,omitempty"`                         // Местоположение предмета
+	City        string                  `json:"city,omitempty" example:"Алматы"`            // Город
+	Valuation   *entity.Money           `json:"valuation,omitempty"`                        // Оценка стоимости предмета владельцем
+	DistanceKm  *float64                `json:"distanceKm,omitempty" example:"2.5"`         // Расстояние до точки поиска в километрах
+	Status      entity.ListingStatus    `json:"status" example:"active"`                    // Статус объявления
+	Moderation  entity.ModerationStatus `json:"moderation,omitempty" example:"approved"`    // Статус модерации текста
+	UpdatedAt   time.Time               `json:"updatedAt"`                                  // Дата обновления
+	CreatedAt   time.Time               `json:"createdAt"`                                  // Дата создания
+}
+
+// NewListing возвращает объявление.
+func NewListing(listing *entity.Listing) Listing {
+	if listing == nil {
+		return Listing{}
+	}
+
+	return Listing{
+		ID:          listing.ID,
+		OwnerID:     listing.OwnerID,
+		Title:       listing.Title,
+		Description: listing.Description,
+		Category:    listing.Category,
+		Condition:   listing.Condition,
+		Photos:      listing.Photos,
+		DesiredTags: listing.DesiredTags,
+		Location:    listing.Location,
+		City:        listing.City,
+		Valuation:   listing.Valuation,
+		DistanceKm:  listing.DistanceKm,
+		Status:      listing.Status,
+		Moderation:  listing.Moderation,
+		UpdatedAt:   listing.UpdatedAt,
+		CreatedAt:   listing.CreatedAt,
+	}
+}
+
+// NewListings возвращает список объявлений.
+func NewListings(listings entity.Listings) []Listing {
+	result := make([]Listing, 0, len(listings))
+	for _, listing := range listings {
+		result = append(result, NewListing(listing))
+	}
+
+	return result
+}
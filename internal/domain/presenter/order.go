@@ -1,12 +1,16 @@
 package presenter
 
-import "github.com/alisher-99/LomBarter/internal/domain/entity"
+import (
+	"time"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
 
 // CreatedOrder информация о созданном заказе.
 type CreatedOrder struct {
-	ID     string `json:"id" example:"655d8a3577a0a79c69a7cdfc"`     // Идентификатор заказа
-	UserID string `json:"userID" example:"655d8a4d3afea534e56b570e"` // Идентификатор пользователя
-	Cost   int    `json:"cost" example:"39900"`                      // Стоимость заказа
+	ID     string       `json:"id" example:"655d8a3577a0a79c69a7cdfc"`     // Идентификатор заказа
+	UserID string       `json:"userID" example:"655d8a4d3afea534e56b570e"` // Идентификатор пользователя
+	Cost   entity.Money `json:"cost"`                                      // Стоимость заказа
 }
 
 // NewCreatedOrder создает информацию о созданном заказе.
@@ -21,3 +25,95 @@ func NewCreatedOrder(order *entity.Order) CreatedOrder {
 		Cost:   order.Cost,
 	}
 }
+
+// Order заказ.
+type Order struct {
+	ID             string              `json:"id" example:"655d8a3577a0a79c69a7cdfc"`     // Идентификатор заказа
+	UserID         string              `json:"userID" example:"655d8a4d3afea534e56b570e"` // Идентификатор пользователя
+	CounterpartyID string              `json:"counterpartyID,omitempty"`                  // Идентификатор второй стороны сделки
+	Cost           entity.Money        `json:"cost"`                                      // Стоимость заказа
+	Status         entity.OrderStatus  `json:"status" example:"created"`                  // Статус заказа
+	History        []OrderStatusChange `json:"history,omitempty"`                         // История изменения статуса, если запрошена
+	TradeOfferID   string              `json:"tradeOfferID,omitempty"`                    // Предложение обмена, по которому создан заказ
+	Legs           []OrderLeg          `json:"legs,omitempty"`                            // Части сделки обмена
+	CreatedAt      time.Time           `json:"createdAt"`                                 // Дата создания заказа
+}
+
+// OrderLeg часть сделки обмена.
+type OrderLeg struct {
+	Kind       entity.OrderLegKind `json:"kind" example:"goods"` // Тип части сделки
+	FromUserID string              `json:"fromUserID"`           // Кто отдает
+	ToUserID   string              `json:"toUserID"`             // Кто получает
+	ListingID  string              `json:"listingID,omitempty"`  // Объявление передаваемого предмета
+	Title      string              `json:"title,omitempty"`      // Заголовок объявления на момент сделки
+	Amount     *entity.Money       `json:"amount,omitempty"`     // Оценка предмета или сумма доплаты
+}
+
+// OrderStatusChange изменение статуса заказа.
+type OrderStatusChange struct {
+	ActorID   string             `json:"actorID"`          // Идентификатор пользователя, изменившего статус
+	From      entity.OrderStatus `json:"from"`             // Предыдущий статус
+	To        entity.OrderStatus `json:"to"`               // Новый статус
+	Reason    string             `json:"reason,omitempty"` // Причина изменения
+	CreatedAt time.Time          `json:"createdAt"`        // Дата изменения
+}
+
+// NewOrder возвращает заказ.
+func NewOrder(order *entity.Order) Order {
+	if order == nil {
+		return Order{}
+	}
+
+	result := Order{
+		ID:             order.ID,
+		UserID:         order.UserID,
+		CounterpartyID: order.CounterpartyID,
+		Cost:           order.Cost,
+		Status:         order.Status,
+		TradeOfferID:   order.TradeOfferID,
+		CreatedAt:      order.CreatedAt,
+	}
+
+	if len(order.History) > 0 {
+		result.History = NewOrderHistory(order.History)
+	}
+
+	for _, leg := range order.Legs {
+		result.Legs = append(result.Legs, OrderLeg{
+			Kind:       leg.Kind,
+			FromUserID: leg.FromUserID,
+			ToUserID:   leg.ToUserID,
+			ListingID:  leg.ListingID,
+			Title:      leg.Title,
+			Amount:     leg.Amount,
+		})
+	}
+
+	return result
+}
+
+// NewOrders возвращает список заказов.
+func NewOrders(orders entity.Orders) []Order {
+	result := make([]Order, 0, len(orders))
+	for _, order := range orders {
+		result = append(result, NewOrder(order))
+	}
+
+	return result
+}
+
+// NewOrderHistory возвращает историю изменения статуса заказа.
+func NewOrderHistory(history entity.OrderHistory) []OrderStatusChange {
+	result := make([]OrderStatusChange, 0, len(history))
+	for _, change := range history {
+		result = append(result, OrderStatusChange{
+			ActorID:   change.ActorID,
+			From:      change.From,
+			To:        change.To,
+			Reason:    change.Reason,
+			CreatedAt: change.CreatedAt,
+		})
+	}
+
+	return result
+}
@@ -1,5 +1,11 @@
 package presenter
 
+import (
+	"time"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
 // CreatedUser информация о созданном пользователе.
 type CreatedUser struct {
 	ID string `json:"id" example:"5f8b9b1b3afea534e56b570e"` // Идентификатор пользователя
@@ -9,3 +15,62 @@ type CreatedUser struct {
 func NewCreatedUser(id string) CreatedUser {
 	return CreatedUser{ID: id}
 }
+
+// User пользователь.
+type User struct {
+	ID         string                  `json:"id" example:"5f8b9b1b3afea534e56b570e"`   // Идентификатор пользователя
+	Name       string                  `json:"name" example:"Алиса"`                    // Имя пользователя
+	Bio        string                  `json:"bio" example:"Меняю книги"`               // Биография, пустая если отклонена модератором
+	Reputation Reputation              `json:"reputation"`                              // Репутация по оценкам после сделок
+	City       string                  `json:"city,omitempty" example:"Алматы"`         // Город
+	Location   *entity.GeoPoint        `json:"location,omitempty"`                      // Местоположение
+	AvatarID   string                  `json:"avatarID,omitempty"`                      // Идентификатор медиафайла аватара
+	Moderation entity.ModerationStatus `json:"moderation,omitempty" example:"approved"` // Статус модерации биографии
+	UpdatedAt  time.Time               `json:"updatedAt"`                               // Дата обновления
+	CreatedAt  time.Time               `json:"createdAt"`                               // Дата создания
+}
+
+// Reputation репутация пользователя.
+type Reputation struct {
+	Score     float64 `json:"score" example:"4.7"`   // Взвешенная средняя оценка
+	Count     int64   `json:"count" example:"12"`    // Количество оценок
+	Penalties int64   `json:"penalties" example:"0"` // Сумма штрафов по решениям споров
+	Trend     float64 `json:"trend" example:"0.1"`   // Разница между недавней и общей средней оценкой
+}
+
+// NewUser возвращает пользователя для публичного ответа: отклоненная модератором биография скрыта.
+func NewUser(user *entity.User) User {
+	if user == nil {
+		return User{}
+	}
+
+	user = user.Public()
+
+	return User{
+		ID:   user.ID,
+		Name: user.Name,
+		Bio:  user.Bio,
+		Reputation: Reputation{
+			Score:     user.Reputation.Score,
+			Count:     user.Reputation.Count,
+			Penalties: user.Reputation.Penalties,
+			Trend:     user.Reputation.Trend,
+		},
+		City:       user.City,
+		Location:   user.Location,
+		AvatarID:   user.AvatarID,
+		Moderation: user.Moderation,
+		UpdatedAt:  user.UpdatedAt,
+		CreatedAt:  user.CreatedAt,
+	}
+}
+
+// NewUsers возвращает список пользователей.
+func NewUsers(users entity.Users) []User {
+	result := make([]User, 0, len(users))
+	for i := range users {
+		result = append(result, NewUser(&users[i]))
+	}
+
+	return result
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// BlobStore представляет интерфейс хранилища файлов. Файлы адресуются ключами вида media/<токен>/<имя>.
+type BlobStore interface {
+	// Put сохраняет файл размером size байт. Существующий файл с тем же ключом перезаписывается.
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	// Get возвращает файл. Если файла нет, возвращает entity.ErrBlobNotFound.
+	Get(ctx context.Context, key string) (*entity.Blob, error)
+	// Delete удаляет файл. Отсутствующий файл не считается ошибкой.
+	Delete(ctx context.Context, key string) error
+	// SignedURL возвращает ссылку на скачивание файла, которая действует ttl.
+	SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error)
+}
@@ -10,6 +10,8 @@ import (
 type CacheStore interface {
 	// UserCache возвращает репозиторий пользователей.
 	UserCache() UserCache
+	// NotificationCache возвращает кэш счетчиков уведомлений.
+	NotificationCache() NotificationCache
 }
 
 // UserCache представляет интерфейс для работы с кэшем пользователей.
@@ -19,3 +21,14 @@ type UserCache interface {
 	// SetUser сохраняет пользователя.
 	SetUser(ctx context.Context, user *entity.User) error
 }
+
+// NotificationCache представляет интерфейс для работы с кэшем счетчиков непрочитанных уведомлений.
+type NotificationCache interface {
+	// GetUnreadCount возвращает количество непрочитанных уведомлений пользователя.
+	// Если счетчика нет в кэше, возвращает entity.ErrNotificationCountNotCached.
+	GetUnreadCount(ctx context.Context, userID string) (int64, error)
+	// SetUnreadCount сохраняет количество непрочитанных уведомлений пользователя.
+	SetUnreadCount(ctx context.Context, userID string, count int64) error
+	// DeleteUnreadCount сбрасывает счетчик пользователя после изменения входящих.
+	DeleteUnreadCount(ctx context.Context, userID string) error
+}
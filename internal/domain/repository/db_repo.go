@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/alisher-99/LomBarter/internal/domain/entity"
 	"github.com/alisher-99/LomBarter/internal/domain/form"
@@ -9,7 +10,7 @@ import (
 
 type DataStore interface {
 	// TxStarter интерфейс для работы с транзакциями
-	// TxStarter
+	TxStarter
 
 	// Base базовый интерфейс для работы с DataStore
 	Base
@@ -17,6 +18,36 @@ type DataStore interface {
 	UserRepository() UserRepository
 	// OrdersRepository возвращает репозиторий заказов.
 	OrdersRepository() OrdersRepository
+	// ListingRepository возвращает репозиторий объявлений.
+	ListingRepository() ListingRepository
+	// TradeOfferRepository возвращает репозиторий предложений обмена.
+	TradeOfferRepository() TradeOfferRepository
+	// TradeCycleRepository возвращает репозиторий циклов обмена.
+	TradeCycleRepository() TradeCycleRepository
+	// WishlistRepository возвращает репозиторий списков желаний.
+	WishlistRepository() WishlistRepository
+	// RatingRepository возвращает репозиторий оценок.
+	RatingRepository() RatingRepository
+	// ConversationRepository возвращает репозиторий переписок.
+	ConversationRepository() ConversationRepository
+	// SearchRepository возвращает репозиторий полнотекстового поиска.
+	SearchRepository() SearchRepository
+	// MediaRepository возвращает репозиторий медиафайлов.
+	MediaRepository() MediaRepository
+	// NotificationRepository возвращает репозиторий уведомлений.
+	NotificationRepository() NotificationRepository
+	// LedgerRepository возвращает репозиторий счетов и проводок кредитов.
+	LedgerRepository() LedgerRepository
+	// PaymentRepository возвращает репозиторий платежей.
+	PaymentRepository() PaymentRepository
+	// DisputeRepository возвращает репозиторий споров.
+	DisputeRepository() DisputeRepository
+	// AuditRepository возвращает репозиторий журнала изменений.
+	AuditRepository() AuditRepository
+	// ModerationRepository возвращает репозиторий дел модерации.
+	ModerationRepository() ModerationRepository
+	// ImportRunRepository возвращает репозиторий запусков импорта.
+	ImportRunRepository() ImportRunRepository
 }
 
 // Base представляет базовый интерфейс для работы с DataStore.
@@ -41,6 +72,18 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, user *entity.User) (string, error)
 	// UpdateUser обновляет пользователя.
 	UpdateUser(ctx context.Context, user *entity.User) error
+	// UpdateUserReputation сохраняет репутацию пользователя.
+	UpdateUserReputation(ctx context.Context, userID string, reputation entity.Reputation) error
+	// SetUserAvatar сохраняет идентификатор медиафайла аватара пользователя. Пустой идентификатор убирает аватар.
+	SetUserAvatar(ctx context.Context, userID, mediaID string, currentTime time.Time) error
+	// UpdateUserModeration сохраняет статус модерации биографии и блокировку пользователя.
+	UpdateUserModeration(ctx context.Context, user *entity.User) error
+	// CreateUsers сохраняет пачку пользователей с заданными идентификаторами. Ошибка одного пользователя
+	// не мешает сохранению остальных и возвращается по его индексу, уже существующий пользователь
+	// возвращается как entity.ErrImportRowExists.
+	CreateUsers(ctx context.Context, users []*entity.User) (map[int]error, error)
+	// ExportUsers передает в fn пользователей по фильтру в порядке создания.
+	ExportUsers(ctx context.Context, filter form.UsersExport, fn func(*entity.User) error) error
 }
 
 // OrdersRepository представляет интерфейс для работы с репозиторием заказов.
@@ -51,6 +94,254 @@ type OrdersRepository interface {
 	GetOrdersForClient(ctx context.Context, filter form.OrdersGetForClient) (entity.Orders, error)
 	// GetOrderForClient возвращает заказ для клиента.
 	GetOrderForClient(ctx context.Context, filter form.OrderGetForClient) (*entity.Order, error)
+	// UpdateOrderStatus изменяет статус заказа и добавляет запись в историю.
+	UpdateOrderStatus(ctx context.Context, order *entity.Order, change entity.OrderStatusChange) error
+	// GetOrderHistory возвращает историю изменения статуса заказа.
+	GetOrderHistory(ctx context.Context, filter form.OrderGetForClient) (entity.OrderHistory, error)
+	// CreateOrders сохраняет пачку заказов с заданными идентификаторами. Ошибка одного заказа
+	// не мешает сохранению остальных и возвращается по его индексу, уже существующий заказ
+	// возвращается как entity.ErrImportRowExists.
+	CreateOrders(ctx context.Context, orders []*entity.Order) (map[int]error, error)
+	// ExportOrders передает в fn заказы по фильтру в порядке создания. История не выгружается.
+	ExportOrders(ctx context.Context, filter form.OrdersExport, fn func(*entity.Order) error) error
+}
+
+// ListingRepository представляет интерфейс для работы с репозиторием объявлений.
+type ListingRepository interface {
+	// CreateListing сохраняет объявление.
+	CreateListing(ctx context.Context, listing *entity.Listing) (string, error)
+	// GetListingByID возвращает объявление по идентификатору.
+	GetListingByID(ctx context.Context, id string) (*entity.Listing, error)
+	// GetListings возвращает список объявлений по фильтру и их общее количество.
+	GetListings(ctx context.Context, filter form.ListingsGet) (entity.Listings, int64, error)
+	// UpdateListing обновляет объявление, если его статус все еще from. Статус сохраняется, только если он изменился.
+	// Если объявление изменено или удалено параллельно, возвращает entity.ErrListingConflict.
+	UpdateListing(ctx context.Context, listing *entity.Listing, from entity.ListingStatus) error
+	// DeleteListing удаляет объявление владельца, если оно не участвует в сделке.
+	DeleteListing(ctx context.Context, filter form.ListingDelete) error
+	// GetListingsByIDs возвращает объявления по списку идентификаторов.
+	GetListingsByIDs(ctx context.Context, ids []string) (entity.Listings, error)
+	// UpdateListingsStatus переводит объявления из статуса from в статус to.
+	// Возвращает количество измененных объявлений.
+	UpdateListingsStatus(ctx context.Context, ids []string, from, to entity.ListingStatus, currentTime time.Time) (int64, error)
+	// GetListingsByDesiredTags возвращает активные объявления, владельцы которых хотят получить любой из тегов.
+	GetListingsByDesiredTags(ctx context.Context, tags []string, limit int64) (entity.Listings, error)
+	// UpdateListingModeration сохраняет статус модерации объявления.
+	UpdateListingModeration(ctx context.Context, listing *entity.Listing) error
+	// RejectOwnerListings скрывает все объявления владельца. Возвращает количество измененных объявлений.
+	RejectOwnerListings(ctx context.Context, ownerID string, currentTime time.Time) (int64, error)
+}
+
+// TradeOfferRepository представляет интерфейс для работы с репозиторием предложений обмена.
+type TradeOfferRepository interface {
+	// CreateTradeOffer сохраняет предложение обмена.
+	CreateTradeOffer(ctx context.Context, offer *entity.TradeOffer) error
+	// GetTradeOfferByID возвращает предложение обмена по идентификатору.
+	GetTradeOfferByID(ctx context.Context, id string) (*entity.TradeOffer, error)
+	// GetTradeOffers возвращает список предложений обмена пользователя и их общее количество.
+	GetTradeOffers(ctx context.Context, filter form.TradeOffersGet) (entity.TradeOffers, int64, error)
+	// UpdateTradeOfferStatus сохраняет новый статус предложения, если текущий статус равен from.
+	UpdateTradeOfferStatus(ctx context.Context, offer *entity.TradeOffer, from entity.TradeOfferStatus) error
+}
+
+// TradeCycleRepository представляет интерфейс для работы с репозиторием циклов обмена.
+type TradeCycleRepository interface {
+	// CreateTradeCycle сохраняет цикл обмена. Если такой цикл уже предложен, возвращает entity.ErrTradeCycleExists.
+	CreateTradeCycle(ctx context.Context, cycle *entity.TradeCycle) error
+	// GetTradeCycleByID возвращает цикл обмена по идентификатору.
+	GetTradeCycleByID(ctx context.Context, id string) (*entity.TradeCycle, error)
+	// GetTradeCycles возвращает список циклов обмена пользователя и их общее количество.
+	GetTradeCycles(ctx context.Context, filter form.TradeCyclesGet) (entity.TradeCycles, int64, error)
+	// UpdateTradeCycle сохраняет цикл обмена, если его версия не изменилась с момента чтения.
+	UpdateTradeCycle(ctx context.Context, cycle *entity.TradeCycle) error
+	// ExpireTradeCycles переводит предложенные циклы с объявлением в статус expired.
+	// Возвращает количество измененных циклов.
+	ExpireTradeCycles(ctx context.Context, listingID string, currentTime time.Time) (int64, error)
+}
+
+// WishlistRepository представляет интерфейс для работы с репозиторием списков желаний.
+type WishlistRepository interface {
+	// CreateWishlistItem сохраняет позицию списка желаний.
+	CreateWishlistItem(ctx context.Context, item *entity.WishlistItem) error
+	// CountWishlistItems возвращает количество позиций в списке желаний пользователя.
+	CountWishlistItems(ctx context.Context, userID string) (int64, error)
+	// GetWishlistItems возвращает список желаний пользователя и общее количество позиций.
+	GetWishlistItems(ctx context.Context, filter form.WishlistGet) (entity.WishlistItems, int64, error)
+	// DeleteWishlistItem удаляет позицию из списка желаний пользователя.
+	DeleteWishlistItem(ctx context.Context, userID, itemID string) error
+	// GetWishlistCandidates возвращает позиции чужих списков желаний, которые могут подойти под объявление.
+	// Местоположение не проверяется, окончательное решение принимает entity.WishlistItem.Matches.
+	GetWishlistCandidates(ctx context.Context, listing *entity.Listing, limit int64) (entity.WishlistItems, error)
+	// CreateWishlistMatch сохраняет совпадение. Если пользователь уже уведомлен об объявлении,
+	// возвращает entity.ErrWishlistMatchExists.
+	CreateWishlistMatch(ctx context.Context, match *entity.WishlistMatch) error
+}
+
+// RatingRepository представляет интерфейс для работы с репозиторием оценок.
+type RatingRepository interface {
+	// CreateRating сохраняет оценку. Если автор уже оценил заказ, возвращает entity.ErrRatingExists.
+	CreateRating(ctx context.Context, rating *entity.Rating) error
+	// GetRatings возвращает оценки, полученные пользователем, и их общее количество.
+	GetRatings(ctx context.Context, filter form.RatingsGet) (entity.Ratings, int64, error)
+}
+
+// ConversationRepository представляет интерфейс для работы с репозиторием переписок и сообщений.
+type ConversationRepository interface {
+	// CreateConversation сохраняет переписку. Если переписка по сделке уже есть, возвращает entity.ErrConversationExists.
+	CreateConversation(ctx context.Context, conversation *entity.Conversation) error
+	// GetConversationByID возвращает переписку по идентификатору.
+	GetConversationByID(ctx context.Context, id string) (*entity.Conversation, error)
+	// GetConversationBySubject возвращает переписку по сделке.
+	GetConversationBySubject(ctx context.Context, subjectType entity.ConversationSubject, subjectID string) (*entity.Conversation, error)
+	// GetConversations возвращает переписки пользователя и их общее количество.
+	GetConversations(ctx context.Context, filter form.ConversationsGet) (entity.Conversations, int64, error)
+	// MarkConversationRead отмечает переписку прочитанной участником на момент readAt.
+	MarkConversationRead(ctx context.Context, id, userID string, readAt time.Time) error
+	// CreateMessage сохраняет сообщение и обновляет дату последнего сообщения переписки.
+	CreateMessage(ctx context.Context, message *entity.Message) error
+	// GetMessages возвращает страницу сообщений, начиная с самых новых, и курсор следующей страницы.
+	// Если страниц больше нет, курсор пуст.
+	GetMessages(ctx context.Context, filter form.MessagesGet) (entity.Messages, []byte, error)
+	// CountMessagesSince возвращает количество сообщений отправителя в переписке начиная с since.
+	CountMessagesSince(ctx context.Context, conversationID, senderID string, since time.Time) (int64, error)
+}
+
+// SearchRepository представляет интерфейс полнотекстового поиска по пользователям и объявлениям.
+type SearchRepository interface {
+	// Search возвращает страницу документов по убыванию релевантности, их общее количество с учетом фильтров
+	// и фасеты. Фасеты считаются по всем найденным документам без учета фильтров по типу и категории.
+	// Ищутся только доступные для обмена объявления.
+	Search(ctx context.Context, filter form.Search) (entity.SearchResult, error)
+}
+
+// MediaRepository представляет интерфейс для работы с репозиторием медиафайлов.
+type MediaRepository interface {
+	// CreateMedia сохраняет медиафайл.
+	CreateMedia(ctx context.Context, media *entity.Media) (string, error)
+	// GetMediaByID возвращает медиафайл по идентификатору.
+	GetMediaByID(ctx context.Context, id string) (*entity.Media, error)
+	// GetMediaBySubject возвращает медиафайлы, прикрепленные к сущности.
+	GetMediaBySubject(ctx context.Context, subjectType entity.MediaSubject, subjectID string) (entity.MediaList, error)
+	// AttachMedia прикрепляет медиафайл к сущности. Файл, прикрепленный к другой сущности, не меняется
+	// и возвращается ошибка entity.ErrMediaAttached.
+	AttachMedia(ctx context.Context, id string, subjectType entity.MediaSubject, subjectID string) error
+	// DeleteMedia удаляет медиафайл. Отсутствующий файл не считается ошибкой.
+	DeleteMedia(ctx context.Context, id string) error
+}
+
+// NotificationRepository представляет интерфейс для работы с репозиторием настроек уведомлений,
+// устройств пользователей и входящих уведомлений.
+type NotificationRepository interface {
+	// GetNotificationPreferences возвращает настройки уведомлений пользователя.
+	// Если пользователь их не сохранял, возвращает entity.ErrNotificationPreferencesNotFound.
+	GetNotificationPreferences(ctx context.Context, userID string) (*entity.NotificationPreferences, error)
+	// SaveNotificationPreferences сохраняет настройки уведомлений пользователя целиком.
+	SaveNotificationPreferences(ctx context.Context, preferences *entity.NotificationPreferences) error
+	// SaveDeviceToken сохраняет токен устройства. Токен другого пользователя переходит к новому владельцу.
+	SaveDeviceToken(ctx context.Context, device *entity.DeviceToken) error
+	// GetDeviceTokens возвращает устройства пользователя.
+	GetDeviceTokens(ctx context.Context, userID string) (entity.DeviceTokens, error)
+	// DeleteDeviceToken удаляет устройство пользователя.
+	DeleteDeviceToken(ctx context.Context, userID, token string) error
+	// CreateInboxNotification сохраняет уведомление во входящих пользователя.
+	CreateInboxNotification(ctx context.Context, notification *entity.InboxNotification) error
+	// GetInboxNotifications возвращает входящие пользователя от новых к старым и их общее количество.
+	GetInboxNotifications(ctx context.Context, filter form.InboxGet) (entity.InboxNotifications, int64, error)
+	// MarkInboxNotificationRead отмечает уведомление пользователя прочитанным.
+	// Если уведомления нет во входящих пользователя, возвращает entity.ErrInboxNotificationNotFound.
+	MarkInboxNotificationRead(ctx context.Context, userID, id string, readAt time.Time) error
+	// MarkAllInboxNotificationsRead отмечает все уведомления пользователя прочитанными и возвращает их количество.
+	MarkAllInboxNotificationsRead(ctx context.Context, userID string, readAt time.Time) (int64, error)
+	// CountUnreadInboxNotifications возвращает количество непрочитанных уведомлений пользователя.
+	CountUnreadInboxNotifications(ctx context.Context, userID string) (int64, error)
+}
+
+// LedgerRepository представляет интерфейс для работы со счетами и проводками кредитов.
+type LedgerRepository interface {
+	// GetLedgerAccounts возвращает найденные счета по идентификаторам. Отсутствующие счета пропускаются.
+	GetLedgerAccounts(ctx context.Context, ids []string) (entity.LedgerAccounts, error)
+	// SaveLedgerAccount сохраняет баланс счета, если с предыдущей версии его никто не менял.
+	// Иначе возвращает entity.ErrLedgerConflict.
+	SaveLedgerAccount(ctx context.Context, account *entity.LedgerAccount) error
+	// CreateLedgerTransaction сохраняет проводки транзакции и заполняет их идентификаторы.
+	CreateLedgerTransaction(ctx context.Context, transaction *entity.LedgerTransaction) error
+	// GetLedgerPostings возвращает проводки по счету пользователя от новых к старым и их общее количество.
+	GetLedgerPostings(ctx context.Context, filter form.WalletStatementGet) (entity.LedgerPostings, int64, error)
+}
+
+// PaymentRepository представляет интерфейс для работы с платежами по заказам.
+type PaymentRepository interface {
+	// CreatePayment сохраняет платеж. Если заказ уже оплачен, возвращает entity.ErrPaymentAlreadyExists.
+	CreatePayment(ctx context.Context, payment *entity.Payment) error
+	// GetPaymentByOrderID возвращает платеж по заказу. Если его нет, возвращает entity.ErrPaymentNotFound.
+	GetPaymentByOrderID(ctx context.Context, orderID string) (*entity.Payment, error)
+	// GetPaymentByProviderID возвращает платеж по идентификатору у провайдера.
+	// Если его нет, возвращает entity.ErrPaymentNotFound.
+	GetPaymentByProviderID(ctx context.Context, providerPaymentID string) (*entity.Payment, error)
+	// UpdatePayment сохраняет статус, возвраты и ожидающую операцию платежа, если с предыдущей версии его никто не менял.
+	// Иначе возвращает entity.ErrPaymentConflict.
+	UpdatePayment(ctx context.Context, payment *entity.Payment) error
+	// GetPendingSettlements возвращает платежи с операцией, очередная попытка которой наступила к dueAt.
+	GetPendingSettlements(ctx context.Context, dueAt time.Time, limit int64) (entity.Payments, error)
+	// SaveWebhookEvent запоминает обработанное уведомление провайдера до expiresAt.
+	// Если уведомление уже обрабатывали, возвращает false.
+	SaveWebhookEvent(ctx context.Context, eventID string, receivedAt, expiresAt time.Time) (bool, error)
+}
+
+// DisputeRepository представляет интерфейс для работы со спорами по заказам и перепиской по ним.
+type DisputeRepository interface {
+	// CreateDispute сохраняет спор. Если по заказу уже открыт спор, возвращает entity.ErrDisputeAlreadyExists.
+	CreateDispute(ctx context.Context, dispute *entity.Dispute) error
+	// GetDisputeByID возвращает спор по идентификатору. Если его нет, возвращает entity.ErrDisputeNotFound.
+	GetDisputeByID(ctx context.Context, id string) (*entity.Dispute, error)
+	// GetDisputes возвращает споры, в которых пользователь является стороной, и их общее количество.
+	GetDisputes(ctx context.Context, filter form.DisputesGet) (entity.Disputes, int64, error)
+	// GetDisputeQueue возвращает нерешенные споры по возрастанию срока и их общее количество.
+	GetDisputeQueue(ctx context.Context, filter form.DisputeQueueGet) (entity.Disputes, int64, error)
+	// GetDueDisputes возвращает нерешенные споры, срок которых наступил к dueAt, но просрочка еще не отмечена.
+	GetDueDisputes(ctx context.Context, dueAt time.Time, limit int64) (entity.Disputes, error)
+	// UpdateDispute сохраняет статус, модератора, доказательства, решение и сроки спора,
+	// если с предыдущей версии его никто не менял. Иначе возвращает entity.ErrDisputeConflict.
+	UpdateDispute(ctx context.Context, dispute *entity.Dispute) error
+	// CreateDisputeMessage сохраняет сообщение в переписке по спору.
+	CreateDisputeMessage(ctx context.Context, message *entity.DisputeMessage) error
+	// GetDisputeMessages возвращает сообщения по спору от старых к новым и их общее количество.
+	GetDisputeMessages(ctx context.Context, filter form.DisputeMessagesGet) (entity.DisputeMessages, int64, error)
+}
+
+// AuditRepository представляет интерфейс для работы с журналом изменений сущностей.
+type AuditRepository interface {
+	// CreateAuditEntry добавляет запись в журнал изменений.
+	CreateAuditEntry(ctx context.Context, entry *entity.AuditEntry) error
+	// GetAuditEntries возвращает записи журнала по фильтру от новых к старым и их общее количество.
+	GetAuditEntries(ctx context.Context, filter form.AuditEntriesGet) (entity.AuditEntries, int64, error)
+	// CountActorChanges возвращает количество изменений сущностей entityTypes, сделанных автором начиная с since.
+	CountActorChanges(ctx context.Context, actorID string, entityTypes []entity.AuditEntityType, since time.Time) (int64, error)
+}
+
+// ModerationRepository представляет интерфейс для работы с очередью модерации.
+type ModerationRepository interface {
+	// CreateCase сохраняет дело. Если по сущности уже открыто дело, возвращает entity.ErrModerationConflict.
+	CreateCase(ctx context.Context, moderationCase *entity.ModerationCase) error
+	// GetCaseByID возвращает дело по идентификатору. Если его нет, возвращает entity.ErrModerationCaseNotFound.
+	GetCaseByID(ctx context.Context, id string) (*entity.ModerationCase, error)
+	// GetOpenCase возвращает открытое дело по сущности. Если его нет, возвращает entity.ErrModerationCaseNotFound.
+	GetOpenCase(ctx context.Context, subjectType entity.ModerationSubjectType, subjectID string) (*entity.ModerationCase, error)
+	// GetQueue возвращает дела по фильтру от старых к новым и их общее количество.
+	GetQueue(ctx context.Context, filter form.ModerationQueueGet) (entity.ModerationCases, int64, error)
+	// UpdateCase сохраняет текст, нарушения, жалобы, статус и решение по делу,
+	// если с предыдущей версии его никто не менял. Иначе возвращает entity.ErrModerationConflict.
+	UpdateCase(ctx context.Context, moderationCase *entity.ModerationCase) error
+}
+
+// ImportRunRepository представляет интерфейс для работы с запусками импорта.
+type ImportRunRepository interface {
+	// CreateImportRun сохраняет новый запуск и заполняет его идентификатор.
+	CreateImportRun(ctx context.Context, run *entity.ImportRun) error
+	// GetImportRun возвращает запуск по идентификатору. Если его нет, возвращает entity.ErrImportRunNotFound.
+	GetImportRun(ctx context.Context, id string) (*entity.ImportRun, error)
+	// UpdateImportRun сохраняет счетчики, номер последней обработанной строки и статус запуска.
+	UpdateImportRun(ctx context.Context, run *entity.ImportRun) error
 }
 
 // TxCallback представляет функцию обратного вызова для обработки результатов транзакции.
@@ -36,6 +36,20 @@ func (m *MockCacheStore) EXPECT() *MockCacheStoreMockRecorder {
 	return m.recorder
 }
 
+// NotificationCache mocks base method.
+func (m *MockCacheStore) NotificationCache() repository.NotificationCache {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationCache")
+	ret0, _ := ret[0].(repository.NotificationCache)
+	return ret0
+}
+
+// NotificationCache indicates an expected call of NotificationCache.
+func (mr *MockCacheStoreMockRecorder) NotificationCache() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationCache", reflect.TypeOf((*MockCacheStore)(nil).NotificationCache))
+}
+
 // UserCache mocks base method.
 func (m *MockCacheStore) UserCache() repository.UserCache {
 	m.ctrl.T.Helper()
@@ -101,3 +115,69 @@ func (mr *MockUserCacheMockRecorder) SetUser(ctx, user interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUser", reflect.TypeOf((*MockUserCache)(nil).SetUser), ctx, user)
 }
+
+// MockNotificationCache is a mock of NotificationCache interface.
+type MockNotificationCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationCacheMockRecorder
+}
+
+// MockNotificationCacheMockRecorder is the mock recorder for MockNotificationCache.
+type MockNotificationCacheMockRecorder struct {
+	mock *MockNotificationCache
+}
+
+// NewMockNotificationCache creates a new mock instance.
+func NewMockNotificationCache(ctrl *gomock.Controller) *MockNotificationCache {
+	mock := &MockNotificationCache{ctrl: ctrl}
+	mock.recorder = &MockNotificationCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationCache) EXPECT() *MockNotificationCacheMockRecorder {
+	return m.recorder
+}
+
+// DeleteUnreadCount mocks base method.
+func (m *MockNotificationCache) DeleteUnreadCount(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnreadCount", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUnreadCount indicates an expected call of DeleteUnreadCount.
+func (mr *MockNotificationCacheMockRecorder) DeleteUnreadCount(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnreadCount", reflect.TypeOf((*MockNotificationCache)(nil).DeleteUnreadCount), ctx, userID)
+}
+
+// GetUnreadCount mocks base method.
+func (m *MockNotificationCache) GetUnreadCount(ctx context.Context, userID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadCount", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadCount indicates an expected call of GetUnreadCount.
+func (mr *MockNotificationCacheMockRecorder) GetUnreadCount(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadCount", reflect.TypeOf((*MockNotificationCache)(nil).GetUnreadCount), ctx, userID)
+}
+
+// SetUnreadCount mocks base method.
+func (m *MockNotificationCache) SetUnreadCount(ctx context.Context, userID string, count int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUnreadCount", ctx, userID, count)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUnreadCount indicates an expected call of SetUnreadCount.
+func (mr *MockNotificationCacheMockRecorder) SetUnreadCount(ctx, userID, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUnreadCount", reflect.TypeOf((*MockNotificationCache)(nil).SetUnreadCount), ctx, userID, count)
+}
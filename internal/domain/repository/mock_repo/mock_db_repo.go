@@ -7,6 +7,7 @@ package mock_repo
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/alisher-99/LomBarter/internal/domain/entity"
 	form "github.com/alisher-99/LomBarter/internal/domain/form"
@@ -37,6 +38,20 @@ func (m *MockDataStore) EXPECT() *MockDataStoreMockRecorder {
 	return m.recorder
 }
 
+// AuditRepository mocks base method.
+func (m *MockDataStore) AuditRepository() repository.AuditRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditRepository")
+	ret0, _ := ret[0].(repository.AuditRepository)
+	return ret0
+}
+
+// AuditRepository indicates an expected call of AuditRepository.
+func (mr *MockDataStoreMockRecorder) AuditRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditRepository", reflect.TypeOf((*MockDataStore)(nil).AuditRepository))
+}
+
 // Close mocks base method.
 func (m *MockDataStore) Close(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -65,6 +80,104 @@ func (mr *MockDataStoreMockRecorder) Connect() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockDataStore)(nil).Connect))
 }
 
+// ConversationRepository mocks base method.
+func (m *MockDataStore) ConversationRepository() repository.ConversationRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConversationRepository")
+	ret0, _ := ret[0].(repository.ConversationRepository)
+	return ret0
+}
+
+// ConversationRepository indicates an expected call of ConversationRepository.
+func (mr *MockDataStoreMockRecorder) ConversationRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConversationRepository", reflect.TypeOf((*MockDataStore)(nil).ConversationRepository))
+}
+
+// DisputeRepository mocks base method.
+func (m *MockDataStore) DisputeRepository() repository.DisputeRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisputeRepository")
+	ret0, _ := ret[0].(repository.DisputeRepository)
+	return ret0
+}
+
+// DisputeRepository indicates an expected call of DisputeRepository.
+func (mr *MockDataStoreMockRecorder) DisputeRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisputeRepository", reflect.TypeOf((*MockDataStore)(nil).DisputeRepository))
+}
+
+// ImportRunRepository mocks base method.
+func (m *MockDataStore) ImportRunRepository() repository.ImportRunRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportRunRepository")
+	ret0, _ := ret[0].(repository.ImportRunRepository)
+	return ret0
+}
+
+// ImportRunRepository indicates an expected call of ImportRunRepository.
+func (mr *MockDataStoreMockRecorder) ImportRunRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportRunRepository", reflect.TypeOf((*MockDataStore)(nil).ImportRunRepository))
+}
+
+// LedgerRepository mocks base method.
+func (m *MockDataStore) LedgerRepository() repository.LedgerRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LedgerRepository")
+	ret0, _ := ret[0].(repository.LedgerRepository)
+	return ret0
+}
+
+// LedgerRepository indicates an expected call of LedgerRepository.
+func (mr *MockDataStoreMockRecorder) LedgerRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LedgerRepository", reflect.TypeOf((*MockDataStore)(nil).LedgerRepository))
+}
+
+// ListingRepository mocks base method.
+func (m *MockDataStore) ListingRepository() repository.ListingRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListingRepository")
+	ret0, _ := ret[0].(repository.ListingRepository)
+	return ret0
+}
+
+// ListingRepository indicates an expected call of ListingRepository.
+func (mr *MockDataStoreMockRecorder) ListingRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListingRepository", reflect.TypeOf((*MockDataStore)(nil).ListingRepository))
+}
+
+// MediaRepository mocks base method.
+func (m *MockDataStore) MediaRepository() repository.MediaRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MediaRepository")
+	ret0, _ := ret[0].(repository.MediaRepository)
+	return ret0
+}
+
+// MediaRepository indicates an expected call of MediaRepository.
+func (mr *MockDataStoreMockRecorder) MediaRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MediaRepository", reflect.TypeOf((*MockDataStore)(nil).MediaRepository))
+}
+
+// ModerationRepository mocks base method.
+func (m *MockDataStore) ModerationRepository() repository.ModerationRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerationRepository")
+	ret0, _ := ret[0].(repository.ModerationRepository)
+	return ret0
+}
+
+// ModerationRepository indicates an expected call of ModerationRepository.
+func (mr *MockDataStoreMockRecorder) ModerationRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerationRepository", reflect.TypeOf((*MockDataStore)(nil).ModerationRepository))
+}
+
 // Name mocks base method.
 func (m *MockDataStore) Name() string {
 	m.ctrl.T.Helper()
@@ -79,6 +192,20 @@ func (mr *MockDataStoreMockRecorder) Name() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockDataStore)(nil).Name))
 }
 
+// NotificationRepository mocks base method.
+func (m *MockDataStore) NotificationRepository() repository.NotificationRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationRepository")
+	ret0, _ := ret[0].(repository.NotificationRepository)
+	return ret0
+}
+
+// NotificationRepository indicates an expected call of NotificationRepository.
+func (mr *MockDataStoreMockRecorder) NotificationRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationRepository", reflect.TypeOf((*MockDataStore)(nil).NotificationRepository))
+}
+
 // OrdersRepository mocks base method.
 func (m *MockDataStore) OrdersRepository() repository.OrdersRepository {
 	m.ctrl.T.Helper()
@@ -93,6 +220,92 @@ func (mr *MockDataStoreMockRecorder) OrdersRepository() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrdersRepository", reflect.TypeOf((*MockDataStore)(nil).OrdersRepository))
 }
 
+// PaymentRepository mocks base method.
+func (m *MockDataStore) PaymentRepository() repository.PaymentRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PaymentRepository")
+	ret0, _ := ret[0].(repository.PaymentRepository)
+	return ret0
+}
+
+// PaymentRepository indicates an expected call of PaymentRepository.
+func (mr *MockDataStoreMockRecorder) PaymentRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PaymentRepository", reflect.TypeOf((*MockDataStore)(nil).PaymentRepository))
+}
+
+// RatingRepository mocks base method.
+func (m *MockDataStore) RatingRepository() repository.RatingRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RatingRepository")
+	ret0, _ := ret[0].(repository.RatingRepository)
+	return ret0
+}
+
+// RatingRepository indicates an expected call of RatingRepository.
+func (mr *MockDataStoreMockRecorder) RatingRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RatingRepository", reflect.TypeOf((*MockDataStore)(nil).RatingRepository))
+}
+
+// SearchRepository mocks base method.
+func (m *MockDataStore) SearchRepository() repository.SearchRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchRepository")
+	ret0, _ := ret[0].(repository.SearchRepository)
+	return ret0
+}
+
+// SearchRepository indicates an expected call of SearchRepository.
+func (mr *MockDataStoreMockRecorder) SearchRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchRepository", reflect.TypeOf((*MockDataStore)(nil).SearchRepository))
+}
+
+// StartSession mocks base method.
+func (m *MockDataStore) StartSession(ctx context.Context) (context.Context, repository.TxCallback, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSession", ctx)
+	ret0, _ := ret[0].(context.Context)
+	ret1, _ := ret[1].(repository.TxCallback)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// StartSession indicates an expected call of StartSession.
+func (mr *MockDataStoreMockRecorder) StartSession(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSession", reflect.TypeOf((*MockDataStore)(nil).StartSession), ctx)
+}
+
+// TradeCycleRepository mocks base method.
+func (m *MockDataStore) TradeCycleRepository() repository.TradeCycleRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TradeCycleRepository")
+	ret0, _ := ret[0].(repository.TradeCycleRepository)
+	return ret0
+}
+
+// TradeCycleRepository indicates an expected call of TradeCycleRepository.
+func (mr *MockDataStoreMockRecorder) TradeCycleRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TradeCycleRepository", reflect.TypeOf((*MockDataStore)(nil).TradeCycleRepository))
+}
+
+// TradeOfferRepository mocks base method.
+func (m *MockDataStore) TradeOfferRepository() repository.TradeOfferRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TradeOfferRepository")
+	ret0, _ := ret[0].(repository.TradeOfferRepository)
+	return ret0
+}
+
+// TradeOfferRepository indicates an expected call of TradeOfferRepository.
+func (mr *MockDataStoreMockRecorder) TradeOfferRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TradeOfferRepository", reflect.TypeOf((*MockDataStore)(nil).TradeOfferRepository))
+}
+
 // UserRepository mocks base method.
 func (m *MockDataStore) UserRepository() repository.UserRepository {
 	m.ctrl.T.Helper()
@@ -107,6 +320,20 @@ func (mr *MockDataStoreMockRecorder) UserRepository() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserRepository", reflect.TypeOf((*MockDataStore)(nil).UserRepository))
 }
 
+// WishlistRepository mocks base method.
+func (m *MockDataStore) WishlistRepository() repository.WishlistRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WishlistRepository")
+	ret0, _ := ret[0].(repository.WishlistRepository)
+	return ret0
+}
+
+// WishlistRepository indicates an expected call of WishlistRepository.
+func (mr *MockDataStoreMockRecorder) WishlistRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WishlistRepository", reflect.TypeOf((*MockDataStore)(nil).WishlistRepository))
+}
+
 // MockBase is a mock of Base interface.
 type MockBase struct {
 	ctrl     *gomock.Controller
@@ -210,6 +437,35 @@ func (mr *MockUserRepositoryMockRecorder) CreateUser(ctx, user interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserRepository)(nil).CreateUser), ctx, user)
 }
 
+// CreateUsers mocks base method.
+func (m *MockUserRepository) CreateUsers(ctx context.Context, users []*entity.User) (map[int]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUsers", ctx, users)
+	ret0, _ := ret[0].(map[int]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUsers indicates an expected call of CreateUsers.
+func (mr *MockUserRepositoryMockRecorder) CreateUsers(ctx, users interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUsers", reflect.TypeOf((*MockUserRepository)(nil).CreateUsers), ctx, users)
+}
+
+// ExportUsers mocks base method.
+func (m *MockUserRepository) ExportUsers(ctx context.Context, filter form.UsersExport, fn func(*entity.User) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportUsers", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportUsers indicates an expected call of ExportUsers.
+func (mr *MockUserRepositoryMockRecorder) ExportUsers(ctx, filter, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUsers", reflect.TypeOf((*MockUserRepository)(nil).ExportUsers), ctx, filter, fn)
+}
+
 // GetUserByID mocks base method.
 func (m *MockUserRepository) GetUserByID(ctx context.Context, id string) (*entity.User, error) {
 	m.ctrl.T.Helper()
@@ -240,6 +496,20 @@ func (mr *MockUserRepositoryMockRecorder) GetUsersByBio(ctx, filter interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByBio", reflect.TypeOf((*MockUserRepository)(nil).GetUsersByBio), ctx, filter)
 }
 
+// SetUserAvatar mocks base method.
+func (m *MockUserRepository) SetUserAvatar(ctx context.Context, userID, mediaID string, currentTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserAvatar", ctx, userID, mediaID, currentTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserAvatar indicates an expected call of SetUserAvatar.
+func (mr *MockUserRepositoryMockRecorder) SetUserAvatar(ctx, userID, mediaID, currentTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserAvatar", reflect.TypeOf((*MockUserRepository)(nil).SetUserAvatar), ctx, userID, mediaID, currentTime)
+}
+
 // UpdateUser mocks base method.
 func (m *MockUserRepository) UpdateUser(ctx context.Context, user *entity.User) error {
 	m.ctrl.T.Helper()
@@ -254,6 +524,34 @@ func (mr *MockUserRepositoryMockRecorder) UpdateUser(ctx, user interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserRepository)(nil).UpdateUser), ctx, user)
 }
 
+// UpdateUserModeration mocks base method.
+func (m *MockUserRepository) UpdateUserModeration(ctx context.Context, user *entity.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserModeration", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserModeration indicates an expected call of UpdateUserModeration.
+func (mr *MockUserRepositoryMockRecorder) UpdateUserModeration(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserModeration", reflect.TypeOf((*MockUserRepository)(nil).UpdateUserModeration), ctx, user)
+}
+
+// UpdateUserReputation mocks base method.
+func (m *MockUserRepository) UpdateUserReputation(ctx context.Context, userID string, reputation entity.Reputation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserReputation", ctx, userID, reputation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserReputation indicates an expected call of UpdateUserReputation.
+func (mr *MockUserRepositoryMockRecorder) UpdateUserReputation(ctx, userID, reputation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserReputation", reflect.TypeOf((*MockUserRepository)(nil).UpdateUserReputation), ctx, userID, reputation)
+}
+
 // MockOrdersRepository is a mock of OrdersRepository interface.
 type MockOrdersRepository struct {
 	ctrl     *gomock.Controller
@@ -291,6 +589,35 @@ func (mr *MockOrdersRepositoryMockRecorder) CreateOrder(ctx, order interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockOrdersRepository)(nil).CreateOrder), ctx, order)
 }
 
+// CreateOrders mocks base method.
+func (m *MockOrdersRepository) CreateOrders(ctx context.Context, orders []*entity.Order) (map[int]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrders", ctx, orders)
+	ret0, _ := ret[0].(map[int]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrders indicates an expected call of CreateOrders.
+func (mr *MockOrdersRepositoryMockRecorder) CreateOrders(ctx, orders interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrders", reflect.TypeOf((*MockOrdersRepository)(nil).CreateOrders), ctx, orders)
+}
+
+// ExportOrders mocks base method.
+func (m *MockOrdersRepository) ExportOrders(ctx context.Context, filter form.OrdersExport, fn func(*entity.Order) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportOrders", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportOrders indicates an expected call of ExportOrders.
+func (mr *MockOrdersRepositoryMockRecorder) ExportOrders(ctx, filter, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportOrders", reflect.TypeOf((*MockOrdersRepository)(nil).ExportOrders), ctx, filter, fn)
+}
+
 // GetOrderForClient mocks base method.
 func (m *MockOrdersRepository) GetOrderForClient(ctx context.Context, filter form.OrderGetForClient) (*entity.Order, error) {
 	m.ctrl.T.Helper()
@@ -306,6 +633,21 @@ func (mr *MockOrdersRepositoryMockRecorder) GetOrderForClient(ctx, filter interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderForClient", reflect.TypeOf((*MockOrdersRepository)(nil).GetOrderForClient), ctx, filter)
 }
 
+// GetOrderHistory mocks base method.
+func (m *MockOrdersRepository) GetOrderHistory(ctx context.Context, filter form.OrderGetForClient) (entity.OrderHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderHistory", ctx, filter)
+	ret0, _ := ret[0].(entity.OrderHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderHistory indicates an expected call of GetOrderHistory.
+func (mr *MockOrdersRepositoryMockRecorder) GetOrderHistory(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderHistory", reflect.TypeOf((*MockOrdersRepository)(nil).GetOrderHistory), ctx, filter)
+}
+
 // GetOrdersForClient mocks base method.
 func (m *MockOrdersRepository) GetOrdersForClient(ctx context.Context, filter form.OrdersGetForClient) (entity.Orders, error) {
 	m.ctrl.T.Helper()
@@ -321,6 +663,1546 @@ func (mr *MockOrdersRepositoryMockRecorder) GetOrdersForClient(ctx, filter inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrdersForClient", reflect.TypeOf((*MockOrdersRepository)(nil).GetOrdersForClient), ctx, filter)
 }
 
+// UpdateOrderStatus mocks base method.
+func (m *MockOrdersRepository) UpdateOrderStatus(ctx context.Context, order *entity.Order, change entity.OrderStatusChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderStatus", ctx, order, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrderStatus indicates an expected call of UpdateOrderStatus.
+func (mr *MockOrdersRepositoryMockRecorder) UpdateOrderStatus(ctx, order, change interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderStatus", reflect.TypeOf((*MockOrdersRepository)(nil).UpdateOrderStatus), ctx, order, change)
+}
+
+// MockListingRepository is a mock of ListingRepository interface.
+type MockListingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockListingRepositoryMockRecorder
+}
+
+// MockListingRepositoryMockRecorder is the mock recorder for MockListingRepository.
+type MockListingRepositoryMockRecorder struct {
+	mock *MockListingRepository
+}
+
+// NewMockListingRepository creates a new mock instance.
+func NewMockListingRepository(ctrl *gomock.Controller) *MockListingRepository {
+	mock := &MockListingRepository{ctrl: ctrl}
+	mock.recorder = &MockListingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListingRepository) EXPECT() *MockListingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateListing mocks base method.
+func (m *MockListingRepository) CreateListing(ctx context.Context, listing *entity.Listing) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateListing", ctx, listing)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateListing indicates an expected call of CreateListing.
+func (mr *MockListingRepositoryMockRecorder) CreateListing(ctx, listing interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateListing", reflect.TypeOf((*MockListingRepository)(nil).CreateListing), ctx, listing)
+}
+
+// DeleteListing mocks base method.
+func (m *MockListingRepository) DeleteListing(ctx context.Context, filter form.ListingDelete) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteListing", ctx, filter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteListing indicates an expected call of DeleteListing.
+func (mr *MockListingRepositoryMockRecorder) DeleteListing(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteListing", reflect.TypeOf((*MockListingRepository)(nil).DeleteListing), ctx, filter)
+}
+
+// GetListingByID mocks base method.
+func (m *MockListingRepository) GetListingByID(ctx context.Context, id string) (*entity.Listing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Listing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingByID indicates an expected call of GetListingByID.
+func (mr *MockListingRepositoryMockRecorder) GetListingByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingByID", reflect.TypeOf((*MockListingRepository)(nil).GetListingByID), ctx, id)
+}
+
+// GetListings mocks base method.
+func (m *MockListingRepository) GetListings(ctx context.Context, filter form.ListingsGet) (entity.Listings, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListings", ctx, filter)
+	ret0, _ := ret[0].(entity.Listings)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetListings indicates an expected call of GetListings.
+func (mr *MockListingRepositoryMockRecorder) GetListings(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListings", reflect.TypeOf((*MockListingRepository)(nil).GetListings), ctx, filter)
+}
+
+// GetListingsByDesiredTags mocks base method.
+func (m *MockListingRepository) GetListingsByDesiredTags(ctx context.Context, tags []string, limit int64) (entity.Listings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingsByDesiredTags", ctx, tags, limit)
+	ret0, _ := ret[0].(entity.Listings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingsByDesiredTags indicates an expected call of GetListingsByDesiredTags.
+func (mr *MockListingRepositoryMockRecorder) GetListingsByDesiredTags(ctx, tags, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingsByDesiredTags", reflect.TypeOf((*MockListingRepository)(nil).GetListingsByDesiredTags), ctx, tags, limit)
+}
+
+// GetListingsByIDs mocks base method.
+func (m *MockListingRepository) GetListingsByIDs(ctx context.Context, ids []string) (entity.Listings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListingsByIDs", ctx, ids)
+	ret0, _ := ret[0].(entity.Listings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListingsByIDs indicates an expected call of GetListingsByIDs.
+func (mr *MockListingRepositoryMockRecorder) GetListingsByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListingsByIDs", reflect.TypeOf((*MockListingRepository)(nil).GetListingsByIDs), ctx, ids)
+}
+
+// RejectOwnerListings mocks base method.
+func (m *MockListingRepository) RejectOwnerListings(ctx context.Context, ownerID string, currentTime time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectOwnerListings", ctx, ownerID, currentTime)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectOwnerListings indicates an expected call of RejectOwnerListings.
+func (mr *MockListingRepositoryMockRecorder) RejectOwnerListings(ctx, ownerID, currentTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectOwnerListings", reflect.TypeOf((*MockListingRepository)(nil).RejectOwnerListings), ctx, ownerID, currentTime)
+}
+
+// UpdateListing mocks base method.
+func (m *MockListingRepository) UpdateListing(ctx context.Context, listing *entity.Listing, from entity.ListingStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateListing", ctx, listing, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateListing indicates an expected call of UpdateListing.
+func (mr *MockListingRepositoryMockRecorder) UpdateListing(ctx, listing, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListing", reflect.TypeOf((*MockListingRepository)(nil).UpdateListing), ctx, listing, from)
+}
+
+// UpdateListingModeration mocks base method.
+func (m *MockListingRepository) UpdateListingModeration(ctx context.Context, listing *entity.Listing) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateListingModeration", ctx, listing)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateListingModeration indicates an expected call of UpdateListingModeration.
+func (mr *MockListingRepositoryMockRecorder) UpdateListingModeration(ctx, listing interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListingModeration", reflect.TypeOf((*MockListingRepository)(nil).UpdateListingModeration), ctx, listing)
+}
+
+// UpdateListingsStatus mocks base method.
+func (m *MockListingRepository) UpdateListingsStatus(ctx context.Context, ids []string, from, to entity.ListingStatus, currentTime time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateListingsStatus", ctx, ids, from, to, currentTime)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateListingsStatus indicates an expected call of UpdateListingsStatus.
+func (mr *MockListingRepositoryMockRecorder) UpdateListingsStatus(ctx, ids, from, to, currentTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListingsStatus", reflect.TypeOf((*MockListingRepository)(nil).UpdateListingsStatus), ctx, ids, from, to, currentTime)
+}
+
+// MockTradeOfferRepository is a mock of TradeOfferRepository interface.
+type MockTradeOfferRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTradeOfferRepositoryMockRecorder
+}
+
+// MockTradeOfferRepositoryMockRecorder is the mock recorder for MockTradeOfferRepository.
+type MockTradeOfferRepositoryMockRecorder struct {
+	mock *MockTradeOfferRepository
+}
+
+// NewMockTradeOfferRepository creates a new mock instance.
+func NewMockTradeOfferRepository(ctrl *gomock.Controller) *MockTradeOfferRepository {
+	mock := &MockTradeOfferRepository{ctrl: ctrl}
+	mock.recorder = &MockTradeOfferRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTradeOfferRepository) EXPECT() *MockTradeOfferRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateTradeOffer mocks base method.
+func (m *MockTradeOfferRepository) CreateTradeOffer(ctx context.Context, offer *entity.TradeOffer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTradeOffer", ctx, offer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTradeOffer indicates an expected call of CreateTradeOffer.
+func (mr *MockTradeOfferRepositoryMockRecorder) CreateTradeOffer(ctx, offer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTradeOffer", reflect.TypeOf((*MockTradeOfferRepository)(nil).CreateTradeOffer), ctx, offer)
+}
+
+// GetTradeOfferByID mocks base method.
+func (m *MockTradeOfferRepository) GetTradeOfferByID(ctx context.Context, id string) (*entity.TradeOffer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTradeOfferByID", ctx, id)
+	ret0, _ := ret[0].(*entity.TradeOffer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTradeOfferByID indicates an expected call of GetTradeOfferByID.
+func (mr *MockTradeOfferRepositoryMockRecorder) GetTradeOfferByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTradeOfferByID", reflect.TypeOf((*MockTradeOfferRepository)(nil).GetTradeOfferByID), ctx, id)
+}
+
+// GetTradeOffers mocks base method.
+func (m *MockTradeOfferRepository) GetTradeOffers(ctx context.Context, filter form.TradeOffersGet) (entity.TradeOffers, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTradeOffers", ctx, filter)
+	ret0, _ := ret[0].(entity.TradeOffers)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTradeOffers indicates an expected call of GetTradeOffers.
+func (mr *MockTradeOfferRepositoryMockRecorder) GetTradeOffers(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTradeOffers", reflect.TypeOf((*MockTradeOfferRepository)(nil).GetTradeOffers), ctx, filter)
+}
+
+// UpdateTradeOfferStatus mocks base method.
+func (m *MockTradeOfferRepository) UpdateTradeOfferStatus(ctx context.Context, offer *entity.TradeOffer, from entity.TradeOfferStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTradeOfferStatus", ctx, offer, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTradeOfferStatus indicates an expected call of UpdateTradeOfferStatus.
+func (mr *MockTradeOfferRepositoryMockRecorder) UpdateTradeOfferStatus(ctx, offer, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTradeOfferStatus", reflect.TypeOf((*MockTradeOfferRepository)(nil).UpdateTradeOfferStatus), ctx, offer, from)
+}
+
+// MockTradeCycleRepository is a mock of TradeCycleRepository interface.
+type MockTradeCycleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTradeCycleRepositoryMockRecorder
+}
+
+// MockTradeCycleRepositoryMockRecorder is the mock recorder for MockTradeCycleRepository.
+type MockTradeCycleRepositoryMockRecorder struct {
+	mock *MockTradeCycleRepository
+}
+
+// NewMockTradeCycleRepository creates a new mock instance.
+func NewMockTradeCycleRepository(ctrl *gomock.Controller) *MockTradeCycleRepository {
+	mock := &MockTradeCycleRepository{ctrl: ctrl}
+	mock.recorder = &MockTradeCycleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTradeCycleRepository) EXPECT() *MockTradeCycleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateTradeCycle mocks base method.
+func (m *MockTradeCycleRepository) CreateTradeCycle(ctx context.Context, cycle *entity.TradeCycle) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTradeCycle", ctx, cycle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTradeCycle indicates an expected call of CreateTradeCycle.
+func (mr *MockTradeCycleRepositoryMockRecorder) CreateTradeCycle(ctx, cycle interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTradeCycle", reflect.TypeOf((*MockTradeCycleRepository)(nil).CreateTradeCycle), ctx, cycle)
+}
+
+// ExpireTradeCycles mocks base method.
+func (m *MockTradeCycleRepository) ExpireTradeCycles(ctx context.Context, listingID string, currentTime time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireTradeCycles", ctx, listingID, currentTime)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireTradeCycles indicates an expected call of ExpireTradeCycles.
+func (mr *MockTradeCycleRepositoryMockRecorder) ExpireTradeCycles(ctx, listingID, currentTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireTradeCycles", reflect.TypeOf((*MockTradeCycleRepository)(nil).ExpireTradeCycles), ctx, listingID, currentTime)
+}
+
+// GetTradeCycleByID mocks base method.
+func (m *MockTradeCycleRepository) GetTradeCycleByID(ctx context.Context, id string) (*entity.TradeCycle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTradeCycleByID", ctx, id)
+	ret0, _ := ret[0].(*entity.TradeCycle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTradeCycleByID indicates an expected call of GetTradeCycleByID.
+func (mr *MockTradeCycleRepositoryMockRecorder) GetTradeCycleByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTradeCycleByID", reflect.TypeOf((*MockTradeCycleRepository)(nil).GetTradeCycleByID), ctx, id)
+}
+
+// GetTradeCycles mocks base method.
+func (m *MockTradeCycleRepository) GetTradeCycles(ctx context.Context, filter form.TradeCyclesGet) (entity.TradeCycles, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTradeCycles", ctx, filter)
+	ret0, _ := ret[0].(entity.TradeCycles)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTradeCycles indicates an expected call of GetTradeCycles.
+func (mr *MockTradeCycleRepositoryMockRecorder) GetTradeCycles(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTradeCycles", reflect.TypeOf((*MockTradeCycleRepository)(nil).GetTradeCycles), ctx, filter)
+}
+
+// UpdateTradeCycle mocks base method.
+func (m *MockTradeCycleRepository) UpdateTradeCycle(ctx context.Context, cycle *entity.TradeCycle) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTradeCycle", ctx, cycle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTradeCycle indicates an expected call of UpdateTradeCycle.
+func (mr *MockTradeCycleRepositoryMockRecorder) UpdateTradeCycle(ctx, cycle interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTradeCycle", reflect.TypeOf((*MockTradeCycleRepository)(nil).UpdateTradeCycle), ctx, cycle)
+}
+
+// MockWishlistRepository is a mock of WishlistRepository interface.
+type MockWishlistRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWishlistRepositoryMockRecorder
+}
+
+// MockWishlistRepositoryMockRecorder is the mock recorder for MockWishlistRepository.
+type MockWishlistRepositoryMockRecorder struct {
+	mock *MockWishlistRepository
+}
+
+// NewMockWishlistRepository creates a new mock instance.
+func NewMockWishlistRepository(ctrl *gomock.Controller) *MockWishlistRepository {
+	mock := &MockWishlistRepository{ctrl: ctrl}
+	mock.recorder = &MockWishlistRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWishlistRepository) EXPECT() *MockWishlistRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountWishlistItems mocks base method.
+func (m *MockWishlistRepository) CountWishlistItems(ctx context.Context, userID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountWishlistItems", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountWishlistItems indicates an expected call of CountWishlistItems.
+func (mr *MockWishlistRepositoryMockRecorder) CountWishlistItems(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWishlistItems", reflect.TypeOf((*MockWishlistRepository)(nil).CountWishlistItems), ctx, userID)
+}
+
+// CreateWishlistItem mocks base method.
+func (m *MockWishlistRepository) CreateWishlistItem(ctx context.Context, item *entity.WishlistItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWishlistItem", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWishlistItem indicates an expected call of CreateWishlistItem.
+func (mr *MockWishlistRepositoryMockRecorder) CreateWishlistItem(ctx, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWishlistItem", reflect.TypeOf((*MockWishlistRepository)(nil).CreateWishlistItem), ctx, item)
+}
+
+// CreateWishlistMatch mocks base method.
+func (m *MockWishlistRepository) CreateWishlistMatch(ctx context.Context, match *entity.WishlistMatch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWishlistMatch", ctx, match)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWishlistMatch indicates an expected call of CreateWishlistMatch.
+func (mr *MockWishlistRepositoryMockRecorder) CreateWishlistMatch(ctx, match interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWishlistMatch", reflect.TypeOf((*MockWishlistRepository)(nil).CreateWishlistMatch), ctx, match)
+}
+
+// DeleteWishlistItem mocks base method.
+func (m *MockWishlistRepository) DeleteWishlistItem(ctx context.Context, userID, itemID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWishlistItem", ctx, userID, itemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWishlistItem indicates an expected call of DeleteWishlistItem.
+func (mr *MockWishlistRepositoryMockRecorder) DeleteWishlistItem(ctx, userID, itemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWishlistItem", reflect.TypeOf((*MockWishlistRepository)(nil).DeleteWishlistItem), ctx, userID, itemID)
+}
+
+// GetWishlistCandidates mocks base method.
+func (m *MockWishlistRepository) GetWishlistCandidates(ctx context.Context, listing *entity.Listing, limit int64) (entity.WishlistItems, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWishlistCandidates", ctx, listing, limit)
+	ret0, _ := ret[0].(entity.WishlistItems)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWishlistCandidates indicates an expected call of GetWishlistCandidates.
+func (mr *MockWishlistRepositoryMockRecorder) GetWishlistCandidates(ctx, listing, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWishlistCandidates", reflect.TypeOf((*MockWishlistRepository)(nil).GetWishlistCandidates), ctx, listing, limit)
+}
+
+// GetWishlistItems mocks base method.
+func (m *MockWishlistRepository) GetWishlistItems(ctx context.Context, filter form.WishlistGet) (entity.WishlistItems, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWishlistItems", ctx, filter)
+	ret0, _ := ret[0].(entity.WishlistItems)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWishlistItems indicates an expected call of GetWishlistItems.
+func (mr *MockWishlistRepositoryMockRecorder) GetWishlistItems(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWishlistItems", reflect.TypeOf((*MockWishlistRepository)(nil).GetWishlistItems), ctx, filter)
+}
+
+// MockRatingRepository is a mock of RatingRepository interface.
+type MockRatingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRatingRepositoryMockRecorder
+}
+
+// MockRatingRepositoryMockRecorder is the mock recorder for MockRatingRepository.
+type MockRatingRepositoryMockRecorder struct {
+	mock *MockRatingRepository
+}
+
+// NewMockRatingRepository creates a new mock instance.
+func NewMockRatingRepository(ctrl *gomock.Controller) *MockRatingRepository {
+	mock := &MockRatingRepository{ctrl: ctrl}
+	mock.recorder = &MockRatingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRatingRepository) EXPECT() *MockRatingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateRating mocks base method.
+func (m *MockRatingRepository) CreateRating(ctx context.Context, rating *entity.Rating) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRating", ctx, rating)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRating indicates an expected call of CreateRating.
+func (mr *MockRatingRepositoryMockRecorder) CreateRating(ctx, rating interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRating", reflect.TypeOf((*MockRatingRepository)(nil).CreateRating), ctx, rating)
+}
+
+// GetRatings mocks base method.
+func (m *MockRatingRepository) GetRatings(ctx context.Context, filter form.RatingsGet) (entity.Ratings, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRatings", ctx, filter)
+	ret0, _ := ret[0].(entity.Ratings)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRatings indicates an expected call of GetRatings.
+func (mr *MockRatingRepositoryMockRecorder) GetRatings(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRatings", reflect.TypeOf((*MockRatingRepository)(nil).GetRatings), ctx, filter)
+}
+
+// MockConversationRepository is a mock of ConversationRepository interface.
+type MockConversationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockConversationRepositoryMockRecorder
+}
+
+// MockConversationRepositoryMockRecorder is the mock recorder for MockConversationRepository.
+type MockConversationRepositoryMockRecorder struct {
+	mock *MockConversationRepository
+}
+
+// NewMockConversationRepository creates a new mock instance.
+func NewMockConversationRepository(ctrl *gomock.Controller) *MockConversationRepository {
+	mock := &MockConversationRepository{ctrl: ctrl}
+	mock.recorder = &MockConversationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConversationRepository) EXPECT() *MockConversationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountMessagesSince mocks base method.
+func (m *MockConversationRepository) CountMessagesSince(ctx context.Context, conversationID, senderID string, since time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountMessagesSince", ctx, conversationID, senderID, since)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountMessagesSince indicates an expected call of CountMessagesSince.
+func (mr *MockConversationRepositoryMockRecorder) CountMessagesSince(ctx, conversationID, senderID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountMessagesSince", reflect.TypeOf((*MockConversationRepository)(nil).CountMessagesSince), ctx, conversationID, senderID, since)
+}
+
+// CreateConversation mocks base method.
+func (m *MockConversationRepository) CreateConversation(ctx context.Context, conversation *entity.Conversation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateConversation", ctx, conversation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateConversation indicates an expected call of CreateConversation.
+func (mr *MockConversationRepositoryMockRecorder) CreateConversation(ctx, conversation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConversation", reflect.TypeOf((*MockConversationRepository)(nil).CreateConversation), ctx, conversation)
+}
+
+// CreateMessage mocks base method.
+func (m *MockConversationRepository) CreateMessage(ctx context.Context, message *entity.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMessage", ctx, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMessage indicates an expected call of CreateMessage.
+func (mr *MockConversationRepositoryMockRecorder) CreateMessage(ctx, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMessage", reflect.TypeOf((*MockConversationRepository)(nil).CreateMessage), ctx, message)
+}
+
+// GetConversationByID mocks base method.
+func (m *MockConversationRepository) GetConversationByID(ctx context.Context, id string) (*entity.Conversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversationByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Conversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversationByID indicates an expected call of GetConversationByID.
+func (mr *MockConversationRepositoryMockRecorder) GetConversationByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversationByID", reflect.TypeOf((*MockConversationRepository)(nil).GetConversationByID), ctx, id)
+}
+
+// GetConversationBySubject mocks base method.
+func (m *MockConversationRepository) GetConversationBySubject(ctx context.Context, subjectType entity.ConversationSubject, subjectID string) (*entity.Conversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversationBySubject", ctx, subjectType, subjectID)
+	ret0, _ := ret[0].(*entity.Conversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversationBySubject indicates an expected call of GetConversationBySubject.
+func (mr *MockConversationRepositoryMockRecorder) GetConversationBySubject(ctx, subjectType, subjectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversationBySubject", reflect.TypeOf((*MockConversationRepository)(nil).GetConversationBySubject), ctx, subjectType, subjectID)
+}
+
+// GetConversations mocks base method.
+func (m *MockConversationRepository) GetConversations(ctx context.Context, filter form.ConversationsGet) (entity.Conversations, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversations", ctx, filter)
+	ret0, _ := ret[0].(entity.Conversations)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetConversations indicates an expected call of GetConversations.
+func (mr *MockConversationRepositoryMockRecorder) GetConversations(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversations", reflect.TypeOf((*MockConversationRepository)(nil).GetConversations), ctx, filter)
+}
+
+// GetMessages mocks base method.
+func (m *MockConversationRepository) GetMessages(ctx context.Context, filter form.MessagesGet) (entity.Messages, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessages", ctx, filter)
+	ret0, _ := ret[0].(entity.Messages)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMessages indicates an expected call of GetMessages.
+func (mr *MockConversationRepositoryMockRecorder) GetMessages(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessages", reflect.TypeOf((*MockConversationRepository)(nil).GetMessages), ctx, filter)
+}
+
+// MarkConversationRead mocks base method.
+func (m *MockConversationRepository) MarkConversationRead(ctx context.Context, id, userID string, readAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkConversationRead", ctx, id, userID, readAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkConversationRead indicates an expected call of MarkConversationRead.
+func (mr *MockConversationRepositoryMockRecorder) MarkConversationRead(ctx, id, userID, readAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkConversationRead", reflect.TypeOf((*MockConversationRepository)(nil).MarkConversationRead), ctx, id, userID, readAt)
+}
+
+// MockSearchRepository is a mock of SearchRepository interface.
+type MockSearchRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchRepositoryMockRecorder
+}
+
+// MockSearchRepositoryMockRecorder is the mock recorder for MockSearchRepository.
+type MockSearchRepositoryMockRecorder struct {
+	mock *MockSearchRepository
+}
+
+// NewMockSearchRepository creates a new mock instance.
+func NewMockSearchRepository(ctrl *gomock.Controller) *MockSearchRepository {
+	mock := &MockSearchRepository{ctrl: ctrl}
+	mock.recorder = &MockSearchRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchRepository) EXPECT() *MockSearchRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockSearchRepository) Search(ctx context.Context, filter form.Search) (entity.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter)
+	ret0, _ := ret[0].(entity.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockSearchRepositoryMockRecorder) Search(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSearchRepository)(nil).Search), ctx, filter)
+}
+
+// MockMediaRepository is a mock of MediaRepository interface.
+type MockMediaRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMediaRepositoryMockRecorder
+}
+
+// MockMediaRepositoryMockRecorder is the mock recorder for MockMediaRepository.
+type MockMediaRepositoryMockRecorder struct {
+	mock *MockMediaRepository
+}
+
+// NewMockMediaRepository creates a new mock instance.
+func NewMockMediaRepository(ctrl *gomock.Controller) *MockMediaRepository {
+	mock := &MockMediaRepository{ctrl: ctrl}
+	mock.recorder = &MockMediaRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMediaRepository) EXPECT() *MockMediaRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AttachMedia mocks base method.
+func (m *MockMediaRepository) AttachMedia(ctx context.Context, id string, subjectType entity.MediaSubject, subjectID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachMedia", ctx, id, subjectType, subjectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachMedia indicates an expected call of AttachMedia.
+func (mr *MockMediaRepositoryMockRecorder) AttachMedia(ctx, id, subjectType, subjectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachMedia", reflect.TypeOf((*MockMediaRepository)(nil).AttachMedia), ctx, id, subjectType, subjectID)
+}
+
+// CreateMedia mocks base method.
+func (m *MockMediaRepository) CreateMedia(ctx context.Context, media *entity.Media) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMedia", ctx, media)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMedia indicates an expected call of CreateMedia.
+func (mr *MockMediaRepositoryMockRecorder) CreateMedia(ctx, media interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMedia", reflect.TypeOf((*MockMediaRepository)(nil).CreateMedia), ctx, media)
+}
+
+// DeleteMedia mocks base method.
+func (m *MockMediaRepository) DeleteMedia(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMedia", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMedia indicates an expected call of DeleteMedia.
+func (mr *MockMediaRepositoryMockRecorder) DeleteMedia(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMedia", reflect.TypeOf((*MockMediaRepository)(nil).DeleteMedia), ctx, id)
+}
+
+// GetMediaByID mocks base method.
+func (m *MockMediaRepository) GetMediaByID(ctx context.Context, id string) (*entity.Media, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMediaByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Media)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMediaByID indicates an expected call of GetMediaByID.
+func (mr *MockMediaRepositoryMockRecorder) GetMediaByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMediaByID", reflect.TypeOf((*MockMediaRepository)(nil).GetMediaByID), ctx, id)
+}
+
+// GetMediaBySubject mocks base method.
+func (m *MockMediaRepository) GetMediaBySubject(ctx context.Context, subjectType entity.MediaSubject, subjectID string) (entity.MediaList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMediaBySubject", ctx, subjectType, subjectID)
+	ret0, _ := ret[0].(entity.MediaList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMediaBySubject indicates an expected call of GetMediaBySubject.
+func (mr *MockMediaRepositoryMockRecorder) GetMediaBySubject(ctx, subjectType, subjectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMediaBySubject", reflect.TypeOf((*MockMediaRepository)(nil).GetMediaBySubject), ctx, subjectType, subjectID)
+}
+
+// MockNotificationRepository is a mock of NotificationRepository interface.
+type MockNotificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationRepositoryMockRecorder
+}
+
+// MockNotificationRepositoryMockRecorder is the mock recorder for MockNotificationRepository.
+type MockNotificationRepositoryMockRecorder struct {
+	mock *MockNotificationRepository
+}
+
+// NewMockNotificationRepository creates a new mock instance.
+func NewMockNotificationRepository(ctrl *gomock.Controller) *MockNotificationRepository {
+	mock := &MockNotificationRepository{ctrl: ctrl}
+	mock.recorder = &MockNotificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationRepository) EXPECT() *MockNotificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountUnreadInboxNotifications mocks base method.
+func (m *MockNotificationRepository) CountUnreadInboxNotifications(ctx context.Context, userID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnreadInboxNotifications", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnreadInboxNotifications indicates an expected call of CountUnreadInboxNotifications.
+func (mr *MockNotificationRepositoryMockRecorder) CountUnreadInboxNotifications(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnreadInboxNotifications", reflect.TypeOf((*MockNotificationRepository)(nil).CountUnreadInboxNotifications), ctx, userID)
+}
+
+// CreateInboxNotification mocks base method.
+func (m *MockNotificationRepository) CreateInboxNotification(ctx context.Context, notification *entity.InboxNotification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInboxNotification", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateInboxNotification indicates an expected call of CreateInboxNotification.
+func (mr *MockNotificationRepositoryMockRecorder) CreateInboxNotification(ctx, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInboxNotification", reflect.TypeOf((*MockNotificationRepository)(nil).CreateInboxNotification), ctx, notification)
+}
+
+// DeleteDeviceToken mocks base method.
+func (m *MockNotificationRepository) DeleteDeviceToken(ctx context.Context, userID, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeviceToken", ctx, userID, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDeviceToken indicates an expected call of DeleteDeviceToken.
+func (mr *MockNotificationRepositoryMockRecorder) DeleteDeviceToken(ctx, userID, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeviceToken", reflect.TypeOf((*MockNotificationRepository)(nil).DeleteDeviceToken), ctx, userID, token)
+}
+
+// GetDeviceTokens mocks base method.
+func (m *MockNotificationRepository) GetDeviceTokens(ctx context.Context, userID string) (entity.DeviceTokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceTokens", ctx, userID)
+	ret0, _ := ret[0].(entity.DeviceTokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceTokens indicates an expected call of GetDeviceTokens.
+func (mr *MockNotificationRepositoryMockRecorder) GetDeviceTokens(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceTokens", reflect.TypeOf((*MockNotificationRepository)(nil).GetDeviceTokens), ctx, userID)
+}
+
+// GetInboxNotifications mocks base method.
+func (m *MockNotificationRepository) GetInboxNotifications(ctx context.Context, filter form.InboxGet) (entity.InboxNotifications, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInboxNotifications", ctx, filter)
+	ret0, _ := ret[0].(entity.InboxNotifications)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetInboxNotifications indicates an expected call of GetInboxNotifications.
+func (mr *MockNotificationRepositoryMockRecorder) GetInboxNotifications(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInboxNotifications", reflect.TypeOf((*MockNotificationRepository)(nil).GetInboxNotifications), ctx, filter)
+}
+
+// GetNotificationPreferences mocks base method.
+func (m *MockNotificationRepository) GetNotificationPreferences(ctx context.Context, userID string) (*entity.NotificationPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationPreferences", ctx, userID)
+	ret0, _ := ret[0].(*entity.NotificationPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationPreferences indicates an expected call of GetNotificationPreferences.
+func (mr *MockNotificationRepositoryMockRecorder) GetNotificationPreferences(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationPreferences", reflect.TypeOf((*MockNotificationRepository)(nil).GetNotificationPreferences), ctx, userID)
+}
+
+// MarkAllInboxNotificationsRead mocks base method.
+func (m *MockNotificationRepository) MarkAllInboxNotificationsRead(ctx context.Context, userID string, readAt time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllInboxNotificationsRead", ctx, userID, readAt)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllInboxNotificationsRead indicates an expected call of MarkAllInboxNotificationsRead.
+func (mr *MockNotificationRepositoryMockRecorder) MarkAllInboxNotificationsRead(ctx, userID, readAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllInboxNotificationsRead", reflect.TypeOf((*MockNotificationRepository)(nil).MarkAllInboxNotificationsRead), ctx, userID, readAt)
+}
+
+// MarkInboxNotificationRead mocks base method.
+func (m *MockNotificationRepository) MarkInboxNotificationRead(ctx context.Context, userID, id string, readAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkInboxNotificationRead", ctx, userID, id, readAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkInboxNotificationRead indicates an expected call of MarkInboxNotificationRead.
+func (mr *MockNotificationRepositoryMockRecorder) MarkInboxNotificationRead(ctx, userID, id, readAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkInboxNotificationRead", reflect.TypeOf((*MockNotificationRepository)(nil).MarkInboxNotificationRead), ctx, userID, id, readAt)
+}
+
+// SaveDeviceToken mocks base method.
+func (m *MockNotificationRepository) SaveDeviceToken(ctx context.Context, device *entity.DeviceToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDeviceToken", ctx, device)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDeviceToken indicates an expected call of SaveDeviceToken.
+func (mr *MockNotificationRepositoryMockRecorder) SaveDeviceToken(ctx, device interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDeviceToken", reflect.TypeOf((*MockNotificationRepository)(nil).SaveDeviceToken), ctx, device)
+}
+
+// SaveNotificationPreferences mocks base method.
+func (m *MockNotificationRepository) SaveNotificationPreferences(ctx context.Context, preferences *entity.NotificationPreferences) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveNotificationPreferences", ctx, preferences)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveNotificationPreferences indicates an expected call of SaveNotificationPreferences.
+func (mr *MockNotificationRepositoryMockRecorder) SaveNotificationPreferences(ctx, preferences interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveNotificationPreferences", reflect.TypeOf((*MockNotificationRepository)(nil).SaveNotificationPreferences), ctx, preferences)
+}
+
+// MockLedgerRepository is a mock of LedgerRepository interface.
+type MockLedgerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerRepositoryMockRecorder
+}
+
+// MockLedgerRepositoryMockRecorder is the mock recorder for MockLedgerRepository.
+type MockLedgerRepositoryMockRecorder struct {
+	mock *MockLedgerRepository
+}
+
+// NewMockLedgerRepository creates a new mock instance.
+func NewMockLedgerRepository(ctrl *gomock.Controller) *MockLedgerRepository {
+	mock := &MockLedgerRepository{ctrl: ctrl}
+	mock.recorder = &MockLedgerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerRepository) EXPECT() *MockLedgerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateLedgerTransaction mocks base method.
+func (m *MockLedgerRepository) CreateLedgerTransaction(ctx context.Context, transaction *entity.LedgerTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLedgerTransaction", ctx, transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateLedgerTransaction indicates an expected call of CreateLedgerTransaction.
+func (mr *MockLedgerRepositoryMockRecorder) CreateLedgerTransaction(ctx, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLedgerTransaction", reflect.TypeOf((*MockLedgerRepository)(nil).CreateLedgerTransaction), ctx, transaction)
+}
+
+// GetLedgerAccounts mocks base method.
+func (m *MockLedgerRepository) GetLedgerAccounts(ctx context.Context, ids []string) (entity.LedgerAccounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLedgerAccounts", ctx, ids)
+	ret0, _ := ret[0].(entity.LedgerAccounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLedgerAccounts indicates an expected call of GetLedgerAccounts.
+func (mr *MockLedgerRepositoryMockRecorder) GetLedgerAccounts(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLedgerAccounts", reflect.TypeOf((*MockLedgerRepository)(nil).GetLedgerAccounts), ctx, ids)
+}
+
+// GetLedgerPostings mocks base method.
+func (m *MockLedgerRepository) GetLedgerPostings(ctx context.Context, filter form.WalletStatementGet) (entity.LedgerPostings, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLedgerPostings", ctx, filter)
+	ret0, _ := ret[0].(entity.LedgerPostings)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLedgerPostings indicates an expected call of GetLedgerPostings.
+func (mr *MockLedgerRepositoryMockRecorder) GetLedgerPostings(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLedgerPostings", reflect.TypeOf((*MockLedgerRepository)(nil).GetLedgerPostings), ctx, filter)
+}
+
+// SaveLedgerAccount mocks base method.
+func (m *MockLedgerRepository) SaveLedgerAccount(ctx context.Context, account *entity.LedgerAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveLedgerAccount", ctx, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveLedgerAccount indicates an expected call of SaveLedgerAccount.
+func (mr *MockLedgerRepositoryMockRecorder) SaveLedgerAccount(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLedgerAccount", reflect.TypeOf((*MockLedgerRepository)(nil).SaveLedgerAccount), ctx, account)
+}
+
+// MockPaymentRepository is a mock of PaymentRepository interface.
+type MockPaymentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentRepositoryMockRecorder
+}
+
+// MockPaymentRepositoryMockRecorder is the mock recorder for MockPaymentRepository.
+type MockPaymentRepositoryMockRecorder struct {
+	mock *MockPaymentRepository
+}
+
+// NewMockPaymentRepository creates a new mock instance.
+func NewMockPaymentRepository(ctrl *gomock.Controller) *MockPaymentRepository {
+	mock := &MockPaymentRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentRepository) EXPECT() *MockPaymentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePayment mocks base method.
+func (m *MockPaymentRepository) CreatePayment(ctx context.Context, payment *entity.Payment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayment", ctx, payment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePayment indicates an expected call of CreatePayment.
+func (mr *MockPaymentRepositoryMockRecorder) CreatePayment(ctx, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayment", reflect.TypeOf((*MockPaymentRepository)(nil).CreatePayment), ctx, payment)
+}
+
+// GetPaymentByOrderID mocks base method.
+func (m *MockPaymentRepository) GetPaymentByOrderID(ctx context.Context, orderID string) (*entity.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentByOrderID", ctx, orderID)
+	ret0, _ := ret[0].(*entity.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentByOrderID indicates an expected call of GetPaymentByOrderID.
+func (mr *MockPaymentRepositoryMockRecorder) GetPaymentByOrderID(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentByOrderID", reflect.TypeOf((*MockPaymentRepository)(nil).GetPaymentByOrderID), ctx, orderID)
+}
+
+// GetPaymentByProviderID mocks base method.
+func (m *MockPaymentRepository) GetPaymentByProviderID(ctx context.Context, providerPaymentID string) (*entity.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentByProviderID", ctx, providerPaymentID)
+	ret0, _ := ret[0].(*entity.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentByProviderID indicates an expected call of GetPaymentByProviderID.
+func (mr *MockPaymentRepositoryMockRecorder) GetPaymentByProviderID(ctx, providerPaymentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentByProviderID", reflect.TypeOf((*MockPaymentRepository)(nil).GetPaymentByProviderID), ctx, providerPaymentID)
+}
+
+// GetPendingSettlements mocks base method.
+func (m *MockPaymentRepository) GetPendingSettlements(ctx context.Context, dueAt time.Time, limit int64) (entity.Payments, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingSettlements", ctx, dueAt, limit)
+	ret0, _ := ret[0].(entity.Payments)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingSettlements indicates an expected call of GetPendingSettlements.
+func (mr *MockPaymentRepositoryMockRecorder) GetPendingSettlements(ctx, dueAt, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingSettlements", reflect.TypeOf((*MockPaymentRepository)(nil).GetPendingSettlements), ctx, dueAt, limit)
+}
+
+// SaveWebhookEvent mocks base method.
+func (m *MockPaymentRepository) SaveWebhookEvent(ctx context.Context, eventID string, receivedAt, expiresAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveWebhookEvent", ctx, eventID, receivedAt, expiresAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveWebhookEvent indicates an expected call of SaveWebhookEvent.
+func (mr *MockPaymentRepositoryMockRecorder) SaveWebhookEvent(ctx, eventID, receivedAt, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWebhookEvent", reflect.TypeOf((*MockPaymentRepository)(nil).SaveWebhookEvent), ctx, eventID, receivedAt, expiresAt)
+}
+
+// UpdatePayment mocks base method.
+func (m *MockPaymentRepository) UpdatePayment(ctx context.Context, payment *entity.Payment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePayment", ctx, payment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePayment indicates an expected call of UpdatePayment.
+func (mr *MockPaymentRepositoryMockRecorder) UpdatePayment(ctx, payment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayment", reflect.TypeOf((*MockPaymentRepository)(nil).UpdatePayment), ctx, payment)
+}
+
+// MockDisputeRepository is a mock of DisputeRepository interface.
+type MockDisputeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDisputeRepositoryMockRecorder
+}
+
+// MockDisputeRepositoryMockRecorder is the mock recorder for MockDisputeRepository.
+type MockDisputeRepositoryMockRecorder struct {
+	mock *MockDisputeRepository
+}
+
+// NewMockDisputeRepository creates a new mock instance.
+func NewMockDisputeRepository(ctrl *gomock.Controller) *MockDisputeRepository {
+	mock := &MockDisputeRepository{ctrl: ctrl}
+	mock.recorder = &MockDisputeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDisputeRepository) EXPECT() *MockDisputeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateDispute mocks base method.
+func (m *MockDisputeRepository) CreateDispute(ctx context.Context, dispute *entity.Dispute) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDispute", ctx, dispute)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDispute indicates an expected call of CreateDispute.
+func (mr *MockDisputeRepositoryMockRecorder) CreateDispute(ctx, dispute interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDispute", reflect.TypeOf((*MockDisputeRepository)(nil).CreateDispute), ctx, dispute)
+}
+
+// CreateDisputeMessage mocks base method.
+func (m *MockDisputeRepository) CreateDisputeMessage(ctx context.Context, message *entity.DisputeMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDisputeMessage", ctx, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDisputeMessage indicates an expected call of CreateDisputeMessage.
+func (mr *MockDisputeRepositoryMockRecorder) CreateDisputeMessage(ctx, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDisputeMessage", reflect.TypeOf((*MockDisputeRepository)(nil).CreateDisputeMessage), ctx, message)
+}
+
+// GetDisputeByID mocks base method.
+func (m *MockDisputeRepository) GetDisputeByID(ctx context.Context, id string) (*entity.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDisputeByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDisputeByID indicates an expected call of GetDisputeByID.
+func (mr *MockDisputeRepositoryMockRecorder) GetDisputeByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDisputeByID", reflect.TypeOf((*MockDisputeRepository)(nil).GetDisputeByID), ctx, id)
+}
+
+// GetDisputeMessages mocks base method.
+func (m *MockDisputeRepository) GetDisputeMessages(ctx context.Context, filter form.DisputeMessagesGet) (entity.DisputeMessages, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDisputeMessages", ctx, filter)
+	ret0, _ := ret[0].(entity.DisputeMessages)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDisputeMessages indicates an expected call of GetDisputeMessages.
+func (mr *MockDisputeRepositoryMockRecorder) GetDisputeMessages(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDisputeMessages", reflect.TypeOf((*MockDisputeRepository)(nil).GetDisputeMessages), ctx, filter)
+}
+
+// GetDisputeQueue mocks base method.
+func (m *MockDisputeRepository) GetDisputeQueue(ctx context.Context, filter form.DisputeQueueGet) (entity.Disputes, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDisputeQueue", ctx, filter)
+	ret0, _ := ret[0].(entity.Disputes)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDisputeQueue indicates an expected call of GetDisputeQueue.
+func (mr *MockDisputeRepositoryMockRecorder) GetDisputeQueue(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDisputeQueue", reflect.TypeOf((*MockDisputeRepository)(nil).GetDisputeQueue), ctx, filter)
+}
+
+// GetDisputes mocks base method.
+func (m *MockDisputeRepository) GetDisputes(ctx context.Context, filter form.DisputesGet) (entity.Disputes, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDisputes", ctx, filter)
+	ret0, _ := ret[0].(entity.Disputes)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDisputes indicates an expected call of GetDisputes.
+func (mr *MockDisputeRepositoryMockRecorder) GetDisputes(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDisputes", reflect.TypeOf((*MockDisputeRepository)(nil).GetDisputes), ctx, filter)
+}
+
+// GetDueDisputes mocks base method.
+func (m *MockDisputeRepository) GetDueDisputes(ctx context.Context, dueAt time.Time, limit int64) (entity.Disputes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueDisputes", ctx, dueAt, limit)
+	ret0, _ := ret[0].(entity.Disputes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueDisputes indicates an expected call of GetDueDisputes.
+func (mr *MockDisputeRepositoryMockRecorder) GetDueDisputes(ctx, dueAt, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueDisputes", reflect.TypeOf((*MockDisputeRepository)(nil).GetDueDisputes), ctx, dueAt, limit)
+}
+
+// UpdateDispute mocks base method.
+func (m *MockDisputeRepository) UpdateDispute(ctx context.Context, dispute *entity.Dispute) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDispute", ctx, dispute)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDispute indicates an expected call of UpdateDispute.
+func (mr *MockDisputeRepositoryMockRecorder) UpdateDispute(ctx, dispute interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDispute", reflect.TypeOf((*MockDisputeRepository)(nil).UpdateDispute), ctx, dispute)
+}
+
+// MockAuditRepository is a mock of AuditRepository interface.
+type MockAuditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditRepositoryMockRecorder
+}
+
+// MockAuditRepositoryMockRecorder is the mock recorder for MockAuditRepository.
+type MockAuditRepositoryMockRecorder struct {
+	mock *MockAuditRepository
+}
+
+// NewMockAuditRepository creates a new mock instance.
+func NewMockAuditRepository(ctrl *gomock.Controller) *MockAuditRepository {
+	mock := &MockAuditRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditRepository) EXPECT() *MockAuditRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountActorChanges mocks base method.
+func (m *MockAuditRepository) CountActorChanges(ctx context.Context, actorID string, entityTypes []entity.AuditEntityType, since time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActorChanges", ctx, actorID, entityTypes, since)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActorChanges indicates an expected call of CountActorChanges.
+func (mr *MockAuditRepositoryMockRecorder) CountActorChanges(ctx, actorID, entityTypes, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActorChanges", reflect.TypeOf((*MockAuditRepository)(nil).CountActorChanges), ctx, actorID, entityTypes, since)
+}
+
+// CreateAuditEntry mocks base method.
+func (m *MockAuditRepository) CreateAuditEntry(ctx context.Context, entry *entity.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditEntry", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuditEntry indicates an expected call of CreateAuditEntry.
+func (mr *MockAuditRepositoryMockRecorder) CreateAuditEntry(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditEntry", reflect.TypeOf((*MockAuditRepository)(nil).CreateAuditEntry), ctx, entry)
+}
+
+// GetAuditEntries mocks base method.
+func (m *MockAuditRepository) GetAuditEntries(ctx context.Context, filter form.AuditEntriesGet) (entity.AuditEntries, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditEntries", ctx, filter)
+	ret0, _ := ret[0].(entity.AuditEntries)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAuditEntries indicates an expected call of GetAuditEntries.
+func (mr *MockAuditRepositoryMockRecorder) GetAuditEntries(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditEntries", reflect.TypeOf((*MockAuditRepository)(nil).GetAuditEntries), ctx, filter)
+}
+
+// MockModerationRepository is a mock of ModerationRepository interface.
+type MockModerationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockModerationRepositoryMockRecorder
+}
+
+// MockModerationRepositoryMockRecorder is the mock recorder for MockModerationRepository.
+type MockModerationRepositoryMockRecorder struct {
+	mock *MockModerationRepository
+}
+
+// NewMockModerationRepository creates a new mock instance.
+func NewMockModerationRepository(ctrl *gomock.Controller) *MockModerationRepository {
+	mock := &MockModerationRepository{ctrl: ctrl}
+	mock.recorder = &MockModerationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModerationRepository) EXPECT() *MockModerationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateCase mocks base method.
+func (m *MockModerationRepository) CreateCase(ctx context.Context, moderationCase *entity.ModerationCase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCase", ctx, moderationCase)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCase indicates an expected call of CreateCase.
+func (mr *MockModerationRepositoryMockRecorder) CreateCase(ctx, moderationCase interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCase", reflect.TypeOf((*MockModerationRepository)(nil).CreateCase), ctx, moderationCase)
+}
+
+// GetCaseByID mocks base method.
+func (m *MockModerationRepository) GetCaseByID(ctx context.Context, id string) (*entity.ModerationCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCaseByID", ctx, id)
+	ret0, _ := ret[0].(*entity.ModerationCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCaseByID indicates an expected call of GetCaseByID.
+func (mr *MockModerationRepositoryMockRecorder) GetCaseByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCaseByID", reflect.TypeOf((*MockModerationRepository)(nil).GetCaseByID), ctx, id)
+}
+
+// GetOpenCase mocks base method.
+func (m *MockModerationRepository) GetOpenCase(ctx context.Context, subjectType entity.ModerationSubjectType, subjectID string) (*entity.ModerationCase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenCase", ctx, subjectType, subjectID)
+	ret0, _ := ret[0].(*entity.ModerationCase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenCase indicates an expected call of GetOpenCase.
+func (mr *MockModerationRepositoryMockRecorder) GetOpenCase(ctx, subjectType, subjectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenCase", reflect.TypeOf((*MockModerationRepository)(nil).GetOpenCase), ctx, subjectType, subjectID)
+}
+
+// GetQueue mocks base method.
+func (m *MockModerationRepository) GetQueue(ctx context.Context, filter form.ModerationQueueGet) (entity.ModerationCases, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueue", ctx, filter)
+	ret0, _ := ret[0].(entity.ModerationCases)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetQueue indicates an expected call of GetQueue.
+func (mr *MockModerationRepositoryMockRecorder) GetQueue(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueue", reflect.TypeOf((*MockModerationRepository)(nil).GetQueue), ctx, filter)
+}
+
+// UpdateCase mocks base method.
+func (m *MockModerationRepository) UpdateCase(ctx context.Context, moderationCase *entity.ModerationCase) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCase", ctx, moderationCase)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCase indicates an expected call of UpdateCase.
+func (mr *MockModerationRepositoryMockRecorder) UpdateCase(ctx, moderationCase interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCase", reflect.TypeOf((*MockModerationRepository)(nil).UpdateCase), ctx, moderationCase)
+}
+
+// MockImportRunRepository is a mock of ImportRunRepository interface.
+type MockImportRunRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportRunRepositoryMockRecorder
+}
+
+// MockImportRunRepositoryMockRecorder is the mock recorder for MockImportRunRepository.
+type MockImportRunRepositoryMockRecorder struct {
+	mock *MockImportRunRepository
+}
+
+// NewMockImportRunRepository creates a new mock instance.
+func NewMockImportRunRepository(ctrl *gomock.Controller) *MockImportRunRepository {
+	mock := &MockImportRunRepository{ctrl: ctrl}
+	mock.recorder = &MockImportRunRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportRunRepository) EXPECT() *MockImportRunRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateImportRun mocks base method.
+func (m *MockImportRunRepository) CreateImportRun(ctx context.Context, run *entity.ImportRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImportRun", ctx, run)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateImportRun indicates an expected call of CreateImportRun.
+func (mr *MockImportRunRepositoryMockRecorder) CreateImportRun(ctx, run interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImportRun", reflect.TypeOf((*MockImportRunRepository)(nil).CreateImportRun), ctx, run)
+}
+
+// GetImportRun mocks base method.
+func (m *MockImportRunRepository) GetImportRun(ctx context.Context, id string) (*entity.ImportRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportRun", ctx, id)
+	ret0, _ := ret[0].(*entity.ImportRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImportRun indicates an expected call of GetImportRun.
+func (mr *MockImportRunRepositoryMockRecorder) GetImportRun(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportRun", reflect.TypeOf((*MockImportRunRepository)(nil).GetImportRun), ctx, id)
+}
+
+// UpdateImportRun mocks base method.
+func (m *MockImportRunRepository) UpdateImportRun(ctx context.Context, run *entity.ImportRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImportRun", ctx, run)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateImportRun indicates an expected call of UpdateImportRun.
+func (mr *MockImportRunRepositoryMockRecorder) UpdateImportRun(ctx, run interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImportRun", reflect.TypeOf((*MockImportRunRepository)(nil).UpdateImportRun), ctx, run)
+}
+
 // MockTxStarter is a mock of TxStarter interface.
 type MockTxStarter struct {
 	ctrl     *gomock.Controller
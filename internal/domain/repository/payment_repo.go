@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// PaymentProvider представляет интерфейс платежного провайдера.
+// Все операции с деньгами идемпотентны: повтор запроса с тем же ключом возвращает первый результат.
+type PaymentProvider interface {
+	// Authorize блокирует сумму на счете плательщика. Если провайдер отказал, возвращает entity.ErrPaymentDeclined.
+	Authorize(ctx context.Context, charge entity.PaymentCharge) (*entity.ProviderPayment, error)
+	// Capture списывает заблокированную сумму. Повторное списание возвращает текущее состояние платежа.
+	Capture(ctx context.Context, paymentID string) (*entity.ProviderPayment, error)
+	// Void снимает блокировку без списания.
+	Void(ctx context.Context, paymentID string) (*entity.ProviderPayment, error)
+	// Refund возвращает плательщику часть или всю списанную сумму.
+	Refund(ctx context.Context, paymentID string, amount entity.Money, idempotencyKey string) (*entity.PaymentRefund, error)
+	// VerifyWebhook проверяет подпись уведомления провайдера и возвращает его содержимое.
+	// Если подпись неверна или устарела, возвращает entity.ErrPaymentWebhookSignature.
+	VerifyWebhook(payload []byte, signature string, now time.Time) (*entity.PaymentWebhookEvent, error)
+}
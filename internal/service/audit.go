@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.com/example/gophers/libs/logger"
+	"gitlab.com/example/gophers/libs/trace"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// AuditService представляет интерфейс сервиса для просмотра журнала изменений.
+type AuditService interface {
+	// GetAuditEntries возвращает записи журнала изменений по фильтру и их общее количество. Доступно администраторам.
+	GetAuditEntries(ctx context.Context, filter form.AuditEntriesGet) (entity.AuditEntries, int64, error)
+}
+
+// auditService представляет сервис для просмотра журнала изменений.
+type auditService struct {
+	auditRepo repository.AuditRepository // Репозиторий журнала изменений
+	admins    entity.Admins              // Администраторы, которым доступен журнал
+	tracer    trace.TracerProvider       // Отслеживает запросы между слоями и микросервисами
+	logger    logger.Logger              // Логирование запросов и ошибок сервиса
+}
+
+// NewAuditService создает новый экземпляр сервиса журнала изменений.
+func NewAuditService(
+	auditRepo repository.AuditRepository,
+	admins entity.Admins,
+	l logger.Logger,
+	tracer trace.TracerProvider,
+) AuditService {
+	return &auditService{
+		auditRepo: auditRepo,
+		admins:    admins,
+		tracer:    tracer,
+		logger:    l.WithFields(logger.Fields{"layer": "audit-service"}),
+	}
+}
+
+// GetAuditEntries возвращает записи журнала изменений по фильтру и их общее количество.
+func (s *auditService) GetAuditEntries(ctx context.Context, filter form.AuditEntriesGet) (entity.AuditEntries, int64, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "AuditService.GetAuditEntries")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	if !s.admins.Contains(filter.RequesterID) {
+		return nil, 0, entity.ErrAuditForbidden
+	}
+
+	entries, count, err := s.auditRepo.GetAuditEntries(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение журнала изменений: %w", err)
+	}
+
+	return entries, count, nil
+}
+
+// recordAudit записывает в журнал изменение сущности от имени автора из контекста.
+// Вызывается в той же транзакции, что и само изменение, поэтому изменение без записи в журнале не сохранится.
+// Изменение без измененных полей не записывается.
+func recordAudit(
+	ctx context.Context,
+	auditRepo repository.AuditRepository,
+	entityType entity.AuditEntityType,
+	entityID string,
+	action entity.AuditAction,
+	before, after any,
+	currentTime time.Time,
+) error {
+	changes, err := entity.DiffAudit(before, after)
+	if err != nil {
+		return fmt.Errorf("сравнение состояний %s %s: %w", entityType, entityID, err)
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	entry := entity.NewAuditEntry(ctx, entityType, entityID, action, changes, currentTime)
+	if err = auditRepo.CreateAuditEntry(ctx, entry); err != nil {
+		return fmt.Errorf("запись в журнал изменений: %w", err)
+	}
+
+	return nil
+}
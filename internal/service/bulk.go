@@ -0,0 +1,445 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"gitlab.com/example/gophers/libs/logger"
+	"gitlab.com/example/gophers/libs/trace"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// defaultImportBatchSize количество строк в пачке импорта, если оно не задано.
+const defaultImportBatchSize = 500
+
+// ImportReporter получает результат каждой обработанной строки импорта в порядке строк файла.
+// Результаты пачки передаются после того, как пачка сохранена и запуск обновлен.
+type ImportReporter func(result entity.ImportRowResult) error
+
+// BulkService представляет интерфейс сервиса массового импорта и экспорта пользователей и заказов.
+// Доступен администраторам.
+type BulkService interface {
+	// StartImport создает запуск импорта или возвращает прерванный запуск для продолжения.
+	StartImport(ctx context.Context, startForm form.ImportStart, currentTime time.Time) (*entity.ImportRun, error)
+	// ImportUsers читает строки пользователей, проверяет каждую формой form.UserCreate и сохраняет пачками.
+	// Строки, обработанные прерванной попыткой запуска, пропускаются.
+	ImportUsers(ctx context.Context, run *entity.ImportRun, rows *form.BulkDecoder, report ImportReporter, currentTime time.Time) error
+	// ImportOrders читает строки заказов, проверяет каждую формой form.OrderCreate и сохраняет пачками.
+	// Строки, обработанные прерванной попыткой запуска, пропускаются.
+	ImportOrders(ctx context.Context, run *entity.ImportRun, rows *form.BulkDecoder, report ImportReporter, currentTime time.Time) error
+	// ExportUsers передает в fn пользователей по фильтру в порядке создания.
+	ExportUsers(ctx context.Context, filter form.UsersExport, fn func(*entity.User) error) error
+	// ExportOrders передает в fn заказы по фильтру в порядке создания.
+	ExportOrders(ctx context.Context, filter form.OrdersExport, fn func(*entity.Order) error) error
+}
+
+// bulkService представляет сервис массового импорта и экспорта.
+type bulkService struct {
+	userRepo      repository.UserRepository      // Репозиторий пользователей
+	ordersRepo    repository.OrdersRepository    // Репозиторий заказов
+	importRunRepo repository.ImportRunRepository // Репозиторий запусков импорта
+	auditRepo     repository.AuditRepository     // Журнал изменений
+	txStarter     repository.TxStarter           // Транзакции
+	moderator     ContentModerator               // Проверка биографий
+	currencies    entity.Currencies              // Допустимые валюты заказов
+	admins        entity.Admins                  // Администраторы, которым доступен импорт и экспорт
+	batchSize     int                            // Количество строк в пачке
+	tracer        trace.TracerProvider           // Отслеживает запросы между слоями и микросервисами
+	logger        logger.Logger                  // Логирование запросов и ошибок сервиса
+}
+
+// NewBulkService создает новый экземпляр сервиса массового импорта и экспорта.
+func NewBulkService(
+	userRepo repository.UserRepository,
+	ordersRepo repository.OrdersRepository,
+	importRunRepo repository.ImportRunRepository,
+	auditRepo repository.AuditRepository,
+	txStarter repository.TxStarter,
+	moderator ContentModerator,
+	currencies entity.Currencies,
+	admins entity.Admins,
+	batchSize int,
+	l logger.Logger,
+	tracer trace.TracerProvider,
+) BulkService {
+	if batchSize <= 0 {
+		batchSize = defaultImportBatchSize
+	}
+
+	return &bulkService{
+		userRepo:      userRepo,
+		ordersRepo:    ordersRepo,
+		importRunRepo: importRunRepo,
+		auditRepo:     auditRepo,
+		txStarter:     txStarter,
+		moderator:     moderator,
+		currencies:    currencies,
+		admins:        admins,
+		batchSize:     batchSize,
+		tracer:        tracer,
+		logger:        l.WithFields(logger.Fields{"layer": "bulk-service"}),
+	}
+}
+
+// StartImport создает запуск импорта или возвращает прерванный запуск для продолжения.
+// Продолжить можно только свой запуск того же вида сущностей.
+func (s *bulkService) StartImport(ctx context.Context, startForm form.ImportStart, currentTime time.Time) (*entity.ImportRun, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "BulkService.StartImport")
+	defer span.End()
+
+	if err := startForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	if !s.admins.Contains(startForm.RequesterID) {
+		return nil, entity.ErrBulkForbidden
+	}
+
+	if startForm.RunID == "" {
+		run := entity.NewImportRun(startForm.Kind, startForm.RequesterID, currentTime)
+		if err := s.importRunRepo.CreateImportRun(ctx, run); err != nil {
+			return nil, fmt.Errorf("создание запуска импорта: %w", err)
+		}
+
+		return run, nil
+	}
+
+	run, err := s.importRunRepo.GetImportRun(ctx, startForm.RunID)
+	if err != nil {
+		return nil, fmt.Errorf("получение запуска импорта: %w", err)
+	}
+
+	if run.Kind != startForm.Kind || run.RequesterID != startForm.RequesterID {
+		return nil, entity.ErrImportRunMismatch
+	}
+
+	return run, nil
+}
+
+// ImportUsers импортирует пользователей. Биография каждого пользователя проверяется модерацией,
+// как при создании через API.
+func (s *bulkService) ImportUsers(
+	ctx context.Context,
+	run *entity.ImportRun,
+	rows *form.BulkDecoder,
+	report ImportReporter,
+	currentTime time.Time,
+) error {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "BulkService.ImportUsers")
+	defer span.End()
+
+	return runImport(ctx, s, run, rows, report, currentTime, importer[form.UserCreate, userImport]{
+		decode: func(rows *form.BulkDecoder) (form.UserCreate, error) {
+			var createForm form.UserCreate
+			err := rows.Next(&createForm)
+
+			return createForm, err
+		},
+		prepare: s.prepareUser,
+		id:      func(item userImport) string { return item.user.ID },
+		save: func(ctx context.Context, items []userImport) (map[int]error, error) {
+			users := make([]*entity.User, 0, len(items))
+			for _, item := range items {
+				users = append(users, item.user)
+			}
+
+			return s.userRepo.CreateUsers(ctx, users)
+		},
+		created: func(ctx context.Context, item userImport, currentTime time.Time) error {
+			if item.user.Moderation == entity.ModerationStatusPending {
+				err := s.moderator.Flag(ctx, item.user.ModerationContent(currentTime), item.violations, currentTime)
+				if err != nil {
+					return fmt.Errorf("отправка биографии на модерацию: %w", err)
+				}
+			}
+
+			return recordAudit(ctx, s.auditRepo, entity.AuditEntityUser, item.user.ID, entity.AuditActionCreate, nil, item.user, currentTime)
+		},
+	})
+}
+
+// userImport пользователь из строки импорта вместе с нарушениями в биографии.
+type userImport struct {
+	user       *entity.User                // Пользователь
+	violations entity.ModerationViolations // Нарушения, найденные в биографии
+}
+
+// prepareUser проверяет строку пользователя и создает пользователя с идентификатором id.
+func (s *bulkService) prepareUser(ctx context.Context, createForm form.UserCreate, id string, currentTime time.Time) (userImport, error) {
+	if err := createForm.Validate(); err != nil {
+		return userImport{}, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	user := entity.NewUser(currentTime)
+	if err := createForm.Fill(user); err != nil {
+		return userImport{}, fmt.Errorf("заполнение формы: %w", err)
+	}
+
+	user.ID = id
+
+	var (
+		violations entity.ModerationViolations
+		err        error
+	)
+
+	user.Moderation, violations, err = s.moderator.Screen(ctx, user.ModerationContent(currentTime), "")
+	if err != nil {
+		return userImport{}, fmt.Errorf("проверка биографии: %w", err)
+	}
+
+	return userImport{user: user, violations: violations}, nil
+}
+
+// ImportOrders импортирует заказы. Валюта каждого заказа проверяется так же, как при создании через API.
+func (s *bulkService) ImportOrders(
+	ctx context.Context,
+	run *entity.ImportRun,
+	rows *form.BulkDecoder,
+	report ImportReporter,
+	currentTime time.Time,
+) error {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "BulkService.ImportOrders")
+	defer span.End()
+
+	return runImport(ctx, s, run, rows, report, currentTime, importer[form.OrderCreate, *entity.Order]{
+		decode: func(rows *form.BulkDecoder) (form.OrderCreate, error) {
+			var row form.OrderImport
+			err := rows.Next(&row)
+
+			return row.Form(), err
+		},
+		prepare: s.prepareOrder,
+		id:      func(order *entity.Order) string { return order.ID },
+		save:    s.ordersRepo.CreateOrders,
+		created: func(ctx context.Context, order *entity.Order, currentTime time.Time) error {
+			return recordAudit(ctx, s.auditRepo, entity.AuditEntityOrder, order.ID, entity.AuditActionCreate, nil, order, currentTime)
+		},
+	})
+}
+
+// prepareOrder проверяет строку заказа и создает заказ с идентификатором id.
+func (s *bulkService) prepareOrder(_ context.Context, createForm form.OrderCreate, id string, currentTime time.Time) (*entity.Order, error) {
+	if err := createForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	if err := s.currencies.Validate(createForm.Cost.Currency); err != nil {
+		return nil, fmt.Errorf("валидация валюты: %w", err)
+	}
+
+	order := entity.NewOrder(currentTime)
+	if err := createForm.Fill(order); err != nil {
+		return nil, fmt.Errorf("заполнение сущности заказа: %w", err)
+	}
+
+	order.ID = id
+	order.History = entity.OrderHistory{
+		entity.NewOrderStatusChange(order.UserID, "", order.Status, "", currentTime),
+	}
+
+	return order, nil
+}
+
+// ExportUsers передает в fn пользователей по фильтру в порядке создания.
+func (s *bulkService) ExportUsers(ctx context.Context, filter form.UsersExport, fn func(*entity.User) error) error {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "BulkService.ExportUsers")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	if !s.admins.Contains(filter.RequesterID) {
+		return entity.ErrBulkForbidden
+	}
+
+	if err := s.userRepo.ExportUsers(ctx, filter, fn); err != nil {
+		return fmt.Errorf("экспорт пользователей: %w", err)
+	}
+
+	return nil
+}
+
+// ExportOrders передает в fn заказы по фильтру в порядке создания.
+func (s *bulkService) ExportOrders(ctx context.Context, filter form.OrdersExport, fn func(*entity.Order) error) error {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "BulkService.ExportOrders")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	if !s.admins.Contains(filter.RequesterID) {
+		return entity.ErrBulkForbidden
+	}
+
+	if err := s.ordersRepo.ExportOrders(ctx, filter, fn); err != nil {
+		return fmt.Errorf("экспорт заказов: %w", err)
+	}
+
+	return nil
+}
+
+// importer разбирает, проверяет и сохраняет строки одного вида сущностей.
+// F - форма строки, T - подготовленная к сохранению сущность.
+type importer[F, T any] struct {
+	decode  func(rows *form.BulkDecoder) (F, error)                                       // Читает следующую строку
+	prepare func(ctx context.Context, row F, id string, currentTime time.Time) (T, error) // Проверяет строку и создает сущность
+	id      func(item T) string                                                           // Возвращает идентификатор сущности
+	save    func(ctx context.Context, items []T) (map[int]error, error)                   // Сохраняет пачку
+	created func(ctx context.Context, item T, currentTime time.Time) error                // Вызывается для каждой созданной сущности
+}
+
+// importBatch пачка строк: результаты в порядке строк и сущности, прошедшие проверку.
+type importBatch[T any] struct {
+	results []entity.ImportRowResult // Результаты строк пачки
+	items   []T                      // Сущности к сохранению
+	rows    []int                    // Индексы результатов сущностей
+}
+
+// runImport читает строки и сохраняет их пачками. После каждой пачки запуск сохраняется
+// с номером последней обработанной строки и результаты пачки передаются в report.
+// Ошибка разбора или проверки строки попадает в отчет и не прерывает импорт.
+func runImport[F, T any](
+	ctx context.Context,
+	s *bulkService,
+	run *entity.ImportRun,
+	rows *form.BulkDecoder,
+	report ImportReporter,
+	currentTime time.Time,
+	imp importer[F, T],
+) error {
+	var batch importBatch[T]
+
+	for {
+		row, err := imp.decode(rows)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		number := rows.Row()
+		if run.IsProcessed(number) {
+			continue
+		}
+
+		if err != nil && !errors.Is(err, entity.ErrImportRowDecode) {
+			return fmt.Errorf("чтение строки %d: %w", number, err)
+		}
+
+		if err == nil {
+			var item T
+			if item, err = imp.prepare(ctx, row, run.DocumentID(number), currentTime); err == nil {
+				batch.rows = append(batch.rows, len(batch.results))
+				batch.items = append(batch.items, item)
+			}
+		}
+
+		batch.results = append(batch.results, entity.NewImportRowResult(number, "", err))
+
+		if len(batch.results) >= s.batchSize {
+			if err = flushImport(ctx, s, run, &batch, report, currentTime, imp); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := flushImport(ctx, s, run, &batch, report, currentTime, imp); err != nil {
+		return err
+	}
+
+	run.Complete(currentTime)
+
+	if err := s.importRunRepo.UpdateImportRun(ctx, run); err != nil {
+		return fmt.Errorf("завершение запуска импорта: %w", err)
+	}
+
+	return nil
+}
+
+// flushImport сохраняет пачку, обновляет запуск и передает результаты строк в report.
+// Пачка, журнал изменений и модерация созданных сущностей и номер обработанной строки запуска
+// сохраняются в одной транзакции: при ошибке пачка не сохраняется и повтор запуска обработает ее заново.
+func flushImport[F, T any](
+	ctx context.Context,
+	s *bulkService,
+	run *entity.ImportRun,
+	batch *importBatch[T],
+	report ImportReporter,
+	currentTime time.Time,
+	imp importer[F, T],
+) error {
+	if len(batch.results) == 0 {
+		return nil
+	}
+
+	txCtx, done, err := s.txStarter.StartSession(ctx)
+	if err != nil {
+		return fmt.Errorf("начало транзакции: %w", err)
+	}
+
+	// Запуск обновляется только после фиксации транзакции, чтобы итог прерванного импорта
+	// не учитывал несохраненную пачку.
+	updated := *run
+
+	err = flushInTx(txCtx, s, &updated, batch, currentTime, imp)
+	if err = done(txCtx, err); err != nil {
+		return fmt.Errorf("сохранение пачки строк %d-%d: %w", batch.results[0].Row, batch.results[len(batch.results)-1].Row, err)
+	}
+
+	*run = updated
+
+	for _, result := range batch.results {
+		if err = report(result); err != nil {
+			return fmt.Errorf("отчет о строке %d: %w", result.Row, err)
+		}
+	}
+
+	*batch = importBatch[T]{}
+
+	return nil
+}
+
+// flushInTx сохраняет пачку, журнал изменений и модерацию созданных сущностей и обновляет запуск
+// в рамках транзакции.
+func flushInTx[F, T any](
+	ctx context.Context,
+	s *bulkService,
+	run *entity.ImportRun,
+	batch *importBatch[T],
+	currentTime time.Time,
+	imp importer[F, T],
+) error {
+	errs, err := imp.save(ctx, batch.items)
+	if err != nil {
+		return err
+	}
+
+	for i, item := range batch.items {
+		result := entity.NewImportRowResult(batch.results[batch.rows[i]].Row, imp.id(item), errs[i])
+		batch.results[batch.rows[i]] = result
+
+		if result.Status != entity.ImportRowCreated {
+			continue
+		}
+
+		if err = imp.created(ctx, item, currentTime); err != nil {
+			return fmt.Errorf("строка %d: %w", result.Row, err)
+		}
+	}
+
+	for _, result := range batch.results {
+		run.Record(result, currentTime)
+	}
+
+	if err = s.importRunRepo.UpdateImportRun(ctx, run); err != nil {
+		return fmt.Errorf("обновление запуска импорта: %w", err)
+	}
+
+	return nil
+}
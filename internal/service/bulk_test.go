@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+	"github.com/alisher-99/LomBarter/internal/domain/repository/mock_repo"
+)
+
+func TestBulkService_ImportOrders(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	rows := `{"userID": "` + testProposerID + `", "cost": {"amount": 5000, "currency": "KZT"}}
+{"userID": "` + testRecipientID + `", "cost": {"amount": 7000, "currency": "KZT"}}
+`
+
+	cases := []struct {
+		name     string
+		auditErr error
+	}{
+		{
+			name: "пачка сохранена вместе с журналом изменений",
+		},
+		{
+			name:     "ошибка журнала изменений откатывает пачку",
+			auditErr: errors.New("журнал недоступен"),
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			orders := mock_repo.NewMockOrdersRepository(ctrl)
+			runs := mock_repo.NewMockImportRunRepository(ctrl)
+			audit := mock_repo.NewMockAuditRepository(ctrl)
+			tx := mock_repo.NewMockTxStarter(ctrl)
+
+			var committed bool
+
+			tx.EXPECT().StartSession(gomock.Any()).DoAndReturn(
+				func(ctx context.Context) (context.Context, repository.TxCallback, error) {
+					return ctx, func(_ context.Context, err error) error {
+						committed = err == nil
+
+						return err
+					}, nil
+				},
+			)
+
+			currencies, err := entity.NewCurrencies([]string{"KZT"})
+			require.NoError(t, err)
+
+			run := entity.NewImportRun(entity.BulkKindOrders, testProposerID, now.Add(-time.Hour))
+			run.ID = testOrderID
+
+			orders.EXPECT().CreateOrders(gomock.Any(), gomock.Len(2)).DoAndReturn(
+				func(_ context.Context, items []*entity.Order) (map[int]error, error) {
+					for _, order := range items {
+						assert.Equal(t, now, order.CreatedAt)
+					}
+
+					return map[int]error{1: entity.ErrImportRowExists}, nil
+				},
+			)
+			audit.EXPECT().CreateAuditEntry(gomock.Any(), gomock.Any()).Return(s.auditErr)
+
+			if s.auditErr == nil {
+				runs.EXPECT().UpdateImportRun(gomock.Any(), gomock.Any()).Times(2).Return(nil)
+			}
+
+			svc := NewBulkService(
+				mock_repo.NewMockUserRepository(ctrl), orders, runs, audit, tx, nil,
+				currencies, entity.NewAdmins([]string{testProposerID}), 2, testLogger(t), trace.NewNoopTracerProvider(),
+			)
+
+			decoder, err := form.NewBulkDecoder(strings.NewReader(rows), entity.BulkFormatNDJSON)
+			require.NoError(t, err)
+
+			var reported []entity.ImportRowResult
+
+			err = svc.ImportOrders(context.Background(), run, decoder, func(result entity.ImportRowResult) error {
+				reported = append(reported, result)
+
+				return nil
+			}, now)
+
+			if s.auditErr != nil {
+				assert.ErrorIs(t, err, s.auditErr)
+				assert.False(t, committed)
+				assert.Empty(t, reported, "строки несохраненной пачки не попадают в отчет")
+				assert.Zero(t, run.Checkpoint, "запуск продолжится с несохраненной пачки")
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, committed)
+			require.Len(t, reported, 2)
+			assert.Equal(t, entity.ImportRowCreated, reported[0].Status)
+			assert.Equal(t, entity.ImportRowExists, reported[1].Status)
+			assert.Equal(t, int64(1), run.Created)
+			assert.Equal(t, int64(1), run.Existed)
+			assert.Equal(t, entity.ImportRunStatusCompleted, run.Status)
+			assert.Equal(t, now, run.UpdatedAt)
+		})
+	}
+}
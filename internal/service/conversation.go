@@ -0,0 +1,310 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.com/example/gophers/libs/logger"
+	"gitlab.com/example/gophers/libs/trace"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+	"github.com/alisher-99/LomBarter/internal/service/notifier"
+)
+
+// ConversationService представляет интерфейс сервиса для работы с переписками по сделкам.
+type ConversationService interface {
+	// OpenConversation возвращает переписку по сделке, создавая ее при первом обращении.
+	OpenConversation(ctx context.Context, createForm form.ConversationCreate, currentTime time.Time) (*entity.Conversation, error)
+	// GetConversation возвращает переписку участнику.
+	GetConversation(ctx context.Context, filter form.ConversationGet) (*entity.Conversation, error)
+	// GetConversations возвращает переписки пользователя и их общее количество.
+	GetConversations(ctx context.Context, filter form.ConversationsGet) (entity.Conversations, int64, error)
+	// SendMessage отправляет сообщение в переписку и уведомляет остальных участников.
+	SendMessage(ctx context.Context, createForm form.MessageCreate, currentTime time.Time) (*entity.Message, error)
+	// GetMessages возвращает страницу истории сообщений и курсор следующей страницы.
+	GetMessages(ctx context.Context, filter form.MessagesGet) (entity.Messages, []byte, error)
+	// MarkRead отмечает переписку прочитанной пользователем.
+	MarkRead(ctx context.Context, filter form.ConversationGet, currentTime time.Time) error
+}
+
+// ConversationOptions ограничения переписок.
+type ConversationOptions struct {
+	RateLimit  int           // Максимальное количество сообщений отправителя в переписке за окно
+	RateWindow time.Duration // Окно ограничения частоты сообщений
+}
+
+// conversationService представляет сервис для работы с переписками по сделкам.
+type conversationService struct {
+	convRepo   repository.ConversationRepository // Репозиторий переписок
+	ordersRepo repository.OrdersRepository       // Репозиторий заказов
+	offerRepo  repository.TradeOfferRepository   // Репозиторий предложений обмена
+	notifier   notifier.Notifier                 // Уведомления о новых сообщениях
+	opts       ConversationOptions               // Ограничения переписок
+	tracer     trace.TracerProvider              // Отслеживает запросы между слоями и микросервисами
+	logger     logger.Logger                     // Логирование запросов и ошибок сервиса
+}
+
+// NewConversationService создает новый экземпляр сервиса для работы с переписками по сделкам.
+func NewConversationService(
+	convRepo repository.ConversationRepository,
+	ordersRepo repository.OrdersRepository,
+	offerRepo repository.TradeOfferRepository,
+	n notifier.Notifier,
+	opts ConversationOptions,
+	l logger.Logger,
+	tracer trace.TracerProvider,
+) ConversationService {
+	return &conversationService{
+		convRepo:   convRepo,
+		ordersRepo: ordersRepo,
+		offerRepo:  offerRepo,
+		notifier:   n,
+		opts:       opts,
+		tracer:     tracer,
+		logger:     l.WithFields(logger.Fields{"layer": "conversation-service"}),
+	}
+}
+
+// OpenConversation возвращает переписку по сделке, создавая ее при первом обращении.
+// Открыть переписку может только сторона сделки.
+func (s *conversationService) OpenConversation(
+	ctx context.Context,
+	createForm form.ConversationCreate,
+	currentTime time.Time,
+) (*entity.Conversation, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "ConversationService.OpenConversation")
+	defer span.End()
+
+	if err := createForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	participantIDs, err := s.subjectParticipants(ctx, createForm)
+	if err != nil {
+		return nil, err
+	}
+
+	conversation, err := s.convRepo.GetConversationBySubject(ctx, createForm.SubjectType, createForm.SubjectID)
+	if err == nil {
+		return conversation, nil
+	}
+
+	if !errors.Is(err, entity.ErrConversationNotFound) {
+		return nil, fmt.Errorf("получение переписки: %w", err)
+	}
+
+	conversation = entity.NewConversation(createForm.SubjectType, createForm.SubjectID, participantIDs, currentTime)
+
+	err = s.convRepo.CreateConversation(ctx, conversation)
+	// Вторая сторона успела открыть переписку одновременно с нами.
+	if errors.Is(err, entity.ErrConversationExists) {
+		conversation, err = s.convRepo.GetConversationBySubject(ctx, createForm.SubjectType, createForm.SubjectID)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("создание переписки: %w", err)
+	}
+
+	return conversation, nil
+}
+
+// subjectParticipants возвращает стороны сделки, если пользователь одна из них.
+func (s *conversationService) subjectParticipants(ctx context.Context, createForm form.ConversationCreate) ([]string, error) {
+	switch createForm.SubjectType {
+	case entity.ConversationSubjectOrder:
+		// Заказ возвращается только его сторонам.
+		order, err := s.ordersRepo.GetOrderForClient(ctx, form.OrderGetForClient{
+			OrderID: createForm.SubjectID,
+			UserID:  createForm.UserID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("получение заказа: %w", err)
+		}
+
+		if order.CounterpartyID == "" {
+			return nil, entity.ErrConversationNoPeer
+		}
+
+		return []string{order.UserID, order.CounterpartyID}, nil
+	case entity.ConversationSubjectTradeOffer:
+		offer, err := s.offerRepo.GetTradeOfferByID(ctx, createForm.SubjectID)
+		if err != nil {
+			return nil, fmt.Errorf("получение предложения обмена: %w", err)
+		}
+
+		// Чужие предложения не раскрываем.
+		if !offer.IsParticipant(createForm.UserID) {
+			return nil, entity.ErrTradeOfferNotFound
+		}
+
+		return []string{offer.ProposerID, offer.RecipientID}, nil
+	default:
+		return nil, fmt.Errorf("неизвестный тип сделки %q", createForm.SubjectType)
+	}
+}
+
+// GetConversation возвращает переписку участнику.
+func (s *conversationService) GetConversation(ctx context.Context, filter form.ConversationGet) (*entity.Conversation, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "ConversationService.GetConversation")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	return s.participantConversation(ctx, filter)
+}
+
+// participantConversation возвращает переписку, если пользователь ее участник.
+func (s *conversationService) participantConversation(
+	ctx context.Context,
+	filter form.ConversationGet,
+) (*entity.Conversation, error) {
+	conversation, err := s.convRepo.GetConversationByID(ctx, filter.ConversationID)
+	if err != nil {
+		return nil, fmt.Errorf("получение переписки: %w", err)
+	}
+
+	// Чужие переписки не раскрываем.
+	if !conversation.IsParticipant(filter.UserID) {
+		return nil, entity.ErrConversationNotFound
+	}
+
+	return conversation, nil
+}
+
+// GetConversations возвращает переписки пользователя и их общее количество.
+func (s *conversationService) GetConversations(
+	ctx context.Context,
+	filter form.ConversationsGet,
+) (entity.Conversations, int64, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "ConversationService.GetConversations")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	conversations, count, err := s.convRepo.GetConversations(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение списка переписок: %w", err)
+	}
+
+	return conversations, count, nil
+}
+
+// SendMessage отправляет сообщение в переписку и уведомляет остальных участников.
+// Отправитель ограничен RateLimit сообщениями за RateWindow в одной переписке.
+func (s *conversationService) SendMessage(
+	ctx context.Context,
+	createForm form.MessageCreate,
+	currentTime time.Time,
+) (*entity.Message, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "ConversationService.SendMessage")
+	defer span.End()
+
+	if err := createForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	conversation, err := s.participantConversation(ctx, createForm.ToConversationGet())
+	if err != nil {
+		return nil, err
+	}
+
+	if s.opts.RateLimit > 0 {
+		sent, err := s.convRepo.CountMessagesSince(
+			ctx, conversation.ID, createForm.SenderID, currentTime.Add(-s.opts.RateWindow),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("подсчет отправленных сообщений: %w", err)
+		}
+
+		if sent >= int64(s.opts.RateLimit) {
+			return nil, entity.ErrMessageThrottled
+		}
+	}
+
+	message := entity.NewMessage(currentTime)
+
+	if err = createForm.Fill(message); err != nil {
+		return nil, fmt.Errorf("заполнение формы: %w", err)
+	}
+
+	if err = s.convRepo.CreateMessage(ctx, message); err != nil {
+		return nil, fmt.Errorf("сохранение сообщения: %w", err)
+	}
+
+	// Сообщение уже сохранено, получатель увидит его в истории, поэтому ошибка уведомления только логируется.
+	for _, recipientID := range conversation.Recipients(message.SenderID) {
+		if err = s.notifier.Notify(ctx, newMessageNotification(conversation, message, recipientID)); err != nil {
+			s.logger.Errorf("уведомление пользователя %s о сообщении: %v", recipientID, err)
+		}
+	}
+
+	return message, nil
+}
+
+// GetMessages возвращает страницу истории сообщений и курсор следующей страницы.
+func (s *conversationService) GetMessages(ctx context.Context, filter form.MessagesGet) (entity.Messages, []byte, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "ConversationService.GetMessages")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	conversation, err := s.participantConversation(ctx, filter.ToConversationGet())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	messages, state, err := s.convRepo.GetMessages(ctx, filter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("получение сообщений: %w", err)
+	}
+
+	messages.FillReadBy(conversation)
+
+	return messages, state, nil
+}
+
+// MarkRead отмечает переписку прочитанной пользователем.
+func (s *conversationService) MarkRead(ctx context.Context, filter form.ConversationGet, currentTime time.Time) error {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "ConversationService.MarkRead")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	if _, err := s.participantConversation(ctx, filter); err != nil {
+		return err
+	}
+
+	if err := s.convRepo.MarkConversationRead(ctx, filter.ConversationID, filter.UserID, currentTime); err != nil {
+		return fmt.Errorf("отметка о прочтении: %w", err)
+	}
+
+	return nil
+}
+
+// newMessageNotification создает уведомление о новом сообщении в переписке.
+func newMessageNotification(conversation *entity.Conversation, message *entity.Message, recipientID string) entity.Notification {
+	return entity.Notification{
+		UserID: recipientID,
+		Kind:   entity.NotificationKindMessage,
+		Data: map[string]string{
+			"conversationID": conversation.ID,
+			"messageID":      message.ID,
+			"subjectType":    string(conversation.SubjectType),
+			"subjectID":      conversation.SubjectID,
+			"text":           message.Text,
+		},
+		CreatedAt: message.CreatedAt,
+	}
+}
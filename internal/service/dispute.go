@@ -0,0 +1,639 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"gitlab.com/example/gophers/libs/kafka/producer"
+	"gitlab.com/example/gophers/libs/logger"
+	"gitlab.com/example/gophers/libs/trace"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// disputeOverdueBatch количество споров, просрочка которых отмечается за один проход.
+const disputeOverdueBatch = 100
+
+// DisputeService представляет интерфейс сервиса споров по заказам.
+type DisputeService interface {
+	// OpenDispute открывает спор участника заказа против второй стороны.
+	OpenDispute(ctx context.Context, createForm form.DisputeCreate, currentTime time.Time) (*entity.Dispute, error)
+	// GetDispute возвращает спор стороне спора или модератору.
+	GetDispute(ctx context.Context, filter form.DisputeGet) (*entity.Dispute, error)
+	// GetDisputes возвращает споры, в которых пользователь является стороной, и их общее количество.
+	GetDisputes(ctx context.Context, filter form.DisputesGet) (entity.Disputes, int64, error)
+	// AddEvidence прикладывает к спору загруженный стороной медиафайл.
+	AddEvidence(ctx context.Context, evidenceForm form.DisputeEvidenceAdd, currentTime time.Time) (*entity.Dispute, error)
+	// SendMessage отправляет сообщение в переписку сторон спора с модератором.
+	SendMessage(ctx context.Context, messageForm form.DisputeMessageCreate, currentTime time.Time) (*entity.DisputeMessage, error)
+	// GetMessages возвращает переписку по спору и общее количество сообщений.
+	GetMessages(ctx context.Context, filter form.DisputeMessagesGet) (entity.DisputeMessages, int64, error)
+	// WithdrawDispute отзывает нерешенный спор его автором.
+	WithdrawDispute(ctx context.Context, action form.DisputeGet, currentTime time.Time) (*entity.Dispute, error)
+	// GetQueue возвращает модератору очередь нерешенных споров и их общее количество.
+	GetQueue(ctx context.Context, filter form.DisputeQueueGet) (entity.Disputes, int64, error)
+	// AssignDispute передает спор модератору.
+	AssignDispute(ctx context.Context, assignForm form.DisputeAssign, currentTime time.Time) (*entity.Dispute, error)
+	// ResolveDispute выносит решение по спору и применяет его последствия:
+	// возврат оплаты, списание кредитов и штраф к репутации второй стороны.
+	ResolveDispute(ctx context.Context, resolveForm form.DisputeResolve, currentTime time.Time) (*entity.Dispute, error)
+	// MarkOverdue отмечает споры, срок рассмотрения которых наступил, и возвращает их количество.
+	MarkOverdue(ctx context.Context, currentTime time.Time) (int, error)
+	// WatchSLA раз в interval отмечает просроченные споры, пока не отменен контекст.
+	WatchSLA(ctx context.Context, interval time.Duration) error
+}
+
+// disputeService представляет сервис споров по заказам.
+type disputeService struct {
+	disputeRepo repository.DisputeRepository // Репозиторий споров
+	ordersRepo  repository.OrdersRepository  // Репозиторий заказов
+	paymentRepo repository.PaymentRepository // Репозиторий платежей для возвратов
+	ledgerRepo  repository.LedgerRepository  // Репозиторий счетов для списания кредитов
+	userRepo    repository.UserRepository    // Репозиторий пользователей для штрафов к репутации
+	mediaRepo   repository.MediaRepository   // Репозиторий медиафайлов доказательств
+	cacheData   repository.CacheStore        // Кэш пользователей
+	txStarter   repository.TxStarter         // Запуск транзакций
+	payments    repository.PaymentProvider   // Платежный провайдер
+	admins      entity.Admins                // Администраторы, которые рассматривают споры
+	sla         entity.DisputeSLA            // Сроки рассмотрения споров
+	producer    producer.MessageProducer     // Продюсер событий споров
+	tracer      trace.TracerProvider         // Отслеживает запросы между слоями и микросервисами
+	logger      logger.Logger                // Логирование запросов и ошибок сервиса
+	json        jsoniter.API                 // JSON-парсер
+}
+
+// NewDisputeService создает новый экземпляр сервиса споров по заказам.
+func NewDisputeService(
+	disputeRepo repository.DisputeRepository,
+	ordersRepo repository.OrdersRepository,
+	paymentRepo repository.PaymentRepository,
+	ledgerRepo repository.LedgerRepository,
+	userRepo repository.UserRepository,
+	mediaRepo repository.MediaRepository,
+	cacheData repository.CacheStore,
+	txStarter repository.TxStarter,
+	payments repository.PaymentProvider,
+	admins entity.Admins,
+	sla entity.DisputeSLA,
+	kafkaProducer producer.MessageProducer,
+	l logger.Logger,
+	tracer trace.TracerProvider,
+) DisputeService {
+	return &disputeService{
+		disputeRepo: disputeRepo,
+		ordersRepo:  ordersRepo,
+		paymentRepo: paymentRepo,
+		ledgerRepo:  ledgerRepo,
+		userRepo:    userRepo,
+		mediaRepo:   mediaRepo,
+		cacheData:   cacheData,
+		txStarter:   txStarter,
+		payments:    payments,
+		admins:      admins,
+		sla:         sla,
+		producer:    kafkaProducer,
+		tracer:      tracer,
+		logger:      l.WithFields(logger.Fields{"layer": "dispute-service"}),
+		json:        jsoniter.ConfigCompatibleWithStandardLibrary,
+	}
+}
+
+// OpenDispute открывает спор по заказу. Заказ ищется среди заказов пользователя,
+// поэтому открыть спор по чужому заказу нельзя. По заказу открывается только один спор.
+func (s *disputeService) OpenDispute(
+	ctx context.Context,
+	createForm form.DisputeCreate,
+	currentTime time.Time,
+) (*entity.Dispute, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "DisputeService.OpenDispute")
+	defer span.End()
+
+	if err := createForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	order, err := s.ordersRepo.GetOrderForClient(ctx, createForm.ToOrderGetForClient())
+	if err != nil {
+		return nil, fmt.Errorf("получение заказа: %w", err)
+	}
+
+	dispute, err := entity.NewDispute(order, createForm.UserID, s.sla, currentTime)
+	if err != nil {
+		return nil, err
+	}
+
+	dispute.Reason = createForm.Reason
+	dispute.Description = createForm.Description
+
+	if err = s.disputeRepo.CreateDispute(ctx, dispute); err != nil {
+		return nil, fmt.Errorf("сохранение спора: %w", err)
+	}
+
+	s.logger.WithFields(logger.Fields{"dispute_id": dispute.ID, "order_id": order.ID}).Info("открыт спор")
+	s.publish(ctx, dispute, entity.DisputeEventOpened, createForm.UserID, currentTime)
+
+	return dispute, nil
+}
+
+// GetDispute возвращает спор стороне спора или модератору.
+func (s *disputeService) GetDispute(ctx context.Context, filter form.DisputeGet) (*entity.Dispute, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "DisputeService.GetDispute")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	return s.accessibleDispute(ctx, filter.DisputeID, filter.UserID)
+}
+
+// GetDisputes возвращает споры, в которых пользователь является стороной.
+func (s *disputeService) GetDisputes(ctx context.Context, filter form.DisputesGet) (entity.Disputes, int64, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "DisputeService.GetDisputes")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	disputes, count, err := s.disputeRepo.GetDisputes(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение списка споров: %w", err)
+	}
+
+	return disputes, count, nil
+}
+
+// AddEvidence прикладывает к спору медиафайл стороны. Файл прикрепляется к спору,
+// поэтому один и тот же файл нельзя приложить к другому спору или объявлению.
+func (s *disputeService) AddEvidence(
+	ctx context.Context,
+	evidenceForm form.DisputeEvidenceAdd,
+	currentTime time.Time,
+) (*entity.Dispute, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "DisputeService.AddEvidence")
+	defer span.End()
+
+	if err := evidenceForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	dispute, err := s.disputeRepo.GetDisputeByID(ctx, evidenceForm.DisputeID)
+	if err != nil {
+		return nil, fmt.Errorf("получение спора: %w", err)
+	}
+
+	if !dispute.IsParty(evidenceForm.UserID) {
+		return nil, entity.ErrDisputeForbidden
+	}
+
+	media, err := s.mediaRepo.GetMediaByID(ctx, evidenceForm.MediaID)
+	if err != nil {
+		return nil, fmt.Errorf("получение медиафайла: %w", err)
+	}
+
+	if media.OwnerID != evidenceForm.UserID {
+		return nil, entity.ErrMediaForbidden
+	}
+
+	err = dispute.AddEvidence(entity.DisputeEvidence{
+		MediaID:   media.ID,
+		AuthorID:  evidenceForm.UserID,
+		Comment:   evidenceForm.Comment,
+		CreatedAt: currentTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.mediaRepo.AttachMedia(ctx, media.ID, entity.MediaSubjectDispute, dispute.ID); err != nil {
+		return nil, fmt.Errorf("прикрепление медиафайла: %w", err)
+	}
+
+	if err = s.disputeRepo.UpdateDispute(ctx, dispute); err != nil {
+		return nil, fmt.Errorf("сохранение спора: %w", err)
+	}
+
+	s.publish(ctx, dispute, entity.DisputeEventEvidenceAdded, evidenceForm.UserID, currentTime)
+
+	return dispute, nil
+}
+
+// SendMessage отправляет сообщение в переписку по спору. Писать могут стороны и модераторы, пока спор не решен.
+func (s *disputeService) SendMessage(
+	ctx context.Context,
+	messageForm form.DisputeMessageCreate,
+	currentTime time.Time,
+) (*entity.DisputeMessage, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "DisputeService.SendMessage")
+	defer span.End()
+
+	if err := messageForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	dispute, err := s.accessibleDispute(ctx, messageForm.DisputeID, messageForm.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !dispute.Status.IsActive() {
+		return nil, fmt.Errorf("%w: спор в статусе %s", entity.ErrDisputeClosed, dispute.Status)
+	}
+
+	message := &entity.DisputeMessage{
+		DisputeID: dispute.ID,
+		AuthorID:  messageForm.UserID,
+		Moderator: !dispute.IsParty(messageForm.UserID),
+		Text:      messageForm.Text,
+		CreatedAt: currentTime,
+	}
+
+	if err = s.disputeRepo.CreateDisputeMessage(ctx, message); err != nil {
+		return nil, fmt.Errorf("сохранение сообщения: %w", err)
+	}
+
+	s.publish(ctx, dispute, entity.DisputeEventMessagePosted, messageForm.UserID, currentTime)
+
+	return message, nil
+}
+
+// GetMessages возвращает переписку по спору стороне спора или модератору.
+func (s *disputeService) GetMessages(
+	ctx context.Context,
+	filter form.DisputeMessagesGet,
+) (entity.DisputeMessages, int64, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "DisputeService.GetMessages")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	if _, err := s.accessibleDispute(ctx, filter.DisputeID, filter.UserID); err != nil {
+		return nil, 0, err
+	}
+
+	messages, count, err := s.disputeRepo.GetDisputeMessages(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение сообщений по спору: %w", err)
+	}
+
+	return messages, count, nil
+}
+
+// WithdrawDispute отзывает спор. Отозвать спор может только его автор до решения модератора.
+func (s *disputeService) WithdrawDispute(ctx context.Context, action form.DisputeGet, currentTime time.Time) (*entity.Dispute, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "DisputeService.WithdrawDispute")
+	defer span.End()
+
+	if err := action.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	dispute, err := s.disputeRepo.GetDisputeByID(ctx, action.DisputeID)
+	if err != nil {
+		return nil, fmt.Errorf("получение спора: %w", err)
+	}
+
+	if dispute.OpenerID != action.UserID {
+		return nil, entity.ErrDisputeForbidden
+	}
+
+	if err = dispute.Transition(entity.DisputeStatusWithdrawn, currentTime); err != nil {
+		return nil, err
+	}
+
+	if err = s.disputeRepo.UpdateDispute(ctx, dispute); err != nil {
+		return nil, fmt.Errorf("сохранение спора: %w", err)
+	}
+
+	s.publish(ctx, dispute, entity.DisputeEventWithdrawn, action.UserID, currentTime)
+
+	return dispute, nil
+}
+
+// GetQueue возвращает модератору нерешенные споры, начиная с тех, срок которых наступит раньше.
+func (s *disputeService) GetQueue(ctx context.Context, filter form.DisputeQueueGet) (entity.Disputes, int64, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "DisputeService.GetQueue")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	if !s.admins.Contains(filter.RequesterID) {
+		return nil, 0, entity.ErrDisputeForbidden
+	}
+
+	disputes, count, err := s.disputeRepo.GetDisputeQueue(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение очереди споров: %w", err)
+	}
+
+	return disputes, count, nil
+}
+
+// AssignDispute передает спор модератору. Модератором может быть только администратор.
+func (s *disputeService) AssignDispute(
+	ctx context.Context,
+	assignForm form.DisputeAssign,
+	currentTime time.Time,
+) (*entity.Dispute, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "DisputeService.AssignDispute")
+	defer span.End()
+
+	if err := assignForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	if !s.admins.Contains(assignForm.RequesterID) || !s.admins.Contains(assignForm.ModeratorID) {
+		return nil, entity.ErrDisputeForbidden
+	}
+
+	dispute, err := s.disputeRepo.GetDisputeByID(ctx, assignForm.DisputeID)
+	if err != nil {
+		return nil, fmt.Errorf("получение спора: %w", err)
+	}
+
+	// Модератор не может рассматривать спор, в котором сам является стороной.
+	if dispute.IsParty(assignForm.ModeratorID) {
+		return nil, fmt.Errorf("%w: модератор является стороной спора", entity.ErrDisputeForbidden)
+	}
+
+	if err = dispute.Assign(assignForm.ModeratorID, currentTime); err != nil {
+		return nil, err
+	}
+
+	if err = s.disputeRepo.UpdateDispute(ctx, dispute); err != nil {
+		return nil, fmt.Errorf("сохранение спора: %w", err)
+	}
+
+	s.publish(ctx, dispute, entity.DisputeEventAssigned, assignForm.RequesterID, currentTime)
+
+	return dispute, nil
+}
+
+// ResolveDispute выносит решение по спору. Решение принимает модератор, которому передан спор.
+// Спор, проводки кредитов и репутация второй стороны сохраняются в одной транзакции.
+// Сумма возврата проверяется до транзакции, а деньги возвращаются у провайдера только после
+// ее подтверждения: решение, которое не удалось сохранить, не двигает деньги.
+func (s *disputeService) ResolveDispute(
+	ctx context.Context,
+	resolveForm form.DisputeResolve,
+	currentTime time.Time,
+) (*entity.Dispute, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "DisputeService.ResolveDispute")
+	defer span.End()
+
+	if err := resolveForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	if !s.admins.Contains(resolveForm.RequesterID) {
+		return nil, entity.ErrDisputeForbidden
+	}
+
+	dispute, err := s.disputeRepo.GetDisputeByID(ctx, resolveForm.DisputeID)
+	if err != nil {
+		return nil, fmt.Errorf("получение спора: %w", err)
+	}
+
+	if dispute.ModeratorID != resolveForm.RequesterID {
+		return nil, fmt.Errorf("%w: спор рассматривает другой модератор", entity.ErrDisputeForbidden)
+	}
+
+	err = dispute.Resolve(resolveForm.Status, entity.DisputeResolution{
+		ModeratorID:       resolveForm.RequesterID,
+		Comment:           resolveForm.Comment,
+		Refund:            resolveForm.Refund,
+		CreditReversal:    resolveForm.CreditReversal,
+		ReputationPenalty: resolveForm.ReputationPenalty,
+		ResolvedAt:        currentTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var credits *entity.LedgerTransaction
+	if resolveForm.CreditReversal > 0 {
+		credits, err = entity.NewLedgerTransfer(
+			entity.LedgerTransactionKindDispute, dispute.RespondentID, dispute.OpenerID, resolveForm.CreditReversal,
+			"Решение по спору "+dispute.ID, resolveForm.RequesterID, currentTime,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var payment *entity.Payment
+	if resolveForm.Refund != nil {
+		if payment, err = s.refundablePayment(ctx, dispute, *resolveForm.Refund, currentTime); err != nil {
+			return nil, err
+		}
+	}
+
+	txCtx, done, err := s.txStarter.StartSession(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("начало транзакции: %w", err)
+	}
+
+	respondent, err := s.resolveInTx(txCtx, dispute, credits, payment)
+	if err = done(txCtx, err); err != nil {
+		return nil, fmt.Errorf("сохранение решения по спору: %w", err)
+	}
+
+	if payment != nil {
+		s.refund(ctx, dispute, payment, currentTime)
+	}
+
+	// Профиль в кэше должен показывать репутацию со штрафом.
+	if respondent != nil {
+		if err = s.cacheData.UserCache().SetUser(ctx, respondent); err != nil {
+			s.logger.WithFields(logger.Fields{"id": respondent.ID}).Errorf("обновление кэша: %v", err)
+		}
+	}
+
+	s.logger.WithFields(logger.Fields{
+		"dispute_id":   dispute.ID,
+		"moderator_id": resolveForm.RequesterID,
+		"status":       dispute.Status,
+	}).Info("вынесено решение по спору")
+	s.publish(ctx, dispute, entity.DisputeEventResolved, resolveForm.RequesterID, currentTime)
+
+	return dispute, nil
+}
+
+// resolveInTx сохраняет решение по спору и его последствия, включая возврат оплаты, в рамках транзакции.
+// Возвращает вторую сторону, если ее репутация изменилась.
+func (s *disputeService) resolveInTx(
+	ctx context.Context,
+	dispute *entity.Dispute,
+	credits *entity.LedgerTransaction,
+	payment *entity.Payment,
+) (*entity.User, error) {
+	if err := s.disputeRepo.UpdateDispute(ctx, dispute); err != nil {
+		return nil, fmt.Errorf("сохранение спора: %w", err)
+	}
+
+	if credits != nil {
+		if err := postLedgerTransaction(ctx, s.ledgerRepo, credits); err != nil {
+			return nil, fmt.Errorf("списание кредитов: %w", err)
+		}
+	}
+
+	if payment != nil {
+		if err := s.paymentRepo.UpdatePayment(ctx, payment); err != nil {
+			return nil, fmt.Errorf("сохранение возврата оплаты: %w", err)
+		}
+	}
+
+	if dispute.Resolution.ReputationPenalty == 0 {
+		return nil, nil
+	}
+
+	respondent, err := s.userRepo.GetUserByID(ctx, dispute.RespondentID)
+	if err != nil {
+		return nil, fmt.Errorf("получение пользователя: %w", err)
+	}
+
+	respondent.Reputation.Penalize(dispute.Resolution.ReputationPenalty, dispute.Resolution.ResolvedAt)
+
+	if err = s.userRepo.UpdateUserReputation(ctx, respondent.ID, respondent.Reputation); err != nil {
+		return nil, fmt.Errorf("обновление репутации: %w", err)
+	}
+
+	return respondent, nil
+}
+
+// refundablePayment возвращает платеж заказа с возвратом amount автору спора в очереди.
+// Вернуть можно только деньги, которые заплатил сам автор спора.
+func (s *disputeService) refundablePayment(
+	ctx context.Context,
+	dispute *entity.Dispute,
+	amount entity.Money,
+	currentTime time.Time,
+) (*entity.Payment, error) {
+	payment, err := s.paymentRepo.GetPaymentByOrderID(ctx, dispute.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("получение платежа: %w", err)
+	}
+
+	if payment.PayerID != dispute.OpenerID {
+		return nil, fmt.Errorf("%w: автор спора не оплачивал заказ", entity.ErrDisputeInvalidOutcome)
+	}
+
+	if amount.Currency != payment.Amount.Currency || amount.Amount > payment.Refundable().Amount {
+		return nil, fmt.Errorf("%w: %d %s", entity.ErrPaymentRefundExceeds, amount.Amount, amount.Currency)
+	}
+
+	err = payment.RequestSettlement(entity.PaymentStatusRefunded, amount, "Решение по спору "+dispute.ID, "dispute:"+dispute.ID, currentTime)
+	if err != nil {
+		return nil, fmt.Errorf("возврат оплаты по спору: %w", err)
+	}
+
+	return payment, nil
+}
+
+// refund проводит у провайдера возврат по сохраненному решению. Возврат сохранен вместе с решением,
+// поэтому ошибка только логируется, а возврат с ключом идемпотентности спора повторит PaymentService.SettlePending.
+func (s *disputeService) refund(ctx context.Context, dispute *entity.Dispute, payment *entity.Payment, currentTime time.Time) {
+	if err := settlePayment(ctx, s.paymentRepo, s.payments, payment, currentTime); err != nil {
+		s.logger.WithFields(logger.Fields{"dispute_id": dispute.ID, "payment_id": payment.ID}).
+			Errorf("возврат оплаты по спору будет повторен: %v", err)
+	}
+}
+
+// MarkOverdue отмечает просрочку споров, срок текущего этапа которых наступил, и отправляет события о ней.
+// Спор, измененный параллельно, пропускается: если срок все еще просрочен, он попадет в следующий проход.
+func (s *disputeService) MarkOverdue(ctx context.Context, currentTime time.Time) (int, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "DisputeService.MarkOverdue")
+	defer span.End()
+
+	disputes, err := s.disputeRepo.GetDueDisputes(ctx, currentTime, disputeOverdueBatch)
+	if err != nil {
+		return 0, fmt.Errorf("получение просроченных споров: %w", err)
+	}
+
+	marked := 0
+
+	for _, dispute := range disputes {
+		if !dispute.MarkOverdue(currentTime) {
+			continue
+		}
+
+		if err = s.disputeRepo.UpdateDispute(ctx, dispute); err != nil {
+			if errors.Is(err, entity.ErrDisputeConflict) {
+				continue
+			}
+
+			return marked, fmt.Errorf("сохранение спора %s: %w", dispute.ID, err)
+		}
+
+		marked++
+
+		s.logger.WithFields(logger.Fields{"dispute_id": dispute.ID, "status": dispute.Status}).Info("просрочен срок рассмотрения спора")
+		s.publish(ctx, dispute, entity.DisputeEventOverdue, "", currentTime)
+	}
+
+	return marked, nil
+}
+
+// WatchSLA раз в interval отмечает просроченные споры. Ошибка прохода только логируется,
+// следующий проход повторит попытку.
+func (s *disputeService) WatchSLA(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := s.MarkOverdue(ctx, time.Now().UTC()); err != nil {
+				s.logger.Errorf("отметка просроченных споров: %v", err)
+			}
+		}
+	}
+}
+
+// accessibleDispute возвращает спор, если пользователь является его стороной или модератором.
+func (s *disputeService) accessibleDispute(ctx context.Context, disputeID, userID string) (*entity.Dispute, error) {
+	dispute, err := s.disputeRepo.GetDisputeByID(ctx, disputeID)
+	if err != nil {
+		return nil, fmt.Errorf("получение спора: %w", err)
+	}
+
+	if !dispute.IsParty(userID) && !s.admins.Contains(userID) {
+		return nil, entity.ErrDisputeForbidden
+	}
+
+	return dispute, nil
+}
+
+// publish отправляет событие спора в Kafka. Изменение уже сохранено, поэтому ошибка отправки только логируется.
+func (s *disputeService) publish(
+	ctx context.Context,
+	dispute *entity.Dispute,
+	eventType entity.DisputeEventType,
+	actorID string,
+	currentTime time.Time,
+) {
+	value, err := s.json.Marshal(entity.NewDisputeEvent(dispute, eventType, actorID, currentTime))
+	if err != nil {
+		s.logger.Errorf("сериализация события спора %s: %v", dispute.ID, err)
+
+		return
+	}
+
+	err = s.producer.Write(ctx, producer.Message{Key: []byte(dispute.ID), Value: value})
+	if err != nil {
+		s.logger.WithFields(logger.Fields{"dispute_id": dispute.ID, "type": eventType}).
+			Errorf("запись события спора в kafka: %v", err)
+	}
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository/mock_repo"
+	"github.com/alisher-99/LomBarter/internal/storage/payment"
+)
+
+func TestDisputeService_ResolveDispute_Refund(t *testing.T) {
+	t.Parallel()
+
+	const (
+		disputeID   = "665d8a4d3afea534e56b5710"
+		moderatorID = "655d8a4d3afea534e56b57ff"
+	)
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := created.Add(time.Hour)
+	refund := entity.NewMoney(2000, "KZT")
+
+	cases := []struct {
+		name     string
+		credits  int64
+		err      error
+		refunded int64
+	}{
+		{
+			name:     "возврат после сохранения решения",
+			refunded: 2000,
+		},
+		{
+			name:    "решение не сохранено из-за нехватки кредитов",
+			credits: 500,
+			err:     entity.ErrLedgerInsufficientFunds,
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			provider := payment.NewFake(payment.NewWebhookSigner("secret", time.Minute))
+
+			authorized, err := provider.Authorize(ctx, entity.PaymentCharge{
+				PayerID: testProposerID,
+				PayeeID: testRecipientID,
+				Amount:  entity.NewMoney(5000, "KZT"),
+			})
+			require.NoError(t, err)
+
+			_, err = provider.Capture(ctx, authorized.ID)
+			require.NoError(t, err)
+
+			stored := entity.NewPayment(testOrderID, testProposerID, testRecipientID, authorized, created)
+			require.NoError(t, stored.Transition(entity.PaymentStatusCaptured, created))
+
+			dispute := &entity.Dispute{
+				ID:           disputeID,
+				OrderID:      testOrderID,
+				OpenerID:     testProposerID,
+				RespondentID: testRecipientID,
+				Status:       entity.DisputeStatusInReview,
+				ModeratorID:  moderatorID,
+				CreatedAt:    created,
+			}
+
+			ctrl := gomock.NewController(t)
+			disputeRepo := mock_repo.NewMockDisputeRepository(ctrl)
+			paymentRepo := mock_repo.NewMockPaymentRepository(ctrl)
+			ledgerRepo := mock_repo.NewMockLedgerRepository(ctrl)
+			tx := mock_repo.NewMockTxStarter(ctrl)
+			expectTransactions(tx)
+
+			disputeRepo.EXPECT().GetDisputeByID(gomock.Any(), disputeID).Return(dispute, nil)
+			disputeRepo.EXPECT().UpdateDispute(gomock.Any(), dispute).Return(nil)
+			paymentRepo.EXPECT().GetPaymentByOrderID(gomock.Any(), testOrderID).Return(stored, nil)
+
+			if s.credits > 0 {
+				// У второй стороны еще нет счета кредитов, поэтому списать нечего.
+				ledgerRepo.EXPECT().GetLedgerAccounts(gomock.Any(), gomock.Any()).Return(nil, nil)
+			}
+
+			if s.err == nil {
+				// Возврат ставится в очередь вместе с решением и снимается из нее после проведения у провайдера.
+				paymentRepo.EXPECT().UpdatePayment(gomock.Any(), stored).Times(2).Return(nil)
+			}
+
+			svc := NewDisputeService(
+				disputeRepo, nil, paymentRepo, ledgerRepo, nil, nil, nil, tx, provider,
+				entity.NewAdmins([]string{moderatorID}), entity.DisputeSLA{}, nopProducer{},
+				testLogger(t), trace.NewNoopTracerProvider(),
+			)
+
+			_, err = svc.ResolveDispute(ctx, form.DisputeResolve{
+				DisputeID:      disputeID,
+				RequesterID:    moderatorID,
+				Status:         entity.DisputeStatusResolved,
+				Comment:        "Повреждение подтверждено фотографиями",
+				Refund:         &refund,
+				CreditReversal: s.credits,
+			}, now)
+			if s.err != nil {
+				assert.ErrorIs(t, err, s.err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, s.refunded, stored.Refunded.Amount)
+				assert.Nil(t, stored.Settlement)
+			}
+
+			// Если решение не сохранено, деньги у провайдера не возвращаются.
+			payments := provider.Payments()
+			require.Len(t, payments, 1)
+			assert.Equal(t, s.refunded, payments[0].Refunded.Amount)
+		})
+	}
+}
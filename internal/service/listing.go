@@ -0,0 +1,343 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"gitlab.com/example/gophers/libs/kafka/producer"
+	"gitlab.com/example/gophers/libs/logger"
+	"gitlab.com/example/gophers/libs/trace"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/presenter"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// ListingService представляет интерфейс сервиса для работы с объявлениями.
+type ListingService interface {
+	// CreateListing создает объявление.
+	CreateListing(ctx context.Context, createForm form.ListingCreate, currentTime time.Time) (presenter.CreatedListing, error)
+	// GetListingByID возвращает объявление по идентификатору.
+	GetListingByID(ctx context.Context, id string) (*entity.Listing, error)
+	// GetListings возвращает список объявлений по фильтру и их общее количество.
+	GetListings(ctx context.Context, filter form.ListingsGet) (entity.Listings, int64, error)
+	// UpdateListing обновляет объявление.
+	UpdateListing(ctx context.Context, updateForm form.ListingUpdate, currentTime time.Time) (*entity.Listing, error)
+	// DeleteListing удаляет объявление.
+	DeleteListing(ctx context.Context, deleteForm form.ListingDelete, currentTime time.Time) error
+}
+
+// listingService представляет сервис для работы с объявлениями.
+type listingService struct {
+	listingRepo repository.ListingRepository // Репозиторий для работы с объявлениями
+	auditRepo   repository.AuditRepository   // Журнал изменений
+	txStarter   repository.TxStarter         // Запуск транзакций
+	currencies  entity.Currencies            // Допустимые валюты оценки объявления
+	producer    producer.MessageProducer     // Продюсер событий изменения объявлений
+	moderator   ContentModerator             // Проверка заголовка и описания
+	tracer      trace.TracerProvider         // Отслеживает запросы между слоями и микросервисами
+	logger      logger.Logger                // Логирование запросов и ошибок сервиса
+	json        jsoniter.API                 // JSON-парсер
+}
+
+// NewListingService создает новый экземпляр сервиса для работы с объявлениями.
+func NewListingService(
+	listingRepo repository.ListingRepository,
+	auditRepo repository.AuditRepository,
+	txStarter repository.TxStarter,
+	currencies entity.Currencies,
+	kafkaProducer producer.MessageProducer,
+	moderator ContentModerator,
+	l logger.Logger,
+	tracer trace.TracerProvider,
+) ListingService {
+	return &listingService{
+		listingRepo: listingRepo,
+		auditRepo:   auditRepo,
+		txStarter:   txStarter,
+		currencies:  currencies,
+		producer:    kafkaProducer,
+		moderator:   moderator,
+		tracer:      tracer,
+		logger:      l.WithFields(logger.Fields{"layer": "listing-service"}),
+		json:        jsoniter.ConfigCompatibleWithStandardLibrary,
+	}
+}
+
+// CreateListing создает объявление.
+func (s *listingService) CreateListing(ctx context.Context, createForm form.ListingCreate, currentTime time.Time) (presenter.CreatedListing, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "ListingService.CreateListing")
+	defer span.End()
+
+	if err := createForm.Validate(); err != nil {
+		return presenter.CreatedListing{}, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	if createForm.Valuation != nil {
+		if err := s.currencies.Validate(createForm.Valuation.Currency); err != nil {
+			return presenter.CreatedListing{}, fmt.Errorf("валидация валюты оценки: %w", err)
+		}
+	}
+
+	// Создаем сущность объявления.
+	listing := entity.NewListing(currentTime)
+
+	if err := createForm.Fill(listing); err != nil {
+		return presenter.CreatedListing{}, fmt.Errorf("заполнение формы: %w", err)
+	}
+
+	var (
+		violations entity.ModerationViolations
+		err        error
+	)
+
+	listing.Moderation, violations, err = s.moderator.Screen(ctx, listing.ModerationContent(currentTime), "")
+	if err != nil {
+		return presenter.CreatedListing{}, fmt.Errorf("проверка текста объявления: %w", err)
+	}
+
+	txCtx, done, err := s.txStarter.StartSession(ctx)
+	if err != nil {
+		return presenter.CreatedListing{}, fmt.Errorf("начало транзакции: %w", err)
+	}
+
+	err = s.createInTx(txCtx, listing, violations, currentTime)
+	if err = done(txCtx, err); err != nil {
+		return presenter.CreatedListing{}, fmt.Errorf("создание объявления: %w", err)
+	}
+
+	s.publish(ctx, entity.NewListingEvent(listing, currentTime))
+
+	return presenter.NewCreatedListing(listing.ID), nil
+}
+
+// createInTx сохраняет объявление, запись журнала изменений и дело модерации текста в рамках транзакции.
+func (s *listingService) createInTx(
+	ctx context.Context,
+	listing *entity.Listing,
+	violations entity.ModerationViolations,
+	currentTime time.Time,
+) error {
+	id, err := s.listingRepo.CreateListing(ctx, listing)
+	if err != nil {
+		return err
+	}
+
+	listing.ID = id
+
+	if err = s.flag(ctx, listing, violations, currentTime); err != nil {
+		return err
+	}
+
+	return recordAudit(ctx, s.auditRepo, entity.AuditEntityListing, id, entity.AuditActionCreate, nil, listing, currentTime)
+}
+
+// GetListingByID возвращает объявление по идентификатору. Отклоненное модератором объявление не раскрывается.
+func (s *listingService) GetListingByID(ctx context.Context, id string) (*entity.Listing, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "ListingService.GetListingByID")
+	defer span.End()
+
+	listing, err := s.listingRepo.GetListingByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("получение объявления: %w", err)
+	}
+
+	if !listing.Moderation.IsPublic() {
+		return nil, entity.ErrListingNotFound
+	}
+
+	return listing, nil
+}
+
+// GetListings возвращает список объявлений по фильтру и их общее количество.
+func (s *listingService) GetListings(ctx context.Context, filter form.ListingsGet) (entity.Listings, int64, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "ListingService.GetListings")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	listings, count, err := s.listingRepo.GetListings(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение списка объявлений: %w", err)
+	}
+
+	return listings, count, nil
+}
+
+// UpdateListing обновляет объявление.
+func (s *listingService) UpdateListing(ctx context.Context, updateForm form.ListingUpdate, currentTime time.Time) (*entity.Listing, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "ListingService.UpdateListing")
+	defer span.End()
+
+	if err := updateForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	if updateForm.Valuation != nil {
+		if err := s.currencies.Validate(updateForm.Valuation.Currency); err != nil {
+			return nil, fmt.Errorf("валидация валюты оценки: %w", err)
+		}
+	}
+
+	listing, err := s.listingRepo.GetListingByID(ctx, updateForm.ID)
+	if err != nil {
+		return nil, fmt.Errorf("получение объявления: %w", err)
+	}
+
+	// Чужие объявления не раскрываем.
+	if listing.OwnerID != updateForm.OwnerID {
+		return nil, entity.ErrListingNotFound
+	}
+
+	// Статус объявления, участвующего в сделке, меняет только сделка.
+	if updateForm.Status != nil && !listing.IsEditable() {
+		return nil, fmt.Errorf("%w: %s", entity.ErrListingNotEditable, listing.Status)
+	}
+
+	before := *listing
+	if err = updateForm.Fill(listing, currentTime); err != nil {
+		return nil, fmt.Errorf("заполнение формы: %w", err)
+	}
+
+	// Проверяем текст, только если он изменился.
+	var violations entity.ModerationViolations
+	if listingTextChanged(&before, listing) {
+		listing.Moderation, violations, err = s.moderator.Screen(ctx, listing.ModerationContent(currentTime), before.Moderation)
+		if err != nil {
+			return nil, fmt.Errorf("проверка текста объявления: %w", err)
+		}
+	}
+
+	txCtx, done, err := s.txStarter.StartSession(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("начало транзакции: %w", err)
+	}
+
+	err = s.updateInTx(txCtx, &before, listing, violations, currentTime)
+	if err = done(txCtx, err); err != nil {
+		return nil, fmt.Errorf("обновление объявления: %w", err)
+	}
+
+	s.publish(ctx, entity.NewListingEvent(listing, currentTime))
+
+	return listing, nil
+}
+
+// DeleteListing удаляет объявление.
+func (s *listingService) DeleteListing(ctx context.Context, deleteForm form.ListingDelete, currentTime time.Time) error {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "ListingService.DeleteListing")
+	defer span.End()
+
+	if err := deleteForm.Validate(); err != nil {
+		return fmt.Errorf("валидация формы: %w", err)
+	}
+
+	listing, err := s.listingRepo.GetListingByID(ctx, deleteForm.ID)
+	if err != nil {
+		return fmt.Errorf("получение объявления: %w", err)
+	}
+
+	// Чужие объявления не раскрываем.
+	if listing.OwnerID != deleteForm.OwnerID {
+		return entity.ErrListingNotFound
+	}
+
+	// Объявление, участвующее в сделке, удалять нельзя.
+	if !listing.IsEditable() {
+		return fmt.Errorf("%w: %s", entity.ErrListingNotEditable, listing.Status)
+	}
+
+	txCtx, done, err := s.txStarter.StartSession(ctx)
+	if err != nil {
+		return fmt.Errorf("начало транзакции: %w", err)
+	}
+
+	err = s.deleteInTx(txCtx, deleteForm, listing, currentTime)
+	if err = done(txCtx, err); err != nil {
+		return fmt.Errorf("удаление объявления: %w", err)
+	}
+
+	s.publish(ctx, entity.ListingEvent{
+		ListingID:  deleteForm.ID,
+		OwnerID:    deleteForm.OwnerID,
+		Deleted:    true,
+		OccurredAt: currentTime,
+	})
+
+	return nil
+}
+
+// updateInTx сохраняет объявление, запись журнала изменений и дело модерации текста в рамках транзакции.
+func (s *listingService) updateInTx(
+	ctx context.Context,
+	before, listing *entity.Listing,
+	violations entity.ModerationViolations,
+	currentTime time.Time,
+) error {
+	if err := s.listingRepo.UpdateListing(ctx, listing, before.Status); err != nil {
+		return err
+	}
+
+	if listingTextChanged(before, listing) {
+		if err := s.flag(ctx, listing, violations, currentTime); err != nil {
+			return err
+		}
+	}
+
+	return recordAudit(ctx, s.auditRepo, entity.AuditEntityListing, listing.ID, entity.AuditActionUpdate, before, listing, currentTime)
+}
+
+// deleteInTx удаляет объявление и записывает его последнее состояние в журнал изменений в рамках транзакции.
+func (s *listingService) deleteInTx(
+	ctx context.Context,
+	deleteForm form.ListingDelete,
+	listing *entity.Listing,
+	currentTime time.Time,
+) error {
+	if err := s.listingRepo.DeleteListing(ctx, deleteForm); err != nil {
+		return err
+	}
+
+	return recordAudit(ctx, s.auditRepo, entity.AuditEntityListing, listing.ID, entity.AuditActionDelete, listing, nil, currentTime)
+}
+
+// flag отправляет текст объявления, ожидающий решения модератора, в очередь модерации.
+func (s *listingService) flag(
+	ctx context.Context,
+	listing *entity.Listing,
+	violations entity.ModerationViolations,
+	currentTime time.Time,
+) error {
+	if listing.Moderation != entity.ModerationStatusPending {
+		return nil
+	}
+
+	return s.moderator.Flag(ctx, listing.ModerationContent(currentTime), violations, currentTime)
+}
+
+// listingTextChanged изменились ли заголовок или описание объявления.
+func listingTextChanged(before, after *entity.Listing) bool {
+	return before.Title != after.Title || before.Description != after.Description
+}
+
+// publish отправляет событие изменения объявления в Kafka.
+// Изменение уже сохранено, поэтому ошибка отправки только логируется.
+func (s *listingService) publish(ctx context.Context, event entity.ListingEvent) {
+	value, err := s.json.Marshal(event)
+	if err != nil {
+		s.logger.Errorf("сериализация события объявления %s: %v", event.ListingID, err)
+
+		return
+	}
+
+	err = s.producer.Write(ctx, producer.Message{Key: []byte(event.ListingID), Value: value})
+	if err != nil {
+		s.logger.WithFields(logger.Fields{"listing_id": event.ListingID}).
+			Errorf("запись события объявления в kafka: %v", err)
+	}
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository/mock_repo"
+)
+
+func TestListingService_DeleteListing(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	deleteForm := form.ListingDelete{ID: testOfferedID, OwnerID: testProposerID}
+
+	cases := []struct {
+		name    string
+		ownerID string
+		status  entity.ListingStatus
+		delete  bool
+		repoErr error
+		err     error
+	}{
+		{
+			name:    "активное объявление",
+			ownerID: testProposerID,
+			status:  entity.ListingStatusActive,
+			delete:  true,
+		},
+		{
+			name:    "объявление в архиве",
+			ownerID: testProposerID,
+			status:  entity.ListingStatusArchived,
+			delete:  true,
+		},
+		{
+			name:    "объявление участвует в сделке",
+			ownerID: testProposerID,
+			status:  entity.ListingStatusReserved,
+			err:     entity.ErrListingNotEditable,
+		},
+		{
+			name:    "обмен состоялся",
+			ownerID: testProposerID,
+			status:  entity.ListingStatusExchanged,
+			err:     entity.ErrListingNotEditable,
+		},
+		{
+			name:    "чужое объявление",
+			ownerID: testRecipientID,
+			status:  entity.ListingStatusActive,
+			err:     entity.ErrListingNotFound,
+		},
+		{
+			name:    "объявление зарезервировано параллельно",
+			ownerID: testProposerID,
+			status:  entity.ListingStatusActive,
+			delete:  true,
+			repoErr: entity.ErrListingConflict,
+			err:     entity.ErrListingConflict,
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			listings := mock_repo.NewMockListingRepository(ctrl)
+			audit := mock_repo.NewMockAuditRepository(ctrl)
+			tx := mock_repo.NewMockTxStarter(ctrl)
+
+			expectTransactions(tx)
+
+			currencies, err := entity.NewCurrencies(nil)
+			require.NoError(t, err)
+
+			listings.EXPECT().GetListingByID(gomock.Any(), testOfferedID).
+				Return(&entity.Listing{ID: testOfferedID, OwnerID: s.ownerID, Status: s.status}, nil)
+
+			if s.delete {
+				listings.EXPECT().DeleteListing(gomock.Any(), deleteForm).Return(s.repoErr)
+			}
+
+			if s.delete && s.repoErr == nil {
+				audit.EXPECT().CreateAuditEntry(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			svc := NewListingService(listings, audit, tx, currencies, nopProducer{}, nil, testLogger(t), trace.NewNoopTracerProvider())
+
+			err = svc.DeleteListing(context.Background(), deleteForm, now)
+			if s.err != nil {
+				assert.ErrorIs(t, err, s.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestListingService_UpdateListing(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	condition := entity.ListingConditionGood
+	archived := entity.ListingStatusArchived
+
+	cases := []struct {
+		name    string
+		status  entity.ListingStatus
+		form    form.ListingUpdate
+		update  bool
+		saved   entity.ListingStatus
+		repoErr error
+		err     error
+	}{
+		{
+			name:   "изменение полей не трогает статус",
+			status: entity.ListingStatusReserved,
+			form:   form.ListingUpdate{Condition: &condition},
+			update: true,
+			saved:  entity.ListingStatusReserved,
+		},
+		{
+			name:   "владелец снимает объявление",
+			status: entity.ListingStatusActive,
+			form:   form.ListingUpdate{Status: &archived},
+			update: true,
+			saved:  entity.ListingStatusArchived,
+		},
+		{
+			name:    "объявление зарезервировано параллельно",
+			status:  entity.ListingStatusActive,
+			form:    form.ListingUpdate{Status: &archived},
+			update:  true,
+			saved:   entity.ListingStatusArchived,
+			repoErr: entity.ErrListingConflict,
+			err:     entity.ErrListingConflict,
+		},
+		{
+			name:   "статус объявления в сделке меняет только сделка",
+			status: entity.ListingStatusReserved,
+			form:   form.ListingUpdate{Status: &archived},
+			err:    entity.ErrListingNotEditable,
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			listings := mock_repo.NewMockListingRepository(ctrl)
+			audit := mock_repo.NewMockAuditRepository(ctrl)
+			tx := mock_repo.NewMockTxStarter(ctrl)
+
+			expectTransactions(tx)
+			audit.EXPECT().CreateAuditEntry(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+			currencies, err := entity.NewCurrencies(nil)
+			require.NoError(t, err)
+
+			listings.EXPECT().GetListingByID(gomock.Any(), testOfferedID).Return(&entity.Listing{
+				ID:        testOfferedID,
+				OwnerID:   testProposerID,
+				Title:     "Велосипед",
+				Condition: entity.ListingConditionFair,
+				Status:    s.status,
+			}, nil)
+
+			if s.update {
+				// Обновление сверяется со статусом, прочитанным до изменения.
+				listings.EXPECT().UpdateListing(gomock.Any(), gomock.Any(), s.status).DoAndReturn(
+					func(_ context.Context, listing *entity.Listing, _ entity.ListingStatus) error {
+						assert.Equal(t, s.saved, listing.Status)
+
+						return s.repoErr
+					},
+				)
+			}
+
+			svc := NewListingService(listings, audit, tx, currencies, nopProducer{}, nil, testLogger(t), trace.NewNoopTracerProvider())
+
+			updateForm := s.form
+			updateForm.ID = testOfferedID
+			updateForm.OwnerID = testProposerID
+
+			_, err = svc.UpdateListing(context.Background(), updateForm, now)
+			if s.err != nil {
+				assert.ErrorIs(t, err, s.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
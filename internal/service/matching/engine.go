@@ -0,0 +1,167 @@
+// Package matching ищет циклы обмена между несколькими участниками.
+//
+// Объявления образуют ориентированный граф: ребро A→B означает, что владелец B хочет получить
+// то, что предлагает A. Цикл A→B→C→A — обмен, в котором каждый участник отдает свое объявление
+// следующему и получает объявление предыдущего. Поиск инкрементальный: он запускается для одного
+// измененного объявления и ищет только циклы, проходящие через него.
+package matching
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// Значения по умолчанию для ограничений поиска.
+const (
+	defaultMaxLength = 4     // Максимальная длина цикла
+	defaultBudget    = 10000 // Максимальное количество просмотренных ребер
+	defaultPoolLimit = 500   // Максимальное количество объявлений в графе
+	defaultMaxCycles = 5     // Максимальное количество возвращаемых циклов
+
+	minLength = 2 // Минимальная длина цикла — обычный обмен двух участников
+)
+
+// Source источник объявлений для построения графа.
+type Source interface {
+	// GetListingsByDesiredTags возвращает активные объявления, владельцы которых хотят получить любой из тегов.
+	GetListingsByDesiredTags(ctx context.Context, tags []string, limit int64) (entity.Listings, error)
+}
+
+// Options ограничения поиска циклов.
+type Options struct {
+	MaxLength int // Максимальная длина цикла
+	Budget    int // Максимальное количество просмотренных ребер
+	PoolLimit int // Максимальное количество объявлений в графе
+	MaxCycles int // Максимальное количество возвращаемых циклов
+}
+
+// withDefaults заменяет неположительные значения ограничений значениями по умолчанию.
+func (o Options) withDefaults() Options {
+	if o.MaxLength < minLength {
+		o.MaxLength = defaultMaxLength
+	}
+
+	if o.Budget <= 0 {
+		o.Budget = defaultBudget
+	}
+
+	if o.PoolLimit <= 0 {
+		o.PoolLimit = defaultPoolLimit
+	}
+
+	if o.MaxCycles <= 0 {
+		o.MaxCycles = defaultMaxCycles
+	}
+
+	return o
+}
+
+// Result результат поиска циклов.
+type Result struct {
+	Cycles    entity.TradeCycles // Найденные циклы, отсортированные по убыванию качества
+	Examined  int                // Количество просмотренных ребер
+	Exhausted bool               // Поиск остановлен по исчерпанию бюджета
+	Truncated bool               // Граф построен не полностью из-за лимита объявлений
+}
+
+// Engine движок поиска циклов обмена.
+type Engine struct {
+	opts Options // Ограничения поиска
+}
+
+// NewEngine создает движок поиска циклов обмена.
+func NewEngine(opts Options) *Engine {
+	return &Engine{opts: opts.withDefaults()}
+}
+
+// Match ищет циклы обмена, проходящие через объявление seed.
+// Результат детерминирован: при одинаковых данных возвращаются одинаковые циклы в одинаковом порядке.
+func (e *Engine) Match(ctx context.Context, src Source, seed *entity.Listing, currentTime time.Time) (Result, error) {
+	if seed == nil || !seed.IsAvailable() {
+		return Result{}, nil
+	}
+
+	pool, truncated, err := e.collect(ctx, src, seed)
+	if err != nil {
+		return Result{}, err
+	}
+
+	g := newGraph(pool)
+
+	s := newSearcher(g, g.index[seed.ID], e.opts)
+	s.run()
+
+	ranked := rank(s.found)
+	if len(ranked) > e.opts.MaxCycles {
+		ranked = ranked[:e.opts.MaxCycles]
+	}
+
+	cycles := make(entity.TradeCycles, 0, len(ranked))
+	for _, c := range ranked {
+		cycles = append(cycles, entity.NewTradeCycle(c.legs, c.score, currentTime))
+	}
+
+	return Result{
+		Cycles:    cycles,
+		Examined:  s.examined,
+		Exhausted: s.exhausted,
+		Truncated: truncated,
+	}, nil
+}
+
+// collect собирает объявления, достижимые из seed не более чем за MaxLength-1 шагов.
+// Любой цикл через seed длины не больше MaxLength целиком лежит в этом множестве.
+func (e *Engine) collect(ctx context.Context, src Source, seed *entity.Listing) (entity.Listings, bool, error) {
+	pool := entity.Listings{seed}
+	seen := map[string]struct{}{seed.ID: {}}
+	frontier := entity.Listings{seed}
+	truncated := false
+
+	for depth := 1; depth < e.opts.MaxLength && len(frontier) > 0; depth++ {
+		remaining := e.opts.PoolLimit - len(pool)
+		if remaining <= 0 {
+			truncated = true
+
+			break
+		}
+
+		found, err := src.GetListingsByDesiredTags(ctx, offeredTags(frontier), int64(remaining))
+		if err != nil {
+			return nil, false, fmt.Errorf("получение объявлений для графа: %w", err)
+		}
+
+		if len(found) >= remaining {
+			truncated = true
+		}
+
+		next := make(entity.Listings, 0, len(found))
+
+		for _, listing := range found {
+			if _, ok := seen[listing.ID]; ok || !listing.IsAvailable() {
+				continue
+			}
+
+			seen[listing.ID] = struct{}{}
+			pool = append(pool, listing)
+			next = append(next, listing)
+		}
+
+		frontier = next
+	}
+
+	return pool, truncated, nil
+}
+
+// offeredTags возвращает объединение предлагаемых тегов объявлений.
+func offeredTags(listings entity.Listings) []string {
+	tags := make([]string, 0, len(listings))
+
+	for _, listing := range listings {
+		tags = append(tags, listing.OfferedTags()...)
+	}
+
+	return entity.NormalizeTags(tags)
+}
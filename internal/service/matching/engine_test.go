@@ -0,0 +1,197 @@
+package matching_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/service/matching"
+	"github.com/alisher-99/LomBarter/internal/service/matching/matchingtest"
+)
+
+func TestEngine_Match(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		setup   func(h *matchingtest.Harness)
+		seed    string
+		opts    matching.Options
+		expKeys []string
+	}{
+		{
+			name: "обмен двух участников",
+			setup: func(h *matchingtest.Harness) {
+				h.Add("l1", "alice", "books", "Мастер и Маргарита", "sports")
+				h.Add("l2", "bob", "sports", "Велосипед Stels", "books")
+			},
+			seed:    "l1",
+			expKeys: []string{"l1>l2"},
+		},
+		{
+			name: "цикл трех участников",
+			setup: func(h *matchingtest.Harness) {
+				h.Add("l1", "alice", "books", "Мастер и Маргарита", "sports")
+				h.Add("l2", "bob", "sports", "Велосипед Stels", "electronics")
+				h.Add("l3", "carol", "electronics", "Ноутбук Lenovo", "books")
+			},
+			seed:    "l2",
+			expKeys: []string{"l1>l3>l2"},
+		},
+		{
+			name: "цикл длиннее ограничения не ищется",
+			setup: func(h *matchingtest.Harness) {
+				h.Add("l1", "alice", "books", "Мастер и Маргарита", "sports")
+				h.Add("l2", "bob", "sports", "Велосипед Stels", "electronics")
+				h.Add("l3", "carol", "electronics", "Ноутбук Lenovo", "books")
+			},
+			seed:    "l1",
+			opts:    matching.Options{MaxLength: 2},
+			expKeys: []string{},
+		},
+		{
+			name: "участник не может встречаться в цикле дважды",
+			setup: func(h *matchingtest.Harness) {
+				h.Add("l1", "alice", "books", "Мастер и Маргарита", "electronics")
+				h.Add("l2", "bob", "sports", "Велосипед Stels", "books")
+				h.Add("l3", "alice", "home", "Кофемашина", "sports")
+				h.Add("l4", "carol", "electronics", "Ноутбук Lenovo", "home")
+			},
+			seed:    "l1",
+			expKeys: []string{},
+		},
+		{
+			name: "короткий цикл выше длинного",
+			setup: func(h *matchingtest.Harness) {
+				h.Add("l1", "alice", "books", "Мастер и Маргарита", "sports", "electronics")
+				h.Add("l2", "bob", "sports", "Велосипед Stels", "books")
+				h.Add("l3", "carol", "electronics", "Ноутбук Lenovo", "sports")
+				h.Add("l4", "dave", "sports", "Ракетка Wilson", "books")
+			},
+			seed:    "l1",
+			expKeys: []string{"l1>l2", "l1>l4", "l1>l2>l3", "l1>l4>l3"},
+		},
+		{
+			name: "совпадение по категории выше совпадения по заголовку",
+			setup: func(h *matchingtest.Harness) {
+				h.Add("l1", "alice", "books", "Мастер и Маргарита", "велосипед", "sports")
+				h.Add("l2", "bob", "other", "Велосипед детский", "books")
+				h.Add("l3", "carol", "sports", "Гантели", "books")
+			},
+			seed:    "l1",
+			expKeys: []string{"l1>l3", "l1>l2"},
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := matchingtest.New()
+			s.setup(h)
+
+			res, err := matching.NewEngine(s.opts).Match(context.Background(), h, h.Get(s.seed), matchingtest.Now)
+			require.NoError(t, err)
+
+			assert.Equal(t, s.expKeys, matchingtest.Keys(res.Cycles))
+			assert.False(t, res.Exhausted)
+		})
+	}
+}
+
+func TestEngine_Match_Cycle(t *testing.T) {
+	t.Parallel()
+
+	h := matchingtest.New()
+	h.Add("l1", "alice", "books", "Мастер и Маргарита", "sports")
+	h.Add("l2", "bob", "sports", "Велосипед Stels", "electronics")
+	h.Add("l3", "carol", "electronics", "Ноутбук Lenovo", "books")
+
+	res, err := matching.NewEngine(matching.Options{}).Match(context.Background(), h, h.Get("l1"), matchingtest.Now)
+	require.NoError(t, err)
+	require.Len(t, res.Cycles, 1)
+
+	cycle := res.Cycles[0]
+	assert.Equal(t, entity.TradeCycleStatusProposed, cycle.Status)
+	assert.Equal(t, []string{"alice", "carol", "bob"}, cycle.ParticipantIDs)
+	assert.Equal(t, []entity.TradeCycleLeg{
+		{ListingID: "l1", GiverID: "alice", ReceiverID: "carol", MatchedTags: []string{"books"}, Score: 0.75},
+		{ListingID: "l3", GiverID: "carol", ReceiverID: "bob", MatchedTags: []string{"electronics"}, Score: 0.75},
+		{ListingID: "l2", GiverID: "bob", ReceiverID: "alice", MatchedTags: []string{"sports"}, Score: 0.75},
+	}, cycle.Legs)
+	assert.InDelta(t, 0.675, cycle.Score, 1e-9)
+}
+
+func TestEngine_Match_Deterministic(t *testing.T) {
+	t.Parallel()
+
+	h := matchingtest.New()
+	h.Add("l1", "alice", "books", "Мастер и Маргарита", "sports", "electronics")
+	h.Add("l2", "bob", "sports", "Велосипед Stels", "books", "electronics")
+	h.Add("l3", "carol", "electronics", "Ноутбук Lenovo", "sports", "books")
+	h.Add("l4", "dave", "sports", "Ракетка Wilson", "books", "electronics")
+
+	engine := matching.NewEngine(matching.Options{MaxCycles: 100})
+
+	first, err := engine.Match(context.Background(), h, h.Get("l1"), matchingtest.Now)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		next, err := engine.Match(context.Background(), h, h.Get("l1"), matchingtest.Now)
+		require.NoError(t, err)
+		require.Equal(t, matchingtest.Keys(first.Cycles), matchingtest.Keys(next.Cycles))
+	}
+
+	// Цикл, найденный от другого объявления, получает тот же ключ.
+	fromL3, err := engine.Match(context.Background(), h, h.Get("l3"), matchingtest.Now)
+	require.NoError(t, err)
+	assert.Contains(t, matchingtest.Keys(fromL3.Cycles), "l1>l2>l3")
+	assert.Contains(t, matchingtest.Keys(first.Cycles), "l1>l2>l3")
+}
+
+func TestEngine_Match_Bounds(t *testing.T) {
+	t.Parallel()
+
+	h := matchingtest.New()
+	h.Add("l1", "alice", "books", "Мастер и Маргарита", "sports")
+	h.Add("l2", "bob", "sports", "Велосипед Stels", "books")
+	h.Add("l3", "carol", "sports", "Ракетка Wilson", "books")
+	h.Add("l4", "dave", "books", "Война и мир", "sports")
+
+	t.Run("бюджет ребер", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := matching.NewEngine(matching.Options{Budget: 1}).Match(context.Background(), h, h.Get("l1"), matchingtest.Now)
+		require.NoError(t, err)
+
+		assert.True(t, res.Exhausted)
+		assert.Equal(t, 1, res.Examined)
+	})
+
+	t.Run("лимит объявлений", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := matching.NewEngine(matching.Options{PoolLimit: 2}).Match(context.Background(), h, h.Get("l1"), matchingtest.Now)
+		require.NoError(t, err)
+
+		assert.True(t, res.Truncated)
+		assert.Equal(t, []string{"l1>l2"}, matchingtest.Keys(res.Cycles))
+	})
+
+	t.Run("недоступное объявление", func(t *testing.T) {
+		t.Parallel()
+
+		seed := *h.Get("l1")
+		seed.Status = entity.ListingStatusReserved
+
+		res, err := matching.NewEngine(matching.Options{}).Match(context.Background(), h, &seed, matchingtest.Now)
+		require.NoError(t, err)
+
+		assert.Empty(t, res.Cycles)
+	})
+}
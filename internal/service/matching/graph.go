@@ -0,0 +1,74 @@
+package matching
+
+import (
+	"sort"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// edge ребро графа: владелец объявления to хочет получить объявление from.
+type edge struct {
+	from, to int      // Индексы объявлений в графе
+	matched  []string // Желаемые теги получателя, которым соответствует объявление from
+	score    float64  // Качество совпадения
+}
+
+// graph граф объявлений. Узлы упорядочены по идентификатору, ребра каждого узла — по индексу получателя.
+type graph struct {
+	nodes entity.Listings // Объявления
+	index map[string]int  // Индекс объявления по идентификатору
+	out   [][]edge        // Исходящие ребра
+}
+
+// newGraph строит граф из объявлений.
+func newGraph(listings entity.Listings) *graph {
+	nodes := make(entity.Listings, len(listings))
+	copy(nodes, listings)
+
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+
+	g := &graph{
+		nodes: nodes,
+		index: make(map[string]int, len(nodes)),
+		out:   make([][]edge, len(nodes)),
+	}
+
+	// Индекс «желаемый тег → объявления, владельцы которых его хотят».
+	wanted := make(map[string][]int)
+
+	for i, listing := range nodes {
+		g.index[listing.ID] = i
+
+		for _, tag := range entity.NormalizeTags(listing.DesiredTags) {
+			wanted[tag] = append(wanted[tag], i)
+		}
+	}
+
+	for i, listing := range nodes {
+		matched := make(map[int][]string)
+
+		for _, tag := range listing.OfferedTags() {
+			for _, j := range wanted[tag] {
+				if nodes[j].OwnerID == listing.OwnerID {
+					continue
+				}
+
+				matched[j] = append(matched[j], tag)
+			}
+		}
+
+		for j, tags := range matched {
+			sort.Strings(tags)
+			g.out[i] = append(g.out[i], edge{
+				from:    i,
+				to:      j,
+				matched: tags,
+				score:   legScore(listing, tags),
+			})
+		}
+
+		sort.Slice(g.out[i], func(a, b int) bool { return g.out[i][a].to < g.out[i][b].to })
+	}
+
+	return g
+}
@@ -0,0 +1,95 @@
+// Package matchingtest содержит детерминированное окружение для проверки поиска циклов обмена
+// без базы данных и Kafka.
+package matchingtest
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// Now фиксированное время, которое используют сценарии.
+var Now = time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+// Harness хранит объявления в памяти и реализует matching.Source.
+// Выборки упорядочены по идентификатору, поэтому результаты поиска воспроизводимы.
+type Harness struct {
+	listings map[string]*entity.Listing // Объявления по идентификатору
+}
+
+// New создает пустое окружение.
+func New() *Harness {
+	return &Harness{listings: make(map[string]*entity.Listing)}
+}
+
+// Add добавляет активное объявление в хорошем состоянии.
+// Идентификаторы сравниваются как строки, от них зависит порядок обхода графа.
+func (h *Harness) Add(id, ownerID, category, title string, desiredTags ...string) *entity.Listing {
+	listing := entity.NewListing(Now)
+	listing.ID = id
+	listing.OwnerID = ownerID
+	listing.Category = category
+	listing.Title = title
+	listing.Condition = entity.ListingConditionGood
+	listing.DesiredTags = entity.NormalizeTags(desiredTags)
+
+	h.listings[id] = listing
+
+	return listing
+}
+
+// Get возвращает объявление по идентификатору.
+func (h *Harness) Get(id string) *entity.Listing {
+	return h.listings[id]
+}
+
+// GetListingsByDesiredTags возвращает активные объявления, владельцы которых хотят получить любой из тегов.
+func (h *Harness) GetListingsByDesiredTags(_ context.Context, tags []string, limit int64) (entity.Listings, error) {
+	wanted := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		wanted[tag] = struct{}{}
+	}
+
+	ids := make([]string, 0, len(h.listings))
+	for id := range h.listings {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	found := make(entity.Listings, 0)
+
+	for _, id := range ids {
+		listing := h.listings[id]
+		if !listing.IsAvailable() {
+			continue
+		}
+
+		for _, tag := range listing.DesiredTags {
+			if _, ok := wanted[tag]; ok {
+				found = append(found, listing)
+
+				break
+			}
+		}
+
+		if int64(len(found)) >= limit {
+			break
+		}
+	}
+
+	return found, nil
+}
+
+// Keys возвращает ключи циклов в порядке ранжирования.
+func Keys(cycles entity.TradeCycles) []string {
+	keys := make([]string, 0, len(cycles))
+
+	for _, cycle := range cycles {
+		keys = append(keys, cycle.Key)
+	}
+
+	return keys
+}
@@ -0,0 +1,174 @@
+package matching
+
+import (
+	"math"
+	"sort"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+const (
+	// lengthDecay штраф за каждого участника сверх двух: длинные циклы реже доходят до исполнения.
+	lengthDecay = 0.9
+	// titleMatchFactor вес совпадения только по слову заголовка, без совпадения категории.
+	titleMatchFactor = 0.8
+	// scorePrecision точность округления оценки, чтобы ранжирование не зависело от погрешности вычислений.
+	scorePrecision = 1e4
+)
+
+// conditionWeights вес состояния передаваемого предмета.
+var conditionWeights = map[entity.ListingCondition]float64{
+	entity.ListingConditionNew:     1,
+	entity.ListingConditionLikeNew: 0.9,
+	entity.ListingConditionGood:    0.75,
+	entity.ListingConditionFair:    0.5,
+	entity.ListingConditionPoor:    0.3,
+}
+
+// legScore возвращает качество звена: состояние предмета и точность совпадения тегов.
+func legScore(listing *entity.Listing, matched []string) float64 {
+	weight, ok := conditionWeights[listing.Condition]
+	if !ok {
+		weight = conditionWeights[entity.ListingConditionFair]
+	}
+
+	category := entity.NormalizeTags([]string{listing.Category})
+	for _, tag := range matched {
+		if len(category) > 0 && tag == category[0] {
+			return weight
+		}
+	}
+
+	return weight * titleMatchFactor
+}
+
+// candidate найденный цикл до сохранения.
+type candidate struct {
+	legs  []entity.TradeCycleLeg // Звенья цикла
+	key   string                 // Канонический ключ
+	score float64                // Качество цикла
+}
+
+// searcher ограниченный поиск в глубину циклов, проходящих через seed.
+type searcher struct {
+	g      *graph              // Граф объявлений
+	seed   int                 // Объявление, через которое ищутся циклы
+	opts   Options             // Ограничения поиска
+	path   []edge              // Текущий путь от seed
+	owners map[string]struct{} // Владельцы объявлений на текущем пути
+	found  []candidate         // Найденные циклы
+
+	examined  int  // Количество просмотренных ребер
+	exhausted bool // Бюджет исчерпан
+}
+
+// newSearcher создает поиск циклов через объявление seed.
+func newSearcher(g *graph, seed int, opts Options) *searcher {
+	return &searcher{
+		g:      g,
+		seed:   seed,
+		opts:   opts,
+		path:   make([]edge, 0, opts.MaxLength),
+		owners: map[string]struct{}{g.nodes[seed].OwnerID: {}},
+	}
+}
+
+// run запускает поиск.
+func (s *searcher) run() {
+	s.visit(s.seed)
+}
+
+// visit обходит ребра узла. Каждый участник встречается в цикле не более одного раза.
+func (s *searcher) visit(node int) {
+	for _, e := range s.g.out[node] {
+		if s.examined >= s.opts.Budget {
+			s.exhausted = true
+
+			return
+		}
+
+		s.examined++
+
+		if e.to == s.seed {
+			s.record(e)
+
+			continue
+		}
+
+		// Переход в новый узел добавляет в цикл еще одного участника.
+		if len(s.path)+2 > s.opts.MaxLength {
+			continue
+		}
+
+		owner := s.g.nodes[e.to].OwnerID
+		if _, ok := s.owners[owner]; ok {
+			continue
+		}
+
+		s.owners[owner] = struct{}{}
+		s.path = append(s.path, e)
+
+		s.visit(e.to)
+
+		s.path = s.path[:len(s.path)-1]
+		delete(s.owners, owner)
+
+		if s.exhausted {
+			return
+		}
+	}
+}
+
+// record сохраняет цикл, замыкаемый ребром closing.
+func (s *searcher) record(closing edge) {
+	edges := make([]edge, 0, len(s.path)+1)
+	edges = append(edges, s.path...)
+	edges = append(edges, closing)
+
+	legs := make([]entity.TradeCycleLeg, 0, len(edges))
+	ids := make([]string, 0, len(edges))
+	total := 0.0
+
+	for _, e := range edges {
+		from, to := s.g.nodes[e.from], s.g.nodes[e.to]
+
+		legs = append(legs, entity.TradeCycleLeg{
+			ListingID:   from.ID,
+			GiverID:     from.OwnerID,
+			ReceiverID:  to.OwnerID,
+			MatchedTags: e.matched,
+			Score:       e.score,
+		})
+		ids = append(ids, from.ID)
+		total += e.score
+	}
+
+	score := total / float64(len(edges)) * math.Pow(lengthDecay, float64(len(edges)-minLength))
+
+	s.found = append(s.found, candidate{
+		legs:  legs,
+		key:   entity.TradeCycleKey(ids),
+		score: math.Round(score*scorePrecision) / scorePrecision,
+	})
+}
+
+// rank сортирует циклы по убыванию качества, затем по длине и ключу.
+func rank(found []candidate) []candidate {
+	ranked := make([]candidate, len(found))
+	copy(ranked, found)
+
+	sort.Slice(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+
+		switch {
+		case a.score != b.score:
+			return a.score > b.score
+		case len(a.legs) != len(b.legs):
+			return len(a.legs) < len(b.legs)
+		default:
+			return a.key < b.key
+		}
+	})
+
+	return ranked
+}
@@ -0,0 +1,476 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"gitlab.com/example/gophers/libs/logger"
+	"gitlab.com/example/gophers/libs/trace"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+	"github.com/alisher-99/LomBarter/pkg/thumbnail"
+)
+
+// sniffLen количество первых байт файла, по которым определяется его тип.
+const sniffLen = 512
+
+// MediaService представляет интерфейс сервиса для работы с медиафайлами.
+type MediaService interface {
+	// Upload загружает изображение, сохраняет его миниатюру и возвращает медиафайл с подписанными ссылками.
+	Upload(ctx context.Context, uploadForm form.MediaUpload, currentTime time.Time) (*entity.Media, error)
+	// GetMedia возвращает медиафайл с подписанными ссылками.
+	GetMedia(ctx context.Context, id string) (*entity.Media, error)
+	// GetSubjectMedia возвращает медиафайлы, прикрепленные к сущности, с подписанными ссылками.
+	GetSubjectMedia(ctx context.Context, filter form.MediaGet) (entity.MediaList, error)
+	// AttachMedia прикрепляет медиафайл владельца к его объявлению.
+	AttachMedia(ctx context.Context, attachForm form.MediaAttach) (*entity.Media, error)
+	// DeleteMedia удаляет медиафайл владельца вместе с файлами в хранилище.
+	DeleteMedia(ctx context.Context, deleteForm form.MediaDelete, currentTime time.Time) error
+	// DeleteSubjectMedia удаляет медиафайлы удаленной сущности и возвращает их количество.
+	DeleteSubjectMedia(ctx context.Context, subjectType entity.MediaSubject, subjectID string) (int, error)
+	// SetAvatar делает медиафайл аватаром пользователя. Прежний аватар удаляется.
+	SetAvatar(ctx context.Context, avatarForm form.AvatarSet, currentTime time.Time) (*entity.Media, error)
+	// DeleteAvatar убирает и удаляет аватар пользователя.
+	DeleteAvatar(ctx context.Context, deleteForm form.AvatarDelete, currentTime time.Time) error
+	// AvatarURL возвращает подписанную ссылку на аватар пользователя или его миниатюру.
+	AvatarURL(ctx context.Context, userID string, thumbnail bool) (string, error)
+}
+
+// MediaOptions ограничения медиафайлов.
+type MediaOptions struct {
+	MaxSize       int64         // Максимальный размер файла в байтах
+	ThumbnailSize int           // Размер большей стороны миниатюры в пикселях
+	URLTTL        time.Duration // Время действия ссылок на скачивание
+}
+
+// mediaService представляет сервис для работы с медиафайлами.
+type mediaService struct {
+	mediaRepo   repository.MediaRepository   // Репозиторий медиафайлов
+	userRepo    repository.UserRepository    // Репозиторий пользователей
+	listingRepo repository.ListingRepository // Репозиторий объявлений
+	blobs       repository.BlobStore         // Хранилище файлов
+	cacheData   repository.CacheStore        // Кэш пользователей
+	opts        MediaOptions                 // Ограничения медиафайлов
+	tracer      trace.TracerProvider         // Отслеживает запросы между слоями и микросервисами
+	logger      logger.Logger                // Логирование запросов и ошибок сервиса
+}
+
+// NewMediaService создает новый экземпляр сервиса для работы с медиафайлами.
+func NewMediaService(
+	mediaRepo repository.MediaRepository,
+	userRepo repository.UserRepository,
+	listingRepo repository.ListingRepository,
+	blobs repository.BlobStore,
+	cacheData repository.CacheStore,
+	opts MediaOptions,
+	l logger.Logger,
+	tracer trace.TracerProvider,
+) MediaService {
+	return &mediaService{
+		mediaRepo:   mediaRepo,
+		userRepo:    userRepo,
+		listingRepo: listingRepo,
+		blobs:       blobs,
+		cacheData:   cacheData,
+		opts:        opts,
+		tracer:      tracer,
+		logger:      l.WithFields(logger.Fields{"layer": "media-service"}),
+	}
+}
+
+// Upload загружает изображение. Файл читается в память целиком, но не больше MaxSize, тип определяется
+// по первым байтам содержимого. Если запись в базу не удалась, файлы удаляются из хранилища.
+func (s *mediaService) Upload(ctx context.Context, uploadForm form.MediaUpload, currentTime time.Time) (*entity.Media, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "MediaService.Upload")
+	defer span.End()
+
+	if err := uploadForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(uploadForm.File, s.opts.MaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("чтение файла: %w", err)
+	}
+
+	if int64(len(data)) > s.opts.MaxSize {
+		return nil, fmt.Errorf("%w: больше %d байт", entity.ErrMediaTooLarge, s.opts.MaxSize)
+	}
+
+	if len(data) == 0 {
+		return nil, entity.ErrMediaFileRequired
+	}
+
+	media, err := entity.NewMedia(uploadForm.OwnerID, http.DetectContentType(data[:min(len(data), sniffLen)]), int64(len(data)), currentTime)
+	if err != nil {
+		return nil, err
+	}
+
+	thumb, err := thumbnail.Generate(data, s.opts.ThumbnailSize)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", entity.ErrMediaInvalidImage, err.Error())
+	}
+
+	media.Width, media.Height = thumb.Width, thumb.Height
+
+	if err = s.blobs.Put(ctx, media.Key, bytes.NewReader(data), int64(len(data)), media.ContentType); err != nil {
+		return nil, fmt.Errorf("сохранение файла: %w", err)
+	}
+
+	err = s.blobs.Put(ctx, media.ThumbnailKey, bytes.NewReader(thumb.Data), int64(len(thumb.Data)), "image/jpeg")
+	if err == nil {
+		_, err = s.mediaRepo.CreateMedia(ctx, media)
+	}
+
+	if err != nil {
+		s.deleteBlobs(ctx, media)
+
+		return nil, fmt.Errorf("сохранение медиафайла: %w", err)
+	}
+
+	if err = s.sign(ctx, media); err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// GetMedia возвращает медиафайл с подписанными ссылками.
+func (s *mediaService) GetMedia(ctx context.Context, id string) (*entity.Media, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "MediaService.GetMedia")
+	defer span.End()
+
+	media, err := s.mediaRepo.GetMediaByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("получение медиафайла: %w", err)
+	}
+
+	if err = s.sign(ctx, media); err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// GetSubjectMedia возвращает медиафайлы, прикрепленные к сущности, с подписанными ссылками.
+func (s *mediaService) GetSubjectMedia(ctx context.Context, filter form.MediaGet) (entity.MediaList, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "MediaService.GetSubjectMedia")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	list, err := s.mediaRepo.GetMediaBySubject(ctx, filter.SubjectType, filter.SubjectID)
+	if err != nil {
+		return nil, fmt.Errorf("получение медиафайлов: %w", err)
+	}
+
+	for _, media := range list {
+		if err = s.sign(ctx, media); err != nil {
+			return nil, err
+		}
+	}
+
+	return list, nil
+}
+
+// AttachMedia прикрепляет медиафайл владельца к его объявлению.
+func (s *mediaService) AttachMedia(ctx context.Context, attachForm form.MediaAttach) (*entity.Media, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "MediaService.AttachMedia")
+	defer span.End()
+
+	if err := attachForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	media, err := s.ownMedia(ctx, attachForm.ID, attachForm.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	listing, err := s.listingRepo.GetListingByID(ctx, attachForm.SubjectID)
+	if err != nil {
+		return nil, fmt.Errorf("получение объявления: %w", err)
+	}
+
+	if listing.OwnerID != attachForm.OwnerID {
+		return nil, entity.ErrMediaForbidden
+	}
+
+	if err = s.mediaRepo.AttachMedia(ctx, media.ID, attachForm.SubjectType, attachForm.SubjectID); err != nil {
+		return nil, fmt.Errorf("прикрепление медиафайла: %w", err)
+	}
+
+	media.SubjectType, media.SubjectID = attachForm.SubjectType, attachForm.SubjectID
+
+	if err = s.sign(ctx, media); err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// DeleteMedia удаляет медиафайл владельца. Если файл - аватар, аватар у пользователя убирается.
+func (s *mediaService) DeleteMedia(ctx context.Context, deleteForm form.MediaDelete, currentTime time.Time) error {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "MediaService.DeleteMedia")
+	defer span.End()
+
+	if err := deleteForm.Validate(); err != nil {
+		return fmt.Errorf("валидация формы: %w", err)
+	}
+
+	media, err := s.ownMedia(ctx, deleteForm.ID, deleteForm.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	// Доказательства по спору остаются в деле и после решения, поэтому владелец не может их удалить.
+	if media.SubjectType == entity.MediaSubjectDispute {
+		return fmt.Errorf("%w: приложен к спору %s", entity.ErrMediaAttached, media.SubjectID)
+	}
+
+	if media.SubjectType == entity.MediaSubjectUser {
+		if err = s.clearAvatar(ctx, media.SubjectID, media.ID, currentTime); err != nil {
+			return err
+		}
+	}
+
+	return s.remove(ctx, media)
+}
+
+// DeleteSubjectMedia удаляет медиафайлы удаленной сущности. Повторный вызов безопасен.
+func (s *mediaService) DeleteSubjectMedia(ctx context.Context, subjectType entity.MediaSubject, subjectID string) (int, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "MediaService.DeleteSubjectMedia")
+	defer span.End()
+
+	list, err := s.mediaRepo.GetMediaBySubject(ctx, subjectType, subjectID)
+	if err != nil {
+		return 0, fmt.Errorf("получение медиафайлов сущности: %w", err)
+	}
+
+	for i, media := range list {
+		if err = s.remove(ctx, media); err != nil {
+			return i, err
+		}
+	}
+
+	return len(list), nil
+}
+
+// SetAvatar делает медиафайл аватаром пользователя. Прежний аватар удаляется после сохранения нового,
+// ошибка его удаления только логируется.
+func (s *mediaService) SetAvatar(ctx context.Context, avatarForm form.AvatarSet, currentTime time.Time) (*entity.Media, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "MediaService.SetAvatar")
+	defer span.End()
+
+	if err := avatarForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	if avatarForm.RequesterID != avatarForm.UserID {
+		return nil, entity.ErrMediaForbidden
+	}
+
+	media, err := s.ownMedia(ctx, avatarForm.MediaID, avatarForm.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetUserByID(ctx, avatarForm.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("получение пользователя: %w", err)
+	}
+
+	if err = s.mediaRepo.AttachMedia(ctx, media.ID, entity.MediaSubjectUser, user.ID); err != nil {
+		return nil, fmt.Errorf("прикрепление медиафайла: %w", err)
+	}
+
+	if err = s.userRepo.SetUserAvatar(ctx, user.ID, media.ID, currentTime); err != nil {
+		return nil, fmt.Errorf("сохранение аватара: %w", err)
+	}
+
+	previousID := user.AvatarID
+	user.AvatarID, user.UpdatedAt = media.ID, currentTime
+	s.refreshCache(ctx, user)
+
+	if previousID != "" && previousID != media.ID {
+		s.removeByID(ctx, previousID)
+	}
+
+	media.SubjectType, media.SubjectID = entity.MediaSubjectUser, user.ID
+
+	if err = s.sign(ctx, media); err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// DeleteAvatar убирает и удаляет аватар пользователя.
+func (s *mediaService) DeleteAvatar(ctx context.Context, deleteForm form.AvatarDelete, currentTime time.Time) error {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "MediaService.DeleteAvatar")
+	defer span.End()
+
+	if err := deleteForm.Validate(); err != nil {
+		return fmt.Errorf("валидация формы: %w", err)
+	}
+
+	if deleteForm.RequesterID != deleteForm.UserID {
+		return entity.ErrMediaForbidden
+	}
+
+	user, err := s.userRepo.GetUserByID(ctx, deleteForm.UserID)
+	if err != nil {
+		return fmt.Errorf("получение пользователя: %w", err)
+	}
+
+	if user.AvatarID == "" {
+		return entity.ErrMediaNotFound
+	}
+
+	if err = s.clearAvatar(ctx, user.ID, user.AvatarID, currentTime); err != nil {
+		return err
+	}
+
+	s.removeByID(ctx, user.AvatarID)
+
+	return nil
+}
+
+// AvatarURL возвращает подписанную ссылку на аватар пользователя или его миниатюру.
+func (s *mediaService) AvatarURL(ctx context.Context, userID string, thumbnail bool) (string, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "MediaService.AvatarURL")
+	defer span.End()
+
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("получение пользователя: %w", err)
+	}
+
+	if user.AvatarID == "" {
+		return "", entity.ErrMediaNotFound
+	}
+
+	media, err := s.mediaRepo.GetMediaByID(ctx, user.AvatarID)
+	if err != nil {
+		return "", fmt.Errorf("получение аватара: %w", err)
+	}
+
+	key := media.Key
+	if thumbnail {
+		key = media.ThumbnailKey
+	}
+
+	url, err := s.blobs.SignedURL(ctx, key, s.opts.URLTTL)
+	if err != nil {
+		return "", fmt.Errorf("подпись ссылки на аватар: %w", err)
+	}
+
+	return url, nil
+}
+
+// ownMedia возвращает медиафайл, если он принадлежит пользователю.
+func (s *mediaService) ownMedia(ctx context.Context, id, ownerID string) (*entity.Media, error) {
+	media, err := s.mediaRepo.GetMediaByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("получение медиафайла: %w", err)
+	}
+
+	if media.OwnerID != ownerID {
+		return nil, entity.ErrMediaForbidden
+	}
+
+	return media, nil
+}
+
+// clearAvatar убирает аватар у пользователя, если аватар - медиафайл mediaID.
+func (s *mediaService) clearAvatar(ctx context.Context, userID, mediaID string, currentTime time.Time) error {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrUserNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("получение пользователя: %w", err)
+	}
+
+	if user.AvatarID != mediaID {
+		return nil
+	}
+
+	if err = s.userRepo.SetUserAvatar(ctx, userID, "", currentTime); err != nil {
+		return fmt.Errorf("удаление аватара: %w", err)
+	}
+
+	user.AvatarID, user.UpdatedAt = "", currentTime
+	s.refreshCache(ctx, user)
+
+	return nil
+}
+
+// remove удаляет файлы медиафайла из хранилища, затем запись о нем. При ошибке удаления файлов запись
+// остается, и удаление можно повторить.
+func (s *mediaService) remove(ctx context.Context, media *entity.Media) error {
+	for _, key := range media.Keys() {
+		if err := s.blobs.Delete(ctx, key); err != nil {
+			return fmt.Errorf("удаление файла %s: %w", key, err)
+		}
+	}
+
+	if err := s.mediaRepo.DeleteMedia(ctx, media.ID); err != nil {
+		return fmt.Errorf("удаление медиафайла: %w", err)
+	}
+
+	return nil
+}
+
+// removeByID удаляет медиафайл, который больше не используется. Ошибка только логируется.
+func (s *mediaService) removeByID(ctx context.Context, id string) {
+	media, err := s.mediaRepo.GetMediaByID(ctx, id)
+	if err == nil {
+		err = s.remove(ctx, media)
+	}
+
+	if err != nil && !errors.Is(err, entity.ErrMediaNotFound) {
+		s.logger.WithFields(logger.Fields{"media_id": id}).Errorf("удаление неиспользуемого медиафайла: %v", err)
+	}
+}
+
+// deleteBlobs удаляет файлы медиафайла, который не удалось сохранить. Ошибка только логируется.
+func (s *mediaService) deleteBlobs(ctx context.Context, media *entity.Media) {
+	for _, key := range media.Keys() {
+		if err := s.blobs.Delete(ctx, key); err != nil {
+			s.logger.WithFields(logger.Fields{"key": key}).Errorf("удаление файла после ошибки загрузки: %v", err)
+		}
+	}
+}
+
+// refreshCache обновляет профиль пользователя в кэше. Ошибка только логируется.
+func (s *mediaService) refreshCache(ctx context.Context, user *entity.User) {
+	if err := s.cacheData.UserCache().SetUser(ctx, user); err != nil {
+		s.logger.WithFields(logger.Fields{"id": user.ID}).Errorf("обновление кэша: %v", err)
+	}
+}
+
+// sign заполняет подписанные ссылки на файл и миниатюру.
+func (s *mediaService) sign(ctx context.Context, media *entity.Media) error {
+	var err error
+
+	if media.URL, err = s.blobs.SignedURL(ctx, media.Key, s.opts.URLTTL); err != nil {
+		return fmt.Errorf("подпись ссылки на файл: %w", err)
+	}
+
+	if media.ThumbnailURL, err = s.blobs.SignedURL(ctx, media.ThumbnailKey, s.opts.URLTTL); err != nil {
+		return fmt.Errorf("подпись ссылки на миниатюру: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,494 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.com/example/gophers/libs/logger"
+	"gitlab.com/example/gophers/libs/trace"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+	"github.com/alisher-99/LomBarter/internal/service/moderation"
+)
+
+// ContentModerator проверяет пользовательские тексты перед сохранением.
+type ContentModerator interface {
+	// Screen проверяет новый текст и возвращает его статус модерации и найденные нарушения.
+	// Текст с нарушениями и исправленный после отклонения текст получают статус pending.
+	// Если автор заблокирован, возвращает entity.ErrModerationBanned.
+	Screen(
+		ctx context.Context,
+		content entity.ModerationContent,
+		previous entity.ModerationStatus,
+	) (entity.ModerationStatus, entity.ModerationViolations, error)
+	// Flag отправляет текст в очередь модерации: открывает дело или дополняет уже открытое.
+	// Вызывается в транзакции сохранения текста.
+	Flag(ctx context.Context, content entity.ModerationContent, violations entity.ModerationViolations, currentTime time.Time) error
+}
+
+// ModerationService представляет интерфейс сервиса модерации пользовательских текстов.
+type ModerationService interface {
+	ContentModerator
+
+	// Report принимает жалобу пользователя на профиль или объявление.
+	Report(ctx context.Context, reportForm form.ModerationReportCreate, currentTime time.Time) (*entity.ModerationCase, error)
+	// GetQueue возвращает дела модерации по фильтру и их общее количество. Доступно администраторам.
+	GetQueue(ctx context.Context, filter form.ModerationQueueGet) (entity.ModerationCases, int64, error)
+	// Decide закрывает дело решением модератора и применяет его к тексту и автору. Доступно администраторам.
+	Decide(ctx context.Context, decideForm form.ModerationDecide, currentTime time.Time) (*entity.ModerationCase, error)
+}
+
+// moderationService представляет сервис модерации пользовательских текстов.
+type moderationService struct {
+	moderationRepo repository.ModerationRepository // Репозиторий дел модерации
+	userRepo       repository.UserRepository       // Репозиторий пользователей
+	listingRepo    repository.ListingRepository    // Репозиторий объявлений
+	auditRepo      repository.AuditRepository      // Журнал изменений
+	cacheData      repository.CacheStore           // Кэш пользователей
+	txStarter      repository.TxStarter            // Запуск транзакций
+	pipeline       *moderation.Pipeline            // Правила проверки текстов
+	admins         entity.Admins                   // Администраторы, которые принимают решения
+	tracer         trace.TracerProvider            // Отслеживает запросы между слоями и микросервисами
+	logger         logger.Logger                   // Логирование запросов и ошибок сервиса
+}
+
+// NewModerationService создает новый экземпляр сервиса модерации.
+func NewModerationService(
+	moderationRepo repository.ModerationRepository,
+	userRepo repository.UserRepository,
+	listingRepo repository.ListingRepository,
+	auditRepo repository.AuditRepository,
+	cacheData repository.CacheStore,
+	txStarter repository.TxStarter,
+	pipeline *moderation.Pipeline,
+	admins entity.Admins,
+	l logger.Logger,
+	tracer trace.TracerProvider,
+) ModerationService {
+	return &moderationService{
+		moderationRepo: moderationRepo,
+		userRepo:       userRepo,
+		listingRepo:    listingRepo,
+		auditRepo:      auditRepo,
+		cacheData:      cacheData,
+		txStarter:      txStarter,
+		pipeline:       pipeline,
+		admins:         admins,
+		tracer:         tracer,
+		logger:         l.WithFields(logger.Fields{"layer": "moderation-service"}),
+	}
+}
+
+// Screen проверяет новый текст правилами.
+func (s *moderationService) Screen(
+	ctx context.Context,
+	content entity.ModerationContent,
+	previous entity.ModerationStatus,
+) (entity.ModerationStatus, entity.ModerationViolations, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "ModerationService.Screen")
+	defer span.End()
+
+	// У нового пользователя автора еще нет, блокировать некого.
+	if content.AuthorID != "" {
+		author, err := s.userRepo.GetUserByID(ctx, content.AuthorID)
+		if err != nil {
+			return "", nil, fmt.Errorf("получение автора: %w", err)
+		}
+
+		if author.IsBanned() {
+			return "", nil, entity.ErrModerationBanned
+		}
+	}
+
+	// Пустой текст скрывать нечего.
+	if content.Text == "" {
+		return entity.ModerationStatusApproved, nil, nil
+	}
+
+	violations, err := s.pipeline.Check(ctx, content)
+	if err != nil {
+		return "", nil, fmt.Errorf("проверка текста: %w", err)
+	}
+
+	// Исправленный после отклонения текст снова показывается только после решения модератора.
+	if len(violations) > 0 || previous == entity.ModerationStatusRejected {
+		return entity.ModerationStatusPending, violations, nil
+	}
+
+	return entity.ModerationStatusApproved, nil, nil
+}
+
+// Flag открывает дело по тексту или добавляет нарушения в уже открытое.
+func (s *moderationService) Flag(
+	ctx context.Context,
+	content entity.ModerationContent,
+	violations entity.ModerationViolations,
+	currentTime time.Time,
+) error {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "ModerationService.Flag")
+	defer span.End()
+
+	moderationCase, err := s.moderationRepo.GetOpenCase(ctx, content.SubjectType, content.SubjectID)
+	if errors.Is(err, entity.ErrModerationCaseNotFound) {
+		moderationCase = entity.NewModerationCase(content, currentTime)
+		moderationCase.Violations = violations
+
+		if err = s.moderationRepo.CreateCase(ctx, moderationCase); err != nil {
+			return fmt.Errorf("открытие дела модерации: %w", err)
+		}
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("получение открытого дела модерации: %w", err)
+	}
+
+	moderationCase.Flag(content, violations, currentTime)
+	if err = s.moderationRepo.UpdateCase(ctx, moderationCase); err != nil {
+		return fmt.Errorf("обновление дела модерации: %w", err)
+	}
+
+	return nil
+}
+
+// Report добавляет жалобу в открытое дело по тексту или открывает новое.
+func (s *moderationService) Report(
+	ctx context.Context,
+	reportForm form.ModerationReportCreate,
+	currentTime time.Time,
+) (*entity.ModerationCase, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "ModerationService.Report")
+	defer span.End()
+
+	if err := reportForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	content, err := s.subjectContent(ctx, reportForm.SubjectType, reportForm.SubjectID, currentTime)
+	if err != nil {
+		return nil, err
+	}
+
+	report := entity.ModerationReport{
+		ReporterID: reportForm.ReporterID,
+		Reason:     reportForm.Reason,
+		Comment:    reportForm.Comment,
+		CreatedAt:  currentTime,
+	}
+
+	txCtx, done, err := s.txStarter.StartSession(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("начало транзакции: %w", err)
+	}
+
+	moderationCase, err := s.reportInTx(txCtx, content, report)
+	if err = done(txCtx, err); err != nil {
+		return nil, fmt.Errorf("сохранение жалобы: %w", err)
+	}
+
+	s.logger.WithFields(logger.Fields{
+		"case_id":      moderationCase.ID,
+		"subject_type": content.SubjectType,
+		"subject_id":   content.SubjectID,
+		"reason":       report.Reason,
+	}).Info("получена жалоба на текст")
+
+	return moderationCase, nil
+}
+
+// reportInTx добавляет жалобу в дело в рамках транзакции.
+func (s *moderationService) reportInTx(
+	ctx context.Context,
+	content entity.ModerationContent,
+	report entity.ModerationReport,
+) (*entity.ModerationCase, error) {
+	moderationCase, err := s.moderationRepo.GetOpenCase(ctx, content.SubjectType, content.SubjectID)
+	if errors.Is(err, entity.ErrModerationCaseNotFound) {
+		moderationCase = entity.NewModerationCase(content, report.CreatedAt)
+		if err = moderationCase.AddReport(report); err != nil {
+			return nil, err
+		}
+
+		if err = s.moderationRepo.CreateCase(ctx, moderationCase); err != nil {
+			return nil, fmt.Errorf("открытие дела модерации: %w", err)
+		}
+
+		return moderationCase, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("получение открытого дела модерации: %w", err)
+	}
+
+	if err = moderationCase.AddReport(report); err != nil {
+		return nil, err
+	}
+
+	if err = s.moderationRepo.UpdateCase(ctx, moderationCase); err != nil {
+		return nil, fmt.Errorf("обновление дела модерации: %w", err)
+	}
+
+	return moderationCase, nil
+}
+
+// GetQueue возвращает дела модерации по фильтру и их общее количество.
+func (s *moderationService) GetQueue(ctx context.Context, filter form.ModerationQueueGet) (entity.ModerationCases, int64, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "ModerationService.GetQueue")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	if !s.admins.Contains(filter.RequesterID) {
+		return nil, 0, entity.ErrModerationForbidden
+	}
+
+	cases, count, err := s.moderationRepo.GetQueue(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение очереди модерации: %w", err)
+	}
+
+	return cases, count, nil
+}
+
+// Decide закрывает дело решением модератора. Одобрение показывает текст, отклонение скрывает его,
+// блокировка дополнительно скрывает автора и все его объявления. Дело, тексты, автор и записи журнала
+// изменений сохраняются в одной транзакции.
+func (s *moderationService) Decide(
+	ctx context.Context,
+	decideForm form.ModerationDecide,
+	currentTime time.Time,
+) (*entity.ModerationCase, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "ModerationService.Decide")
+	defer span.End()
+
+	if err := decideForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	if !s.admins.Contains(decideForm.RequesterID) {
+		return nil, entity.ErrModerationForbidden
+	}
+
+	moderationCase, err := s.moderationRepo.GetCaseByID(ctx, decideForm.CaseID)
+	if err != nil {
+		return nil, fmt.Errorf("получение дела модерации: %w", err)
+	}
+
+	err = moderationCase.Decide(entity.ModerationDecision{
+		ModeratorID: decideForm.RequesterID,
+		Action:      decideForm.Action,
+		Comment:     decideForm.Comment,
+		DecidedAt:   currentTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	txCtx, done, err := s.txStarter.StartSession(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("начало транзакции: %w", err)
+	}
+
+	users, err := s.decideInTx(txCtx, moderationCase, currentTime)
+	if err = done(txCtx, err); err != nil {
+		return nil, fmt.Errorf("сохранение решения модератора: %w", err)
+	}
+
+	// Профиль в кэше должен показывать новый статус модерации.
+	for _, user := range users {
+		if err = s.cacheData.UserCache().SetUser(ctx, user); err != nil {
+			s.logger.WithFields(logger.Fields{"id": user.ID}).Errorf("обновление кэша: %v", err)
+		}
+	}
+
+	s.logger.WithFields(logger.Fields{
+		"case_id":      moderationCase.ID,
+		"moderator_id": decideForm.RequesterID,
+		"action":       decideForm.Action,
+	}).Info("принято решение модератора")
+
+	return moderationCase, nil
+}
+
+// decideInTx сохраняет решение по делу и применяет его к тексту и автору в рамках транзакции.
+// Возвращает измененных пользователей.
+func (s *moderationService) decideInTx(
+	ctx context.Context,
+	moderationCase *entity.ModerationCase,
+	currentTime time.Time,
+) ([]*entity.User, error) {
+	if err := s.moderationRepo.UpdateCase(ctx, moderationCase); err != nil {
+		return nil, fmt.Errorf("обновление дела модерации: %w", err)
+	}
+
+	status := moderationCase.Decision.ContentStatus()
+	ban := moderationCase.Decision.Action == entity.ModerationActionBan
+
+	var users []*entity.User
+
+	switch moderationCase.SubjectType {
+	case entity.ModerationSubjectUser:
+		user, err := s.moderateUser(ctx, moderationCase.SubjectID, status, ban, currentTime)
+		if err != nil {
+			return nil, err
+		}
+
+		if user != nil {
+			users = append(users, user)
+		}
+	case entity.ModerationSubjectListing:
+		if err := s.moderateListing(ctx, moderationCase.SubjectID, status, currentTime); err != nil {
+			return nil, err
+		}
+
+		if ban {
+			author, err := s.moderateUser(ctx, moderationCase.AuthorID, "", true, currentTime)
+			if err != nil {
+				return nil, err
+			}
+
+			if author != nil {
+				users = append(users, author)
+			}
+		}
+	}
+
+	if ban {
+		hidden, err := s.listingRepo.RejectOwnerListings(ctx, moderationCase.AuthorID, currentTime)
+		if err != nil {
+			return nil, fmt.Errorf("скрытие объявлений автора: %w", err)
+		}
+
+		s.logger.WithFields(logger.Fields{"author_id": moderationCase.AuthorID, "listings": hidden}).
+			Info("объявления заблокированного автора скрыты")
+	}
+
+	return users, nil
+}
+
+// moderateUser сохраняет статус модерации биографии и блокировку пользователя.
+// Пустой статус оставляет биографию без изменений. Удаленного пользователя пропускает.
+func (s *moderationService) moderateUser(
+	ctx context.Context,
+	userID string,
+	status entity.ModerationStatus,
+	ban bool,
+	currentTime time.Time,
+) (*entity.User, error) {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if errors.Is(err, entity.ErrUserNotFound) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("получение пользователя: %w", err)
+	}
+
+	before := *user
+
+	if status != "" {
+		user.Moderation = status
+	}
+
+	if ban && !user.IsBanned() {
+		user.BannedAt = &currentTime
+	}
+
+	user.UpdatedAt = currentTime
+
+	if err = s.userRepo.UpdateUserModeration(ctx, user); err != nil {
+		return nil, fmt.Errorf("обновление статуса модерации пользователя: %w", err)
+	}
+
+	err = recordAudit(ctx, s.auditRepo, entity.AuditEntityUser, user.ID, entity.AuditActionUpdate, &before, user, currentTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// moderateListing сохраняет статус модерации объявления. Удаленное объявление пропускает.
+func (s *moderationService) moderateListing(
+	ctx context.Context,
+	listingID string,
+	status entity.ModerationStatus,
+	currentTime time.Time,
+) error {
+	listing, err := s.listingRepo.GetListingByID(ctx, listingID)
+	if errors.Is(err, entity.ErrListingNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("получение объявления: %w", err)
+	}
+
+	before := *listing
+	listing.Moderation = status
+	listing.UpdatedAt = currentTime
+
+	if err = s.listingRepo.UpdateListingModeration(ctx, listing); err != nil {
+		return fmt.Errorf("обновление статуса модерации объявления: %w", err)
+	}
+
+	return recordAudit(ctx, s.auditRepo, entity.AuditEntityListing, listing.ID, entity.AuditActionUpdate, &before, listing, currentTime)
+}
+
+// subjectContent возвращает текущий текст сущности, на которую пожаловались.
+// Скрытые сущности не раскрываются.
+func (s *moderationService) subjectContent(
+	ctx context.Context,
+	subjectType entity.ModerationSubjectType,
+	subjectID string,
+	currentTime time.Time,
+) (entity.ModerationContent, error) {
+	switch subjectType {
+	case entity.ModerationSubjectUser:
+		user, err := s.userRepo.GetUserByID(ctx, subjectID)
+		if err != nil {
+			return entity.ModerationContent{}, fmt.Errorf("получение пользователя: %w", err)
+		}
+
+		if user.IsBanned() {
+			return entity.ModerationContent{}, entity.ErrUserNotFound
+		}
+
+		return user.ModerationContent(currentTime), nil
+	default:
+		listing, err := s.listingRepo.GetListingByID(ctx, subjectID)
+		if err != nil {
+			return entity.ModerationContent{}, fmt.Errorf("получение объявления: %w", err)
+		}
+
+		if !listing.Moderation.IsPublic() {
+			return entity.ModerationContent{}, entity.ErrListingNotFound
+		}
+
+		return listing.ModerationContent(currentTime), nil
+	}
+}
+
+// auditChangeCounter считает изменения текстов автора по журналу изменений.
+type auditChangeCounter struct {
+	auditRepo repository.AuditRepository // Журнал изменений
+}
+
+// NewAuditChangeCounter возвращает счетчик изменений профилей и объявлений автора для правила частоты.
+func NewAuditChangeCounter(auditRepo repository.AuditRepository) moderation.ChangeCounter {
+	return auditChangeCounter{auditRepo: auditRepo}
+}
+
+// CountChanges возвращает количество изменений профилей и объявлений, сделанных автором начиная с since.
+func (c auditChangeCounter) CountChanges(ctx context.Context, authorID string, since time.Time) (int64, error) {
+	return c.auditRepo.CountActorChanges(
+		ctx, authorID, []entity.AuditEntityType{entity.AuditEntityUser, entity.AuditEntityListing}, since,
+	)
+}
@@ -0,0 +1,51 @@
+// Package moderation проверяет пользовательские тексты набором правил.
+//
+// Правила не принимают решений: они только находят подозрительные места в тексте. Текст с нарушениями
+// сохраняется, но попадает в очередь модерации, где решение принимает модератор.
+package moderation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// Rule правило проверки текста.
+type Rule interface {
+	// Name возвращает название правила. Оно сохраняется в найденных нарушениях.
+	Name() string
+	// Check проверяет текст и возвращает найденные нарушения.
+	Check(ctx context.Context, content entity.ModerationContent) (entity.ModerationViolations, error)
+}
+
+// Pipeline последовательно применяет правила к тексту.
+type Pipeline struct {
+	rules []Rule // Правила проверки
+}
+
+// NewPipeline создает набор правил. Пустой набор пропускает любой текст.
+func NewPipeline(rules ...Rule) *Pipeline {
+	return &Pipeline{rules: rules}
+}
+
+// Check применяет все правила и возвращает нарушения всех правил вместе.
+// Пустой текст не проверяется.
+func (p *Pipeline) Check(ctx context.Context, content entity.ModerationContent) (entity.ModerationViolations, error) {
+	if content.Text == "" {
+		return nil, nil
+	}
+
+	var violations entity.ModerationViolations
+
+	for _, rule := range p.rules {
+		found, err := rule.Check(ctx, content)
+		if err != nil {
+			return nil, fmt.Errorf("правило %s: %w", rule.Name(), err)
+		}
+
+		violations = append(violations, found...)
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,130 @@
+package moderation_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/service/moderation"
+)
+
+// counterFunc считает изменения функцией.
+type counterFunc func(ctx context.Context, authorID string, since time.Time) (int64, error)
+
+func (f counterFunc) CountChanges(ctx context.Context, authorID string, since time.Time) (int64, error) {
+	return f(ctx, authorID, since)
+}
+
+func TestPipeline_Check(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	counter := counterFunc(func(_ context.Context, authorID string, since time.Time) (int64, error) {
+		if !since.Equal(now.Add(-time.Hour)) {
+			return 0, errors.New("неверное окно")
+		}
+
+		if authorID == "spammer" {
+			return 5, nil
+		}
+
+		return 1, nil
+	})
+
+	pipeline := moderation.NewPipeline(
+		moderation.NewBannedWords(map[string][]string{
+			"ru": {"Мошенник"},
+			"kk": {"алаяқ"},
+			"en": {"scam"},
+		}),
+		moderation.NewLinks(),
+		moderation.NewPhones(),
+		moderation.NewRate(counter, 5, time.Hour),
+	)
+
+	cases := []struct {
+		name    string
+		content entity.ModerationContent
+		exp     entity.ModerationViolations
+	}{
+		{
+			name:    "чистый текст",
+			content: entity.ModerationContent{AuthorID: "u1", Text: "Меняю велосипед на книги, 2020 года"},
+		},
+		{
+			name:    "пустой текст",
+			content: entity.ModerationContent{AuthorID: "spammer"},
+		},
+		{
+			name:    "запрещенные слова разных языков без учета регистра",
+			content: entity.ModerationContent{AuthorID: "u1", Text: "SCAM! мошенник, алаяқ и снова мошенник"},
+			exp: entity.ModerationViolations{
+				{Rule: moderation.RuleBannedWords, Detail: "en: scam"},
+				{Rule: moderation.RuleBannedWords, Detail: "kk: алаяқ"},
+				{Rule: moderation.RuleBannedWords, Detail: "ru: мошенник"},
+			},
+		},
+		{
+			name:    "слово внутри другого слова не считается",
+			content: entity.ModerationContent{AuthorID: "u1", Text: "scamper"},
+		},
+		{
+			name:    "ссылки",
+			content: entity.ModerationContent{AuthorID: "u1", Text: "пишите https://example.org/a или в t.me/seller"},
+			exp: entity.ModerationViolations{
+				{Rule: moderation.RuleLink, Detail: "https://example.org/a"},
+				{Rule: moderation.RuleLink, Detail: "t.me/seller"},
+			},
+		},
+		{
+			name:    "номер телефона",
+			content: entity.ModerationContent{AuthorID: "u1", Text: "звоните +7 (701) 123-45-67"},
+			exp: entity.ModerationViolations{
+				{Rule: moderation.RulePhone, Detail: "+7 (701) 123-45-67"},
+			},
+		},
+		{
+			name:    "короткие числа не номер",
+			content: entity.ModerationContent{AuthorID: "u1", Text: "размер 42 44, 2019 год"},
+		},
+		{
+			name:    "частые изменения",
+			content: entity.ModerationContent{AuthorID: "spammer", Text: "Меняю велосипед", SubmittedAt: now},
+			exp: entity.ModerationViolations{
+				{Rule: moderation.RuleRate, Detail: "5 изменений за 1h0m0s"},
+			},
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			if s.content.SubmittedAt.IsZero() {
+				s.content.SubmittedAt = now
+			}
+
+			violations, err := pipeline.Check(context.Background(), s.content)
+			require.NoError(t, err)
+			assert.Equal(t, s.exp, violations)
+		})
+	}
+}
+
+func TestPipeline_CheckError(t *testing.T) {
+	t.Parallel()
+
+	errCount := errors.New("база недоступна")
+	pipeline := moderation.NewPipeline(moderation.NewRate(counterFunc(func(context.Context, string, time.Time) (int64, error) {
+		return 0, errCount
+	}), 1, time.Minute))
+
+	_, err := pipeline.Check(context.Background(), entity.ModerationContent{AuthorID: "u1", Text: "текст"})
+	require.ErrorIs(t, err, errCount)
+}
@@ -0,0 +1,186 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// Названия правил.
+const (
+	RuleBannedWords = "banned_words" // Запрещенные слова
+	RuleLink        = "link"         // Ссылки на внешние ресурсы
+	RulePhone       = "phone"        // Номера телефонов
+	RuleRate        = "rate"         // Слишком частые изменения текстов одним автором
+)
+
+// minPhoneDigits минимальное количество цифр в номере телефона.
+const minPhoneDigits = 10
+
+var (
+	// linkPattern ссылки с протоколом, www, t.me и домены популярных зон.
+	linkPattern = regexp.MustCompile(`(?i)(https?://\S+|www\.\S+|\bt\.me/\S+|\b[a-z0-9-]+\.(com|ru|kz|net|org|io|me|info|biz)\b)`)
+	// phonePattern последовательности цифр, которые могут быть разделены пробелами, скобками и дефисами.
+	phonePattern = regexp.MustCompile(`\+?\d[\d\s().-]{8,}\d`)
+)
+
+// bannedWords правило запрещенных слов.
+type bannedWords struct {
+	words map[string]string // Слово в нижнем регистре и язык списка, в котором оно найдено
+}
+
+// NewBannedWords создает правило запрещенных слов. Списки задаются по языкам: текст проверяется
+// всеми списками сразу, потому что пользователи смешивают языки в одном тексте.
+func NewBannedWords(lists map[string][]string) Rule {
+	words := make(map[string]string)
+
+	for lang, list := range lists {
+		for _, word := range list {
+			if word = strings.ToLower(strings.TrimSpace(word)); word != "" {
+				words[word] = lang
+			}
+		}
+	}
+
+	return &bannedWords{words: words}
+}
+
+// Name возвращает название правила.
+func (r *bannedWords) Name() string { return RuleBannedWords }
+
+// Check находит в тексте слова из списков. Каждое слово сообщается один раз.
+func (r *bannedWords) Check(_ context.Context, content entity.ModerationContent) (entity.ModerationViolations, error) {
+	if len(r.words) == 0 {
+		return nil, nil
+	}
+
+	tokens := strings.FieldsFunc(strings.ToLower(content.Text), func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	})
+
+	found := make(map[string]struct{})
+
+	var violations entity.ModerationViolations
+
+	for _, token := range tokens {
+		lang, ok := r.words[token]
+		if !ok {
+			continue
+		}
+
+		if _, seen := found[token]; seen {
+			continue
+		}
+
+		found[token] = struct{}{}
+		violations = append(violations, entity.ModerationViolation{Rule: RuleBannedWords, Detail: lang + ": " + token})
+	}
+
+	sort.Slice(violations, func(i, j int) bool { return violations[i].Detail < violations[j].Detail })
+
+	return violations, nil
+}
+
+// links правило ссылок.
+type links struct{}
+
+// NewLinks создает правило, которое находит ссылки на внешние ресурсы.
+func NewLinks() Rule {
+	return links{}
+}
+
+// Name возвращает название правила.
+func (links) Name() string { return RuleLink }
+
+// Check находит ссылки в тексте.
+func (links) Check(_ context.Context, content entity.ModerationContent) (entity.ModerationViolations, error) {
+	var violations entity.ModerationViolations
+
+	for _, match := range linkPattern.FindAllString(content.Text, -1) {
+		violations = append(violations, entity.ModerationViolation{Rule: RuleLink, Detail: match})
+	}
+
+	return violations, nil
+}
+
+// phones правило номеров телефонов.
+type phones struct{}
+
+// NewPhones создает правило, которое находит номера телефонов. Контакты передаются в переписке,
+// а номер в открытом тексте обычно означает попытку увести сделку с площадки.
+func NewPhones() Rule {
+	return phones{}
+}
+
+// Name возвращает название правила.
+func (phones) Name() string { return RulePhone }
+
+// Check находит номера телефонов в тексте.
+func (phones) Check(_ context.Context, content entity.ModerationContent) (entity.ModerationViolations, error) {
+	var violations entity.ModerationViolations
+
+	for _, match := range phonePattern.FindAllString(content.Text, -1) {
+		digits := strings.Map(func(c rune) rune {
+			if unicode.IsDigit(c) {
+				return c
+			}
+
+			return -1
+		}, match)
+
+		if len(digits) >= minPhoneDigits {
+			violations = append(violations, entity.ModerationViolation{Rule: RulePhone, Detail: match})
+		}
+	}
+
+	return violations, nil
+}
+
+// ChangeCounter считает изменения текстов автора.
+type ChangeCounter interface {
+	// CountChanges возвращает количество изменений, сделанных автором начиная с since.
+	CountChanges(ctx context.Context, authorID string, since time.Time) (int64, error)
+}
+
+// rate правило частоты изменений.
+type rate struct {
+	counter ChangeCounter // Источник количества изменений
+	limit   int64         // Допустимое количество изменений за окно
+	window  time.Duration // Окно подсчета
+}
+
+// NewRate создает правило, которое отмечает тексты автора, изменившего больше limit текстов за window.
+// Частые правки характерны для спама и перебора формулировок в обход остальных правил.
+func NewRate(counter ChangeCounter, limit int, window time.Duration) Rule {
+	return &rate{counter: counter, limit: int64(limit), window: window}
+}
+
+// Name возвращает название правила.
+func (r *rate) Name() string { return RuleRate }
+
+// Check сравнивает количество недавних изменений автора с ограничением.
+func (r *rate) Check(ctx context.Context, content entity.ModerationContent) (entity.ModerationViolations, error) {
+	if r.limit <= 0 || content.AuthorID == "" {
+		return nil, nil
+	}
+
+	changes, err := r.counter.CountChanges(ctx, content.AuthorID, content.SubmittedAt.Add(-r.window))
+	if err != nil {
+		return nil, fmt.Errorf("подсчет изменений автора %s: %w", content.AuthorID, err)
+	}
+
+	if changes < r.limit {
+		return nil, nil
+	}
+
+	return entity.ModerationViolations{{
+		Rule:   RuleRate,
+		Detail: fmt.Sprintf("%d изменений за %s", changes, r.window),
+	}}, nil
+}
@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.com/example/gophers/libs/logger"
+	"gitlab.com/example/gophers/libs/trace"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// NotificationService представляет интерфейс сервиса настроек уведомлений, устройств и входящих пользователей.
+type NotificationService interface {
+	// GetPreferences возвращает настройки уведомлений пользователя. Если он их не менял, возвращает настройки по умолчанию.
+	GetPreferences(ctx context.Context, filter form.NotificationPreferencesGet) (*entity.NotificationPreferences, error)
+	// UpdatePreferences сохраняет настройки уведомлений пользователя.
+	UpdatePreferences(ctx context.Context, updateForm form.NotificationPreferencesUpdate, currentTime time.Time) (*entity.NotificationPreferences, error)
+	// RegisterDevice регистрирует устройство пользователя для push-уведомлений.
+	RegisterDevice(ctx context.Context, registerForm form.DeviceRegister, currentTime time.Time) (*entity.DeviceToken, error)
+	// UnregisterDevice удаляет устройство пользователя.
+	UnregisterDevice(ctx context.Context, unregisterForm form.DeviceUnregister) error
+	// GetInbox возвращает входящие уведомления пользователя и их общее количество.
+	GetInbox(ctx context.Context, filter form.InboxGet) (entity.InboxNotifications, int64, error)
+	// MarkRead отмечает уведомление пользователя прочитанным.
+	MarkRead(ctx context.Context, markForm form.InboxMarkRead, currentTime time.Time) error
+	// MarkAllRead отмечает все уведомления пользователя прочитанными и возвращает их количество.
+	MarkAllRead(ctx context.Context, markForm form.InboxUser, currentTime time.Time) (int64, error)
+	// UnreadCount возвращает количество непрочитанных уведомлений пользователя.
+	UnreadCount(ctx context.Context, filter form.InboxUser) (*entity.UnreadCount, error)
+}
+
+// notificationService представляет сервис настроек уведомлений, устройств и входящих пользователей.
+type notificationService struct {
+	notificationRepo repository.NotificationRepository // Репозиторий уведомлений
+	cacheData        repository.CacheStore             // Кэш счетчиков непрочитанных уведомлений
+	tracer           trace.TracerProvider              // Отслеживает запросы между слоями и микросервисами
+	logger           logger.Logger                     // Логирование запросов и ошибок сервиса
+}
+
+// NewNotificationService создает новый экземпляр сервиса настроек уведомлений.
+func NewNotificationService(
+	notificationRepo repository.NotificationRepository,
+	cacheData repository.CacheStore,
+	l logger.Logger,
+	tracer trace.TracerProvider,
+) NotificationService {
+	return &notificationService{
+		notificationRepo: notificationRepo,
+		cacheData:        cacheData,
+		tracer:           tracer,
+		logger:           l.WithFields(logger.Fields{"layer": "notification-service"}),
+	}
+}
+
+// GetPreferences возвращает настройки уведомлений пользователя.
+func (s *notificationService) GetPreferences(ctx context.Context, filter form.NotificationPreferencesGet) (*entity.NotificationPreferences, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "NotificationService.GetPreferences")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	if filter.RequesterID != filter.UserID {
+		return nil, entity.ErrNotificationForbidden
+	}
+
+	preferences, err := s.notificationRepo.GetNotificationPreferences(ctx, filter.UserID)
+	if errors.Is(err, entity.ErrNotificationPreferencesNotFound) {
+		return entity.DefaultNotificationPreferences(filter.UserID), nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("получение настроек уведомлений: %w", err)
+	}
+
+	return preferences, nil
+}
+
+// UpdatePreferences сохраняет настройки уведомлений пользователя.
+func (s *notificationService) UpdatePreferences(
+	ctx context.Context,
+	updateForm form.NotificationPreferencesUpdate,
+	currentTime time.Time,
+) (*entity.NotificationPreferences, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "NotificationService.UpdatePreferences")
+	defer span.End()
+
+	if err := updateForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	if updateForm.RequesterID != updateForm.UserID {
+		return nil, entity.ErrNotificationForbidden
+	}
+
+	var preferences entity.NotificationPreferences
+
+	if err := updateForm.Fill(&preferences, currentTime); err != nil {
+		return nil, fmt.Errorf("заполнение формы: %w", err)
+	}
+
+	if err := s.notificationRepo.SaveNotificationPreferences(ctx, &preferences); err != nil {
+		return nil, fmt.Errorf("сохранение настроек уведомлений: %w", err)
+	}
+
+	return &preferences, nil
+}
+
+// RegisterDevice регистрирует устройство пользователя для push-уведомлений.
+func (s *notificationService) RegisterDevice(ctx context.Context, registerForm form.DeviceRegister, currentTime time.Time) (*entity.DeviceToken, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "NotificationService.RegisterDevice")
+	defer span.End()
+
+	if err := registerForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	if registerForm.RequesterID != registerForm.UserID {
+		return nil, entity.ErrNotificationForbidden
+	}
+
+	device := &entity.DeviceToken{
+		Token:     registerForm.Token,
+		UserID:    registerForm.UserID,
+		Platform:  registerForm.Platform,
+		UpdatedAt: currentTime,
+	}
+
+	if err := s.notificationRepo.SaveDeviceToken(ctx, device); err != nil {
+		return nil, fmt.Errorf("сохранение устройства: %w", err)
+	}
+
+	return device, nil
+}
+
+// UnregisterDevice удаляет устройство пользователя.
+func (s *notificationService) UnregisterDevice(ctx context.Context, unregisterForm form.DeviceUnregister) error {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "NotificationService.UnregisterDevice")
+	defer span.End()
+
+	if err := unregisterForm.Validate(); err != nil {
+		return fmt.Errorf("валидация формы: %w", err)
+	}
+
+	if unregisterForm.RequesterID != unregisterForm.UserID {
+		return entity.ErrNotificationForbidden
+	}
+
+	if err := s.notificationRepo.DeleteDeviceToken(ctx, unregisterForm.UserID, unregisterForm.Token); err != nil {
+		return fmt.Errorf("удаление устройства: %w", err)
+	}
+
+	return nil
+}
+
+// GetInbox возвращает входящие уведомления пользователя и их общее количество.
+func (s *notificationService) GetInbox(ctx context.Context, filter form.InboxGet) (entity.InboxNotifications, int64, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "NotificationService.GetInbox")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	notifications, count, err := s.notificationRepo.GetInboxNotifications(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение входящих уведомлений: %w", err)
+	}
+
+	return notifications, count, nil
+}
+
+// MarkRead отмечает уведомление пользователя прочитанным и сбрасывает счетчик непрочитанных.
+func (s *notificationService) MarkRead(ctx context.Context, markForm form.InboxMarkRead, currentTime time.Time) error {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "NotificationService.MarkRead")
+	defer span.End()
+
+	if err := markForm.Validate(); err != nil {
+		return fmt.Errorf("валидация формы: %w", err)
+	}
+
+	if err := s.notificationRepo.MarkInboxNotificationRead(ctx, markForm.UserID, markForm.ID, currentTime); err != nil {
+		return fmt.Errorf("отметка о прочтении уведомления: %w", err)
+	}
+
+	s.resetUnreadCount(ctx, markForm.UserID)
+
+	return nil
+}
+
+// MarkAllRead отмечает все уведомления пользователя прочитанными и сбрасывает счетчик непрочитанных.
+func (s *notificationService) MarkAllRead(ctx context.Context, markForm form.InboxUser, currentTime time.Time) (int64, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "NotificationService.MarkAllRead")
+	defer span.End()
+
+	if err := markForm.Validate(); err != nil {
+		return 0, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	marked, err := s.notificationRepo.MarkAllInboxNotificationsRead(ctx, markForm.UserID, currentTime)
+	if err != nil {
+		return 0, fmt.Errorf("отметка о прочтении уведомлений: %w", err)
+	}
+
+	s.resetUnreadCount(ctx, markForm.UserID)
+
+	return marked, nil
+}
+
+// UnreadCount возвращает количество непрочитанных уведомлений пользователя.
+// Счетчик берется из кэша, при промахе считается в базе и сохраняется в кэш.
+func (s *notificationService) UnreadCount(ctx context.Context, filter form.InboxUser) (*entity.UnreadCount, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "NotificationService.UnreadCount")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	count, cErr := s.cacheData.NotificationCache().GetUnreadCount(ctx, filter.UserID)
+	if cErr == nil {
+		return &entity.UnreadCount{Unread: count}, nil
+	}
+
+	if !errors.Is(cErr, entity.ErrNotificationCountNotCached) {
+		s.logger.WithFields(logger.Fields{"userID": filter.UserID}).Errorf("получение счетчика уведомлений из кэша: %v", cErr)
+	}
+
+	count, err := s.notificationRepo.CountUnreadInboxNotifications(ctx, filter.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("подсчет непрочитанных уведомлений: %w", err)
+	}
+
+	if cErr = s.cacheData.NotificationCache().SetUnreadCount(ctx, filter.UserID, count); cErr != nil {
+		s.logger.WithFields(logger.Fields{"userID": filter.UserID}).Errorf("установка кэша: %v", cErr)
+	}
+
+	return &entity.UnreadCount{Unread: count}, nil
+}
+
+// resetUnreadCount сбрасывает счетчик непрочитанных уведомлений пользователя.
+// Входящие уже изменены, поэтому ошибка кэша только логируется.
+func (s *notificationService) resetUnreadCount(ctx context.Context, userID string) {
+	if err := s.cacheData.NotificationCache().DeleteUnreadCount(ctx, userID); err != nil {
+		s.logger.WithFields(logger.Fields{"userID": userID}).Errorf("сброс счетчика уведомлений: %v", err)
+	}
+}
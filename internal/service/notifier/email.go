@@ -0,0 +1,147 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// Mailer отправляет письма.
+type Mailer interface {
+	// SendMail отправляет текстовое письмо на адрес to.
+	SendMail(ctx context.Context, to, subject, body string) error
+}
+
+// SMTPOptions настройки SMTP-сервера.
+type SMTPOptions struct {
+	Addr     string // Адрес сервера host:port
+	From     string // Адрес отправителя
+	Username string // Имя пользователя. Без него письма отправляются без авторизации
+	Password string // Пароль
+}
+
+// smtpMailer отправляет письма через SMTP-сервер.
+type smtpMailer struct {
+	opts SMTPOptions // Настройки сервера
+}
+
+// NewSMTPMailer создает Mailer, отправляющий письма через SMTP-сервер.
+// Если сервер поддерживает STARTTLS, соединение шифруется.
+func NewSMTPMailer(opts SMTPOptions) Mailer {
+	return &smtpMailer{opts: opts}
+}
+
+// SendMail отправляет письмо. Соединение закрывается по истечении контекста.
+func (m *smtpMailer) SendMail(ctx context.Context, to, subject, body string) error {
+	host, _, err := net.SplitHostPort(m.opts.Addr)
+	if err != nil {
+		return fmt.Errorf("адрес SMTP-сервера: %w", err)
+	}
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", m.opts.Addr)
+	if err != nil {
+		return fmt.Errorf("подключение к SMTP-серверу: %w", err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		_ = conn.Close()
+
+		return fmt.Errorf("приветствие SMTP-сервера: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err = client.StartTLS(&tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("STARTTLS: %w", err)
+		}
+	}
+
+	if m.opts.Username != "" {
+		if err = client.Auth(smtp.PlainAuth("", m.opts.Username, m.opts.Password, host)); err != nil {
+			return fmt.Errorf("авторизация на SMTP-сервере: %w", err)
+		}
+	}
+
+	if err = client.Mail(m.opts.From); err != nil {
+		return fmt.Errorf("отправитель письма: %w", err)
+	}
+
+	if err = client.Rcpt(to); err != nil {
+		return fmt.Errorf("получатель письма: %w", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("начало письма: %w", err)
+	}
+
+	if _, err = w.Write(buildMessage(m.opts.From, to, subject, body, time.Now())); err != nil {
+		return fmt.Errorf("запись письма: %w", err)
+	}
+
+	if err = w.Close(); err != nil {
+		return fmt.Errorf("завершение письма: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// buildMessage собирает текстовое письмо в UTF-8. Переводы строк в заголовках удаляются,
+// чтобы текст уведомления не мог добавить свои заголовки.
+func buildMessage(from, to, subject, body string, date time.Time) []byte {
+	header := strings.NewReplacer("\r", " ", "\n", " ")
+
+	var msg bytes.Buffer
+
+	fmt.Fprintf(&msg, "From: %s\r\n", header.Replace(from))
+	fmt.Fprintf(&msg, "To: %s\r\n", header.Replace(to))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", header.Replace(subject)))
+	fmt.Fprintf(&msg, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	qp := quotedprintable.NewWriter(&msg)
+	_, _ = qp.Write([]byte(body))
+	_ = qp.Close()
+
+	return msg.Bytes()
+}
+
+// emailChannel доставляет уведомления письмом на адрес из настроек пользователя.
+type emailChannel struct {
+	mailer Mailer // Отправка писем
+}
+
+// NewEmailChannel создает канал писем.
+func NewEmailChannel(mailer Mailer) Channel {
+	return &emailChannel{mailer: mailer}
+}
+
+// Name возвращает название канала.
+func (c *emailChannel) Name() entity.NotificationChannel {
+	return entity.NotificationChannelEmail
+}
+
+// Send отправляет письмо, если пользователь указал адрес.
+func (c *emailChannel) Send(ctx context.Context, notification entity.Notification, preferences *entity.NotificationPreferences) error {
+	if preferences.Email == "" {
+		return nil
+	}
+
+	return c.mailer.SendMail(ctx, preferences.Email, notification.Title, notification.Body)
+}
@@ -0,0 +1,125 @@
+package notifier
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTP минимальный SMTP-сервер, который принимает одно письмо и отдает его в канал.
+type fakeSMTP struct {
+	listener net.Listener
+	messages chan string
+}
+
+func newFakeSMTP(t *testing.T) *fakeSMTP {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = listener.Close() })
+
+	s := &fakeSMTP{listener: listener, messages: make(chan string, 1)}
+	go s.serve()
+
+	return s
+}
+
+func (s *fakeSMTP) serve() {
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = io.WriteString(conn, line+"\r\n") }
+
+	reply("220 localhost ESMTP")
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		cmd := strings.ToUpper(strings.TrimSpace(line))
+
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(cmd, "MAIL"), strings.HasPrefix(cmd, "RCPT"):
+			reply("250 OK")
+		case cmd == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+
+			var data strings.Builder
+
+			for {
+				line, err = r.ReadString('\n')
+				if err != nil {
+					return
+				}
+
+				if line == ".\r\n" {
+					break
+				}
+
+				data.WriteString(line)
+			}
+
+			s.messages <- data.String()
+
+			reply("250 OK")
+		case cmd == "QUIT":
+			reply("221 Bye")
+
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
+
+func TestSMTPMailer_SendMail(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeSMTP(t)
+
+	mailer := NewSMTPMailer(SMTPOptions{Addr: server.listener.Addr().String(), From: "noreply@lombarter.local"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	require.NoError(t, mailer.SendMail(ctx, "user@example.com", "Новое сообщение\r\nBcc: evil@example.com", "Привет"))
+
+	var raw string
+	select {
+	case raw = <-server.messages:
+	case <-ctx.Done():
+		t.Fatal("письмо не получено")
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(raw))
+	require.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "Новое сообщение  Bcc: evil@example.com", subject, "перевод строки не добавляет заголовок")
+	assert.Empty(t, msg.Header.Get("Bcc"))
+	assert.Equal(t, "user@example.com", msg.Header.Get("To"))
+
+	body, err := io.ReadAll(msg.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "=D0=9F=D1=80=D0=B8=D0=B2=D0=B5=D1=82")
+}
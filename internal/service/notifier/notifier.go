@@ -0,0 +1,179 @@
+// Package notifier доставляет уведомления пользователям.
+// Сервисы зависят только от интерфейса Notifier и не знают, через какой канал уходит уведомление.
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+	"github.com/alisher-99/LomBarter/pkg/repeatable"
+)
+
+// Notifier доставляет уведомление пользователю.
+type Notifier interface {
+	// Notify отправляет уведомление.
+	Notify(ctx context.Context, notification entity.Notification) error
+}
+
+// Channel канал доставки уведомлений.
+type Channel interface {
+	// Name возвращает название канала.
+	Name() entity.NotificationChannel
+	// Send доставляет уведомление. При ошибке доставка повторяется.
+	Send(ctx context.Context, notification entity.Notification, preferences *entity.NotificationPreferences) error
+}
+
+// Options настройки повторной доставки.
+type Options struct {
+	Attempts int           // Количество попыток доставки в канал
+	Delay    time.Duration // Пауза между попытками
+}
+
+// dispatcher доставляет уведомления по шаблонам во все каналы, включенные в настройках пользователя.
+type dispatcher struct {
+	templates *Templates                             // Шаблоны уведомлений
+	repo      repository.NotificationRepository      // Репозиторий настроек уведомлений
+	channels  map[entity.NotificationChannel]Channel // Настроенные каналы доставки
+	opts      Options                                // Настройки повторной доставки
+}
+
+// New создает Notifier, доставляющий уведомления в каналы channels. Каналы, включенные у пользователя,
+// но не переданные сюда (например, письма без SMTP-сервера), пропускаются.
+func New(
+	templates *Templates,
+	repo repository.NotificationRepository,
+	channels []Channel,
+	opts Options,
+) Notifier {
+	byName := make(map[entity.NotificationChannel]Channel, len(channels))
+	for _, channel := range channels {
+		byName[channel.Name()] = channel
+	}
+
+	if opts.Attempts < 1 {
+		opts.Attempts = 1
+	}
+
+	return &dispatcher{
+		templates: templates,
+		repo:      repo,
+		channels:  byName,
+		opts:      opts,
+	}
+}
+
+// Notify заполняет уведомление по шаблону и доставляет его во все каналы параллельно.
+// Ошибка одного канала не мешает доставке в остальные, ошибки всех каналов возвращаются вместе.
+func (d *dispatcher) Notify(ctx context.Context, notification entity.Notification) error {
+	if err := d.templates.Render(&notification); err != nil {
+		return fmt.Errorf("шаблон уведомления %s: %w", notification.Kind, err)
+	}
+
+	preferences, err := d.repo.GetNotificationPreferences(ctx, notification.UserID)
+	if errors.Is(err, entity.ErrNotificationPreferencesNotFound) {
+		preferences, err = entity.DefaultNotificationPreferences(notification.UserID), nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("получение настроек уведомлений: %w", err)
+	}
+
+	enabled := preferences.ChannelsFor(notification.Kind)
+	errs := make([]error, len(enabled))
+
+	var wg sync.WaitGroup
+
+	for i, name := range enabled {
+		channel, ok := d.channels[name]
+		if !ok {
+			continue
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			err := repeatable.DoWithTries(func() error {
+				return channel.Send(ctx, notification, preferences)
+			}, d.opts.Attempts, d.opts.Delay)
+			if err != nil {
+				errs[i] = fmt.Errorf("канал %s: %w", name, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// inAppChannel сохраняет уведомления во входящие пользователя.
+type inAppChannel struct {
+	repo      repository.NotificationRepository // Репозиторий входящих уведомлений
+	cache     repository.CacheStore             // Кэш счетчиков непрочитанных уведомлений
+	retention time.Duration                     // Срок хранения уведомлений во входящих
+	logger    logger.Logger                     // Логирование ошибок сброса счетчика
+}
+
+// NewInAppChannel создает канал входящих уведомлений. Уведомления удаляются из входящих через retention.
+func NewInAppChannel(
+	repo repository.NotificationRepository,
+	cache repository.CacheStore,
+	retention time.Duration,
+	l logger.Logger,
+) Channel {
+	return &inAppChannel{
+		repo:      repo,
+		cache:     cache,
+		retention: retention,
+		logger:    l.WithFields(logger.Fields{"layer": "inapp-channel"}),
+	}
+}
+
+// Name возвращает название канала.
+func (c *inAppChannel) Name() entity.NotificationChannel {
+	return entity.NotificationChannelInApp
+}
+
+// Send сохраняет уведомление во входящие и сбрасывает счетчик непрочитанных.
+// Ошибка сброса счетчика не повторяет доставку, иначе уведомление сохранится дважды.
+func (c *inAppChannel) Send(ctx context.Context, notification entity.Notification, _ *entity.NotificationPreferences) error {
+	if err := c.repo.CreateInboxNotification(ctx, entity.NewInboxNotification(notification, c.retention)); err != nil {
+		return err
+	}
+
+	if err := c.cache.NotificationCache().DeleteUnreadCount(ctx, notification.UserID); err != nil {
+		c.logger.Errorf("Ошибка при сбросе счетчика уведомлений %s: %v", notification.UserID, err)
+	}
+
+	return nil
+}
+
+// logNotifier пишет уведомления в лог вместо доставки.
+type logNotifier struct {
+	logger logger.Logger // Логирование уведомлений
+}
+
+// NewLogNotifier создает Notifier, который только логирует уведомления.
+func NewLogNotifier(l logger.Logger) Notifier {
+	return &logNotifier{logger: l.WithFields(logger.Fields{"layer": "log-notifier"})}
+}
+
+// Notify логирует уведомление.
+func (n *logNotifier) Notify(_ context.Context, notification entity.Notification) error {
+	n.logger.WithFields(logger.Fields{
+		"user_id": notification.UserID,
+		"kind":    notification.Kind,
+		"data":    notification.Data,
+	}).Info(notification.Title + ": " + notification.Body)
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/repository/mock_repo"
+)
+
+// fakeChannel канал, который запоминает уведомления и первые failures раз возвращает ошибку.
+type fakeChannel struct {
+	name     entity.NotificationChannel
+	failures int
+
+	mu    sync.Mutex
+	calls int
+	sent  []entity.Notification
+}
+
+func (c *fakeChannel) Name() entity.NotificationChannel { return c.name }
+
+func (c *fakeChannel) Send(_ context.Context, n entity.Notification, _ *entity.NotificationPreferences) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls++
+	if c.calls <= c.failures {
+		return errors.New("канал недоступен")
+	}
+
+	c.sent = append(c.sent, n)
+
+	return nil
+}
+
+func TestTemplates_AllKinds(t *testing.T) {
+	t.Parallel()
+
+	templates, err := NewTemplates(DefaultTemplates)
+	require.NoError(t, err)
+
+	data := map[entity.NotificationKind]map[string]string{
+		entity.NotificationKindWishlistMatch:  {"listingTitle": "Горный велосипед"},
+		entity.NotificationKindMessage:        {"text": "Привет"},
+		entity.NotificationKindProfileUpdated: {"name": "Алишер"},
+	}
+
+	for _, kind := range entity.NotificationKinds {
+		n := entity.Notification{Kind: kind, Data: data[kind]}
+
+		require.NoError(t, templates.Render(&n), kind)
+		assert.NotEmpty(t, n.Title, kind)
+		assert.NotEmpty(t, n.Body, kind)
+	}
+
+	n := entity.Notification{Kind: entity.NotificationKindMessage, Data: map[string]string{"text": ""}}
+	require.NoError(t, templates.Render(&n))
+	assert.Equal(t, "Вложение", n.Body)
+
+	n = entity.Notification{Kind: entity.NotificationKindWishlistMatch}
+	require.Error(t, templates.Render(&n), "ключа нет в данных")
+
+	n = entity.Notification{Kind: "unknown"}
+	require.ErrorIs(t, templates.Render(&n), entity.ErrNotificationTemplateNotFound)
+}
+
+func TestNotify(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	notification := entity.Notification{
+		UserID: "655d8a4d3afea534e56b570e",
+		Kind:   entity.NotificationKindMessage,
+		Data:   map[string]string{"text": "Привет"},
+	}
+
+	templates, err := NewTemplates(DefaultTemplates)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name        string
+		preferences *entity.NotificationPreferences
+		prefsErr    error
+		failures    int
+		wantPush    int
+		wantEmail   int
+		wantErr     bool
+	}{
+		{
+			name:      "настройки по умолчанию, без адреса письма не отправляются",
+			prefsErr:  entity.ErrNotificationPreferencesNotFound,
+			wantPush:  1,
+			wantEmail: 0,
+		},
+		{
+			name: "пользователь отключил push для сообщений",
+			preferences: &entity.NotificationPreferences{
+				Email: "user@example.com",
+				Channels: map[entity.NotificationKind]entity.NotificationChannels{
+					entity.NotificationKindMessage: {entity.NotificationChannelEmail},
+				},
+			},
+			wantPush:  0,
+			wantEmail: 1,
+		},
+		{
+			name:      "доставка повторяется после ошибки",
+			prefsErr:  entity.ErrNotificationPreferencesNotFound,
+			failures:  2,
+			wantPush:  1,
+			wantEmail: 0,
+		},
+		{
+			name:     "попытки закончились",
+			prefsErr: entity.ErrNotificationPreferencesNotFound,
+			failures: 3,
+			wantErr:  true,
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := mock_repo.NewMockNotificationRepository(ctrl)
+			repo.EXPECT().GetNotificationPreferences(gomock.Any(), notification.UserID).Return(s.preferences, s.prefsErr)
+
+			push := &fakeChannel{name: entity.NotificationChannelPush, failures: s.failures}
+			email := &fakeChannel{name: entity.NotificationChannelEmail}
+
+			n := New(templates, repo, []Channel{push, email}, Options{Attempts: 3, Delay: time.Millisecond})
+
+			err := n.Notify(ctx, notification)
+			if s.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, push.sent, s.wantPush)
+			assert.Len(t, email.sent, s.wantEmail)
+
+			for _, sent := range append(push.sent, email.sent...) {
+				assert.Equal(t, "Новое сообщение", sent.Title)
+				assert.Equal(t, "Привет", sent.Body)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+
+	jsoniter "github.com/json-iterator/go"
+	"gitlab.com/example/gophers/libs/kafka/producer"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// PushMessage push-уведомление на устройства пользователя.
+type PushMessage struct {
+	Tokens []string          // Токены устройств
+	Title  string            // Заголовок
+	Body   string            // Текст
+	Data   map[string]string // Данные для перехода в приложении
+}
+
+// PushSender отправляет push-уведомления на устройства.
+type PushSender interface {
+	// SendPush отправляет уведомление на устройства.
+	SendPush(ctx context.Context, message PushMessage) error
+}
+
+// pushChannel доставляет уведомления на устройства пользователя.
+type pushChannel struct {
+	sender PushSender                        // Провайдер push-уведомлений
+	repo   repository.NotificationRepository // Репозиторий устройств
+}
+
+// NewPushChannel создает канал push-уведомлений.
+func NewPushChannel(sender PushSender, repo repository.NotificationRepository) Channel {
+	return &pushChannel{sender: sender, repo: repo}
+}
+
+// Name возвращает название канала.
+func (c *pushChannel) Name() entity.NotificationChannel {
+	return entity.NotificationChannelPush
+}
+
+// Send отправляет уведомление на все устройства пользователя.
+func (c *pushChannel) Send(ctx context.Context, notification entity.Notification, _ *entity.NotificationPreferences) error {
+	devices, err := c.repo.GetDeviceTokens(ctx, notification.UserID)
+	if err != nil {
+		return err
+	}
+
+	if len(devices) == 0 {
+		return nil
+	}
+
+	return c.sender.SendPush(ctx, PushMessage{
+		Tokens: devices.Tokens(),
+		Title:  notification.Title,
+		Body:   notification.Body,
+		Data:   notification.Data,
+	})
+}
+
+// pushEvent сообщение топика push-уведомлений.
+type pushEvent struct {
+	Tokens []string          `json:"tokens"`         // Токены устройств
+	Title  string            `json:"title"`          // Заголовок
+	Body   string            `json:"body"`           // Текст
+	Data   map[string]string `json:"data,omitempty"` // Данные для перехода в приложении
+}
+
+// kafkaPushSender записывает push-уведомления в топик Kafka.
+type kafkaPushSender struct {
+	producer producer.MessageProducer // Продюсер топика push-уведомлений
+	json     jsoniter.API             // Сериализация сообщений
+}
+
+// NewKafkaPushSender создает PushSender, который записывает уведомления в топик entity.PushNotificationTopic.
+// Доставка на устройства асинхронная, поэтому отправитель не узнает о недействительных токенах:
+// устройство удаляется, когда пользователь выходит из приложения.
+func NewKafkaPushSender(kafkaProducer producer.MessageProducer) PushSender {
+	return &kafkaPushSender{producer: kafkaProducer, json: jsoniter.ConfigCompatibleWithStandardLibrary}
+}
+
+// SendPush записывает уведомление в топик push-уведомлений.
+func (s *kafkaPushSender) SendPush(ctx context.Context, message PushMessage) error {
+	value, err := s.json.Marshal(pushEvent{
+		Tokens: message.Tokens,
+		Title:  message.Title,
+		Body:   message.Body,
+		Data:   message.Data,
+	})
+	if err != nil {
+		return fmt.Errorf("сериализация push-уведомления: %w", err)
+	}
+
+	if err = s.producer.Write(ctx, producer.Message{Value: value}); err != nil {
+		return fmt.Errorf("запись push-уведомления в kafka: %w", err)
+	}
+
+	return nil
+}
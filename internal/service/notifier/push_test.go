@@ -0,0 +1,49 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/example/gophers/libs/kafka/producer"
+)
+
+// fakeProducer продюсер, который запоминает сообщения.
+type fakeProducer struct {
+	err      error
+	messages []producer.Message
+}
+
+func (p *fakeProducer) Write(_ context.Context, msgs ...producer.Message) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	p.messages = append(p.messages, msgs...)
+
+	return nil
+}
+
+func TestKafkaPushSender_SendPush(t *testing.T) {
+	t.Parallel()
+
+	message := PushMessage{
+		Tokens: []string{"token-1", "token-2"},
+		Title:  "Новое сообщение",
+		Body:   "Привет",
+		Data:   map[string]string{"kind": "message"},
+	}
+
+	p := &fakeProducer{}
+	require.NoError(t, NewKafkaPushSender(p).SendPush(context.Background(), message))
+	require.Len(t, p.messages, 1)
+	assert.JSONEq(t,
+		`{"tokens":["token-1","token-2"],"title":"Новое сообщение","body":"Привет","data":{"kind":"message"}}`,
+		string(p.messages[0].Value),
+	)
+
+	failing := &fakeProducer{err: errors.New("kafka недоступна")}
+	assert.ErrorIs(t, NewKafkaPushSender(failing).SendPush(context.Background(), message), failing.err)
+}
@@ -0,0 +1,107 @@
+package notifier
+
+import (
+	"context"
+	"sync"
+
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// QueueOptions настройки фоновой доставки.
+type QueueOptions struct {
+	Workers int // Количество уведомлений, доставляемых параллельно
+	Size    int // Размер очереди. Уведомление, которое не поместилось, отбрасывается
+}
+
+// queued уведомление в очереди вместе с контекстом, в котором его отправили.
+type queued struct {
+	ctx          context.Context     // Контекст отправителя без отмены
+	notification entity.Notification // Уведомление
+}
+
+// Queue доставляет уведомления в фоне: повторные попытки доставки в каналы не задерживают запрос,
+// который отправил уведомление. Доставка идет, пока запущен Run.
+type Queue struct {
+	next    Notifier      // Доставка уведомления в каналы
+	jobs    chan queued   // Очередь уведомлений
+	workers int           // Количество обработчиков очереди
+	logger  logger.Logger // Логирование ошибок доставки
+}
+
+// NewQueue создает очередь, которая доставляет уведомления через next.
+func NewQueue(next Notifier, opts QueueOptions, l logger.Logger) *Queue {
+	if opts.Workers < 1 {
+		opts.Workers = 1
+	}
+
+	return &Queue{
+		next:    next,
+		jobs:    make(chan queued, opts.Size),
+		workers: opts.Workers,
+		logger:  l.WithFields(logger.Fields{"layer": "notify-queue"}),
+	}
+}
+
+// Notify ставит уведомление в очередь. Доставка сохраняет значения контекста, например трассировку,
+// но не отменяется вместе с запросом. Если очередь заполнена, возвращает entity.ErrNotificationQueueFull.
+func (q *Queue) Notify(ctx context.Context, notification entity.Notification) error {
+	select {
+	case q.jobs <- queued{ctx: context.WithoutCancel(ctx), notification: notification}:
+		return nil
+	default:
+		return entity.ErrNotificationQueueFull
+	}
+}
+
+// Run доставляет уведомления из очереди, пока не отменен ctx. После отмены доставляет
+// уже поставленные в очередь уведомления и завершается.
+func (q *Queue) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+
+	for i := 0; i < q.workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case job := <-q.jobs:
+					q.deliver(job)
+				case <-ctx.Done():
+					q.drain()
+
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+// drain доставляет уведомления, оставшиеся в очереди.
+func (q *Queue) drain() {
+	for {
+		select {
+		case job := <-q.jobs:
+			q.deliver(job)
+		default:
+			return
+		}
+	}
+}
+
+// deliver доставляет уведомление. Отправитель уже получил ответ, поэтому ошибка только логируется.
+func (q *Queue) deliver(job queued) {
+	if err := q.next.Notify(job.ctx, job.notification); err != nil {
+		q.logger.WithFields(logger.Fields{
+			"user_id": job.notification.UserID,
+			"kind":    job.notification.Kind,
+		}).Errorf("доставка уведомления: %v", err)
+	}
+}
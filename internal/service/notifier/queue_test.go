@@ -0,0 +1,62 @@
+package notifier
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// blockingNotifier доставка, которая ждет release и запоминает уведомления.
+type blockingNotifier struct {
+	release chan struct{}
+
+	mu   sync.Mutex
+	sent []entity.Notification
+}
+
+func (n *blockingNotifier) Notify(ctx context.Context, notification entity.Notification) error {
+	<-n.release
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.sent = append(n.sent, notification)
+
+	return ctx.Err()
+}
+
+func TestQueue(t *testing.T) {
+	t.Parallel()
+
+	l, err := logger.New("error", "test")
+	require.NoError(t, err)
+
+	next := &blockingNotifier{release: make(chan struct{})}
+	queue := NewQueue(next, QueueOptions{Workers: 1, Size: 2}, l)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	require.NoError(t, queue.Notify(ctx, entity.Notification{UserID: "1"}))
+	require.NoError(t, queue.Notify(ctx, entity.Notification{UserID: "2"}))
+	assert.ErrorIs(t, queue.Notify(ctx, entity.Notification{UserID: "3"}), entity.ErrNotificationQueueFull)
+
+	// Отмена запроса не отменяет доставку уже поставленных уведомлений.
+	cancel()
+
+	runCtx, stop := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	go func() { done <- queue.Run(runCtx) }()
+
+	stop()
+	close(next.release)
+	require.NoError(t, <-done)
+
+	assert.ElementsMatch(t, []entity.Notification{{UserID: "1"}, {UserID: "2"}}, next.sent)
+}
@@ -0,0 +1,90 @@
+package notifier
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// Template шаблоны заголовка и текста уведомления одного типа. Шаблоны заполняются из Notification.Data.
+type Template struct {
+	Title string // Шаблон заголовка
+	Body  string // Шаблон текста
+}
+
+// DefaultTemplates шаблоны уведомлений по типам.
+var DefaultTemplates = map[entity.NotificationKind]Template{
+	entity.NotificationKindWishlistMatch: {
+		Title: "Нашлось объявление из вашего списка желаний",
+		Body:  "{{.listingTitle}}",
+	},
+	entity.NotificationKindMessage: {
+		Title: "Новое сообщение",
+		Body:  "{{with .text}}{{.}}{{else}}Вложение{{end}}",
+	},
+	entity.NotificationKindProfileUpdated: {
+		Title: "Профиль обновлен",
+		Body:  "Данные профиля {{.name}} изменены. Если это были не вы, обратитесь в поддержку",
+	},
+}
+
+// compiledTemplate разобранные шаблоны уведомления.
+type compiledTemplate struct {
+	title *template.Template // Шаблон заголовка
+	body  *template.Template // Шаблон текста
+}
+
+// Templates разобранные шаблоны уведомлений.
+type Templates struct {
+	byKind map[entity.NotificationKind]compiledTemplate // Шаблоны по типам уведомлений
+}
+
+// NewTemplates разбирает шаблоны. Ключ, которого нет в данных уведомления, считается ошибкой рендеринга.
+func NewTemplates(templates map[entity.NotificationKind]Template) (*Templates, error) {
+	byKind := make(map[entity.NotificationKind]compiledTemplate, len(templates))
+
+	for kind, tmpl := range templates {
+		title, err := template.New(string(kind) + ".title").Option("missingkey=error").Parse(tmpl.Title)
+		if err != nil {
+			return nil, fmt.Errorf("разбор заголовка %s: %w", kind, err)
+		}
+
+		body, err := template.New(string(kind) + ".body").Option("missingkey=error").Parse(tmpl.Body)
+		if err != nil {
+			return nil, fmt.Errorf("разбор текста %s: %w", kind, err)
+		}
+
+		byKind[kind] = compiledTemplate{title: title, body: body}
+	}
+
+	return &Templates{byKind: byKind}, nil
+}
+
+// Render заполняет заголовок и текст уведомления по шаблону его типа.
+func (t *Templates) Render(notification *entity.Notification) error {
+	tmpl, ok := t.byKind[notification.Kind]
+	if !ok {
+		return fmt.Errorf("%w: %s", entity.ErrNotificationTemplateNotFound, notification.Kind)
+	}
+
+	data := notification.Data
+	if data == nil {
+		data = map[string]string{}
+	}
+
+	var title, body strings.Builder
+
+	if err := tmpl.title.Execute(&title, data); err != nil {
+		return fmt.Errorf("рендеринг заголовка: %w", err)
+	}
+
+	if err := tmpl.body.Execute(&body, data); err != nil {
+		return fmt.Errorf("рендеринг текста: %w", err)
+	}
+
+	notification.Title, notification.Body = title.String(), body.String()
+
+	return nil
+}
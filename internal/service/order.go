@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -22,19 +23,44 @@ type OrdersService interface {
 	GetOrdersForClient(ctx context.Context, form form.OrdersGetForClient) (entity.Orders, error)
 	// GetOrderForClient возвращает заказ для клиента.
 	GetOrderForClient(ctx context.Context, form form.OrderGetForClient) (*entity.Order, error)
+	// ChangeOrderStatus изменяет статус заказа и записывает переход в историю.
+	// Подтверждение списывает оплату, отмена снимает блокировку или возвращает деньги.
+	// Заказ обмена меняется только вместе с предложением обмена.
+	ChangeOrderStatus(ctx context.Context, updateForm form.OrderStatusUpdate, currentTime time.Time) (*entity.Order, error)
+	// GetOrderHistory возвращает историю изменения статуса заказа.
+	GetOrderHistory(ctx context.Context, filter form.OrderGetForClient) (entity.OrderHistory, error)
 }
 
 // orderService представляет сервис для работы с заказами.
 type ordersService struct {
-	ordersRepository repository.OrdersRepository // Репозиторий для работы с заказами
-	tracer           trace.TracerProvider        // Отслеживает запросы между слоями и микросервисами.
-	logger           logger.Logger               // Логирование запросов и ошибок сервиса.
+	ordersRepository repository.OrdersRepository  // Репозиторий для работы с заказами
+	paymentRepo      repository.PaymentRepository // Репозиторий платежей по заказам
+	auditRepo        repository.AuditRepository   // Журнал изменений
+	txStarter        repository.TxStarter         // Запуск транзакций
+	payments         repository.PaymentProvider   // Платежный провайдер
+	currencies       entity.Currencies            // Допустимые валюты стоимости заказа
+	tracer           trace.TracerProvider         // Отслеживает запросы между слоями и микросервисами.
+	logger           logger.Logger                // Логирование запросов и ошибок сервиса.
 }
 
 // NewOrdersService создает новый экзмепляр сервиса для работы с заказами.
-func NewOrdersService(ordersRepository repository.OrdersRepository, l logger.Logger, tracer trace.TracerProvider) OrdersService {
+func NewOrdersService(
+	ordersRepository repository.OrdersRepository,
+	paymentRepo repository.PaymentRepository,
+	auditRepo repository.AuditRepository,
+	txStarter repository.TxStarter,
+	payments repository.PaymentProvider,
+	currencies entity.Currencies,
+	l logger.Logger,
+	tracer trace.TracerProvider,
+) OrdersService {
 	return &ordersService{
 		ordersRepository: ordersRepository,
+		paymentRepo:      paymentRepo,
+		auditRepo:        auditRepo,
+		txStarter:        txStarter,
+		payments:         payments,
+		currencies:       currencies,
 		tracer:           tracer,
 		logger:           l.WithFields(logger.Fields{"layer": "orders-service"}),
 	}
@@ -49,6 +75,10 @@ func (s ordersService) CreateOrder(ctx context.Context, createForm form.OrderCre
 		return presenter.CreatedOrder{}, fmt.Errorf("валидация формы: %w", err)
 	}
 
+	if err := s.currencies.Validate(createForm.Cost.Currency); err != nil {
+		return presenter.CreatedOrder{}, fmt.Errorf("валидация валюты: %w", err)
+	}
+
 	// Создаем сущность заказа.
 	order := entity.NewOrder(currentTime)
 
@@ -56,8 +86,19 @@ func (s ordersService) CreateOrder(ctx context.Context, createForm form.OrderCre
 		return presenter.CreatedOrder{}, fmt.Errorf("заполнение сущности заказа: %w", err)
 	}
 
-	// Сохраняем заказ в репозитории.
-	if err := s.ordersRepository.CreateOrder(ctx, order); err != nil {
+	// Первая запись истории фиксирует создание заказа.
+	order.History = entity.OrderHistory{
+		entity.NewOrderStatusChange(order.UserID, "", order.Status, "", currentTime),
+	}
+
+	// Сохраняем заказ в репозитории вместе с записью в журнале изменений.
+	txCtx, done, err := s.txStarter.StartSession(ctx)
+	if err != nil {
+		return presenter.CreatedOrder{}, fmt.Errorf("начало транзакции: %w", err)
+	}
+
+	err = s.createInTx(txCtx, order, currentTime)
+	if err = done(txCtx, err); err != nil {
 		return presenter.CreatedOrder{}, fmt.Errorf("создание заказа: %w", err)
 	}
 
@@ -65,6 +106,15 @@ func (s ordersService) CreateOrder(ctx context.Context, createForm form.OrderCre
 	return presenter.NewCreatedOrder(order), nil
 }
 
+// createInTx сохраняет заказ и запись журнала изменений в рамках транзакции.
+func (s ordersService) createInTx(ctx context.Context, order *entity.Order, currentTime time.Time) error {
+	if err := s.ordersRepository.CreateOrder(ctx, order); err != nil {
+		return err
+	}
+
+	return recordAudit(ctx, s.auditRepo, entity.AuditEntityOrder, order.ID, entity.AuditActionCreate, nil, order, currentTime)
+}
+
 // GetOrdersForClient возвращает список заказов для клиента.
 func (s ordersService) GetOrdersForClient(ctx context.Context, filter form.OrdersGetForClient) (entity.Orders, error) {
 	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "OrdersService.GetOrdersForClient")
@@ -98,3 +148,151 @@ func (s ordersService) GetOrderForClient(ctx context.Context, filter form.OrderG
 
 	return order, nil
 }
+
+// ChangeOrderStatus изменяет статус заказа и записывает переход в историю.
+// Операция с оплатой сохраняется вместе со статусом и проводится у провайдера после фиксации транзакции.
+func (s ordersService) ChangeOrderStatus(ctx context.Context, updateForm form.OrderStatusUpdate, currentTime time.Time) (*entity.Order, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "OrdersService.ChangeOrderStatus")
+	defer span.End()
+
+	if err := updateForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	order, err := s.ordersRepository.GetOrderForClient(ctx, updateForm.ToOrderGetForClient())
+	if err != nil {
+		return nil, fmt.Errorf("получение заказа: %w", err)
+	}
+
+	// Заказ обмена завершается и отменяется вместе с предложением, чтобы объявления и доплата не зависли.
+	if order.TradeOfferID != "" {
+		return nil, fmt.Errorf("%w: предложение %s", entity.ErrOrderTradeManaged, order.TradeOfferID)
+	}
+
+	before := *order
+
+	change, err := order.ChangeStatus(updateForm.UserID, updateForm.Status, updateForm.Reason, currentTime)
+	if err != nil {
+		return nil, fmt.Errorf("изменение статуса заказа: %w", err)
+	}
+
+	payment, err := s.requestSettlement(ctx, order, updateForm, currentTime)
+	if err != nil {
+		return nil, err
+	}
+
+	txCtx, done, err := s.txStarter.StartSession(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("начало транзакции: %w", err)
+	}
+
+	err = s.changeStatusInTx(txCtx, &before, order, change, payment, currentTime)
+	if err = done(txCtx, err); err != nil {
+		return nil, fmt.Errorf("сохранение статуса заказа: %w", err)
+	}
+
+	s.logger.WithFields(logger.Fields{
+		"order_id": order.ID,
+		"actor_id": change.ActorID,
+		"from":     change.From,
+		"to":       change.To,
+	}).Info("статус заказа изменен")
+
+	if payment != nil {
+		s.settle(ctx, payment, currentTime)
+	}
+
+	return order, nil
+}
+
+// changeStatusInTx сохраняет статус заказа, запись журнала изменений и операцию с оплатой в рамках транзакции.
+func (s ordersService) changeStatusInTx(
+	ctx context.Context,
+	before, order *entity.Order,
+	change entity.OrderStatusChange,
+	payment *entity.Payment,
+	currentTime time.Time,
+) error {
+	if err := s.ordersRepository.UpdateOrderStatus(ctx, order, change); err != nil {
+		return err
+	}
+
+	if payment != nil {
+		if err := s.paymentRepo.UpdatePayment(ctx, payment); err != nil {
+			return fmt.Errorf("сохранение операции с оплатой: %w", err)
+		}
+	}
+
+	return recordAudit(ctx, s.auditRepo, entity.AuditEntityOrder, order.ID, entity.AuditActionUpdate, before, order, currentTime)
+}
+
+// requestSettlement ставит в очередь платежа заказа операцию, которой требует новый статус,
+// и проверяет ее до сохранения статуса. Если операция не нужна, возвращает nil.
+func (s ordersService) requestSettlement(
+	ctx context.Context,
+	order *entity.Order,
+	updateForm form.OrderStatusUpdate,
+	currentTime time.Time,
+) (*entity.Payment, error) {
+	payment, err := s.paymentRepo.GetPaymentByOrderID(ctx, order.ID)
+	if err != nil {
+		if errors.Is(err, entity.ErrPaymentNotFound) && updateForm.Refund == nil {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("получение платежа: %w", err)
+	}
+
+	switch {
+	case order.Status == entity.OrderStatusConfirmed && payment.Status == entity.PaymentStatusAuthorized:
+		err = payment.RequestSettlement(entity.PaymentStatusCaptured, entity.Money{}, updateForm.Reason, "", currentTime)
+	case order.Status == entity.OrderStatusCancelled && payment.Status == entity.PaymentStatusAuthorized:
+		err = payment.RequestSettlement(entity.PaymentStatusVoided, entity.Money{}, updateForm.Reason, "", currentTime)
+	case order.Status == entity.OrderStatusCancelled && payment.Status.IsRefundable():
+		amount := payment.Refundable()
+		if updateForm.Refund != nil {
+			amount = *updateForm.Refund
+		}
+
+		err = payment.RequestSettlement(
+			entity.PaymentStatusRefunded, amount, updateForm.Reason, "order-cancel:"+payment.OrderID, currentTime,
+		)
+	case updateForm.Refund != nil:
+		return nil, fmt.Errorf("%w: платеж в статусе %s", entity.ErrPaymentRefundExceeds, payment.Status)
+	default:
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("операция с оплатой заказа: %w", err)
+	}
+
+	return payment, nil
+}
+
+// settle проводит у провайдера операцию с оплатой после сохранения статуса заказа:
+// если статус сохранить не удалось, деньги не двигаются. Операция сохранена вместе со статусом,
+// поэтому ошибка только логируется, а операцию повторит PaymentService.SettlePending.
+func (s ordersService) settle(ctx context.Context, payment *entity.Payment, currentTime time.Time) {
+	if err := settlePayment(ctx, s.paymentRepo, s.payments, payment, currentTime); err != nil {
+		s.logger.WithFields(logger.Fields{"order_id": payment.OrderID, "payment_id": payment.ID}).
+			Errorf("операция с оплатой заказа будет повторена: %v", err)
+	}
+}
+
+// GetOrderHistory возвращает историю изменения статуса заказа.
+func (s ordersService) GetOrderHistory(ctx context.Context, filter form.OrderGetForClient) (entity.OrderHistory, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "OrdersService.GetOrderHistory")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	history, err := s.ordersRepository.GetOrderHistory(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("получение истории заказа: %w", err)
+	}
+
+	return history, nil
+}
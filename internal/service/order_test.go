@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository/mock_repo"
+	"github.com/alisher-99/LomBarter/internal/storage/payment"
+)
+
+func TestOrdersService_ChangeOrderStatus_Payment(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := created.Add(time.Hour)
+	price := entity.NewMoney(5000, "KZT")
+	excess := entity.NewMoney(6000, "KZT")
+
+	cases := []struct {
+		name     string
+		status   entity.OrderStatus
+		captured bool
+		refund   *entity.Money
+		saved    bool
+		saveErr  error
+		err      error
+		provider entity.PaymentStatus
+	}{
+		{
+			name:     "подтверждение списывает оплату",
+			status:   entity.OrderStatusConfirmed,
+			saved:    true,
+			provider: entity.PaymentStatusCaptured,
+		},
+		{
+			name:     "отмена снимает блокировку",
+			status:   entity.OrderStatusCancelled,
+			saved:    true,
+			provider: entity.PaymentStatusVoided,
+		},
+		{
+			name:     "статус изменен параллельно",
+			status:   entity.OrderStatusConfirmed,
+			saved:    true,
+			saveErr:  entity.ErrOrderStatusConflict,
+			err:      entity.ErrOrderStatusConflict,
+			provider: entity.PaymentStatusAuthorized,
+		},
+		{
+			name:     "возврат больше оплаты",
+			status:   entity.OrderStatusCancelled,
+			captured: true,
+			refund:   &excess,
+			err:      entity.ErrPaymentRefundExceeds,
+			provider: entity.PaymentStatusCaptured,
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			provider := payment.NewFake(payment.NewWebhookSigner("secret", time.Minute))
+
+			authorized, err := provider.Authorize(ctx, entity.PaymentCharge{
+				PayerID: testProposerID,
+				PayeeID: testRecipientID,
+				Amount:  price,
+			})
+			require.NoError(t, err)
+
+			stored := entity.NewPayment(testOrderID, testProposerID, testRecipientID, authorized, created)
+
+			if s.captured {
+				_, err = provider.Capture(ctx, authorized.ID)
+				require.NoError(t, err)
+				require.NoError(t, stored.Transition(entity.PaymentStatusCaptured, created))
+			}
+
+			order := entity.NewOrder(created)
+			order.ID = testOrderID
+			order.UserID = testProposerID
+			order.Cost = price
+
+			ctrl := gomock.NewController(t)
+			ordersRepo := mock_repo.NewMockOrdersRepository(ctrl)
+			paymentRepo := mock_repo.NewMockPaymentRepository(ctrl)
+			auditRepo := mock_repo.NewMockAuditRepository(ctrl)
+			tx := mock_repo.NewMockTxStarter(ctrl)
+			expectTransactions(tx)
+			auditRepo.EXPECT().CreateAuditEntry(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+			ordersRepo.EXPECT().GetOrderForClient(gomock.Any(), gomock.Any()).Return(order, nil)
+			paymentRepo.EXPECT().GetPaymentByOrderID(gomock.Any(), testOrderID).
+				Return(stored, nil)
+
+			if s.saved {
+				ordersRepo.EXPECT().UpdateOrderStatus(gomock.Any(), order, gomock.Any()).Return(s.saveErr)
+			}
+
+			// Операция ставится в очередь вместе со статусом и снимается из нее после проведения у провайдера.
+			var queued []entity.PaymentStatus
+
+			if s.saved && s.saveErr == nil {
+				paymentRepo.EXPECT().UpdatePayment(gomock.Any(), stored).Times(2).DoAndReturn(
+					func(_ context.Context, stored *entity.Payment) error {
+						if stored.Settlement != nil {
+							queued = append(queued, stored.Settlement.Status)
+						}
+
+						return nil
+					},
+				)
+			}
+
+			svc := NewOrdersService(ordersRepo, paymentRepo, auditRepo, tx, provider, nil, testLogger(t), trace.NewNoopTracerProvider())
+
+			_, err = svc.ChangeOrderStatus(ctx, form.OrderStatusUpdate{
+				OrderID: testOrderID,
+				UserID:  testProposerID,
+				Status:  s.status,
+				Refund:  s.refund,
+			}, now)
+			if s.err != nil {
+				assert.ErrorIs(t, err, s.err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, []entity.PaymentStatus{s.provider}, queued)
+				assert.Equal(t, s.provider, stored.Status)
+				assert.Nil(t, stored.Settlement)
+			}
+
+			// Если статус не сохранен, деньги у провайдера не двигаются.
+			payments := provider.Payments()
+			require.Len(t, payments, 1)
+			assert.Equal(t, s.provider, payments[0].Status)
+		})
+	}
+}
+
+func TestOrdersService_ChangeOrderStatus_TradeOrder(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	order := entity.NewOrder(created)
+	order.ID = testOrderID
+	order.UserID = testProposerID
+	order.CounterpartyID = testRecipientID
+	order.TradeOfferID = testOfferID
+
+	ctrl := gomock.NewController(t)
+	ordersRepo := mock_repo.NewMockOrdersRepository(ctrl)
+	ordersRepo.EXPECT().GetOrderForClient(gomock.Any(), gomock.Any()).Return(order, nil)
+
+	svc := NewOrdersService(
+		ordersRepo, mock_repo.NewMockPaymentRepository(ctrl), mock_repo.NewMockAuditRepository(ctrl),
+		mock_repo.NewMockTxStarter(ctrl), nil, nil, testLogger(t), trace.NewNoopTracerProvider(),
+	)
+
+	_, err := svc.ChangeOrderStatus(context.Background(), form.OrderStatusUpdate{
+		OrderID: testOrderID,
+		UserID:  testRecipientID,
+		Status:  entity.OrderStatusCancelled,
+	}, created.Add(time.Hour))
+	assert.ErrorIs(t, err, entity.ErrOrderTradeManaged)
+	assert.Equal(t, entity.OrderStatusCreated, order.Status, "статус заказа обмена не меняется")
+}
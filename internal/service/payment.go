@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.com/example/gophers/libs/logger"
+	"gitlab.com/example/gophers/libs/trace"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// PaymentService представляет интерфейс сервиса оплаты заказов.
+type PaymentService interface {
+	// PayOrder блокирует стоимость заказа на счете покупателя. Подтвержденный заказ оплачивается сразу.
+	PayOrder(ctx context.Context, payForm form.OrderPay, currentTime time.Time) (*entity.Payment, error)
+	// GetOrderPayment возвращает платеж по заказу участнику заказа.
+	GetOrderPayment(ctx context.Context, filter form.OrderGetForClient) (*entity.Payment, error)
+	// HandleWebhook применяет уведомление платежного провайдера. Повторное уведомление игнорируется.
+	HandleWebhook(ctx context.Context, webhook form.PaymentWebhook, currentTime time.Time) error
+	// SettlePending проводит у провайдера операции с платежами, очередная попытка которых наступила.
+	// Возвращает количество проведенных операций.
+	SettlePending(ctx context.Context, currentTime time.Time) (int, error)
+	// WatchSettlements раз в interval проводит ожидающие операции с платежами, пока не отменен ctx.
+	WatchSettlements(ctx context.Context, interval time.Duration) error
+}
+
+// paymentSettlementBatch количество операций с платежами, проводимых за один проход.
+const paymentSettlementBatch = 100
+
+// paymentService представляет сервис оплаты заказов.
+type paymentService struct {
+	paymentRepo      repository.PaymentRepository // Репозиторий платежей
+	ordersRepository repository.OrdersRepository  // Репозиторий заказов
+	txStarter        repository.TxStarter         // Запуск транзакций
+	payments         repository.PaymentProvider   // Платежный провайдер
+	webhookRetention time.Duration                // Срок хранения обработанных уведомлений
+	tracer           trace.TracerProvider         // Отслеживает запросы между слоями и микросервисами
+	logger           logger.Logger                // Логирование запросов и ошибок сервиса
+}
+
+// NewPaymentService создает новый экземпляр сервиса оплаты заказов.
+func NewPaymentService(
+	paymentRepo repository.PaymentRepository,
+	ordersRepository repository.OrdersRepository,
+	txStarter repository.TxStarter,
+	payments repository.PaymentProvider,
+	webhookRetention time.Duration,
+	l logger.Logger,
+	tracer trace.TracerProvider,
+) PaymentService {
+	return &paymentService{
+		paymentRepo:      paymentRepo,
+		ordersRepository: ordersRepository,
+		txStarter:        txStarter,
+		payments:         payments,
+		webhookRetention: webhookRetention,
+		tracer:           tracer,
+		logger:           l.WithFields(logger.Fields{"layer": "payment-service"}),
+	}
+}
+
+// PayOrder блокирует стоимость заказа на счете покупателя.
+// Ключ идемпотентности привязан к заказу: если платеж не удалось сохранить, повтор вернет ту же блокировку.
+func (s *paymentService) PayOrder(ctx context.Context, payForm form.OrderPay, currentTime time.Time) (*entity.Payment, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "PaymentService.PayOrder")
+	defer span.End()
+
+	if err := payForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	order, err := s.ordersRepository.GetOrderForClient(ctx, payForm.ToOrderGetForClient())
+	if err != nil {
+		return nil, fmt.Errorf("получение заказа: %w", err)
+	}
+
+	if order.UserID != payForm.UserID {
+		return nil, entity.ErrPaymentForbidden
+	}
+
+	// Доплата по обмену проводится при принятии предложения.
+	if order.Status.IsTerminal() || order.TradeOfferID != "" || order.Cost.Amount <= 0 {
+		return nil, fmt.Errorf("%w: заказ %s в статусе %s", entity.ErrPaymentNotAllowed, order.ID, order.Status)
+	}
+
+	_, err = s.paymentRepo.GetPaymentByOrderID(ctx, order.ID)
+	switch {
+	case err == nil:
+		return nil, entity.ErrPaymentAlreadyExists
+	case !errors.Is(err, entity.ErrPaymentNotFound):
+		return nil, fmt.Errorf("получение платежа: %w", err)
+	}
+
+	provided, err := s.payments.Authorize(ctx, entity.PaymentCharge{
+		IdempotencyKey: "order:" + order.ID,
+		PayerID:        order.UserID,
+		PayeeID:        order.CounterpartyID,
+		Amount:         order.Cost,
+		Description:    "Оплата заказа " + order.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("блокировка оплаты: %w", err)
+	}
+
+	// Заказ подтвердили до оплаты, поэтому деньги списываются сразу.
+	if order.Status == entity.OrderStatusConfirmed {
+		if provided, err = s.payments.Capture(ctx, provided.ID); err != nil {
+			return nil, fmt.Errorf("списание оплаты: %w", err)
+		}
+	}
+
+	payment := entity.NewPayment(order.ID, order.UserID, order.CounterpartyID, provided, currentTime)
+
+	if err = s.paymentRepo.CreatePayment(ctx, payment); err != nil {
+		return nil, fmt.Errorf("сохранение платежа: %w", err)
+	}
+
+	s.logger.WithFields(logger.Fields{"order_id": order.ID, "payment_id": payment.ID}).Info("заказ оплачен")
+
+	return payment, nil
+}
+
+// GetOrderPayment возвращает платеж по заказу участнику заказа.
+func (s *paymentService) GetOrderPayment(ctx context.Context, filter form.OrderGetForClient) (*entity.Payment, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "PaymentService.GetOrderPayment")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	// Заказ ищется среди заказов пользователя, поэтому чужой платеж не раскрывается.
+	if _, err := s.ordersRepository.GetOrderForClient(ctx, filter); err != nil {
+		return nil, fmt.Errorf("получение заказа: %w", err)
+	}
+
+	payment, err := s.paymentRepo.GetPaymentByOrderID(ctx, filter.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("получение платежа: %w", err)
+	}
+
+	return payment, nil
+}
+
+// HandleWebhook проверяет подпись уведомления и приводит платеж к состоянию у провайдера.
+// Подпись с временем отправки отсекает старые уведомления, а идентификатор уведомления,
+// сохраненный в той же транзакции, что и платеж, отсекает повторы в пределах допуска.
+func (s *paymentService) HandleWebhook(ctx context.Context, webhook form.PaymentWebhook, currentTime time.Time) error {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "PaymentService.HandleWebhook")
+	defer span.End()
+
+	if err := webhook.Validate(); err != nil {
+		return fmt.Errorf("валидация уведомления: %w", err)
+	}
+
+	event, err := s.payments.VerifyWebhook(webhook.Payload, webhook.Signature, currentTime)
+	if err != nil {
+		return fmt.Errorf("проверка уведомления: %w", err)
+	}
+
+	txCtx, done, err := s.txStarter.StartSession(ctx)
+	if err != nil {
+		return fmt.Errorf("начало транзакции: %w", err)
+	}
+
+	err = s.handleWebhookInTx(txCtx, event, currentTime)
+	if err = done(txCtx, err); err != nil {
+		return fmt.Errorf("обработка уведомления %s: %w", event.ID, err)
+	}
+
+	return nil
+}
+
+// handleWebhookInTx запоминает уведомление и обновляет платеж в рамках транзакции.
+func (s *paymentService) handleWebhookInTx(ctx context.Context, event *entity.PaymentWebhookEvent, currentTime time.Time) error {
+	fresh, err := s.paymentRepo.SaveWebhookEvent(ctx, event.ID, currentTime, currentTime.Add(s.webhookRetention))
+	if err != nil {
+		return fmt.Errorf("сохранение уведомления: %w", err)
+	}
+
+	if !fresh {
+		s.logger.WithFields(logger.Fields{"event_id": event.ID}).Info("повторное уведомление провайдера пропущено")
+
+		return nil
+	}
+
+	payment, err := s.paymentRepo.GetPaymentByProviderID(ctx, event.ProviderPaymentID)
+	if err != nil {
+		return fmt.Errorf("получение платежа: %w", err)
+	}
+
+	changed, err := payment.Sync(*event)
+	if err != nil {
+		return fmt.Errorf("применение уведомления к платежу %s: %w", payment.ID, err)
+	}
+
+	if !changed {
+		return nil
+	}
+
+	if err = s.paymentRepo.UpdatePayment(ctx, payment); err != nil {
+		return fmt.Errorf("сохранение платежа: %w", err)
+	}
+
+	return nil
+}
+
+// SettlePending проводит у провайдера операции с платежами, очередная попытка которых наступила.
+// Неудачная попытка откладывается, а платеж, измененный параллельно, попадет в следующий проход.
+func (s *paymentService) SettlePending(ctx context.Context, currentTime time.Time) (int, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "PaymentService.SettlePending")
+	defer span.End()
+
+	pending, err := s.paymentRepo.GetPendingSettlements(ctx, currentTime, paymentSettlementBatch)
+	if err != nil {
+		return 0, fmt.Errorf("получение ожидающих операций с платежами: %w", err)
+	}
+
+	settled := 0
+
+	for _, payment := range pending {
+		if err = settlePayment(ctx, s.paymentRepo, s.payments, payment, currentTime); err != nil {
+			s.logger.WithFields(logger.Fields{"order_id": payment.OrderID, "payment_id": payment.ID}).
+				Errorf("проведение операции с платежом: %v", err)
+
+			continue
+		}
+
+		settled++
+	}
+
+	return settled, nil
+}
+
+// WatchSettlements раз в interval проводит ожидающие операции с платежами. Ошибка прохода только логируется,
+// следующий проход повторит попытку.
+func (s *paymentService) WatchSettlements(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := s.SettlePending(ctx, time.Now().UTC()); err != nil {
+				s.logger.Errorf("проведение операций с платежами: %v", err)
+			}
+		}
+	}
+}
+
+// settlePayment проводит у провайдера операцию, поставленную в очередь платежа, и сохраняет результат.
+// Вызывается после фиксации транзакции, в которой операцию поставили в очередь. Если провайдер отказал,
+// попытка сохраняется с паузой до следующей, и операцию повторит SettlePending.
+func settlePayment(
+	ctx context.Context,
+	paymentRepo repository.PaymentRepository,
+	payments repository.PaymentProvider,
+	payment *entity.Payment,
+	currentTime time.Time,
+) error {
+	settlement := payment.Settlement
+	if settlement == nil {
+		return nil
+	}
+
+	var (
+		refund *entity.PaymentRefund
+		err    error
+	)
+
+	switch settlement.Status {
+	case entity.PaymentStatusCaptured:
+		_, err = payments.Capture(ctx, payment.ProviderPaymentID)
+	case entity.PaymentStatusVoided:
+		_, err = payments.Void(ctx, payment.ProviderPaymentID)
+	default:
+		refund, err = payments.Refund(ctx, payment.ProviderPaymentID, settlement.Refund, settlement.IdempotencyKey)
+	}
+
+	if err != nil {
+		payment.FailSettlement(err, currentTime)
+
+		if uErr := paymentRepo.UpdatePayment(ctx, payment); uErr != nil {
+			return fmt.Errorf("%w; сохранение попытки: %w", err, uErr)
+		}
+
+		return fmt.Errorf("операция %s: %w", settlement.Status, err)
+	}
+
+	if err = payment.CompleteSettlement(refund, currentTime); err != nil {
+		return fmt.Errorf("применение операции %s: %w", settlement.Status, err)
+	}
+
+	if err = paymentRepo.UpdatePayment(ctx, payment); err != nil {
+		return fmt.Errorf("сохранение платежа: %w", err)
+	}
+
+	return nil
+}
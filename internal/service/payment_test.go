@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository/mock_repo"
+	"github.com/alisher-99/LomBarter/internal/storage/payment"
+)
+
+func TestPaymentService_HandleWebhook_Replay(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	provider := payment.NewFake(payment.NewWebhookSigner("secret", time.Minute)).
+		WithClock(func() time.Time { return now })
+
+	authorized, err := provider.Authorize(ctx, entity.PaymentCharge{
+		PayerID: testProposerID,
+		PayeeID: testRecipientID,
+		Amount:  entity.NewMoney(5000, "KZT"),
+	})
+	require.NoError(t, err)
+
+	stored := entity.NewPayment(testOrderID, testProposerID, testRecipientID, authorized, now)
+
+	_, err = provider.Capture(ctx, authorized.ID)
+	require.NoError(t, err)
+
+	payload, signature, err := provider.Webhook(authorized.ID)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	paymentRepo := mock_repo.NewMockPaymentRepository(ctrl)
+	tx := mock_repo.NewMockTxStarter(ctrl)
+	expectTransactions(tx)
+
+	// Хранилище уведомлений: второе сохранение того же идентификатора сообщает о повторе.
+	events := make(map[string]struct{})
+	paymentRepo.EXPECT().SaveWebhookEvent(gomock.Any(), gomock.Any(), now, now.Add(time.Hour)).Times(2).DoAndReturn(
+		func(_ context.Context, eventID string, _, _ time.Time) (bool, error) {
+			if _, ok := events[eventID]; ok {
+				return false, nil
+			}
+
+			events[eventID] = struct{}{}
+
+			return true, nil
+		},
+	)
+	paymentRepo.EXPECT().GetPaymentByProviderID(gomock.Any(), authorized.ID).Return(stored, nil)
+	paymentRepo.EXPECT().UpdatePayment(gomock.Any(), stored).Return(nil)
+
+	svc := NewPaymentService(paymentRepo, nil, tx, provider, time.Hour, testLogger(t), trace.NewNoopTracerProvider())
+	webhook := form.PaymentWebhook{Payload: payload, Signature: signature}
+
+	require.NoError(t, svc.HandleWebhook(ctx, webhook, now))
+	assert.Equal(t, entity.PaymentStatusCaptured, stored.Status)
+
+	require.NoError(t, svc.HandleWebhook(ctx, webhook, now), "повтор уведомления не считается ошибкой")
+	assert.Len(t, events, 1)
+}
+
+func TestPaymentService_SettlePending(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	provider := payment.NewFake(payment.NewWebhookSigner("secret", time.Minute))
+
+	authorized, err := provider.Authorize(ctx, entity.PaymentCharge{
+		PayerID: testProposerID,
+		PayeeID: testRecipientID,
+		Amount:  entity.NewMoney(5000, "KZT"),
+	})
+	require.NoError(t, err)
+
+	settled := entity.NewPayment(testOrderID, testProposerID, testRecipientID, authorized, now)
+	require.NoError(t, settled.RequestSettlement(entity.PaymentStatusCaptured, entity.Money{}, "", "", now))
+
+	// Платеж, которого провайдер не знает: операция не проходит и откладывается.
+	failed := entity.NewPayment(testOfferID, testProposerID, testRecipientID, &entity.ProviderPayment{
+		ID: "unknown", Status: entity.PaymentStatusAuthorized, Amount: entity.NewMoney(1000, "KZT"),
+	}, now)
+	require.NoError(t, failed.RequestSettlement(entity.PaymentStatusVoided, entity.Money{}, "", "", now))
+
+	ctrl := gomock.NewController(t)
+	paymentRepo := mock_repo.NewMockPaymentRepository(ctrl)
+	paymentRepo.EXPECT().GetPendingSettlements(gomock.Any(), now, int64(paymentSettlementBatch)).
+		Return(entity.Payments{settled, failed}, nil)
+	paymentRepo.EXPECT().UpdatePayment(gomock.Any(), settled).Return(nil)
+	paymentRepo.EXPECT().UpdatePayment(gomock.Any(), failed).Return(nil)
+
+	svc := NewPaymentService(paymentRepo, nil, nil, provider, time.Hour, testLogger(t), trace.NewNoopTracerProvider())
+
+	count, err := svc.SettlePending(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	assert.Equal(t, entity.PaymentStatusCaptured, settled.Status)
+	assert.Nil(t, settled.Settlement)
+
+	assert.Equal(t, entity.PaymentStatusAuthorized, failed.Status)
+	require.NotNil(t, failed.Settlement)
+	assert.Equal(t, 1, failed.Settlement.Attempts)
+	assert.Equal(t, now.Add(time.Minute), failed.Settlement.NextAttemptAt)
+}
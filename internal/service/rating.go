@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.com/example/gophers/libs/logger"
+	"gitlab.com/example/gophers/libs/trace"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// RatingService представляет интерфейс сервиса для работы с оценками и репутацией.
+type RatingService interface {
+	// RateOrder сохраняет оценку второй стороны завершенного заказа и пересчитывает ее репутацию.
+	RateOrder(ctx context.Context, createForm form.RatingCreate, currentTime time.Time) (*entity.Rating, error)
+	// GetRatings возвращает оценки, полученные пользователем, и их общее количество.
+	GetRatings(ctx context.Context, filter form.RatingsGet) (entity.Ratings, int64, error)
+}
+
+// ratingService представляет сервис для работы с оценками и репутацией.
+type ratingService struct {
+	ratingRepo repository.RatingRepository // Репозиторий оценок
+	ordersRepo repository.OrdersRepository // Репозиторий заказов
+	userRepo   repository.UserRepository   // Репозиторий пользователей
+	cacheData  repository.CacheStore       // Кэш пользователей
+	txStarter  repository.TxStarter        // Запуск транзакций
+	tracer     trace.TracerProvider        // Отслеживает запросы между слоями и микросервисами
+	logger     logger.Logger               // Логирование запросов и ошибок сервиса
+}
+
+// NewRatingService создает новый экземпляр сервиса для работы с оценками и репутацией.
+func NewRatingService(
+	ratingRepo repository.RatingRepository,
+	ordersRepo repository.OrdersRepository,
+	userRepo repository.UserRepository,
+	cacheData repository.CacheStore,
+	txStarter repository.TxStarter,
+	l logger.Logger,
+	tracer trace.TracerProvider,
+) RatingService {
+	return &ratingService{
+		ratingRepo: ratingRepo,
+		ordersRepo: ordersRepo,
+		userRepo:   userRepo,
+		cacheData:  cacheData,
+		txStarter:  txStarter,
+		tracer:     tracer,
+		logger:     l.WithFields(logger.Fields{"layer": "rating-service"}),
+	}
+}
+
+// RateOrder сохраняет оценку второй стороны завершенного заказа и пересчитывает ее репутацию.
+func (s *ratingService) RateOrder(
+	ctx context.Context,
+	createForm form.RatingCreate,
+	currentTime time.Time,
+) (*entity.Rating, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "RatingService.RateOrder")
+	defer span.End()
+
+	if err := createForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	// Заказ возвращается только его сторонам.
+	order, err := s.ordersRepo.GetOrderForClient(ctx, createForm.ToOrderGetForClient())
+	if err != nil {
+		return nil, fmt.Errorf("получение заказа: %w", err)
+	}
+
+	if order.Status != entity.OrderStatusCompleted {
+		return nil, entity.ErrRatingOrderNotCompleted
+	}
+
+	rateeID, ok := order.Counterparty(createForm.RaterID)
+	if !ok {
+		return nil, entity.ErrRatingNoCounterparty
+	}
+
+	rating := entity.NewRating(currentTime)
+
+	if err = createForm.Fill(rating, rateeID); err != nil {
+		return nil, fmt.Errorf("заполнение формы: %w", err)
+	}
+
+	txCtx, done, err := s.txStarter.StartSession(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("начало транзакции: %w", err)
+	}
+
+	ratee, err := s.rateInTx(txCtx, rating)
+	if err = done(txCtx, err); err != nil {
+		return nil, fmt.Errorf("сохранение оценки: %w", err)
+	}
+
+	// Профиль в кэше должен показывать новую репутацию.
+	if err = s.cacheData.UserCache().SetUser(ctx, ratee); err != nil {
+		s.logger.WithFields(logger.Fields{"id": ratee.ID}).Errorf("обновление кэша: %v", err)
+	}
+
+	return rating, nil
+}
+
+// rateInTx сохраняет оценку и учитывает ее в репутации оцененного пользователя.
+// Параллельная оценка того же пользователя приведет к конфликту записи и откату транзакции.
+func (s *ratingService) rateInTx(ctx context.Context, rating *entity.Rating) (*entity.User, error) {
+	if err := s.ratingRepo.CreateRating(ctx, rating); err != nil {
+		return nil, fmt.Errorf("создание оценки: %w", err)
+	}
+
+	ratee, err := s.userRepo.GetUserByID(ctx, rating.RateeID)
+	if err != nil {
+		return nil, fmt.Errorf("получение пользователя: %w", err)
+	}
+
+	ratee.Reputation.Add(rating.Score, rating.CreatedAt)
+
+	if err = s.userRepo.UpdateUserReputation(ctx, ratee.ID, ratee.Reputation); err != nil {
+		return nil, fmt.Errorf("обновление репутации: %w", err)
+	}
+
+	return ratee, nil
+}
+
+// GetRatings возвращает оценки, полученные пользователем, и их общее количество.
+func (s *ratingService) GetRatings(ctx context.Context, filter form.RatingsGet) (entity.Ratings, int64, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "RatingService.GetRatings")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	ratings, count, err := s.ratingRepo.GetRatings(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение списка оценок: %w", err)
+	}
+
+	return ratings, count, nil
+}
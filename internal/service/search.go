@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.com/example/gophers/libs/logger"
+	"gitlab.com/example/gophers/libs/trace"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+	"github.com/alisher-99/LomBarter/pkg/textsearch"
+)
+
+// highlightLength максимальная длина фрагмента с выделенными словами в символах.
+const highlightLength = 160
+
+// SearchService представляет интерфейс сервиса полнотекстового поиска.
+type SearchService interface {
+	// Search ищет пользователей и объявления по тексту и выделяет найденные слова.
+	Search(ctx context.Context, filter form.Search) (entity.SearchResult, error)
+}
+
+// searchService представляет сервис полнотекстового поиска.
+type searchService struct {
+	searchRepo repository.SearchRepository // Репозиторий полнотекстового поиска
+	tracer     trace.TracerProvider        // Отслеживает запросы между слоями и микросервисами
+	logger     logger.Logger               // Логирование запросов и ошибок сервиса
+}
+
+// NewSearchService создает новый экземпляр сервиса полнотекстового поиска.
+func NewSearchService(searchRepo repository.SearchRepository, l logger.Logger, tracer trace.TracerProvider) SearchService {
+	return &searchService{
+		searchRepo: searchRepo,
+		tracer:     tracer,
+		logger:     l.WithFields(logger.Fields{"layer": "search-service"}),
+	}
+}
+
+// Search ищет пользователей и объявления по тексту и выделяет найденные слова.
+func (s *searchService) Search(ctx context.Context, filter form.Search) (entity.SearchResult, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "SearchService.Search")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return entity.SearchResult{}, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	stems := textsearch.QueryStems(filter.Query)
+	if len(stems) == 0 {
+		return entity.SearchResult{}, entity.ErrSearchQueryEmpty
+	}
+
+	if filter.Pagination.Offset()+filter.Pagination.Limit > entity.MaxSearchResults {
+		return entity.SearchResult{}, entity.ErrSearchTooDeep
+	}
+
+	result, err := s.searchRepo.Search(ctx, filter)
+	if err != nil {
+		return entity.SearchResult{}, fmt.Errorf("поиск: %w", err)
+	}
+
+	for _, hit := range result.Items {
+		highlight(hit, stems)
+	}
+
+	return result, nil
+}
+
+// highlight заполняет фрагменты полей документа, в которых найдены слова запроса.
+func highlight(hit *entity.SearchHit, stems []string) {
+	fields := make(map[string]string, 3)
+
+	switch {
+	case hit.User != nil:
+		fields["name"] = hit.User.Name
+		fields["bio"] = hit.User.Bio
+	case hit.Listing != nil:
+		fields["title"] = hit.Listing.Title
+		fields["description"] = hit.Listing.Description
+	}
+
+	for field, text := range fields {
+		if fragment := textsearch.Highlight(text, stems, highlightLength); fragment != "" {
+			if hit.Highlights == nil {
+				hit.Highlights = make(map[string]string, len(fields))
+			}
+
+			hit.Highlights[field] = fragment
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/example/gophers/libs/kafka/producer"
+	"gitlab.com/example/gophers/libs/logger"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+	"github.com/alisher-99/LomBarter/internal/domain/repository/mock_repo"
+)
+
+// nopProducer продюсер, который ничего не отправляет.
+type nopProducer struct{}
+
+func (nopProducer) Write(context.Context, ...producer.Message) error { return nil }
+
+// testLogger возвращает логгер, который пишет только ошибки.
+func testLogger(t *testing.T) logger.Logger {
+	t.Helper()
+
+	l, err := logger.New("error", "test")
+	require.NoError(t, err)
+
+	return l
+}
+
+// expectTransactions разрешает сервису открывать транзакции.
+// Завершение транзакции возвращает ошибку ее тела, как при откате.
+func expectTransactions(tx *mock_repo.MockTxStarter) {
+	tx.EXPECT().StartSession(gomock.Any()).AnyTimes().DoAndReturn(
+		func(ctx context.Context) (context.Context, repository.TxCallback, error) {
+			return ctx, func(_ context.Context, err error) error { return err }, nil
+		},
+	)
+}
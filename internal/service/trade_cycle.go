@@ -0,0 +1,242 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.com/example/gophers/libs/logger"
+	"gitlab.com/example/gophers/libs/trace"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+	"github.com/alisher-99/LomBarter/internal/service/matching"
+)
+
+// TradeCycleService представляет интерфейс сервиса для работы с циклами обмена.
+type TradeCycleService interface {
+	// MatchListing ищет циклы обмена через измененное объявление и предлагает новые циклы участникам.
+	MatchListing(ctx context.Context, listingID string, currentTime time.Time) (entity.TradeCycles, error)
+	// GetTradeCycle возвращает цикл обмена участнику.
+	GetTradeCycle(ctx context.Context, action form.TradeCycleAction) (*entity.TradeCycle, error)
+	// GetTradeCycles возвращает список циклов обмена пользователя и их общее количество.
+	GetTradeCycles(ctx context.Context, filter form.TradeCyclesGet) (entity.TradeCycles, int64, error)
+	// AcceptTradeCycle фиксирует согласие участника. После согласия всех объявления резервируются.
+	AcceptTradeCycle(ctx context.Context, action form.TradeCycleAction, currentTime time.Time) (*entity.TradeCycle, error)
+	// DeclineTradeCycle отклоняет цикл обмена.
+	DeclineTradeCycle(ctx context.Context, action form.TradeCycleAction, currentTime time.Time) (*entity.TradeCycle, error)
+}
+
+// tradeCycleService представляет сервис для работы с циклами обмена.
+type tradeCycleService struct {
+	cycleRepo   repository.TradeCycleRepository // Репозиторий циклов обмена
+	listingRepo repository.ListingRepository    // Репозиторий объявлений
+	txStarter   repository.TxStarter            // Запуск транзакций
+	engine      *matching.Engine                // Движок поиска циклов
+	tracer      trace.TracerProvider            // Отслеживает запросы между слоями и микросервисами
+	logger      logger.Logger                   // Логирование запросов и ошибок сервиса
+}
+
+// NewTradeCycleService создает новый экземпляр сервиса для работы с циклами обмена.
+func NewTradeCycleService(
+	cycleRepo repository.TradeCycleRepository,
+	listingRepo repository.ListingRepository,
+	txStarter repository.TxStarter,
+	engine *matching.Engine,
+	l logger.Logger,
+	tracer trace.TracerProvider,
+) TradeCycleService {
+	return &tradeCycleService{
+		cycleRepo:   cycleRepo,
+		listingRepo: listingRepo,
+		txStarter:   txStarter,
+		engine:      engine,
+		tracer:      tracer,
+		logger:      l.WithFields(logger.Fields{"layer": "trade-cycle-service"}),
+	}
+}
+
+// MatchListing ищет циклы обмена через измененное объявление и предлагает новые циклы участникам.
+// Если объявление удалено или стало недоступно, предложенные циклы с ним устаревают.
+func (s *tradeCycleService) MatchListing(ctx context.Context, listingID string, currentTime time.Time) (entity.TradeCycles, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "TradeCycleService.MatchListing")
+	defer span.End()
+
+	listing, err := s.listingRepo.GetListingByID(ctx, listingID)
+	if err != nil && !errors.Is(err, entity.ErrListingNotFound) {
+		return nil, fmt.Errorf("получение объявления: %w", err)
+	}
+
+	if listing == nil || !listing.IsAvailable() {
+		if _, err = s.cycleRepo.ExpireTradeCycles(ctx, listingID, currentTime); err != nil {
+			return nil, fmt.Errorf("устаревание циклов обмена: %w", err)
+		}
+
+		return nil, nil
+	}
+
+	res, err := s.engine.Match(ctx, s.listingRepo, listing, currentTime)
+	if err != nil {
+		return nil, fmt.Errorf("поиск циклов обмена: %w", err)
+	}
+
+	if res.Exhausted || res.Truncated {
+		s.logger.WithFields(logger.Fields{
+			"listing_id": listingID,
+			"examined":   res.Examined,
+			"exhausted":  res.Exhausted,
+			"truncated":  res.Truncated,
+		}).Info("поиск циклов обмена остановлен по ограничению")
+	}
+
+	created := make(entity.TradeCycles, 0, len(res.Cycles))
+
+	for _, cycle := range res.Cycles {
+		err = s.cycleRepo.CreateTradeCycle(ctx, cycle)
+		if errors.Is(err, entity.ErrTradeCycleExists) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("сохранение цикла обмена: %w", err)
+		}
+
+		created = append(created, cycle)
+	}
+
+	return created, nil
+}
+
+// GetTradeCycle возвращает цикл обмена участнику.
+func (s *tradeCycleService) GetTradeCycle(ctx context.Context, action form.TradeCycleAction) (*entity.TradeCycle, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "TradeCycleService.GetTradeCycle")
+	defer span.End()
+
+	if err := action.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	cycle, err := s.cycleRepo.GetTradeCycleByID(ctx, action.CycleID)
+	if err != nil {
+		return nil, fmt.Errorf("получение цикла обмена: %w", err)
+	}
+
+	// Чужие циклы не раскрываем.
+	if !cycle.IsParticipant(action.UserID) {
+		return nil, entity.ErrTradeCycleNotFound
+	}
+
+	return cycle, nil
+}
+
+// GetTradeCycles возвращает список циклов обмена пользователя и их общее количество.
+func (s *tradeCycleService) GetTradeCycles(ctx context.Context, filter form.TradeCyclesGet) (entity.TradeCycles, int64, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "TradeCycleService.GetTradeCycles")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	cycles, count, err := s.cycleRepo.GetTradeCycles(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение списка циклов обмена: %w", err)
+	}
+
+	return cycles, count, nil
+}
+
+// AcceptTradeCycle фиксирует согласие участника. После согласия всех объявления резервируются.
+func (s *tradeCycleService) AcceptTradeCycle(
+	ctx context.Context,
+	action form.TradeCycleAction,
+	currentTime time.Time,
+) (*entity.TradeCycle, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "TradeCycleService.AcceptTradeCycle")
+	defer span.End()
+
+	cycle, err := s.GetTradeCycle(ctx, action)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = cycle.Accept(action.UserID, currentTime); err != nil {
+		return nil, err
+	}
+
+	if cycle.Status != entity.TradeCycleStatusAccepted {
+		if err = s.cycleRepo.UpdateTradeCycle(ctx, cycle); err != nil {
+			return nil, fmt.Errorf("сохранение согласия: %w", err)
+		}
+
+		return cycle, nil
+	}
+
+	txCtx, done, err := s.txStarter.StartSession(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("начало транзакции: %w", err)
+	}
+
+	err = s.acceptInTx(txCtx, cycle, currentTime)
+	if err = done(txCtx, err); err != nil {
+		return nil, fmt.Errorf("исполнение цикла обмена: %w", err)
+	}
+
+	return cycle, nil
+}
+
+// acceptInTx сохраняет принятый цикл, резервирует объявления и завершает другие циклы с ними.
+func (s *tradeCycleService) acceptInTx(ctx context.Context, cycle *entity.TradeCycle, currentTime time.Time) error {
+	if err := s.cycleRepo.UpdateTradeCycle(ctx, cycle); err != nil {
+		return fmt.Errorf("сохранение цикла обмена: %w", err)
+	}
+
+	ids := cycle.ListingIDs()
+
+	reserved, err := s.listingRepo.UpdateListingsStatus(
+		ctx, ids, entity.ListingStatusActive, entity.ListingStatusReserved, currentTime,
+	)
+	if err != nil {
+		return fmt.Errorf("резервирование объявлений: %w", err)
+	}
+
+	// Если хотя бы одно объявление успели зарезервировать в другом обмене, откатываем транзакцию.
+	if reserved != int64(len(ids)) {
+		return entity.ErrTradeCycleListingUnavailable
+	}
+
+	for _, id := range ids {
+		if _, err = s.cycleRepo.ExpireTradeCycles(ctx, id, currentTime); err != nil {
+			return fmt.Errorf("устаревание циклов обмена: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// DeclineTradeCycle отклоняет цикл обмена.
+func (s *tradeCycleService) DeclineTradeCycle(
+	ctx context.Context,
+	action form.TradeCycleAction,
+	currentTime time.Time,
+) (*entity.TradeCycle, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "TradeCycleService.DeclineTradeCycle")
+	defer span.End()
+
+	cycle, err := s.GetTradeCycle(ctx, action)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = cycle.Transition(entity.TradeCycleStatusDeclined, currentTime); err != nil {
+		return nil, err
+	}
+
+	if err = s.cycleRepo.UpdateTradeCycle(ctx, cycle); err != nil {
+		return nil, fmt.Errorf("сохранение цикла обмена: %w", err)
+	}
+
+	return cycle, nil
+}
@@ -0,0 +1,824 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"gitlab.com/example/gophers/libs/kafka/producer"
+	"gitlab.com/example/gophers/libs/logger"
+	"gitlab.com/example/gophers/libs/trace"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// TradeOfferService представляет интерфейс сервиса для работы с предложениями обмена.
+type TradeOfferService interface {
+	// CreateTradeOffer создает предложение обмена.
+	CreateTradeOffer(ctx context.Context, createForm form.TradeOfferCreate, currentTime time.Time) (*entity.TradeOffer, error)
+	// GetTradeOffer возвращает предложение обмена участнику.
+	GetTradeOffer(ctx context.Context, action form.TradeOfferAction) (*entity.TradeOffer, error)
+	// GetTradeOffers возвращает список предложений обмена пользователя и их общее количество.
+	GetTradeOffers(ctx context.Context, filter form.TradeOffersGet) (entity.TradeOffers, int64, error)
+	// AcceptTradeOffer принимает предложение обмена и резервирует объявления.
+	AcceptTradeOffer(ctx context.Context, action form.TradeOfferAction, currentTime time.Time) (*entity.TradeOffer, error)
+	// RejectTradeOffer отклоняет предложение обмена.
+	RejectTradeOffer(ctx context.Context, action form.TradeOfferAction, currentTime time.Time) (*entity.TradeOffer, error)
+	// CancelTradeOffer отзывает ожидающее предложение автором или отменяет принятое любым участником.
+	// Отмена принятого предложения освобождает объявления и отменяет заказ обмена.
+	CancelTradeOffer(ctx context.Context, action form.TradeOfferAction, currentTime time.Time) (*entity.TradeOffer, error)
+	// CompleteTradeOffer отмечает принятый обмен состоявшимся, переводит объявления в статус exchanged,
+	// завершает заказ обмена и списывает доплату.
+	CompleteTradeOffer(ctx context.Context, action form.TradeOfferAction, currentTime time.Time) (*entity.TradeOffer, error)
+	// CounterTradeOffer создает встречное предложение обмена.
+	CounterTradeOffer(ctx context.Context, counterForm form.TradeOfferCounter, currentTime time.Time) (*entity.TradeOffer, error)
+}
+
+// tradeOfferService представляет сервис для работы с предложениями обмена.
+type tradeOfferService struct {
+	offerRepo         repository.TradeOfferRepository // Репозиторий предложений обмена
+	listingRepo       repository.ListingRepository    // Репозиторий объявлений
+	orderRepo         repository.OrdersRepository     // Репозиторий заказов, создаваемых при принятии предложения
+	paymentRepo       repository.PaymentRepository    // Репозиторий платежей доплат
+	auditRepo         repository.AuditRepository      // Журнал изменений
+	txStarter         repository.TxStarter            // Запуск транзакций
+	payments          repository.PaymentProvider      // Платежный провайдер для доплат
+	currencies        entity.Currencies               // Допустимые валюты доплаты
+	fairnessTolerance int64                           // Допустимый перевес обмена в процентах
+	producer          producer.MessageProducer        // Продюсер событий предложений обмена
+	tracer            trace.TracerProvider            // Отслеживает запросы между слоями и микросервисами
+	logger            logger.Logger                   // Логирование запросов и ошибок сервиса
+	json              jsoniter.API                    // JSON-парсер
+}
+
+// NewTradeOfferService создает новый экземпляр сервиса для работы с предложениями обмена.
+func NewTradeOfferService(
+	offerRepo repository.TradeOfferRepository,
+	listingRepo repository.ListingRepository,
+	orderRepo repository.OrdersRepository,
+	paymentRepo repository.PaymentRepository,
+	auditRepo repository.AuditRepository,
+	txStarter repository.TxStarter,
+	payments repository.PaymentProvider,
+	currencies entity.Currencies,
+	fairnessTolerance int64,
+	kafkaProducer producer.MessageProducer,
+	l logger.Logger,
+	tracer trace.TracerProvider,
+) TradeOfferService {
+	return &tradeOfferService{
+		offerRepo:         offerRepo,
+		listingRepo:       listingRepo,
+		orderRepo:         orderRepo,
+		paymentRepo:       paymentRepo,
+		auditRepo:         auditRepo,
+		txStarter:         txStarter,
+		payments:          payments,
+		currencies:        currencies,
+		fairnessTolerance: fairnessTolerance,
+		producer:          kafkaProducer,
+		tracer:            tracer,
+		logger:            l.WithFields(logger.Fields{"layer": "trade-offer-service"}),
+		json:              jsoniter.ConfigCompatibleWithStandardLibrary,
+	}
+}
+
+// CreateTradeOffer создает предложение обмена.
+func (s *tradeOfferService) CreateTradeOffer(
+	ctx context.Context,
+	createForm form.TradeOfferCreate,
+	currentTime time.Time,
+) (*entity.TradeOffer, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "TradeOfferService.CreateTradeOffer")
+	defer span.End()
+
+	if err := createForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	offer, err := s.newTradeOffer(ctx, createForm, currentTime)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.offerRepo.CreateTradeOffer(ctx, offer); err != nil {
+		return nil, fmt.Errorf("создание предложения обмена: %w", err)
+	}
+
+	s.publish(ctx, offer, offer.ProposerID, currentTime)
+
+	return offer, nil
+}
+
+// GetTradeOffer возвращает предложение обмена участнику.
+func (s *tradeOfferService) GetTradeOffer(ctx context.Context, action form.TradeOfferAction) (*entity.TradeOffer, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "TradeOfferService.GetTradeOffer")
+	defer span.End()
+
+	if err := action.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	offer, err := s.offerRepo.GetTradeOfferByID(ctx, action.OfferID)
+	if err != nil {
+		return nil, fmt.Errorf("получение предложения обмена: %w", err)
+	}
+
+	// Чужие предложения не раскрываем.
+	if !offer.IsParticipant(action.UserID) {
+		return nil, entity.ErrTradeOfferNotFound
+	}
+
+	return offer, nil
+}
+
+// GetTradeOffers возвращает список предложений обмена пользователя и их общее количество.
+func (s *tradeOfferService) GetTradeOffers(ctx context.Context, filter form.TradeOffersGet) (entity.TradeOffers, int64, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "TradeOfferService.GetTradeOffers")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	offers, count, err := s.offerRepo.GetTradeOffers(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение списка предложений обмена: %w", err)
+	}
+
+	return offers, count, nil
+}
+
+// AcceptTradeOffer принимает предложение обмена, блокирует доплату и резервирует объявления обеих сторон.
+// Доплата блокируется до транзакции: если сохранить обмен не удалось, блокировка снимается.
+func (s *tradeOfferService) AcceptTradeOffer(
+	ctx context.Context,
+	action form.TradeOfferAction,
+	currentTime time.Time,
+) (*entity.TradeOffer, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "TradeOfferService.AcceptTradeOffer")
+	defer span.End()
+
+	offer, err := s.GetTradeOffer(ctx, action)
+	if err != nil {
+		return nil, err
+	}
+
+	if offer.RecipientID != action.UserID {
+		return nil, fmt.Errorf("%w: принять предложение может только получатель", entity.ErrTradeOfferForbidden)
+	}
+
+	// Объявления могли измениться с момента создания предложения.
+	_, listings, err := s.checkListings(ctx, offer.ProposerID, offer.OfferedListingIDs, offer.RequestedListingIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	from := offer.Status
+	if err = offer.Transition(entity.TradeOfferStatusAccepted, currentTime); err != nil {
+		return nil, err
+	}
+
+	provided, err := s.charge(ctx, offer, currentTime)
+	if err != nil {
+		return nil, err
+	}
+
+	txCtx, done, err := s.txStarter.StartSession(ctx)
+	if err != nil {
+		s.release(ctx, offer)
+
+		return nil, fmt.Errorf("начало транзакции: %w", err)
+	}
+
+	err = s.acceptInTx(txCtx, offer, listings, provided, from, currentTime)
+	if err = done(txCtx, err); err != nil {
+		s.release(ctx, offer)
+
+		return nil, fmt.Errorf("принятие предложения обмена: %w", err)
+	}
+
+	s.publish(ctx, offer, action.UserID, currentTime)
+
+	return offer, nil
+}
+
+// acceptInTx создает заказ с платежом доплаты, сохраняет принятое предложение
+// и резервирует объявления в рамках транзакции.
+func (s *tradeOfferService) acceptInTx(
+	ctx context.Context,
+	offer *entity.TradeOffer,
+	listings map[string]*entity.Listing,
+	provided *entity.ProviderPayment,
+	from entity.TradeOfferStatus,
+	currentTime time.Time,
+) error {
+	order := entity.NewTradeOrder(offer, listings, currentTime)
+	order.History = entity.OrderHistory{
+		entity.NewOrderStatusChange(offer.RecipientID, "", order.Status, "", currentTime),
+	}
+
+	if err := s.orderRepo.CreateOrder(ctx, order); err != nil {
+		return fmt.Errorf("создание заказа: %w", err)
+	}
+
+	err := recordAudit(ctx, s.auditRepo, entity.AuditEntityOrder, order.ID, entity.AuditActionCreate, nil, order, currentTime)
+	if err != nil {
+		return err
+	}
+
+	offer.OrderID = order.ID
+
+	if provided != nil {
+		payerID, payeeID, _ := offer.CashParties()
+		payment := entity.NewPayment(order.ID, payerID, payeeID, provided, currentTime)
+
+		if err := s.paymentRepo.CreatePayment(ctx, payment); err != nil {
+			return fmt.Errorf("сохранение платежа доплаты: %w", err)
+		}
+	}
+
+	if err := s.offerRepo.UpdateTradeOfferStatus(ctx, offer, from); err != nil {
+		return fmt.Errorf("сохранение статуса предложения: %w", err)
+	}
+
+	ids := offer.ListingIDs()
+
+	reserved, err := s.listingRepo.UpdateListingsStatus(
+		ctx, ids, entity.ListingStatusActive, entity.ListingStatusReserved, currentTime,
+	)
+	if err != nil {
+		return fmt.Errorf("резервирование объявлений: %w", err)
+	}
+
+	// Если хотя бы одно объявление успели зарезервировать в другом обмене, откатываем транзакцию.
+	if reserved != int64(len(ids)) {
+		return entity.ErrTradeOfferListingUnavailable
+	}
+
+	for _, id := range ids {
+		listing, ok := listings[id]
+		if !ok {
+			continue
+		}
+
+		after := *listing
+		after.Status = entity.ListingStatusReserved
+
+		err = recordAudit(ctx, s.auditRepo, entity.AuditEntityListing, id, entity.AuditActionUpdate, listing, &after, currentTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// charge блокирует доплату на счете плательщика. Если доплаты нет, возвращает nil.
+// Списывается доплата, когда обмен состоялся, а при отмене блокировка снимается.
+// Ключ идемпотентности защищает от двойной блокировки при повторе запроса к провайдеру,
+// но отличается у разных попыток принять предложение.
+func (s *tradeOfferService) charge(
+	ctx context.Context,
+	offer *entity.TradeOffer,
+	currentTime time.Time,
+) (*entity.ProviderPayment, error) {
+	payerID, payeeID, ok := offer.CashParties()
+	if !ok {
+		return nil, nil
+	}
+
+	authorized, err := s.payments.Authorize(ctx, entity.PaymentCharge{
+		IdempotencyKey: fmt.Sprintf("trade-offer:%s:%d", offer.ID, currentTime.UnixNano()),
+		PayerID:        payerID,
+		PayeeID:        payeeID,
+		Amount:         offer.Cash.Amount,
+		Description:    "Доплата по предложению обмена " + offer.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("блокировка доплаты: %w", err)
+	}
+
+	offer.Cash.PaymentID = authorized.ID
+
+	return authorized, nil
+}
+
+// release снимает блокировку доплаты, если обмен не удалось сохранить. Ошибка только логируется:
+// платеж не сохранен, поэтому блокировка снимается вручную или истекает у провайдера.
+func (s *tradeOfferService) release(ctx context.Context, offer *entity.TradeOffer) {
+	if offer.Cash == nil || offer.Cash.PaymentID == "" {
+		return
+	}
+
+	if _, err := s.payments.Void(ctx, offer.Cash.PaymentID); err != nil {
+		s.logger.WithFields(logger.Fields{"offer_id": offer.ID, "payment_id": offer.Cash.PaymentID}).
+			Errorf("снятие блокировки доплаты: %v", err)
+	}
+
+	offer.Cash.PaymentID = ""
+}
+
+// RejectTradeOffer отклоняет предложение обмена.
+func (s *tradeOfferService) RejectTradeOffer(
+	ctx context.Context,
+	action form.TradeOfferAction,
+	currentTime time.Time,
+) (*entity.TradeOffer, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "TradeOfferService.RejectTradeOffer")
+	defer span.End()
+
+	offer, err := s.GetTradeOffer(ctx, action)
+	if err != nil {
+		return nil, err
+	}
+
+	if offer.RecipientID != action.UserID {
+		return nil, fmt.Errorf("%w: отклонить предложение может только получатель", entity.ErrTradeOfferForbidden)
+	}
+
+	return s.transition(ctx, offer, entity.TradeOfferStatusRejected, action.UserID, currentTime)
+}
+
+// CancelTradeOffer отзывает ожидающее предложение автором или отменяет принятое любым участником.
+func (s *tradeOfferService) CancelTradeOffer(
+	ctx context.Context,
+	action form.TradeOfferAction,
+	currentTime time.Time,
+) (*entity.TradeOffer, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "TradeOfferService.CancelTradeOffer")
+	defer span.End()
+
+	offer, err := s.GetTradeOffer(ctx, action)
+	if err != nil {
+		return nil, err
+	}
+
+	// Принятый обмен может сорваться по вине любой стороны.
+	if offer.Status == entity.TradeOfferStatusAccepted {
+		return s.close(ctx, offer, entity.TradeOfferStatusCancelled, action.UserID, currentTime)
+	}
+
+	if offer.ProposerID != action.UserID {
+		return nil, fmt.Errorf("%w: отозвать предложение может только автор", entity.ErrTradeOfferForbidden)
+	}
+
+	return s.transition(ctx, offer, entity.TradeOfferStatusCancelled, action.UserID, currentTime)
+}
+
+// CompleteTradeOffer отмечает принятый обмен состоявшимся любым участником.
+func (s *tradeOfferService) CompleteTradeOffer(
+	ctx context.Context,
+	action form.TradeOfferAction,
+	currentTime time.Time,
+) (*entity.TradeOffer, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "TradeOfferService.CompleteTradeOffer")
+	defer span.End()
+
+	offer, err := s.GetTradeOffer(ctx, action)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.close(ctx, offer, entity.TradeOfferStatusCompleted, action.UserID, currentTime)
+}
+
+// close завершает или отменяет принятое предложение. В одной транзакции с новым статусом объявления
+// обеих сторон переходят из reserved в exchanged или возвращаются в active, заказ обмена завершается
+// или отменяется, а в очередь платежа ставится списание, снятие блокировки или возврат доплаты.
+// Операция с доплатой проводится у провайдера после фиксации транзакции.
+func (s *tradeOfferService) close(
+	ctx context.Context,
+	offer *entity.TradeOffer,
+	to entity.TradeOfferStatus,
+	actorID string,
+	currentTime time.Time,
+) (*entity.TradeOffer, error) {
+	from := offer.Status
+	if err := offer.Transition(to, currentTime); err != nil {
+		return nil, err
+	}
+
+	listings, err := s.listingRepo.GetListingsByIDs(ctx, offer.ListingIDs())
+	if err != nil {
+		return nil, fmt.Errorf("получение объявлений: %w", err)
+	}
+
+	var (
+		order   *entity.Order
+		payment *entity.Payment
+	)
+
+	if offer.OrderID != "" {
+		order, err = s.orderRepo.GetOrderForClient(ctx, form.OrderGetForClient{OrderID: offer.OrderID, UserID: actorID})
+		if err != nil {
+			return nil, fmt.Errorf("получение заказа обмена: %w", err)
+		}
+
+		// Заказ, закрытый раньше, уже провел доплату.
+		if order.Status.IsTerminal() {
+			order = nil
+		}
+	}
+
+	if order != nil {
+		if payment, err = s.tradePayment(ctx, order.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	txCtx, done, err := s.txStarter.StartSession(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("начало транзакции: %w", err)
+	}
+
+	err = s.closeInTx(txCtx, offer, from, listings, order, payment, actorID, currentTime)
+	if err = done(txCtx, err); err != nil {
+		return nil, fmt.Errorf("сохранение статуса предложения: %w", err)
+	}
+
+	if payment != nil && payment.Settlement != nil {
+		s.settle(ctx, offer, payment, currentTime)
+	}
+
+	s.publish(ctx, offer, actorID, currentTime)
+
+	return offer, nil
+}
+
+// closeInTx сохраняет статус предложения, объявлений, заказа обмена и операцию с доплатой в рамках транзакции.
+func (s *tradeOfferService) closeInTx(
+	ctx context.Context,
+	offer *entity.TradeOffer,
+	from entity.TradeOfferStatus,
+	listings entity.Listings,
+	order *entity.Order,
+	payment *entity.Payment,
+	actorID string,
+	currentTime time.Time,
+) error {
+	if err := s.offerRepo.UpdateTradeOfferStatus(ctx, offer, from); err != nil {
+		return fmt.Errorf("сохранение статуса предложения: %w", err)
+	}
+
+	status := entity.ListingStatusActive
+	if offer.Status == entity.TradeOfferStatusCompleted {
+		status = entity.ListingStatusExchanged
+	}
+
+	ids := offer.ListingIDs()
+
+	updated, err := s.listingRepo.UpdateListingsStatus(ctx, ids, entity.ListingStatusReserved, status, currentTime)
+	if err != nil {
+		return fmt.Errorf("изменение статуса объявлений: %w", err)
+	}
+
+	// Зарезервированные объявления меняет только этот обмен: расхождение означает параллельное изменение.
+	if updated != int64(len(ids)) {
+		return entity.ErrTradeOfferConflict
+	}
+
+	for _, listing := range listings {
+		after := *listing
+		after.Status = status
+
+		err = recordAudit(ctx, s.auditRepo, entity.AuditEntityListing, listing.ID, entity.AuditActionUpdate, listing, &after, currentTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	if order == nil {
+		return nil
+	}
+
+	if err = s.closeOrder(ctx, order, offer.Status, actorID, currentTime); err != nil {
+		return err
+	}
+
+	if payment == nil {
+		return nil
+	}
+
+	return s.requestCash(ctx, payment, offer.Status, currentTime)
+}
+
+// closeOrder переводит заказ обмена в статус закрытого предложения и записывает переходы в историю.
+// Неподтвержденный заказ при завершении сначала подтверждается: обмен состоялся с согласия обеих сторон.
+func (s *tradeOfferService) closeOrder(
+	ctx context.Context,
+	order *entity.Order,
+	to entity.TradeOfferStatus,
+	actorID string,
+	currentTime time.Time,
+) error {
+	before := *order
+
+	reason := "предложение обмена отменено"
+	steps := []entity.OrderStatus{entity.OrderStatusCancelled}
+
+	if to == entity.TradeOfferStatusCompleted {
+		reason = "обмен состоялся"
+		steps = []entity.OrderStatus{entity.OrderStatusCompleted}
+
+		if order.Status == entity.OrderStatusCreated {
+			steps = []entity.OrderStatus{entity.OrderStatusConfirmed, entity.OrderStatusCompleted}
+		}
+	}
+
+	for _, status := range steps {
+		change, err := order.ChangeStatus(actorID, status, reason, currentTime)
+		if err != nil {
+			return fmt.Errorf("изменение статуса заказа обмена: %w", err)
+		}
+
+		if err = s.orderRepo.UpdateOrderStatus(ctx, order, change); err != nil {
+			return fmt.Errorf("изменение статуса заказа обмена: %w", err)
+		}
+	}
+
+	return recordAudit(ctx, s.auditRepo, entity.AuditEntityOrder, order.ID, entity.AuditActionUpdate, &before, order, currentTime)
+}
+
+// requestCash ставит в очередь платежа операцию с доплатой закрытого предложения и записывает ее в журнал:
+// состоявшийся обмен списывает заблокированную доплату, отмена снимает блокировку или возвращает списанную.
+// Ключ идемпотентности не дает вернуть доплату дважды.
+func (s *tradeOfferService) requestCash(
+	ctx context.Context,
+	payment *entity.Payment,
+	to entity.TradeOfferStatus,
+	currentTime time.Time,
+) error {
+	before := *payment
+
+	var err error
+
+	switch {
+	case to == entity.TradeOfferStatusCompleted && payment.Status == entity.PaymentStatusAuthorized:
+		err = payment.RequestSettlement(entity.PaymentStatusCaptured, entity.Money{}, "обмен состоялся", "", currentTime)
+	case to == entity.TradeOfferStatusCancelled && payment.Status == entity.PaymentStatusAuthorized:
+		err = payment.RequestSettlement(entity.PaymentStatusVoided, entity.Money{}, "предложение обмена отменено", "", currentTime)
+	case to == entity.TradeOfferStatusCancelled && payment.Status.IsRefundable():
+		err = payment.RequestSettlement(
+			entity.PaymentStatusRefunded, payment.Refundable(), "предложение обмена отменено",
+			"trade-offer-cancel:"+payment.OrderID, currentTime,
+		)
+	default:
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("операция с доплатой: %w", err)
+	}
+
+	if err = s.paymentRepo.UpdatePayment(ctx, payment); err != nil {
+		return fmt.Errorf("сохранение операции с доплатой: %w", err)
+	}
+
+	return recordAudit(ctx, s.auditRepo, entity.AuditEntityPayment, payment.ID, entity.AuditActionUpdate, &before, payment, currentTime)
+}
+
+// tradePayment возвращает платеж доплаты заказа обмена. Если доплаты нет, возвращает nil.
+func (s *tradeOfferService) tradePayment(ctx context.Context, orderID string) (*entity.Payment, error) {
+	payment, err := s.paymentRepo.GetPaymentByOrderID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, entity.ErrPaymentNotFound) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("получение платежа доплаты: %w", err)
+	}
+
+	return payment, nil
+}
+
+// settle проводит у провайдера операцию с доплатой после фиксации транзакции. Операция сохранена
+// вместе со статусом обмена, поэтому ошибка только логируется, а операцию повторит PaymentService.SettlePending.
+func (s *tradeOfferService) settle(ctx context.Context, offer *entity.TradeOffer, payment *entity.Payment, currentTime time.Time) {
+	if err := settlePayment(ctx, s.paymentRepo, s.payments, payment, currentTime); err != nil {
+		s.logger.WithFields(logger.Fields{"offer_id": offer.ID, "order_id": payment.OrderID, "payment_id": payment.ID}).
+			Errorf("операция с доплатой будет повторена: %v", err)
+	}
+}
+
+// CounterTradeOffer создает встречное предложение обмена.
+// Исходное предложение переходит в статус countered, а встречное адресуется его автору.
+func (s *tradeOfferService) CounterTradeOffer(
+	ctx context.Context,
+	counterForm form.TradeOfferCounter,
+	currentTime time.Time,
+) (*entity.TradeOffer, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "TradeOfferService.CounterTradeOffer")
+	defer span.End()
+
+	if err := counterForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	original, err := s.GetTradeOffer(ctx, form.TradeOfferAction{OfferID: counterForm.OfferID, UserID: counterForm.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	if original.RecipientID != counterForm.UserID {
+		return nil, fmt.Errorf("%w: встречное предложение может сделать только получатель", entity.ErrTradeOfferForbidden)
+	}
+
+	counter, err := s.newTradeOffer(ctx, counterForm.ToTradeOfferCreate(), currentTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// Встречное предложение адресуется только автору исходного.
+	if counter.RecipientID != original.ProposerID {
+		return nil, fmt.Errorf("%w: запрошены объявления не автора исходного предложения", entity.ErrTradeOfferListingOwner)
+	}
+
+	counter.ParentID = original.ID
+
+	from := original.Status
+	if err = original.Transition(entity.TradeOfferStatusCountered, currentTime); err != nil {
+		return nil, err
+	}
+
+	txCtx, done, err := s.txStarter.StartSession(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("начало транзакции: %w", err)
+	}
+
+	err = s.counterInTx(txCtx, original, counter, from)
+	if err = done(txCtx, err); err != nil {
+		return nil, fmt.Errorf("создание встречного предложения: %w", err)
+	}
+
+	s.publish(ctx, original, counterForm.UserID, currentTime)
+	s.publish(ctx, counter, counterForm.UserID, currentTime)
+
+	return counter, nil
+}
+
+// counterInTx сохраняет встречное предложение и обновляет исходное в рамках транзакции.
+func (s *tradeOfferService) counterInTx(
+	ctx context.Context,
+	original, counter *entity.TradeOffer,
+	from entity.TradeOfferStatus,
+) error {
+	if err := s.offerRepo.CreateTradeOffer(ctx, counter); err != nil {
+		return fmt.Errorf("сохранение встречного предложения: %w", err)
+	}
+
+	original.CounterOfferID = counter.ID
+
+	if err := s.offerRepo.UpdateTradeOfferStatus(ctx, original, from); err != nil {
+		return fmt.Errorf("сохранение статуса исходного предложения: %w", err)
+	}
+
+	return nil
+}
+
+// transition переводит предложение в новый статус, сохраняет его и отправляет событие.
+func (s *tradeOfferService) transition(
+	ctx context.Context,
+	offer *entity.TradeOffer,
+	to entity.TradeOfferStatus,
+	actorID string,
+	currentTime time.Time,
+) (*entity.TradeOffer, error) {
+	from := offer.Status
+	if err := offer.Transition(to, currentTime); err != nil {
+		return nil, err
+	}
+
+	if err := s.offerRepo.UpdateTradeOfferStatus(ctx, offer, from); err != nil {
+		return nil, fmt.Errorf("сохранение статуса предложения: %w", err)
+	}
+
+	s.publish(ctx, offer, actorID, currentTime)
+
+	return offer, nil
+}
+
+// newTradeOffer проверяет объявления и доплату, оценивает равноценность и создает сущность предложения обмена.
+func (s *tradeOfferService) newTradeOffer(
+	ctx context.Context,
+	createForm form.TradeOfferCreate,
+	currentTime time.Time,
+) (*entity.TradeOffer, error) {
+	if createForm.Cash != nil {
+		if err := s.currencies.Validate(createForm.Cash.Amount.Currency); err != nil {
+			return nil, fmt.Errorf("валидация валюты доплаты: %w", err)
+		}
+	}
+
+	recipientID, listings, err := s.checkListings(ctx, createForm.ProposerID, createForm.OfferedListingIDs, createForm.RequestedListingIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	offer := entity.NewTradeOffer(currentTime)
+
+	if err = createForm.Fill(offer, recipientID); err != nil {
+		return nil, fmt.Errorf("заполнение формы: %w", err)
+	}
+
+	offer.Fairness, err = entity.NewTradeFairness(
+		pickListings(listings, offer.OfferedListingIDs),
+		pickListings(listings, offer.RequestedListingIDs),
+		offer.Cash, s.fairnessTolerance,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("оценка равноценности обмена: %w", err)
+	}
+
+	return offer, nil
+}
+
+// pickListings возвращает объявления по идентификаторам в порядке идентификаторов.
+func pickListings(listings map[string]*entity.Listing, ids []string) entity.Listings {
+	picked := make(entity.Listings, 0, len(ids))
+	for _, id := range ids {
+		picked = append(picked, listings[id])
+	}
+
+	return picked
+}
+
+// checkListings проверяет принадлежность и доступность объявлений обеих сторон.
+// Возвращает идентификатор владельца запрошенных объявлений и объявления по идентификаторам.
+func (s *tradeOfferService) checkListings(
+	ctx context.Context,
+	proposerID string,
+	offered, requested []string,
+) (string, map[string]*entity.Listing, error) {
+	ids := make([]string, 0, len(offered)+len(requested))
+	ids = append(ids, offered...)
+	ids = append(ids, requested...)
+
+	listings, err := s.listingRepo.GetListingsByIDs(ctx, ids)
+	if err != nil {
+		return "", nil, fmt.Errorf("получение объявлений: %w", err)
+	}
+
+	byID := make(map[string]*entity.Listing, len(listings))
+	for _, listing := range listings {
+		byID[listing.ID] = listing
+	}
+
+	for _, id := range offered {
+		listing, ok := byID[id]
+		if !ok {
+			return "", nil, fmt.Errorf("%w: %s", entity.ErrListingNotFound, id)
+		}
+
+		if listing.OwnerID != proposerID {
+			return "", nil, fmt.Errorf("%w: %s", entity.ErrTradeOfferListingOwner, id)
+		}
+
+		if !listing.IsAvailable() {
+			return "", nil, fmt.Errorf("%w: %s", entity.ErrTradeOfferListingUnavailable, id)
+		}
+	}
+
+	var recipientID string
+
+	for _, id := range requested {
+		listing, ok := byID[id]
+		if !ok {
+			return "", nil, fmt.Errorf("%w: %s", entity.ErrListingNotFound, id)
+		}
+
+		switch {
+		case listing.OwnerID == proposerID:
+			return "", nil, fmt.Errorf("%w: %s", entity.ErrTradeOfferSelf, id)
+		case recipientID != "" && listing.OwnerID != recipientID:
+			return "", nil, fmt.Errorf("%w: %s", entity.ErrTradeOfferRecipientsDifferent, id)
+		case !listing.IsAvailable():
+			return "", nil, fmt.Errorf("%w: %s", entity.ErrTradeOfferListingUnavailable, id)
+		}
+
+		recipientID = listing.OwnerID
+	}
+
+	return recipientID, byID, nil
+}
+
+// publish отправляет событие изменения статуса предложения в Kafka.
+// Изменение уже сохранено, поэтому ошибка отправки только логируется.
+func (s *tradeOfferService) publish(ctx context.Context, offer *entity.TradeOffer, actorID string, currentTime time.Time) {
+	value, err := s.json.Marshal(entity.NewTradeOfferEvent(offer, actorID, currentTime))
+	if err != nil {
+		s.logger.Errorf("сериализация события предложения обмена %s: %v", offer.ID, err)
+
+		return
+	}
+
+	err = s.producer.Write(ctx, producer.Message{Key: []byte(offer.ID), Value: value})
+	if err != nil {
+		s.logger.WithFields(logger.Fields{"offer_id": offer.ID, "status": offer.Status}).
+			Errorf("запись события предложения обмена в kafka: %v", err)
+	}
+}
@@ -0,0 +1,375 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+	"github.com/alisher-99/LomBarter/internal/domain/repository/mock_repo"
+	"github.com/alisher-99/LomBarter/internal/storage/payment"
+)
+
+const (
+	testOfferID     = "655d8a3577a0a79c69a7cdfc"
+	testOrderID     = "655d8a3577a0a79c69a7cdfd"
+	testProposerID  = "655d8a4d3afea534e56b5701"
+	testRecipientID = "655d8a4d3afea534e56b5702"
+	testOfferedID   = "655d8a4d3afea534e56b5711"
+	testRequestedID = "655d8a4d3afea534e56b5712"
+)
+
+// tradeOfferMocks репозитории сервиса предложений обмена.
+type tradeOfferMocks struct {
+	offers   *mock_repo.MockTradeOfferRepository
+	listings *mock_repo.MockListingRepository
+	orders   *mock_repo.MockOrdersRepository
+	payments *mock_repo.MockPaymentRepository
+	audit    *mock_repo.MockAuditRepository
+	tx       *mock_repo.MockTxStarter
+}
+
+// newTestTradeOfferService создает сервис предложений обмена на моках репозиториев и платежном провайдере payments.
+func newTestTradeOfferService(t *testing.T, payments repository.PaymentProvider) (TradeOfferService, tradeOfferMocks) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	mocks := tradeOfferMocks{
+		offers:   mock_repo.NewMockTradeOfferRepository(ctrl),
+		listings: mock_repo.NewMockListingRepository(ctrl),
+		orders:   mock_repo.NewMockOrdersRepository(ctrl),
+		payments: mock_repo.NewMockPaymentRepository(ctrl),
+		audit:    mock_repo.NewMockAuditRepository(ctrl),
+		tx:       mock_repo.NewMockTxStarter(ctrl),
+	}
+
+	expectTransactions(mocks.tx)
+	mocks.audit.EXPECT().CreateAuditEntry(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+	currencies, err := entity.NewCurrencies(nil)
+	require.NoError(t, err)
+
+	svc := NewTradeOfferService(
+		mocks.offers, mocks.listings, mocks.orders, mocks.payments, mocks.audit, mocks.tx, payments,
+		currencies, 10, nopProducer{}, testLogger(t), trace.NewNoopTracerProvider(),
+	)
+
+	return svc, mocks
+}
+
+// testTradeListings возвращает объявления обеих сторон в статусе status.
+func testTradeListings(status entity.ListingStatus) entity.Listings {
+	return entity.Listings{
+		{ID: testOfferedID, OwnerID: testProposerID, Title: "Велосипед", Status: status},
+		{ID: testRequestedID, OwnerID: testRecipientID, Title: "Самокат", Status: status},
+	}
+}
+
+// testTradeOffer возвращает предложение обмена одного объявления на другое в статусе status.
+func testTradeOffer(status entity.TradeOfferStatus, created time.Time) *entity.TradeOffer {
+	offer := entity.NewTradeOffer(created)
+	offer.ID = testOfferID
+	offer.ProposerID = testProposerID
+	offer.RecipientID = testRecipientID
+	offer.OfferedListingIDs = []string{testOfferedID}
+	offer.RequestedListingIDs = []string{testRequestedID}
+	offer.Status = status
+
+	if status != entity.TradeOfferStatusPending {
+		offer.OrderID = testOrderID
+	}
+
+	return offer
+}
+
+func TestTradeOfferService_AcceptTradeOffer(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := created.Add(time.Hour)
+	ids := []string{testOfferedID, testRequestedID}
+
+	cases := []struct {
+		name     string
+		userID   string
+		reserved int64
+		setup    bool
+		err      error
+	}{
+		{
+			name:     "получатель принимает предложение",
+			userID:   testRecipientID,
+			reserved: 2,
+			setup:    true,
+		},
+		{
+			name:     "объявление зарезервировано другим обменом",
+			userID:   testRecipientID,
+			reserved: 1,
+			setup:    true,
+			err:      entity.ErrTradeOfferListingUnavailable,
+		},
+		{
+			name:   "автор не может принять свое предложение",
+			userID: testProposerID,
+			err:    entity.ErrTradeOfferForbidden,
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc, mocks := newTestTradeOfferService(t, nil)
+
+			mocks.offers.EXPECT().GetTradeOfferByID(gomock.Any(), testOfferID).
+				Return(testTradeOffer(entity.TradeOfferStatusPending, created), nil)
+
+			if s.setup {
+				mocks.listings.EXPECT().GetListingsByIDs(gomock.Any(), ids).
+					Return(testTradeListings(entity.ListingStatusActive), nil)
+				mocks.orders.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, order *entity.Order) error {
+						assert.Equal(t, testOfferID, order.TradeOfferID)
+						assert.Len(t, order.Legs, 2)
+
+						order.ID = testOrderID
+
+						return nil
+					},
+				)
+				mocks.offers.EXPECT().UpdateTradeOfferStatus(gomock.Any(), gomock.Any(), entity.TradeOfferStatusPending).Return(nil)
+				mocks.listings.EXPECT().
+					UpdateListingsStatus(gomock.Any(), ids, entity.ListingStatusActive, entity.ListingStatusReserved, now).
+					Return(s.reserved, nil)
+			}
+
+			offer, err := svc.AcceptTradeOffer(context.Background(), tradeOfferAction(s.userID), now)
+			if s.err != nil {
+				assert.ErrorIs(t, err, s.err)
+				assert.Nil(t, offer)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, entity.TradeOfferStatusAccepted, offer.Status)
+			assert.Equal(t, testOrderID, offer.OrderID)
+			assert.Equal(t, now, offer.UpdatedAt)
+		})
+	}
+}
+
+func TestTradeOfferService_CloseAccepted(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := created.Add(24 * time.Hour)
+	ids := []string{testOfferedID, testRequestedID}
+
+	cases := []struct {
+		name    string
+		cancel  bool
+		userID  string
+		status  entity.TradeOfferStatus
+		listing entity.ListingStatus
+		updated int64
+		orders  []entity.OrderStatus
+		cash    entity.PaymentStatus
+		err     error
+	}{
+		{
+			name:    "автор завершает обмен",
+			userID:  testProposerID,
+			status:  entity.TradeOfferStatusCompleted,
+			listing: entity.ListingStatusExchanged,
+			updated: 2,
+			orders:  []entity.OrderStatus{entity.OrderStatusConfirmed, entity.OrderStatusCompleted},
+			cash:    entity.PaymentStatusCaptured,
+		},
+		{
+			name:    "получатель отменяет принятый обмен",
+			cancel:  true,
+			userID:  testRecipientID,
+			status:  entity.TradeOfferStatusCancelled,
+			listing: entity.ListingStatusActive,
+			updated: 2,
+			orders:  []entity.OrderStatus{entity.OrderStatusCancelled},
+			cash:    entity.PaymentStatusVoided,
+		},
+		{
+			name:    "объявление изменено параллельно",
+			userID:  testRecipientID,
+			status:  entity.TradeOfferStatusCompleted,
+			listing: entity.ListingStatusExchanged,
+			updated: 1,
+			cash:    entity.PaymentStatusAuthorized,
+			err:     entity.ErrTradeOfferConflict,
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			provider := payment.NewFake(payment.NewWebhookSigner("secret", time.Minute))
+
+			// Доплата заблокирована при принятии предложения.
+			authorized, err := provider.Authorize(ctx, entity.PaymentCharge{
+				PayerID: testProposerID,
+				PayeeID: testRecipientID,
+				Amount:  entity.NewMoney(2000, "KZT"),
+			})
+			require.NoError(t, err)
+
+			stored := entity.NewPayment(testOrderID, testProposerID, testRecipientID, authorized, created)
+			stored.ID = testOfferID
+
+			order := entity.NewOrder(created)
+			order.ID = testOrderID
+			order.UserID = testProposerID
+			order.CounterpartyID = testRecipientID
+			order.TradeOfferID = testOfferID
+
+			svc, mocks := newTestTradeOfferService(t, provider)
+
+			mocks.offers.EXPECT().GetTradeOfferByID(gomock.Any(), testOfferID).
+				Return(testTradeOffer(entity.TradeOfferStatusAccepted, created), nil)
+			mocks.listings.EXPECT().GetListingsByIDs(gomock.Any(), ids).
+				Return(testTradeListings(entity.ListingStatusReserved), nil)
+			mocks.orders.EXPECT().GetOrderForClient(gomock.Any(), gomock.Any()).Return(order, nil)
+			mocks.payments.EXPECT().GetPaymentByOrderID(gomock.Any(), testOrderID).Return(stored, nil)
+			mocks.offers.EXPECT().UpdateTradeOfferStatus(gomock.Any(), gomock.Any(), entity.TradeOfferStatusAccepted).Return(nil)
+			mocks.listings.EXPECT().
+				UpdateListingsStatus(gomock.Any(), ids, entity.ListingStatusReserved, s.listing, now).
+				Return(s.updated, nil)
+
+			var orders []entity.OrderStatus
+
+			if s.err == nil {
+				mocks.orders.EXPECT().UpdateOrderStatus(gomock.Any(), order, gomock.Any()).Times(len(s.orders)).DoAndReturn(
+					func(_ context.Context, _ *entity.Order, change entity.OrderStatusChange) error {
+						assert.Equal(t, s.userID, change.ActorID)
+						orders = append(orders, change.To)
+
+						return nil
+					},
+				)
+				// Операция с доплатой ставится в очередь вместе со статусом и снимается из нее после проведения.
+				mocks.payments.EXPECT().UpdatePayment(gomock.Any(), stored).Times(2).Return(nil)
+			}
+
+			var offer *entity.TradeOffer
+
+			if s.cancel {
+				offer, err = svc.CancelTradeOffer(ctx, tradeOfferAction(s.userID), now)
+			} else {
+				offer, err = svc.CompleteTradeOffer(ctx, tradeOfferAction(s.userID), now)
+			}
+
+			// Если обмен не сохранен, доплата у провайдера не двигается.
+			payments := provider.Payments()
+			require.Len(t, payments, 1)
+			assert.Equal(t, s.cash, payments[0].Status)
+
+			if s.err != nil {
+				assert.ErrorIs(t, err, s.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, s.status, offer.Status)
+			assert.Equal(t, s.orders, orders)
+			assert.Equal(t, s.orders[len(s.orders)-1], order.Status)
+			assert.Equal(t, s.cash, stored.Status)
+			assert.Nil(t, stored.Settlement)
+		})
+	}
+}
+
+func TestTradeOfferService_CompleteThenRate(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := created.Add(24 * time.Hour)
+	ctx := context.Background()
+
+	order := entity.NewOrder(created)
+	order.ID = testOrderID
+	order.UserID = testProposerID
+	order.CounterpartyID = testRecipientID
+	order.TradeOfferID = testOfferID
+
+	svc, mocks := newTestTradeOfferService(t, nil)
+
+	mocks.offers.EXPECT().GetTradeOfferByID(gomock.Any(), testOfferID).
+		Return(testTradeOffer(entity.TradeOfferStatusAccepted, created), nil)
+	mocks.listings.EXPECT().GetListingsByIDs(gomock.Any(), gomock.Any()).
+		Return(testTradeListings(entity.ListingStatusReserved), nil)
+	mocks.offers.EXPECT().UpdateTradeOfferStatus(gomock.Any(), gomock.Any(), entity.TradeOfferStatusAccepted).Return(nil)
+	mocks.listings.EXPECT().UpdateListingsStatus(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), now).Return(int64(2), nil)
+	mocks.orders.EXPECT().GetOrderForClient(gomock.Any(), gomock.Any()).Times(2).Return(order, nil)
+	mocks.orders.EXPECT().UpdateOrderStatus(gomock.Any(), order, gomock.Any()).Times(2).Return(nil)
+	mocks.payments.EXPECT().GetPaymentByOrderID(gomock.Any(), testOrderID).Return(nil, entity.ErrPaymentNotFound)
+
+	_, err := svc.CompleteTradeOffer(ctx, tradeOfferAction(testProposerID), now)
+	require.NoError(t, err)
+	require.Equal(t, entity.OrderStatusCompleted, order.Status)
+
+	ctrl := gomock.NewController(t)
+	ratingRepo := mock_repo.NewMockRatingRepository(ctrl)
+	userRepo := mock_repo.NewMockUserRepository(ctrl)
+	cache := mock_repo.NewMockCacheStore(ctrl)
+	userCache := mock_repo.NewMockUserCache(ctrl)
+	tx := mock_repo.NewMockTxStarter(ctrl)
+	expectTransactions(tx)
+
+	ratee := &entity.User{ID: testRecipientID}
+
+	ratingRepo.EXPECT().CreateRating(gomock.Any(), gomock.Any()).Return(nil)
+	userRepo.EXPECT().GetUserByID(gomock.Any(), testRecipientID).Return(ratee, nil)
+	userRepo.EXPECT().UpdateUserReputation(gomock.Any(), testRecipientID, gomock.Any()).Return(nil)
+	cache.EXPECT().UserCache().Return(userCache)
+	userCache.EXPECT().SetUser(gomock.Any(), ratee).Return(nil)
+
+	ratings := NewRatingService(ratingRepo, mocks.orders, userRepo, cache, tx, testLogger(t), trace.NewNoopTracerProvider())
+
+	rating, err := ratings.RateOrder(ctx, form.RatingCreate{
+		OrderID: testOrderID,
+		RaterID: testProposerID,
+		Score:   5,
+	}, now)
+	require.NoError(t, err, "завершенный обмен можно оценить")
+	assert.Equal(t, testRecipientID, rating.RateeID)
+}
+
+func TestTradeOfferService_CompletePending(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	svc, mocks := newTestTradeOfferService(t, nil)
+
+	mocks.offers.EXPECT().GetTradeOfferByID(gomock.Any(), testOfferID).
+		Return(testTradeOffer(entity.TradeOfferStatusPending, created), nil)
+
+	_, err := svc.CompleteTradeOffer(context.Background(), tradeOfferAction(testRecipientID), created.Add(time.Hour))
+	assert.ErrorIs(t, err, entity.ErrTradeOfferTransition)
+}
+
+// tradeOfferAction возвращает форму действия пользователя над тестовым предложением.
+func tradeOfferAction(userID string) form.TradeOfferAction {
+	return form.TradeOfferAction{OfferID: testOfferID, UserID: userID}
+}
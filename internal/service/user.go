@@ -15,6 +15,7 @@ import (
 	"github.com/alisher-99/LomBarter/internal/domain/form"
 	"github.com/alisher-99/LomBarter/internal/domain/presenter"
 	"github.com/alisher-99/LomBarter/internal/domain/repository"
+	"github.com/alisher-99/LomBarter/internal/service/notifier"
 	"github.com/alisher-99/LomBarter/pkg/metrics"
 )
 
@@ -35,31 +36,43 @@ type UserService interface {
 
 // userService представляет сервис для работы с пользователей.
 type userService struct {
-	userRepo  repository.UserRepository // Репозиторий для работы с пользователями
-	cacheData repository.CacheStore     // Кэш для хранения данных о пользователях
-	tracer    trace.TracerProvider      // Отслеживает запросы между слоями и микросервисами
-	logger    logger.Logger             // Логирование запросов и ошибок сервиса
-	producer  producer.MessageProducer  // Продюсер в топик Кафки
-	metrics   metrics.UserMetrics       // Метрики пользователей
-	json      jsoniter.API              // JSON-парсер
+	userRepo  repository.UserRepository  // Репозиторий для работы с пользователями
+	auditRepo repository.AuditRepository // Журнал изменений
+	txStarter repository.TxStarter       // Запуск транзакций
+	cacheData repository.CacheStore      // Кэш для хранения данных о пользователях
+	tracer    trace.TracerProvider       // Отслеживает запросы между слоями и микросервисами
+	logger    logger.Logger              // Логирование запросов и ошибок сервиса
+	producer  producer.MessageProducer   // Продюсер в топик Кафки
+	metrics   metrics.UserMetrics        // Метрики пользователей
+	notifier  notifier.Notifier          // Доставка уведомлений
+	moderator ContentModerator           // Проверка биографии
+	json      jsoniter.API               // JSON-парсер
 }
 
 // NewUserService создает новый экземпляр сервиса для работы с пользователями.
 func NewUserService(
 	repo repository.UserRepository,
+	auditRepo repository.AuditRepository,
+	txStarter repository.TxStarter,
 	cacheData repository.CacheStore,
 	l logger.Logger,
 	tracer trace.TracerProvider,
 	kafkaProducer producer.MessageProducer,
 	userMetrics metrics.UserMetrics,
+	notify notifier.Notifier,
+	moderator ContentModerator,
 ) UserService {
 	return &userService{
 		userRepo:  repo,
+		auditRepo: auditRepo,
+		txStarter: txStarter,
 		cacheData: cacheData,
 		logger:    l.WithFields(logger.Fields{"layer": "updateForm-service"}),
 		tracer:    tracer,
 		producer:  kafkaProducer,
 		metrics:   userMetrics,
+		notifier:  notify,
+		moderator: moderator,
 		json:      jsoniter.ConfigCompatibleWithStandardLibrary,
 	}
 }
@@ -86,10 +99,25 @@ func (u *userService) GetUsersByBio(ctx context.Context, filter form.UsersGetByB
 }
 
 // GetUserByID возвращает пользователя по идентификатору.
+// Заблокированный пользователь не раскрывается, отклоненная модератором биография скрыта.
 func (u *userService) GetUserByID(ctx context.Context, id string) (*entity.User, error) {
 	ctx, span := u.tracer.Tracer(tracerName).Start(ctx, "UserService.GetUserByID")
 	defer span.End()
 
+	user, err := u.getUserByID(ctx, id)
+	if err != nil {
+		return &entity.User{}, err
+	}
+
+	if user.IsBanned() {
+		return &entity.User{}, entity.ErrUserNotFound
+	}
+
+	return user.Public(), nil
+}
+
+// getUserByID возвращает пользователя из кэша, а при промахе из базы с сохранением в кэш.
+func (u *userService) getUserByID(ctx context.Context, id string) (*entity.User, error) {
 	user, cErr := u.cacheData.UserCache().GetUserByID(ctx, id)
 	if cErr == nil {
 		return user, nil
@@ -101,7 +129,7 @@ func (u *userService) GetUserByID(ctx context.Context, id string) (*entity.User,
 
 	user, err := u.userRepo.GetUserByID(ctx, id)
 	if err != nil {
-		return &entity.User{}, fmt.Errorf("получение пользователя: %w", err)
+		return nil, fmt.Errorf("получение пользователя: %w", err)
 	}
 
 	if cErr = u.cacheData.UserCache().SetUser(ctx, user); cErr != nil {
@@ -127,12 +155,48 @@ func (u *userService) CreateUser(ctx context.Context, createForm form.UserCreate
 		return presenter.CreatedUser{}, fmt.Errorf("заполнение формы: %w", err)
 	}
 
-	id, err := u.userRepo.CreateUser(ctx, user)
+	var (
+		violations entity.ModerationViolations
+		err        error
+	)
+
+	user.Moderation, violations, err = u.moderator.Screen(ctx, user.ModerationContent(currentTime), "")
+	if err != nil {
+		return presenter.CreatedUser{}, fmt.Errorf("проверка биографии: %w", err)
+	}
+
+	txCtx, done, err := u.txStarter.StartSession(ctx)
 	if err != nil {
+		return presenter.CreatedUser{}, fmt.Errorf("начало транзакции: %w", err)
+	}
+
+	err = u.createInTx(txCtx, user, violations, currentTime)
+	if err = done(txCtx, err); err != nil {
 		return presenter.CreatedUser{}, fmt.Errorf("создание пользователя: %w", err)
 	}
 
-	return presenter.NewCreatedUser(id), nil
+	return presenter.NewCreatedUser(user.ID), nil
+}
+
+// createInTx сохраняет пользователя, запись журнала изменений и дело модерации биографии в рамках транзакции.
+func (u *userService) createInTx(
+	ctx context.Context,
+	user *entity.User,
+	violations entity.ModerationViolations,
+	currentTime time.Time,
+) error {
+	id, err := u.userRepo.CreateUser(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	user.ID = id
+
+	if err = u.flag(ctx, user, violations, currentTime); err != nil {
+		return err
+	}
+
+	return recordAudit(ctx, u.auditRepo, entity.AuditEntityUser, user.ID, entity.AuditActionCreate, nil, user, currentTime)
 }
 
 // UpdateUser обновляет пользователя.
@@ -150,22 +214,45 @@ func (u *userService) UpdateUser(ctx context.Context, updateForm form.UserUpdate
 		return fmt.Errorf("получение пользователя: %w", err)
 	}
 
+	if user.IsBanned() {
+		return entity.ErrModerationBanned
+	}
+
 	// Заполняем сущность пользователя обновленными данными.
+	before := *user
 	if err = updateForm.Fill(user, currentTime); err != nil {
 		return fmt.Errorf("заполнение формы: %w", err)
 	}
 
+	// Проверяем биографию, только если она изменилась.
+	var violations entity.ModerationViolations
+	if user.Bio != before.Bio {
+		user.Moderation, violations, err = u.moderator.Screen(ctx, user.ModerationContent(currentTime), before.Moderation)
+		if err != nil {
+			return fmt.Errorf("проверка биографии: %w", err)
+		}
+	}
+
 	// Обновляем кэш.
 	if err = u.cacheData.UserCache().SetUser(ctx, user); err != nil {
 		return fmt.Errorf("удаление кэша: %w", err)
 	}
 
-	// Обновляем пользователя.
-	if err = u.userRepo.UpdateUser(ctx, user); err != nil {
+	// Обновляем пользователя вместе с записью в журнале изменений.
+	txCtx, done, err := u.txStarter.StartSession(ctx)
+	if err != nil {
+		return fmt.Errorf("начало транзакции: %w", err)
+	}
+
+	err = u.updateInTx(txCtx, &before, user, violations, currentTime)
+	if err = done(txCtx, err); err != nil {
 		return fmt.Errorf("обновление пользователя: %w", err)
 	}
 
-	u.logger.Debugf("отправка уведомления: %v", user)
+	// Пользователь уже обновлен, поэтому ошибка уведомления только логируется.
+	if err = u.notifier.Notify(ctx, newProfileUpdatedNotification(user)); err != nil {
+		u.logger.Errorf("уведомление пользователя %s об изменении профиля: %v", user.ID, err)
+	}
 
 	// Пример отправки сообщения в топик Кафки
 	err = u.producer.Write(ctx, producer.Message{Value: []byte(fmt.Sprintf("User %q updated", user.ID))})
@@ -175,3 +262,42 @@ func (u *userService) UpdateUser(ctx context.Context, updateForm form.UserUpdate
 
 	return nil
 }
+
+// updateInTx сохраняет пользователя, запись журнала изменений и дело модерации биографии в рамках транзакции.
+func (u *userService) updateInTx(
+	ctx context.Context,
+	before, user *entity.User,
+	violations entity.ModerationViolations,
+	currentTime time.Time,
+) error {
+	if err := u.userRepo.UpdateUser(ctx, user); err != nil {
+		return err
+	}
+
+	if user.Bio != before.Bio {
+		if err := u.flag(ctx, user, violations, currentTime); err != nil {
+			return err
+		}
+	}
+
+	return recordAudit(ctx, u.auditRepo, entity.AuditEntityUser, user.ID, entity.AuditActionUpdate, before, user, currentTime)
+}
+
+// flag отправляет биографию, ожидающую решения модератора, в очередь модерации.
+func (u *userService) flag(ctx context.Context, user *entity.User, violations entity.ModerationViolations, currentTime time.Time) error {
+	if user.Moderation != entity.ModerationStatusPending {
+		return nil
+	}
+
+	return u.moderator.Flag(ctx, user.ModerationContent(currentTime), violations, currentTime)
+}
+
+// newProfileUpdatedNotification создает уведомление об изменении профиля.
+func newProfileUpdatedNotification(user *entity.User) entity.Notification {
+	return entity.Notification{
+		UserID:    user.ID,
+		Kind:      entity.NotificationKindProfileUpdated,
+		Data:      map[string]string{"name": user.Name},
+		CreatedAt: user.UpdatedAt,
+	}
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.com/example/gophers/libs/logger"
+	"gitlab.com/example/gophers/libs/trace"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// WalletService представляет интерфейс сервиса кошельков кредитов.
+type WalletService interface {
+	// GetWallet возвращает счет кредитов пользователя. Если проводок еще не было, баланс нулевой.
+	GetWallet(ctx context.Context, filter form.WalletGet) (*entity.LedgerAccount, error)
+	// GetStatement возвращает выписку по счету пользователя и общее количество проводок.
+	GetStatement(ctx context.Context, filter form.WalletStatementGet) (entity.LedgerPostings, int64, error)
+	// Transfer переводит кредиты другому пользователю.
+	Transfer(ctx context.Context, transferForm form.WalletTransfer, currentTime time.Time) (*entity.LedgerTransaction, error)
+	// Grant начисляет или списывает кредиты пользователя от имени администратора.
+	Grant(ctx context.Context, grantForm form.WalletGrant, currentTime time.Time) (*entity.LedgerTransaction, error)
+}
+
+// walletService представляет сервис кошельков кредитов.
+type walletService struct {
+	ledgerRepo repository.LedgerRepository // Репозиторий счетов и проводок
+	userRepo   repository.UserRepository   // Репозиторий пользователей
+	txStarter  repository.TxStarter        // Запуск транзакций
+	admins     entity.Admins               // Администраторы, которым доступны начисления
+	tracer     trace.TracerProvider        // Отслеживает запросы между слоями и микросервисами
+	logger     logger.Logger               // Логирование запросов и ошибок сервиса
+}
+
+// NewWalletService создает новый экземпляр сервиса кошельков кредитов.
+func NewWalletService(
+	ledgerRepo repository.LedgerRepository,
+	userRepo repository.UserRepository,
+	txStarter repository.TxStarter,
+	admins entity.Admins,
+	l logger.Logger,
+	tracer trace.TracerProvider,
+) WalletService {
+	return &walletService{
+		ledgerRepo: ledgerRepo,
+		userRepo:   userRepo,
+		txStarter:  txStarter,
+		admins:     admins,
+		tracer:     tracer,
+		logger:     l.WithFields(logger.Fields{"layer": "wallet-service"}),
+	}
+}
+
+// GetWallet возвращает счет кредитов пользователя.
+func (s *walletService) GetWallet(ctx context.Context, filter form.WalletGet) (*entity.LedgerAccount, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "WalletService.GetWallet")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	accounts, err := s.ledgerRepo.GetLedgerAccounts(ctx, []string{filter.UserID})
+	if err != nil {
+		return nil, fmt.Errorf("получение счета кредитов: %w", err)
+	}
+
+	if len(accounts) == 0 {
+		return entity.NewLedgerAccount(filter.UserID), nil
+	}
+
+	return accounts[0], nil
+}
+
+// GetStatement возвращает выписку по счету пользователя.
+func (s *walletService) GetStatement(ctx context.Context, filter form.WalletStatementGet) (entity.LedgerPostings, int64, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "WalletService.GetStatement")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	postings, count, err := s.ledgerRepo.GetLedgerPostings(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение проводок: %w", err)
+	}
+
+	return postings, count, nil
+}
+
+// Transfer переводит кредиты другому пользователю.
+func (s *walletService) Transfer(
+	ctx context.Context,
+	transferForm form.WalletTransfer,
+	currentTime time.Time,
+) (*entity.LedgerTransaction, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "WalletService.Transfer")
+	defer span.End()
+
+	if err := transferForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	transaction, err := entity.NewLedgerTransfer(
+		entity.LedgerTransactionKindTransfer,
+		transferForm.FromUserID, transferForm.ToUserID, transferForm.Amount,
+		transferForm.Comment, transferForm.FromUserID, currentTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.userRepo.GetUserByID(ctx, transferForm.ToUserID); err != nil {
+		return nil, fmt.Errorf("получение получателя: %w", err)
+	}
+
+	if err = s.post(ctx, transaction); err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// Grant начисляет кредиты пользователю со счета начислений. Отрицательная сумма возвращает кредиты на этот счет.
+func (s *walletService) Grant(ctx context.Context, grantForm form.WalletGrant, currentTime time.Time) (*entity.LedgerTransaction, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "WalletService.Grant")
+	defer span.End()
+
+	if err := grantForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	if !s.admins.Contains(grantForm.RequesterID) {
+		return nil, entity.ErrLedgerForbidden
+	}
+
+	from, to, amount := entity.LedgerGrantsAccountID, grantForm.UserID, grantForm.Amount
+	if amount < 0 {
+		from, to, amount = to, from, -amount
+	}
+
+	transaction, err := entity.NewLedgerTransfer(
+		entity.LedgerTransactionKindGrant, from, to, amount,
+		grantForm.Comment, grantForm.RequesterID, currentTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = s.userRepo.GetUserByID(ctx, grantForm.UserID); err != nil {
+		return nil, fmt.Errorf("получение пользователя: %w", err)
+	}
+
+	if err = s.post(ctx, transaction); err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// post проводит транзакцию: балансы счетов и проводки сохраняются атомарно.
+func (s *walletService) post(ctx context.Context, transaction *entity.LedgerTransaction) error {
+	txCtx, done, err := s.txStarter.StartSession(ctx)
+	if err != nil {
+		return fmt.Errorf("начало транзакции: %w", err)
+	}
+
+	err = postLedgerTransaction(txCtx, s.ledgerRepo, transaction)
+	if err = done(txCtx, err); err != nil {
+		return fmt.Errorf("проведение транзакции кредитов: %w", err)
+	}
+
+	return nil
+}
+
+// postLedgerTransaction проверяет инварианты на актуальных балансах и сохраняет счета и проводки.
+// Вызывается в рамках транзакции, чтобы балансы и проводки изменились атомарно.
+func postLedgerTransaction(ctx context.Context, ledgerRepo repository.LedgerRepository, transaction *entity.LedgerTransaction) error {
+	ids := transaction.AccountIDs()
+
+	found, err := ledgerRepo.GetLedgerAccounts(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("получение счетов кредитов: %w", err)
+	}
+
+	accounts := make(map[string]*entity.LedgerAccount, len(ids))
+	for _, account := range found {
+		accounts[account.ID] = account
+	}
+
+	// Счет пользователя появляется при первой проводке.
+	for _, id := range ids {
+		if _, ok := accounts[id]; !ok {
+			accounts[id] = entity.NewLedgerAccount(id)
+		}
+	}
+
+	if err = transaction.Apply(accounts); err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err = ledgerRepo.SaveLedgerAccount(ctx, accounts[id]); err != nil {
+			return fmt.Errorf("сохранение счета кредитов %s: %w", id, err)
+		}
+	}
+
+	if err = ledgerRepo.CreateLedgerTransaction(ctx, transaction); err != nil {
+		return fmt.Errorf("сохранение проводок: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.com/example/gophers/libs/logger"
+	"gitlab.com/example/gophers/libs/trace"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+	"github.com/alisher-99/LomBarter/internal/service/notifier"
+)
+
+// wishlistCandidatesLimit максимальное количество позиций списков желаний, проверяемых для одного объявления.
+const wishlistCandidatesLimit = 1000
+
+// WishlistService представляет интерфейс сервиса для работы со списками желаний.
+type WishlistService interface {
+	// AddWishlistItem добавляет позицию в список желаний пользователя.
+	AddWishlistItem(ctx context.Context, createForm form.WishlistItemCreate, currentTime time.Time) (*entity.WishlistItem, error)
+	// GetWishlist возвращает список желаний пользователя и общее количество позиций.
+	GetWishlist(ctx context.Context, filter form.WishlistGet) (entity.WishlistItems, int64, error)
+	// DeleteWishlistItem удаляет позицию из списка желаний пользователя.
+	DeleteWishlistItem(ctx context.Context, deleteForm form.WishlistItemDelete) error
+	// MatchListing сопоставляет объявление со списками желаний и уведомляет пользователей.
+	// Возвращает количество уведомленных пользователей.
+	MatchListing(ctx context.Context, listingID string, currentTime time.Time) (int, error)
+}
+
+// wishlistService представляет сервис для работы со списками желаний.
+type wishlistService struct {
+	wishlistRepo repository.WishlistRepository // Репозиторий списков желаний
+	listingRepo  repository.ListingRepository  // Репозиторий объявлений
+	notifier     notifier.Notifier             // Доставка уведомлений о совпадениях
+	tracer       trace.TracerProvider          // Отслеживает запросы между слоями и микросервисами
+	logger       logger.Logger                 // Логирование запросов и ошибок сервиса
+}
+
+// NewWishlistService создает новый экземпляр сервиса для работы со списками желаний.
+func NewWishlistService(
+	wishlistRepo repository.WishlistRepository,
+	listingRepo repository.ListingRepository,
+	n notifier.Notifier,
+	l logger.Logger,
+	tracer trace.TracerProvider,
+) WishlistService {
+	return &wishlistService{
+		wishlistRepo: wishlistRepo,
+		listingRepo:  listingRepo,
+		notifier:     n,
+		tracer:       tracer,
+		logger:       l.WithFields(logger.Fields{"layer": "wishlist-service"}),
+	}
+}
+
+// AddWishlistItem добавляет позицию в список желаний пользователя.
+func (s *wishlistService) AddWishlistItem(
+	ctx context.Context,
+	createForm form.WishlistItemCreate,
+	currentTime time.Time,
+) (*entity.WishlistItem, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "WishlistService.AddWishlistItem")
+	defer span.End()
+
+	if err := createForm.Validate(); err != nil {
+		return nil, fmt.Errorf("валидация формы: %w", err)
+	}
+
+	if createForm.RequesterID != createForm.UserID {
+		return nil, entity.ErrWishlistForbidden
+	}
+
+	count, err := s.wishlistRepo.CountWishlistItems(ctx, createForm.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("подсчет позиций списка желаний: %w", err)
+	}
+
+	if count >= entity.MaxWishlistItems {
+		return nil, entity.ErrWishlistLimit
+	}
+
+	item := entity.NewWishlistItem(currentTime)
+
+	if err = createForm.Fill(item); err != nil {
+		return nil, fmt.Errorf("заполнение формы: %w", err)
+	}
+
+	if err = s.wishlistRepo.CreateWishlistItem(ctx, item); err != nil {
+		return nil, fmt.Errorf("создание позиции списка желаний: %w", err)
+	}
+
+	return item, nil
+}
+
+// GetWishlist возвращает список желаний пользователя и общее количество позиций.
+func (s *wishlistService) GetWishlist(ctx context.Context, filter form.WishlistGet) (entity.WishlistItems, int64, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "WishlistService.GetWishlist")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("валидация фильтра: %w", err)
+	}
+
+	if filter.RequesterID != filter.UserID {
+		return nil, 0, entity.ErrWishlistForbidden
+	}
+
+	items, count, err := s.wishlistRepo.GetWishlistItems(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение списка желаний: %w", err)
+	}
+
+	return items, count, nil
+}
+
+// DeleteWishlistItem удаляет позицию из списка желаний пользователя.
+func (s *wishlistService) DeleteWishlistItem(ctx context.Context, deleteForm form.WishlistItemDelete) error {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "WishlistService.DeleteWishlistItem")
+	defer span.End()
+
+	if err := deleteForm.Validate(); err != nil {
+		return fmt.Errorf("валидация формы: %w", err)
+	}
+
+	if deleteForm.RequesterID != deleteForm.UserID {
+		return entity.ErrWishlistForbidden
+	}
+
+	if err := s.wishlistRepo.DeleteWishlistItem(ctx, deleteForm.UserID, deleteForm.ItemID); err != nil {
+		return fmt.Errorf("удаление позиции списка желаний: %w", err)
+	}
+
+	return nil
+}
+
+// MatchListing сопоставляет объявление со списками желаний и уведомляет пользователей.
+// Совпадение фиксируется до отправки уведомления, поэтому повторная обработка события не дублирует уведомления.
+func (s *wishlistService) MatchListing(ctx context.Context, listingID string, currentTime time.Time) (int, error) {
+	ctx, span := s.tracer.Tracer(tracerName).Start(ctx, "WishlistService.MatchListing")
+	defer span.End()
+
+	listing, err := s.listingRepo.GetListingByID(ctx, listingID)
+	if errors.Is(err, entity.ErrListingNotFound) {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("получение объявления: %w", err)
+	}
+
+	if !listing.IsAvailable() {
+		return 0, nil
+	}
+
+	candidates, err := s.wishlistRepo.GetWishlistCandidates(ctx, listing, wishlistCandidatesLimit)
+	if err != nil {
+		return 0, fmt.Errorf("поиск позиций списков желаний: %w", err)
+	}
+
+	// Группируем совпавшие позиции по пользователям с сохранением порядка.
+	matches := make([]*entity.WishlistMatch, 0, len(candidates))
+	byUser := make(map[string]*entity.WishlistMatch, len(candidates))
+
+	for _, item := range candidates {
+		if !item.Matches(listing) {
+			continue
+		}
+
+		if match, ok := byUser[item.UserID]; ok {
+			match.ItemIDs = append(match.ItemIDs, item.ID)
+
+			continue
+		}
+
+		match := &entity.WishlistMatch{
+			UserID:    item.UserID,
+			ListingID: listing.ID,
+			ItemIDs:   []string{item.ID},
+			CreatedAt: currentTime,
+		}
+		byUser[item.UserID] = match
+		matches = append(matches, match)
+	}
+
+	notified := 0
+
+	for _, match := range matches {
+		err = s.wishlistRepo.CreateWishlistMatch(ctx, match)
+		if errors.Is(err, entity.ErrWishlistMatchExists) {
+			continue
+		}
+
+		if err != nil {
+			return notified, fmt.Errorf("сохранение совпадения со списком желаний: %w", err)
+		}
+
+		// Совпадение уже сохранено, повтор не доставит уведомление, поэтому ошибка только логируется.
+		if err = s.notifier.Notify(ctx, newWishlistMatchNotification(listing, match)); err != nil {
+			s.logger.Errorf("уведомление пользователя %s о совпадении: %v", match.UserID, err)
+
+			continue
+		}
+
+		notified++
+	}
+
+	return notified, nil
+}
+
+// newWishlistMatchNotification создает уведомление о появлении объявления из списка желаний.
+func newWishlistMatchNotification(listing *entity.Listing, match *entity.WishlistMatch) entity.Notification {
+	return entity.Notification{
+		UserID: match.UserID,
+		Kind:   entity.NotificationKindWishlistMatch,
+		Data: map[string]string{
+			"listingID":      listing.ID,
+			"listingTitle":   listing.Title,
+			"wishlistItemID": match.ItemIDs[0],
+		},
+		CreatedAt: match.CreatedAt,
+	}
+}
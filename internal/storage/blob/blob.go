@@ -0,0 +1,51 @@
+// Package blob реализует хранилища файлов: локальную файловую систему и S3-совместимое хранилище.
+package blob
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/alisher-99/LomBarter/internal/config"
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// Названия хранилищ файлов.
+const (
+	DriverLocal = "local" // Локальная файловая система
+	DriverS3    = "s3"    // S3-совместимое хранилище
+)
+
+// ErrInvalidDriver неизвестное хранилище файлов.
+var ErrInvalidDriver = errors.New("неверное название хранилища файлов, доступные: local, s3")
+
+// New создает хранилище файлов по конфигурации.
+func New(cfg *config.Media) (repository.BlobStore, error) {
+	switch cfg.BlobDriver {
+	case DriverLocal:
+		return NewLocal(cfg.LocalDir, cfg.PublicURL, NewSigner(cfg.SigningKey))
+	case DriverS3:
+		return NewS3(S3Options{
+			Endpoint:  cfg.S3Endpoint,
+			Region:    cfg.S3Region,
+			Bucket:    cfg.S3Bucket,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+			PathStyle: cfg.S3PathStyle,
+		})
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDriver, cfg.BlobDriver)
+	}
+}
+
+// validateKey проверяет, что ключ - относительный путь без переходов на уровень выше.
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, `\`) || path.Clean(key) != key ||
+		key == ".." || strings.HasPrefix(key, "../") {
+		return fmt.Errorf("%w: %q", entity.ErrBlobInvalidKey, key)
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// Права на директории и файлы локального хранилища.
+const (
+	dirPerm  = 0o750
+	filePerm = 0o640
+)
+
+// defaultContentType тип файла с неизвестным расширением.
+const defaultContentType = "application/octet-stream"
+
+// Local хранилище файлов в локальной файловой системе. Ссылки на скачивание ведут на publicURL
+// и подписываются signer, проверить их можно через OpenSigned.
+type Local struct {
+	root      string // Корневая директория файлов
+	publicURL string // Адрес, по которому раздаются файлы
+	signer    Signer // Подписывает ссылки на скачивание
+}
+
+// NewLocal создает локальное хранилище файлов в директории root.
+func NewLocal(root, publicURL string, signer Signer) (*Local, error) {
+	if err := os.MkdirAll(root, dirPerm); err != nil {
+		return nil, fmt.Errorf("создание директории хранилища файлов: %w", err)
+	}
+
+	return &Local{root: root, publicURL: strings.TrimSuffix(publicURL, "/"), signer: signer}, nil
+}
+
+// Put сохраняет файл. Файл сначала пишется во временный файл рядом, затем переименовывается,
+// поэтому читатели не видят частично записанный файл.
+func (l *Local) Put(_ context.Context, key string, body io.Reader, size int64, _ string) error {
+	filename, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(filename)
+	if err = os.MkdirAll(dir, dirPerm); err != nil {
+		return fmt.Errorf("создание директории файла: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".upload-*")
+	if err != nil {
+		return fmt.Errorf("создание временного файла: %w", err)
+	}
+
+	// После переименования временного файла уже нет, поэтому ошибка удаления не важна.
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	written, err := io.Copy(tmp, body)
+	if cErr := tmp.Close(); err == nil {
+		err = cErr
+	}
+
+	if err != nil {
+		return fmt.Errorf("запись файла: %w", err)
+	}
+
+	if size >= 0 && written != size {
+		return fmt.Errorf("запись файла: записано %d байт из %d", written, size)
+	}
+
+	if err = os.Chmod(tmp.Name(), filePerm); err != nil {
+		return fmt.Errorf("права файла: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), filename); err != nil {
+		return fmt.Errorf("сохранение файла: %w", err)
+	}
+
+	return nil
+}
+
+// Get возвращает файл. Тип файла определяется по расширению ключа.
+func (l *Local) Get(_ context.Context, key string) (*entity.Blob, error) {
+	filename, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, entity.ErrBlobNotFound
+		}
+
+		return nil, fmt.Errorf("открытие файла: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+
+		return nil, fmt.Errorf("получение размера файла: %w", err)
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = defaultContentType
+	}
+
+	return &entity.Blob{Body: file, ContentType: contentType, Size: info.Size()}, nil
+}
+
+// Delete удаляет файл и опустевшую директорию файла.
+func (l *Local) Delete(_ context.Context, key string) error {
+	filename, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Remove(filename); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("удаление файла: %w", err)
+	}
+
+	// Директория удаляется, только если она пуста, ошибка для непустой директории ожидаема.
+	if dir := filepath.Dir(filename); dir != filepath.Clean(l.root) {
+		_ = os.Remove(dir)
+	}
+
+	return nil
+}
+
+// SignedURL возвращает подписанную ссылку на файл.
+func (l *Local) SignedURL(_ context.Context, key string, ttl time.Duration) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+
+	return l.publicURL + "/" + key + "?" + l.signer.Sign(key, time.Now().Add(ttl)).Encode(), nil
+}
+
+// OpenSigned проверяет подпись ссылки на файл и возвращает файл.
+func (l *Local) OpenSigned(ctx context.Context, key string, query url.Values) (*entity.Blob, error) {
+	if err := l.signer.Verify(key, query, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return l.Get(ctx, key)
+}
+
+// path возвращает путь к файлу в файловой системе.
+func (l *Local) path(key string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(l.root, filepath.FromSlash(key)), nil
+}
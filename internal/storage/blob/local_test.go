@@ -0,0 +1,89 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+func TestLocal(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	store, err := NewLocal(t.TempDir(), "http://localhost:8000/media/files/", NewSigner("secret"))
+	require.NoError(t, err)
+
+	const key = "media/abc/original.png"
+
+	require.NoError(t, store.Put(ctx, key, strings.NewReader("image"), 5, "image/png"))
+
+	blob, err := store.Get(ctx, key)
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(blob.Body)
+	require.NoError(t, err)
+	require.NoError(t, blob.Body.Close())
+	assert.Equal(t, "image", string(data))
+	assert.Equal(t, "image/png", blob.ContentType)
+	assert.EqualValues(t, 5, blob.Size)
+
+	// Подписанная ссылка открывает файл, подделанная - нет.
+	signed, err := store.SignedURL(ctx, key, time.Minute)
+	require.NoError(t, err)
+
+	u, err := url.Parse(signed)
+	require.NoError(t, err)
+	assert.Equal(t, "/media/files/"+key, u.Path)
+
+	blob, err = store.OpenSigned(ctx, key, u.Query())
+	require.NoError(t, err)
+	require.NoError(t, blob.Body.Close())
+
+	_, err = store.OpenSigned(ctx, "media/abc/thumb.jpg", u.Query())
+	assert.ErrorIs(t, err, entity.ErrSignedURLInvalid)
+
+	require.NoError(t, store.Delete(ctx, key))
+	require.NoError(t, store.Delete(ctx, key))
+
+	_, err = store.Get(ctx, key)
+	assert.ErrorIs(t, err, entity.ErrBlobNotFound)
+
+	// Размер не совпадает с заявленным.
+	assert.Error(t, store.Put(ctx, key, strings.NewReader("image"), 10, "image/png"))
+}
+
+func TestLocal_InvalidKey(t *testing.T) {
+	t.Parallel()
+
+	store, err := NewLocal(t.TempDir(), "http://localhost", NewSigner("secret"))
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "/etc/passwd", "../secret", "media/../../secret", "media//a", `media\a`} {
+		err = store.Put(context.Background(), key, strings.NewReader("x"), 1, "text/plain")
+		assert.ErrorIs(t, err, entity.ErrBlobInvalidKey, key)
+	}
+}
+
+func TestSigner_Verify(t *testing.T) {
+	t.Parallel()
+
+	signer := NewSigner("secret")
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	query := signer.Sign("media/a", now.Add(time.Minute))
+
+	assert.NoError(t, signer.Verify("media/a", query, now))
+	assert.ErrorIs(t, signer.Verify("media/a", query, now.Add(2*time.Minute)), entity.ErrSignedURLExpired)
+	assert.ErrorIs(t, NewSigner("other").Verify("media/a", query, now), entity.ErrSignedURLInvalid)
+
+	query.Set(expiresParam, "9999999999")
+	assert.ErrorIs(t, signer.Verify("media/a", query, now), entity.ErrSignedURLInvalid)
+}
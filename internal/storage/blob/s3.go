@@ -0,0 +1,198 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// s3Timeout время ожидания ответа S3 на один запрос.
+const s3Timeout = 30 * time.Second
+
+// ErrS3Config неполная конфигурация S3.
+var ErrS3Config = errors.New("не заданы адрес, бакет или ключи S3")
+
+// S3Options параметры подключения к S3-совместимому хранилищу.
+type S3Options struct {
+	Endpoint  string       // Адрес хранилища, например https://s3.eu-central-1.amazonaws.com
+	Region    string       // Регион
+	Bucket    string       // Бакет
+	AccessKey string       // Ключ доступа
+	SecretKey string       // Секретный ключ
+	PathStyle bool         // Бакет в пути (MinIO и большинство совместимых хранилищ), а не в имени хоста
+	Client    *http.Client // HTTP-клиент, по умолчанию с таймаутом s3Timeout
+}
+
+// S3 хранилище файлов в S3-совместимом хранилище. Запросы подписываются AWS Signature Version 4.
+type S3 struct {
+	endpoint  *url.URL     // Адрес хранилища
+	bucket    string       // Бакет
+	pathStyle bool         // Бакет в пути
+	signer    sigV4        // Подписывает запросы
+	client    *http.Client // HTTP-клиент
+}
+
+// NewS3 создает хранилище файлов S3.
+func NewS3(opts S3Options) (*S3, error) {
+	if opts.Endpoint == "" || opts.Bucket == "" || opts.AccessKey == "" || opts.SecretKey == "" {
+		return nil, ErrS3Config
+	}
+
+	endpoint, err := url.Parse(strings.TrimSuffix(opts.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("%w: адрес %q", ErrS3Config, opts.Endpoint)
+	}
+
+	client := opts.Client
+	if client == nil {
+		client = &http.Client{Timeout: s3Timeout}
+	}
+
+	return &S3{
+		endpoint:  endpoint,
+		bucket:    opts.Bucket,
+		pathStyle: opts.PathStyle,
+		signer:    sigV4{accessKey: opts.AccessKey, secretKey: opts.SecretKey, region: opts.Region},
+		client:    client,
+	}, nil
+}
+
+// Put сохраняет файл.
+func (s *S3) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	req, err := s.newRequest(ctx, http.MethodPut, key, body)
+	if err != nil {
+		return err
+	}
+
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := s.do(req)
+	if err != nil {
+		return fmt.Errorf("сохранение файла в S3: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("сохранение файла в S3: %w", responseError(resp))
+	}
+
+	return nil
+}
+
+// Get возвращает файл.
+func (s *S3) Get(ctx context.Context, key string) (*entity.Blob, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("получение файла из S3: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return &entity.Blob{Body: resp.Body, ContentType: resp.Header.Get("Content-Type"), Size: resp.ContentLength}, nil
+	case http.StatusNotFound:
+		_ = resp.Body.Close()
+
+		return nil, entity.ErrBlobNotFound
+	default:
+		defer resp.Body.Close()
+
+		return nil, fmt.Errorf("получение файла из S3: %w", responseError(resp))
+	}
+}
+
+// Delete удаляет файл. S3 не считает удаление отсутствующего файла ошибкой.
+func (s *S3) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return fmt.Errorf("удаление файла из S3: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("удаление файла из S3: %w", responseError(resp))
+	}
+
+	return nil
+}
+
+// SignedURL возвращает подписанную ссылку на скачивание файла напрямую из S3.
+func (s *S3) SignedURL(_ context.Context, key string, ttl time.Duration) (string, error) {
+	u, err := s.objectURL(key)
+	if err != nil {
+		return "", err
+	}
+
+	s.signer.presign(http.MethodGet, u, ttl, time.Now())
+
+	return u.String(), nil
+}
+
+// newRequest создает подписанный запрос к файлу.
+func (s *S3) newRequest(ctx context.Context, method, key string, body io.Reader) (*http.Request, error) {
+	u, err := s.objectURL(key)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("создание запроса к S3: %w", err)
+	}
+
+	return req, nil
+}
+
+// do подписывает и выполняет запрос.
+func (s *S3) do(req *http.Request) (*http.Response, error) {
+	s.signer.signRequest(req, time.Now())
+
+	return s.client.Do(req)
+}
+
+// objectURL возвращает адрес файла в хранилище.
+func (s *S3) objectURL(key string) (*url.URL, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+
+	u := *s.endpoint
+	objectPath := "/" + key
+
+	if s.pathStyle {
+		objectPath = "/" + s.bucket + objectPath
+	} else {
+		u.Host = s.bucket + "." + u.Host
+	}
+
+	u.Path = strings.TrimSuffix(s.endpoint.Path, "/") + objectPath
+	u.RawPath = uriEncode(u.Path, false)
+
+	return &u, nil
+}
+
+// responseError возвращает ошибку с кодом ответа и началом тела ответа S3.
+func responseError(resp *http.Response) error {
+	const maxBody = 512
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxBody))
+
+	return fmt.Errorf("ответ %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+}
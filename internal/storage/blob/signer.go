@@ -0,0 +1,72 @@
+package blob
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// Параметры подписанной ссылки.
+const (
+	expiresParam   = "expires"   // Время истечения ссылки в секундах Unix
+	signatureParam = "signature" // Подпись ключа и времени истечения
+)
+
+// Signer подписывает ссылки на файлы локального хранилища HMAC-SHA256.
+type Signer struct {
+	key []byte // Ключ подписи
+}
+
+// NewSigner создает подписчика ссылок.
+func NewSigner(key string) Signer {
+	return Signer{key: []byte(key)}
+}
+
+// Sign возвращает параметры ссылки на файл key, которая действует до expires.
+func (s Signer) Sign(key string, expires time.Time) url.Values {
+	unix := strconv.FormatInt(expires.Unix(), 10)
+
+	return url.Values{
+		expiresParam:   {unix},
+		signatureParam: {s.signature(key, unix)},
+	}
+}
+
+// Verify проверяет параметры ссылки на файл key в момент now.
+func (s Signer) Verify(key string, query url.Values, now time.Time) error {
+	unix := query.Get(expiresParam)
+
+	expires, err := strconv.ParseInt(unix, 10, 64)
+	if err != nil {
+		return entity.ErrSignedURLInvalid
+	}
+
+	given, err := base64.RawURLEncoding.DecodeString(query.Get(signatureParam))
+	if err != nil {
+		return entity.ErrSignedURLInvalid
+	}
+
+	expected, _ := base64.RawURLEncoding.DecodeString(s.signature(key, unix))
+	if !hmac.Equal(given, expected) {
+		return entity.ErrSignedURLInvalid
+	}
+
+	if now.Unix() > expires {
+		return entity.ErrSignedURLExpired
+	}
+
+	return nil
+}
+
+// signature возвращает подпись ключа файла и времени истечения.
+func (s Signer) signature(key, expires string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(key + "\n" + expires))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,184 @@
+package blob
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Параметры подписи запросов AWS Signature Version 4.
+const (
+	sigV4Algorithm   = "AWS4-HMAC-SHA256"
+	sigV4Service     = "s3"
+	sigV4DateFormat  = "20060102T150405Z"
+	sigV4ShortDate   = "20060102"
+	unsignedPayload  = "UNSIGNED-PAYLOAD"
+	amzDateHeader    = "X-Amz-Date"
+	amzContentSHA256 = "X-Amz-Content-Sha256"
+)
+
+// maxPresignExpires максимальный срок действия подписанной ссылки S3.
+const maxPresignExpires = 7 * 24 * time.Hour
+
+// sigV4 подписывает запросы к S3 по AWS Signature Version 4.
+type sigV4 struct {
+	accessKey string // Ключ доступа
+	secretKey string // Секретный ключ
+	region    string // Регион
+}
+
+// signRequest подписывает запрос заголовком Authorization. Тело запроса не подписывается.
+func (s sigV4) signRequest(req *http.Request, now time.Time) {
+	now = now.UTC()
+
+	req.Header.Set(amzDateHeader, now.Format(sigV4DateFormat))
+	req.Header.Set(amzContentSHA256, unsignedPayload)
+
+	headers := map[string]string{
+		"host":                 req.URL.Host,
+		"x-amz-content-sha256": unsignedPayload,
+		"x-amz-date":           now.Format(sigV4DateFormat),
+	}
+
+	signedHeaders, signature := s.sign(req.Method, req.URL, headers, unsignedPayload, now)
+
+	req.Header.Set("Authorization", sigV4Algorithm+
+		" Credential="+s.accessKey+"/"+s.scope(now)+
+		", SignedHeaders="+signedHeaders+
+		", Signature="+signature)
+}
+
+// presign добавляет в ссылку параметры подписи, с которыми она действует expires.
+func (s sigV4) presign(method string, u *url.URL, expires time.Duration, now time.Time) {
+	now = now.UTC()
+
+	if expires > maxPresignExpires {
+		expires = maxPresignExpires
+	}
+
+	query := u.Query()
+	query.Set("X-Amz-Algorithm", sigV4Algorithm)
+	query.Set("X-Amz-Credential", s.accessKey+"/"+s.scope(now))
+	query.Set("X-Amz-Date", now.Format(sigV4DateFormat))
+	query.Set("X-Amz-Expires", strconv.FormatInt(int64(expires/time.Second), 10))
+	query.Set("X-Amz-SignedHeaders", "host")
+	u.RawQuery = canonicalQuery(query)
+
+	_, signature := s.sign(method, u, map[string]string{"host": u.Host}, unsignedPayload, now)
+
+	query.Set("X-Amz-Signature", signature)
+	u.RawQuery = canonicalQuery(query)
+}
+
+// sign возвращает список подписанных заголовков и подпись запроса.
+func (s sigV4) sign(method string, u *url.URL, headers map[string]string, payloadHash string, now time.Time) (string, string) {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		u.EscapedPath(),
+		u.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	stringToSign := strings.Join([]string{
+		sigV4Algorithm,
+		now.Format(sigV4DateFormat),
+		s.scope(now),
+		hexSHA256(canonicalRequest),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), now.Format(sigV4ShortDate))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, sigV4Service)
+	key = hmacSHA256(key, "aws4_request")
+
+	return signedHeaders, hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+// scope возвращает область действия подписи.
+func (s sigV4) scope(now time.Time) string {
+	return now.Format(sigV4ShortDate) + "/" + s.region + "/" + sigV4Service + "/aws4_request"
+}
+
+// canonicalQuery возвращает параметры запроса, отсортированные и закодированные по правилам подписи.
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		values := append([]string(nil), query[key]...)
+		sort.Strings(values)
+
+		for _, value := range values {
+			pairs = append(pairs, uriEncode(key, true)+"="+uriEncode(value, true))
+		}
+	}
+
+	return strings.Join(pairs, "&")
+}
+
+// uriEncode кодирует строку по правилам подписи: без изменений остаются только
+// незарезервированные символы RFC 3986 и, если encodeSlash ложно, символ «/».
+func uriEncode(s string, encodeSlash bool) string {
+	const hexDigits = "0123456789ABCDEF"
+
+	var b strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			b.WriteByte('%')
+			b.WriteByte(hexDigits[c>>4])
+			b.WriteByte(hexDigits[c&0x0f])
+		}
+	}
+
+	return b.String()
+}
+
+// hmacSHA256 возвращает HMAC-SHA256 данных.
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
+
+// hexSHA256 возвращает SHA-256 строки в шестнадцатеричном виде.
+func hexSHA256(data string) string {
+	sum := sha256.Sum256([]byte(data))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,359 @@
+// Package memsearch реализует полнотекстовый поиск на инвертированном индексе в памяти процесса.
+//
+// Индекс нужен хранилищам без собственного полнотекстового поиска и тестам. Хранилище само добавляет
+// в него документы при изменении пользователей и объявлений через PutUser, PutListing и Delete.
+// Документы ранжируются по BM25 с весами полей, как в текстовых индексах MongoDB.
+// Фильтр по расстоянию сначала отбирает документы по ячейкам геохэша, затем проверяет точное расстояние.
+package memsearch
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/pkg/textsearch"
+)
+
+// Параметры BM25.
+const (
+	bm25K1 = 1.2  // Насыщение частоты слова
+	bm25B  = 0.75 // Влияние длины документа
+)
+
+// Веса полей документов.
+const (
+	userNameWeight           = 5
+	userBioWeight            = 1
+	listingTitleWeight       = 10
+	listingDescriptionWeight = 3
+	listingCategoryWeight    = 2
+)
+
+// geohashPrecision длина самой мелкой ячейки геохэша в индексе, около 1 км.
+const geohashPrecision = 6
+
+// docKey ключ документа в индексе.
+type docKey struct {
+	kind entity.SearchKind // Тип документа
+	id   string            // Идентификатор документа
+}
+
+// document проиндексированный документ.
+type document struct {
+	key     docKey             // Ключ документа
+	user    *entity.User       // Пользователь
+	listing *entity.Listing    // Объявление
+	cells   []string           // Ячейки геохэша местоположения всех длин до geohashPrecision
+	terms   map[string]float64 // Частота основ с учетом весов полей
+	length  float64            // Длина документа с учетом весов полей
+}
+
+// Index инвертированный индекс пользователей и объявлений. Безопасен для конкурентного использования.
+type Index struct {
+	mu       sync.RWMutex
+	docs     map[docKey]*document           // Документы по ключу
+	postings map[string]map[docKey]struct{} // Документы, содержащие основу
+	cells    map[string]map[docKey]struct{} // Документы с местоположением в ячейке геохэша
+	length   float64                        // Суммарная длина документов
+}
+
+// New создает пустой индекс.
+func New() *Index {
+	return &Index{
+		docs:     make(map[docKey]*document),
+		postings: make(map[string]map[docKey]struct{}),
+		cells:    make(map[string]map[docKey]struct{}),
+	}
+}
+
+// PutUser добавляет пользователя в индекс или обновляет его.
+// Заблокированные пользователи и отклоненные модератором биографии удаляются из индекса.
+func (idx *Index) PutUser(user *entity.User) {
+	if user.IsBanned() || !user.Moderation.IsPublic() {
+		idx.Delete(entity.SearchKindUser, user.ID)
+
+		return
+	}
+
+	u := *user
+	doc := newDocument(docKey{kind: entity.SearchKindUser, id: u.ID})
+	doc.user = &u
+	doc.add(u.Name, userNameWeight)
+	doc.add(u.Bio, userBioWeight)
+	doc.locate(u.Location)
+
+	idx.put(doc)
+}
+
+// PutListing добавляет объявление в индекс или обновляет его.
+// Отклоненные модератором объявления удаляются из индекса.
+func (idx *Index) PutListing(listing *entity.Listing) {
+	if !listing.Moderation.IsPublic() {
+		idx.Delete(entity.SearchKindListing, listing.ID)
+
+		return
+	}
+
+	l := *listing
+	doc := newDocument(docKey{kind: entity.SearchKindListing, id: l.ID})
+	doc.listing = &l
+	doc.add(l.Title, listingTitleWeight)
+	doc.add(l.Description, listingDescriptionWeight)
+	doc.add(l.Category, listingCategoryWeight)
+	doc.locate(l.Location)
+
+	idx.put(doc)
+}
+
+// Delete удаляет документ из индекса.
+func (idx *Index) Delete(kind entity.SearchKind, id string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.remove(docKey{kind: kind, id: id})
+}
+
+// put заменяет документ в индексе.
+func (idx *Index) put(doc *document) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.remove(doc.key)
+
+	idx.docs[doc.key] = doc
+	idx.length += doc.length
+
+	for stem := range doc.terms {
+		addKey(idx.postings, stem, doc.key)
+	}
+
+	for _, cell := range doc.cells {
+		addKey(idx.cells, cell, doc.key)
+	}
+}
+
+// remove удаляет документ из индекса. Вызывается под блокировкой на запись.
+func (idx *Index) remove(key docKey) {
+	doc, ok := idx.docs[key]
+	if !ok {
+		return
+	}
+
+	for stem := range doc.terms {
+		removeKey(idx.postings, stem, key)
+	}
+
+	for _, cell := range doc.cells {
+		removeKey(idx.cells, cell, key)
+	}
+
+	idx.length -= doc.length
+	delete(idx.docs, key)
+}
+
+// Search ищет пользователей и объявления по тексту.
+func (idx *Index) Search(_ context.Context, filter form.Search) (entity.SearchResult, error) {
+	stems := textsearch.QueryStems(filter.Query)
+	if len(stems) == 0 {
+		return entity.SearchResult{}, entity.ErrSearchQueryEmpty
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	result := entity.SearchResult{Facets: entity.NewSearchFacets()}
+	hits := make([]*entity.SearchHit, 0)
+
+	var nearby map[docKey]struct{}
+	if filter.Near != nil {
+		nearby = idx.nearby(*filter.Near)
+	}
+
+	for key := range idx.candidates(stems) {
+		doc := idx.docs[key]
+		if doc.listing != nil && !doc.listing.IsAvailable() {
+			continue
+		}
+
+		if filter.Near != nil && !doc.within(*filter.Near, nearby) {
+			continue
+		}
+
+		result.Facets.Kinds[key.kind]++
+
+		if doc.listing != nil {
+			result.Facets.Categories[doc.listing.Category]++
+		}
+
+		if !filter.IncludesKind(key.kind) || (doc.listing != nil && filter.Category != "" && doc.listing.Category != filter.Category) {
+			continue
+		}
+
+		hits = append(hits, doc.hit(idx.score(doc, stems)))
+	}
+
+	result.Count = int64(len(hits))
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+
+		return hits[i].ID < hits[j].ID
+	})
+
+	offset := filter.Pagination.Offset()
+	if offset >= uint64(len(hits)) {
+		result.Items = []*entity.SearchHit{}
+
+		return result, nil
+	}
+
+	end := offset + filter.Pagination.Limit
+	if end > uint64(len(hits)) {
+		end = uint64(len(hits))
+	}
+
+	result.Items = hits[offset:end]
+
+	if filter.Near != nil {
+		for _, hit := range result.Items {
+			hit.FillDistance(filter.Near.Point)
+		}
+	}
+
+	return result, nil
+}
+
+// candidates возвращает документы, содержащие хотя бы одну основу запроса.
+func (idx *Index) candidates(stems []string) map[docKey]struct{} {
+	keys := make(map[docKey]struct{})
+
+	for _, stem := range stems {
+		for key := range idx.postings[stem] {
+			keys[key] = struct{}{}
+		}
+	}
+
+	return keys
+}
+
+// nearby возвращает документы из ячеек геохэша, покрывающих круг поиска.
+// Если круг слишком большой для ячеек, возвращает nil: проверять нужно все документы.
+func (idx *Index) nearby(near form.GeoNear) map[docKey]struct{} {
+	cover := entity.GeohashCover(near.Point, near.RadiusKm, geohashPrecision)
+	if cover == nil {
+		return nil
+	}
+
+	keys := make(map[docKey]struct{})
+
+	for _, cell := range cover {
+		for key := range idx.cells[cell] {
+			keys[key] = struct{}{}
+		}
+	}
+
+	return keys
+}
+
+// score возвращает релевантность документа запросу по BM25.
+func (idx *Index) score(doc *document, stems []string) float64 {
+	total := float64(len(idx.docs))
+	avgLength := idx.length / total
+
+	var score float64
+
+	for _, stem := range stems {
+		tf := doc.terms[stem]
+		if tf == 0 {
+			continue
+		}
+
+		df := float64(len(idx.postings[stem]))
+		idf := math.Log(1 + (total-df+0.5)/(df+0.5))
+		score += idf * tf * (bm25K1 + 1) / (tf + bm25K1*(1-bm25B+bm25B*doc.length/avgLength))
+	}
+
+	return score
+}
+
+// newDocument создает пустой документ.
+func newDocument(key docKey) *document {
+	return &document{key: key, terms: make(map[string]float64)}
+}
+
+// add добавляет в документ основы слов поля с весом weight.
+func (d *document) add(text string, weight float64) {
+	for _, stem := range textsearch.Analyze(text) {
+		d.terms[stem] += weight
+		d.length += weight
+	}
+}
+
+// locate запоминает ячейки геохэша местоположения документа.
+func (d *document) locate(location *entity.GeoPoint) {
+	if location == nil {
+		return
+	}
+
+	hash := location.Geohash(geohashPrecision)
+	for i := 1; i <= len(hash); i++ {
+		d.cells = append(d.cells, hash[:i])
+	}
+}
+
+// within находится ли документ в радиусе поиска. Документы вне nearby отбрасываются без расчета расстояния.
+func (d *document) within(near form.GeoNear, nearby map[docKey]struct{}) bool {
+	if nearby != nil {
+		if _, ok := nearby[d.key]; !ok {
+			return false
+		}
+	}
+
+	if d.user != nil {
+		return near.Contains(d.user.Location)
+	}
+
+	return d.listing != nil && near.Contains(d.listing.Location)
+}
+
+// addKey добавляет ключ документа в множество index[term].
+func addKey(index map[string]map[docKey]struct{}, term string, key docKey) {
+	keys, ok := index[term]
+	if !ok {
+		keys = make(map[docKey]struct{})
+		index[term] = keys
+	}
+
+	keys[key] = struct{}{}
+}
+
+// removeKey удаляет ключ документа из множества index[term] и само множество, если оно опустело.
+func removeKey(index map[string]map[docKey]struct{}, term string, key docKey) {
+	delete(index[term], key)
+
+	if len(index[term]) == 0 {
+		delete(index, term)
+	}
+}
+
+// hit возвращает найденный документ. Сущность копируется, чтобы вызывающий код не менял индекс.
+func (d *document) hit(score float64) *entity.SearchHit {
+	hit := &entity.SearchHit{Kind: d.key.kind, ID: d.key.id, Score: score}
+
+	if d.user != nil {
+		u := *d.user
+		hit.User = &u
+	}
+
+	if d.listing != nil {
+		l := *d.listing
+		hit.Listing = &l
+	}
+
+	return hit
+}
@@ -0,0 +1,185 @@
+package memsearch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+func newTestIndex() *Index {
+	idx := New()
+
+	idx.PutUser(&entity.User{ID: "u1", Name: "Алексей", Bio: "Коллекционирую велосипеды и запчасти"})
+	idx.PutUser(&entity.User{ID: "u2", Name: "Мария", Bio: "Меняю книги"})
+	idx.PutListing(&entity.Listing{
+		ID: "l1", Title: "Горный велосипед", Description: "Почти новый", Category: "sport",
+		Status: entity.ListingStatusActive,
+	})
+	idx.PutListing(&entity.Listing{
+		ID: "l2", Title: "Шлем", Description: "Подойдет к любому велосипеду", Category: "sport",
+		Status: entity.ListingStatusActive,
+	})
+	idx.PutListing(&entity.Listing{
+		ID: "l3", Title: "Детский велосипед", Description: "Для ребенка", Category: "kids",
+		Status: entity.ListingStatusActive,
+	})
+	idx.PutListing(&entity.Listing{
+		ID: "l4", Title: "Велосипед", Category: "sport",
+		Status: entity.ListingStatusExchanged,
+	})
+
+	return idx
+}
+
+func searchForm(query string) form.Search {
+	return form.Search{Query: query, Pagination: form.Pagination{Limit: 10}}
+}
+
+func hitIDs(result entity.SearchResult) []string {
+	ids := make([]string, 0, len(result.Items))
+	for _, hit := range result.Items {
+		ids = append(ids, hit.ID)
+	}
+
+	return ids
+}
+
+func TestIndex_Search(t *testing.T) {
+	t.Parallel()
+
+	var repo repository.SearchRepository = newTestIndex()
+
+	cases := []struct {
+		name       string
+		filter     form.Search
+		ids        []string
+		count      int64
+		kinds      map[entity.SearchKind]int64
+		categories map[string]int64
+	}{
+		{
+			name:   "title outranks description and bio",
+			filter: searchForm("велосипеды"),
+			// l1 и l3 совпадают по заголовку; l3 короче, поэтому выше.
+			ids:        []string{"l3", "l1", "l2", "u1"},
+			count:      4,
+			kinds:      map[entity.SearchKind]int64{entity.SearchKindUser: 1, entity.SearchKindListing: 3},
+			categories: map[string]int64{"sport": 2, "kids": 1},
+		},
+		{
+			name: "facets ignore filters",
+			filter: form.Search{
+				Query: "велосипед", Kind: entity.SearchKindListing, Category: "sport",
+				Pagination: form.Pagination{Limit: 10},
+			},
+			ids:        []string{"l1", "l2"},
+			count:      2,
+			kinds:      map[entity.SearchKind]int64{entity.SearchKindUser: 1, entity.SearchKindListing: 3},
+			categories: map[string]int64{"sport": 2, "kids": 1},
+		},
+		{
+			name:   "pagination",
+			filter: form.Search{Query: "велосипед", Pagination: form.Pagination{Page: 2, Limit: 3}},
+			ids:    []string{"u1"},
+			count:  4,
+		},
+		{
+			name:   "nothing found",
+			filter: searchForm("телефон"),
+			ids:    []string{},
+			count:  0,
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := repo.Search(context.Background(), s.filter)
+			require.NoError(t, err)
+
+			assert.Equal(t, s.ids, hitIDs(result))
+			assert.Equal(t, s.count, result.Count)
+
+			if s.kinds != nil {
+				assert.Equal(t, s.kinds, result.Facets.Kinds)
+				assert.Equal(t, s.categories, result.Facets.Categories)
+			}
+		})
+	}
+}
+
+func TestIndex_PutAndDelete(t *testing.T) {
+	t.Parallel()
+
+	idx := newTestIndex()
+
+	// Обновленный документ ищется по новому тексту и не ищется по старому.
+	idx.PutListing(&entity.Listing{ID: "l2", Title: "Самокат", Category: "sport", Status: entity.ListingStatusActive})
+
+	result, err := idx.Search(context.Background(), searchForm("шлем"))
+	require.NoError(t, err)
+	assert.Empty(t, result.Items)
+
+	result, err = idx.Search(context.Background(), searchForm("самокаты"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"l2"}, hitIDs(result))
+
+	idx.Delete(entity.SearchKindListing, "l2")
+
+	result, err = idx.Search(context.Background(), searchForm("самокат"))
+	require.NoError(t, err)
+	assert.Empty(t, result.Items)
+
+	_, err = idx.Search(context.Background(), searchForm("и в на"))
+	assert.ErrorIs(t, err, entity.ErrSearchQueryEmpty)
+}
+
+func TestIndex_SearchNear(t *testing.T) {
+	t.Parallel()
+
+	center := entity.GeoPoint{Lat: 43.238, Lon: 76.945}
+
+	idx := New()
+	idx.PutUser(&entity.User{ID: "u1", Name: "Алексей", Bio: "Меняю велосипед", Location: &entity.GeoPoint{Lat: 43.25, Lon: 76.95}})
+	idx.PutUser(&entity.User{ID: "u2", Name: "Алексей", Bio: "Меняю велосипед", Location: &entity.GeoPoint{Lat: 51.169, Lon: 71.449}})
+	idx.PutListing(&entity.Listing{
+		ID: "l1", Title: "Велосипед", Status: entity.ListingStatusActive,
+		Location: &entity.GeoPoint{Lat: 43.3, Lon: 76.9},
+	})
+	idx.PutListing(&entity.Listing{ID: "l2", Title: "Велосипед", Status: entity.ListingStatusActive})
+
+	filter := searchForm("велосипед")
+	filter.Near = &form.GeoNear{Point: center, RadiusKm: 10}
+
+	result, err := idx.Search(context.Background(), filter)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"u1", "l1"}, hitIDs(result))
+
+	for _, hit := range result.Items {
+		require.NotNil(t, hit.DistanceKm)
+		assert.Less(t, *hit.DistanceKm, 10.0)
+	}
+
+	// После переезда пользователь пропадает из поиска рядом с прежним местом.
+	idx.PutUser(&entity.User{ID: "u1", Name: "Алексей", Bio: "Меняю велосипед", Location: &entity.GeoPoint{Lat: 51.169, Lon: 71.449}})
+
+	result, err = idx.Search(context.Background(), filter)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"l1"}, hitIDs(result))
+
+	// Радиус больше самой крупной ячейки проверяется по всем документам.
+	filter.Near.RadiusKm = 5000
+
+	result, err = idx.Search(context.Background(), filter)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"u1", "u2", "l1"}, hitIDs(result))
+}
@@ -0,0 +1,141 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gitlab.com/example/gophers/libs/trace"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// auditRepository репозиторий журнала изменений.
+type auditRepository struct {
+	collection *mongo.Collection    // Коллекция журнала изменений
+	tracer     trace.TracerProvider // Отслеживает запросы между слоями и микросервисами
+}
+
+// NewAuditRepository возвращает новый экземпляр репозитория журнала изменений.
+func NewAuditRepository(collection *mongo.Collection, tracer trace.TracerProvider) repository.AuditRepository {
+	return &auditRepository{collection: collection, tracer: tracer}
+}
+
+// CreateAuditEntry добавляет запись в журнал изменений.
+func (r auditRepository) CreateAuditEntry(ctx context.Context, entry *entity.AuditEntry) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "AuditRepository.CreateAuditEntry")
+	defer span.End()
+
+	res, err := r.collection.InsertOne(ctx, bson.D{
+		{Key: "actor_id", Value: entry.ActorID},
+		{Key: "source", Value: entry.Source},
+		{Key: "request_id", Value: entry.RequestID},
+		{Key: "entity_type", Value: entry.EntityType},
+		{Key: "entity_id", Value: entry.EntityID},
+		{Key: "action", Value: entry.Action},
+		{Key: "changes", Value: entry.Changes},
+		{Key: "created_at", Value: entry.CreatedAt},
+	})
+	if err != nil {
+		return fmt.Errorf("сохранение записи журнала изменений: %w", err)
+	}
+
+	objID, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidObjectID, res.InsertedID)
+	}
+
+	entry.ID = objID.Hex()
+
+	return nil
+}
+
+// GetAuditEntries возвращает записи журнала по фильтру от новых к старым.
+func (r auditRepository) GetAuditEntries(ctx context.Context, filter form.AuditEntriesGet) (entity.AuditEntries, int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "AuditRepository.GetAuditEntries")
+	defer span.End()
+
+	match := bson.D{}
+
+	if filter.EntityType != "" {
+		match = append(match, bson.E{Key: "entity_type", Value: filter.EntityType})
+	}
+
+	if filter.EntityID != "" {
+		match = append(match, bson.E{Key: "entity_id", Value: filter.EntityID})
+	}
+
+	if filter.ActorID != "" {
+		match = append(match, bson.E{Key: "actor_id", Value: filter.ActorID})
+	}
+
+	if filter.RequestID != "" {
+		match = append(match, bson.E{Key: "request_id", Value: filter.RequestID})
+	}
+
+	period := bson.D{}
+	if filter.From != nil {
+		period = append(period, bson.E{Key: "$gte", Value: *filter.From})
+	}
+
+	if filter.To != nil {
+		period = append(period, bson.E{Key: "$lt", Value: *filter.To})
+	}
+
+	if len(period) > 0 {
+		match = append(match, bson.E{Key: "created_at", Value: period})
+	}
+
+	count, err := r.collection.CountDocuments(ctx, match)
+	if err != nil {
+		return nil, 0, fmt.Errorf("подсчет записей журнала изменений: %w", err)
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(int64(filter.Pagination.Offset())).
+		SetLimit(int64(filter.Pagination.Limit))
+
+	cursor, err := r.collection.Find(ctx, match, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение записей журнала изменений: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	entries := make(entity.AuditEntries, 0, cursor.RemainingBatchLength())
+	if err = cursor.All(ctx, &entries); err != nil {
+		return nil, 0, fmt.Errorf("декодирование записей журнала изменений: %w", err)
+	}
+
+	return entries, count, nil
+}
+
+// CountActorChanges возвращает количество изменений сущностей entityTypes, сделанных автором начиная с since.
+func (r auditRepository) CountActorChanges(
+	ctx context.Context,
+	actorID string,
+	entityTypes []entity.AuditEntityType,
+	since time.Time,
+) (int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "AuditRepository.CountActorChanges")
+	defer span.End()
+
+	match := bson.D{
+		{Key: "actor_id", Value: actorID},
+		{Key: "entity_type", Value: bson.D{{Key: "$in", Value: entityTypes}}},
+		{Key: "created_at", Value: bson.D{{Key: "$gte", Value: since}}},
+	}
+
+	count, err := r.collection.CountDocuments(ctx, match)
+	if err != nil {
+		return 0, fmt.Errorf("подсчет изменений автора: %w", err)
+	}
+
+	return count, nil
+}
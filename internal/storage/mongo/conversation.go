@@ -0,0 +1,287 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.com/example/gophers/libs/trace"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// conversationRepository репозиторий переписок и сообщений.
+type conversationRepository struct {
+	conversations *mongo.Collection    // Коллекция переписок
+	messages      *mongo.Collection    // Коллекция сообщений
+	tracer        trace.TracerProvider // Отслеживает запросы между слоями и микросервисами
+}
+
+// NewConversationRepository возвращает новый экземпляр репозитория переписок.
+func NewConversationRepository(
+	conversations, messages *mongo.Collection,
+	tracer trace.TracerProvider,
+) repository.ConversationRepository {
+	return &conversationRepository{conversations: conversations, messages: messages, tracer: tracer}
+}
+
+// CreateConversation сохраняет переписку. Если переписка по сделке уже есть, возвращает entity.ErrConversationExists.
+func (r conversationRepository) CreateConversation(ctx context.Context, conversation *entity.Conversation) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ConversationRepository.CreateConversation")
+	defer span.End()
+
+	document := bson.D{
+		{Key: "subject_type", Value: conversation.SubjectType},
+		{Key: "subject_id", Value: conversation.SubjectID},
+		{Key: "participant_ids", Value: conversation.ParticipantIDs},
+		{Key: "read_at", Value: conversation.ReadAt},
+		{Key: "updated_at", Value: conversation.UpdatedAt},
+		{Key: "created_at", Value: conversation.CreatedAt},
+	}
+
+	res, err := r.conversations.InsertOne(ctx, document)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return entity.ErrConversationExists
+		}
+
+		return fmt.Errorf("сохранение переписки: %w", err)
+	}
+
+	objID, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidObjectID, res.InsertedID)
+	}
+
+	conversation.ID = objID.Hex()
+
+	return nil
+}
+
+// GetConversationByID возвращает переписку по идентификатору.
+func (r conversationRepository) GetConversationByID(ctx context.Context, id string) (*entity.Conversation, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ConversationRepository.GetConversationByID")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	return r.findConversation(ctx, bson.D{{Key: "_id", Value: idObj}})
+}
+
+// GetConversationBySubject возвращает переписку по сделке.
+func (r conversationRepository) GetConversationBySubject(
+	ctx context.Context,
+	subjectType entity.ConversationSubject,
+	subjectID string,
+) (*entity.Conversation, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ConversationRepository.GetConversationBySubject")
+	defer span.End()
+
+	return r.findConversation(ctx, bson.D{
+		{Key: "subject_type", Value: subjectType},
+		{Key: "subject_id", Value: subjectID},
+	})
+}
+
+// findConversation возвращает переписку по условию.
+func (r conversationRepository) findConversation(ctx context.Context, match bson.D) (*entity.Conversation, error) {
+	var conversation entity.Conversation
+	if err := r.conversations.FindOne(ctx, match).Decode(&conversation); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, entity.ErrConversationNotFound
+		}
+
+		return nil, fmt.Errorf("получение переписки: %w", err)
+	}
+
+	return &conversation, nil
+}
+
+// GetConversations возвращает переписки пользователя и их общее количество.
+// Переписки с недавними сообщениями идут первыми.
+func (r conversationRepository) GetConversations(
+	ctx context.Context,
+	filter form.ConversationsGet,
+) (entity.Conversations, int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ConversationRepository.GetConversations")
+	defer span.End()
+
+	match := bson.D{{Key: "participant_ids", Value: filter.UserID}}
+
+	count, err := r.conversations.CountDocuments(ctx, match)
+	if err != nil {
+		return nil, 0, fmt.Errorf("подсчет переписок: %w", err)
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "updated_at", Value: -1}}).
+		SetSkip(int64(filter.Pagination.Offset())).
+		SetLimit(int64(filter.Pagination.Limit))
+
+	cursor, err := r.conversations.Find(ctx, match, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение списка переписок: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	conversations := make(entity.Conversations, 0, cursor.RemainingBatchLength())
+	if err = cursor.All(ctx, &conversations); err != nil {
+		return nil, 0, fmt.Errorf("декодирование списка переписок: %w", err)
+	}
+
+	return conversations, count, nil
+}
+
+// MarkConversationRead отмечает переписку прочитанной участником на момент readAt.
+// Отметка не сдвигается назад, если уже стоит более поздняя.
+func (r conversationRepository) MarkConversationRead(ctx context.Context, id, userID string, readAt time.Time) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ConversationRepository.MarkConversationRead")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	match := bson.D{{Key: "_id", Value: idObj}}
+	update := bson.D{{Key: "$max", Value: bson.D{{Key: "read_at." + userID, Value: readAt}}}}
+
+	res, err := r.conversations.UpdateOne(ctx, match, update)
+	if err != nil {
+		return fmt.Errorf("отметка о прочтении переписки: %w", err)
+	}
+
+	if res.MatchedCount == 0 {
+		return entity.ErrConversationNotFound
+	}
+
+	return nil
+}
+
+// CreateMessage сохраняет сообщение и обновляет дату последнего сообщения переписки.
+// Отправитель прочитал переписку на момент отправки.
+func (r conversationRepository) CreateMessage(ctx context.Context, message *entity.Message) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ConversationRepository.CreateMessage")
+	defer span.End()
+
+	conversationID, err := primitive.ObjectIDFromHex(message.ConversationID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	document := bson.D{
+		{Key: "conversation_id", Value: message.ConversationID},
+		{Key: "sender_id", Value: message.SenderID},
+		{Key: "text", Value: message.Text},
+		{Key: "attachments", Value: message.Attachments},
+		{Key: "created_at", Value: message.CreatedAt},
+	}
+
+	res, err := r.messages.InsertOne(ctx, document)
+	if err != nil {
+		return fmt.Errorf("сохранение сообщения: %w", err)
+	}
+
+	objID, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidObjectID, res.InsertedID)
+	}
+
+	message.ID = objID.Hex()
+
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "last_message_at", Value: message.CreatedAt},
+			{Key: "updated_at", Value: message.CreatedAt},
+		}},
+		{Key: "$max", Value: bson.D{{Key: "read_at." + message.SenderID, Value: message.CreatedAt}}},
+	}
+
+	if _, err = r.conversations.UpdateOne(ctx, bson.D{{Key: "_id", Value: conversationID}}, update); err != nil {
+		return fmt.Errorf("обновление переписки: %w", err)
+	}
+
+	return nil
+}
+
+// GetMessages возвращает страницу сообщений, начиная с самых новых, и курсор следующей страницы.
+// Курсор - идентификатор последнего сообщения страницы, поэтому новые сообщения не сдвигают страницы.
+func (r conversationRepository) GetMessages(ctx context.Context, filter form.MessagesGet) (entity.Messages, []byte, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ConversationRepository.GetMessages")
+	defer span.End()
+
+	match := bson.D{{Key: "conversation_id", Value: filter.ConversationID}}
+
+	if state := filter.Pagination.PageStateBytes; len(state) > 0 {
+		if len(state) != len(primitive.ObjectID{}) {
+			return nil, nil, entity.ErrPageInvalidState
+		}
+
+		var before primitive.ObjectID
+		copy(before[:], state)
+
+		match = append(match, bson.E{Key: "_id", Value: bson.D{{Key: "$lt", Value: before}}})
+	}
+
+	// Берем на одно сообщение больше, чтобы узнать, есть ли следующая страница.
+	limit := int64(filter.Pagination.Limit)
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetLimit(limit + 1)
+
+	cursor, err := r.messages.Find(ctx, match, opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("получение сообщений: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	messages := make(entity.Messages, 0, cursor.RemainingBatchLength())
+	if err = cursor.All(ctx, &messages); err != nil {
+		return nil, nil, fmt.Errorf("декодирование сообщений: %w", err)
+	}
+
+	if int64(len(messages)) <= limit {
+		return messages, nil, nil
+	}
+
+	messages = messages[:limit]
+
+	last, err := primitive.ObjectIDFromHex(messages[limit-1].ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	return messages, last[:], nil
+}
+
+// CountMessagesSince возвращает количество сообщений отправителя в переписке начиная с since.
+func (r conversationRepository) CountMessagesSince(
+	ctx context.Context,
+	conversationID, senderID string,
+	since time.Time,
+) (int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ConversationRepository.CountMessagesSince")
+	defer span.End()
+
+	count, err := r.messages.CountDocuments(ctx, bson.D{
+		{Key: "conversation_id", Value: conversationID},
+		{Key: "sender_id", Value: senderID},
+		{Key: "created_at", Value: bson.D{{Key: "$gte", Value: since}}},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("подсчет сообщений: %w", err)
+	}
+
+	return count, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"gitlab.com/example/gophers/libs/logger"
 	"gitlab.com/example/gophers/libs/trace"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
@@ -33,6 +34,48 @@ const (
 	userCollection = "user"
 	// ordersCollection коллекция заказов.
 	ordersCollection = "orders"
+	// listingCollection коллекция объявлений.
+	listingCollection = "listings"
+	// tradeOfferCollection коллекция предложений обмена.
+	tradeOfferCollection = "trade_offers"
+	// tradeCycleCollection коллекция циклов обмена.
+	tradeCycleCollection = "trade_cycles"
+	// wishlistCollection коллекция позиций списков желаний.
+	wishlistCollection = "wishlist_items"
+	// wishlistMatchCollection коллекция совпадений объявлений со списками желаний.
+	wishlistMatchCollection = "wishlist_matches"
+	// ratingCollection коллекция оценок.
+	ratingCollection = "ratings"
+	// conversationCollection коллекция переписок.
+	conversationCollection = "conversations"
+	// messageCollection коллекция сообщений.
+	messageCollection = "messages"
+	// mediaCollection коллекция медиафайлов.
+	mediaCollection = "media"
+	// notificationPreferencesCollection коллекция настроек уведомлений.
+	notificationPreferencesCollection = "notification_preferences"
+	// deviceTokenCollection коллекция токенов устройств для push-уведомлений.
+	deviceTokenCollection = "device_tokens"
+	// notificationCollection коллекция входящих уведомлений.
+	notificationCollection = "notifications"
+	// ledgerAccountCollection коллекция счетов кредитов.
+	ledgerAccountCollection = "ledger_accounts"
+	// ledgerPostingCollection коллекция проводок кредитов.
+	ledgerPostingCollection = "ledger_postings"
+	// paymentCollection коллекция платежей по заказам.
+	paymentCollection = "payments"
+	// paymentEventCollection коллекция обработанных уведомлений платежного провайдера.
+	paymentEventCollection = "payment_webhook_events"
+	// disputeCollection коллекция споров по заказам.
+	disputeCollection = "disputes"
+	// disputeMessageCollection коллекция сообщений по спорам.
+	disputeMessageCollection = "dispute_messages"
+	// auditCollection коллекция журнала изменений.
+	auditCollection = "audit_log"
+	// moderationCollection коллекция дел модерации.
+	moderationCollection = "moderation_cases"
+	// importRunCollection коллекция запусков импорта.
+	importRunCollection = "import_runs"
 )
 
 // Mongo реализация DataStore для MongoDB.
@@ -48,8 +91,23 @@ type Mongo struct {
 	connectionTimeout time.Duration // Время ожидания подключения к MongoDB
 	ensureIdxTimeout  time.Duration // Время ожидания создания индексов
 
-	userRepo   repository.UserRepository   // Репозиторий пользователей
-	ordersRepo repository.OrdersRepository // Репозиторий заказов
+	userRepo       repository.UserRepository         // Репозиторий пользователей
+	ordersRepo     repository.OrdersRepository       // Репозиторий заказов
+	listingRepo    repository.ListingRepository      // Репозиторий объявлений
+	tradeOfferRepo repository.TradeOfferRepository   // Репозиторий предложений обмена
+	tradeCycleRepo repository.TradeCycleRepository   // Репозиторий циклов обмена
+	wishlistRepo   repository.WishlistRepository     // Репозиторий списков желаний
+	ratingRepo     repository.RatingRepository       // Репозиторий оценок
+	convRepo       repository.ConversationRepository // Репозиторий переписок
+	searchRepo     repository.SearchRepository       // Репозиторий полнотекстового поиска
+	mediaRepo      repository.MediaRepository        // Репозиторий медиафайлов
+	notifyRepo     repository.NotificationRepository // Репозиторий уведомлений
+	ledgerRepo     repository.LedgerRepository       // Репозиторий счетов и проводок кредитов
+	paymentRepo    repository.PaymentRepository      // Репозиторий платежей
+	disputeRepo    repository.DisputeRepository      // Репозиторий споров
+	auditRepo      repository.AuditRepository        // Репозиторий журнала изменений
+	moderationRepo repository.ModerationRepository   // Репозиторий дел модерации
+	importRunRepo  repository.ImportRunRepository    // Репозиторий запусков импорта
 }
 
 // Name возвращает название DataStore.
@@ -128,6 +186,162 @@ func (m *Mongo) OrdersRepository() repository.OrdersRepository {
 	return m.ordersRepo
 }
 
+// ListingRepository возвращает репозиторий объявлений.
+func (m *Mongo) ListingRepository() repository.ListingRepository {
+	if m.listingRepo == nil {
+		m.listingRepo = NewListingRepository(m.DB.Collection(listingCollection), m.tracer)
+	}
+
+	return m.listingRepo
+}
+
+// TradeOfferRepository возвращает репозиторий предложений обмена.
+func (m *Mongo) TradeOfferRepository() repository.TradeOfferRepository {
+	if m.tradeOfferRepo == nil {
+		m.tradeOfferRepo = NewTradeOfferRepository(m.DB.Collection(tradeOfferCollection), m.tracer)
+	}
+
+	return m.tradeOfferRepo
+}
+
+// TradeCycleRepository возвращает репозиторий циклов обмена.
+func (m *Mongo) TradeCycleRepository() repository.TradeCycleRepository {
+	if m.tradeCycleRepo == nil {
+		m.tradeCycleRepo = NewTradeCycleRepository(m.DB.Collection(tradeCycleCollection), m.tracer)
+	}
+
+	return m.tradeCycleRepo
+}
+
+// WishlistRepository возвращает репозиторий списков желаний.
+func (m *Mongo) WishlistRepository() repository.WishlistRepository {
+	if m.wishlistRepo == nil {
+		m.wishlistRepo = NewWishlistRepository(
+			m.DB.Collection(wishlistCollection), m.DB.Collection(wishlistMatchCollection), m.tracer,
+		)
+	}
+
+	return m.wishlistRepo
+}
+
+// RatingRepository возвращает репозиторий оценок.
+func (m *Mongo) RatingRepository() repository.RatingRepository {
+	if m.ratingRepo == nil {
+		m.ratingRepo = NewRatingRepository(m.DB.Collection(ratingCollection), m.tracer)
+	}
+
+	return m.ratingRepo
+}
+
+// ConversationRepository возвращает репозиторий переписок.
+func (m *Mongo) ConversationRepository() repository.ConversationRepository {
+	if m.convRepo == nil {
+		m.convRepo = NewConversationRepository(
+			m.DB.Collection(conversationCollection), m.DB.Collection(messageCollection), m.tracer,
+		)
+	}
+
+	return m.convRepo
+}
+
+// SearchRepository возвращает репозиторий полнотекстового поиска.
+func (m *Mongo) SearchRepository() repository.SearchRepository {
+	if m.searchRepo == nil {
+		m.searchRepo = NewSearchRepository(m.DB.Collection(userCollection), m.DB.Collection(listingCollection), m.tracer)
+	}
+
+	return m.searchRepo
+}
+
+// MediaRepository возвращает репозиторий медиафайлов.
+func (m *Mongo) MediaRepository() repository.MediaRepository {
+	if m.mediaRepo == nil {
+		m.mediaRepo = NewMediaRepository(m.DB.Collection(mediaCollection), m.tracer)
+	}
+
+	return m.mediaRepo
+}
+
+// NotificationRepository возвращает репозиторий уведомлений.
+func (m *Mongo) NotificationRepository() repository.NotificationRepository {
+	if m.notifyRepo == nil {
+		m.notifyRepo = NewNotificationRepository(
+			m.DB.Collection(notificationPreferencesCollection),
+			m.DB.Collection(deviceTokenCollection),
+			m.DB.Collection(notificationCollection),
+			m.tracer,
+		)
+	}
+
+	return m.notifyRepo
+}
+
+// LedgerRepository возвращает репозиторий счетов и проводок кредитов.
+func (m *Mongo) LedgerRepository() repository.LedgerRepository {
+	if m.ledgerRepo == nil {
+		m.ledgerRepo = NewLedgerRepository(
+			m.DB.Collection(ledgerAccountCollection),
+			m.DB.Collection(ledgerPostingCollection),
+			m.tracer,
+		)
+	}
+
+	return m.ledgerRepo
+}
+
+// PaymentRepository возвращает репозиторий платежей.
+func (m *Mongo) PaymentRepository() repository.PaymentRepository {
+	if m.paymentRepo == nil {
+		m.paymentRepo = NewPaymentRepository(
+			m.DB.Collection(paymentCollection),
+			m.DB.Collection(paymentEventCollection),
+			m.tracer,
+		)
+	}
+
+	return m.paymentRepo
+}
+
+// DisputeRepository возвращает репозиторий споров.
+func (m *Mongo) DisputeRepository() repository.DisputeRepository {
+	if m.disputeRepo == nil {
+		m.disputeRepo = NewDisputeRepository(
+			m.DB.Collection(disputeCollection),
+			m.DB.Collection(disputeMessageCollection),
+			m.tracer,
+		)
+	}
+
+	return m.disputeRepo
+}
+
+// AuditRepository возвращает репозиторий журнала изменений.
+func (m *Mongo) AuditRepository() repository.AuditRepository {
+	if m.auditRepo == nil {
+		m.auditRepo = NewAuditRepository(m.DB.Collection(auditCollection), m.tracer)
+	}
+
+	return m.auditRepo
+}
+
+// ModerationRepository возвращает репозиторий дел модерации.
+func (m *Mongo) ModerationRepository() repository.ModerationRepository {
+	if m.moderationRepo == nil {
+		m.moderationRepo = NewModerationRepository(m.DB.Collection(moderationCollection), m.tracer)
+	}
+
+	return m.moderationRepo
+}
+
+// ImportRunRepository возвращает репозиторий запусков импорта.
+func (m *Mongo) ImportRunRepository() repository.ImportRunRepository {
+	if m.importRunRepo == nil {
+		m.importRunRepo = NewImportRunRepository(m.DB.Collection(importRunCollection), m.tracer)
+	}
+
+	return m.importRunRepo
+}
+
 // ensureIndexes убеждается что все индексы построены.
 func (m *Mongo) ensureIndexes() error {
 	ctx, cancel := context.WithTimeout(context.Background(), m.connectionTimeout)
@@ -141,17 +355,340 @@ func (m *Mongo) ensureIndexes() error {
 		return fmt.Errorf("построение индексов для заказов: %w", err)
 	}
 
+	if err := m.ensureListingIndexes(ctx); err != nil {
+		return fmt.Errorf("построение индексов для объявлений: %w", err)
+	}
+
+	if err := m.ensureTradeOfferIndexes(ctx); err != nil {
+		return fmt.Errorf("построение индексов для предложений обмена: %w", err)
+	}
+
+	if err := m.ensureTradeCycleIndexes(ctx); err != nil {
+		return fmt.Errorf("построение индексов для циклов обмена: %w", err)
+	}
+
+	if err := m.ensureWishlistIndexes(ctx); err != nil {
+		return fmt.Errorf("построение индексов для списков желаний: %w", err)
+	}
+
+	if err := m.ensureRatingIndexes(ctx); err != nil {
+		return fmt.Errorf("построение индексов для оценок: %w", err)
+	}
+
+	if err := m.ensureConversationIndexes(ctx); err != nil {
+		return fmt.Errorf("построение индексов для переписок: %w", err)
+	}
+
+	if err := m.ensureMediaIndexes(ctx); err != nil {
+		return fmt.Errorf("построение индексов для медиафайлов: %w", err)
+	}
+
+	if err := m.ensureNotificationIndexes(ctx); err != nil {
+		return fmt.Errorf("построение индексов для уведомлений: %w", err)
+	}
+
+	if err := m.ensureLedgerIndexes(ctx); err != nil {
+		return fmt.Errorf("построение индексов для проводок кредитов: %w", err)
+	}
+
+	if err := m.ensurePaymentIndexes(ctx); err != nil {
+		return fmt.Errorf("построение индексов для платежей: %w", err)
+	}
+
+	if err := m.ensureDisputeIndexes(ctx); err != nil {
+		return fmt.Errorf("построение индексов для споров: %w", err)
+	}
+
+	if err := m.ensureAuditIndexes(ctx); err != nil {
+		return fmt.Errorf("построение индексов для журнала изменений: %w", err)
+	}
+
+	if err := m.ensureModerationIndexes(ctx); err != nil {
+		return fmt.Errorf("построение индексов для дел модерации: %w", err)
+	}
+
 	return nil
 }
 
 // ensureUserIndexes убеждается что все индексы построены для коллекции пользователей.
-func (m *Mongo) ensureUserIndexes(_ context.Context) error {
-	return nil
+func (m *Mongo) ensureUserIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		// Полнотекстовый поиск. Имя весит больше биографии.
+		{
+			Keys:    bson.D{{Key: "name", Value: "text"}, {Key: "bio", Value: "text"}},
+			Options: searchTextIndexOptions(bson.D{{Key: "name", Value: 5}, {Key: "bio", Value: 1}}),
+		},
+		// Поиск пользователей рядом с точкой. Пользователи без координат в индекс не попадают.
+		{Keys: bson.D{{Key: "location", Value: "2dsphere"}}},
+	}
+
+	_, err := m.DB.Collection(userCollection).Indexes().CreateMany(ctx, indexes)
+
+	return err
+}
+
+// searchTextIndexOptions возвращает настройки текстового индекса с весами полей.
+// Документы на русском языке, английские слова индексируются без стемминга.
+func searchTextIndexOptions(weights bson.D) *options.IndexOptions {
+	return options.Index().
+		SetName(searchTextIndex).
+		SetWeights(weights).
+		SetDefaultLanguage("russian").
+		SetLanguageOverride("search_language")
 }
 
 // ensureOrdersIndexes убеждается что все индексы построены для коллекции заказов.
-func (m *Mongo) ensureOrdersIndexes(_ context.Context) error {
-	return nil
+func (m *Mongo) ensureOrdersIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "user_id", Value: 1}}},
+		{
+			Keys:    bson.D{{Key: "counterparty_id", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+	}
+
+	_, err := m.DB.Collection(ordersCollection).Indexes().CreateMany(ctx, indexes)
+
+	return err
+}
+
+// ensureListingIndexes убеждается что все индексы построены для коллекции объявлений.
+func (m *Mongo) ensureListingIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "owner_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "category", Value: 1}, {Key: "status", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "desired_tags", Value: 1}, {Key: "status", Value: 1}}},
+		// Полнотекстовый поиск. Заголовок весит больше описания и категории.
+		{
+			Keys: bson.D{
+				{Key: "title", Value: "text"}, {Key: "description", Value: "text"}, {Key: "category", Value: "text"},
+			},
+			Options: searchTextIndexOptions(bson.D{
+				{Key: "title", Value: 10}, {Key: "description", Value: 3}, {Key: "category", Value: 2},
+			}),
+		},
+		// Поиск объявлений рядом с точкой и сортировка по расстоянию.
+		{Keys: bson.D{{Key: "location", Value: "2dsphere"}, {Key: "status", Value: 1}}},
+		{Keys: bson.D{{Key: "city", Value: 1}, {Key: "status", Value: 1}, {Key: "created_at", Value: -1}}},
+	}
+
+	_, err := m.DB.Collection(listingCollection).Indexes().CreateMany(ctx, indexes)
+
+	return err
+}
+
+// ensureTradeOfferIndexes убеждается что все индексы построены для коллекции предложений обмена.
+func (m *Mongo) ensureTradeOfferIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "proposer_id", Value: 1}, {Key: "status", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "recipient_id", Value: 1}, {Key: "status", Value: 1}, {Key: "created_at", Value: -1}}},
+	}
+
+	_, err := m.DB.Collection(tradeOfferCollection).Indexes().CreateMany(ctx, indexes)
+
+	return err
+}
+
+// ensureTradeCycleIndexes убеждается что все индексы построены для коллекции циклов обмена.
+func (m *Mongo) ensureTradeCycleIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		// Один и тот же цикл не может быть предложен дважды, пока ожидает ответа участников.
+		{
+			Keys: bson.D{{Key: "key", Value: 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetPartialFilterExpression(bson.D{{Key: "status", Value: entity.TradeCycleStatusProposed}}),
+		},
+		{Keys: bson.D{{Key: "participant_ids", Value: 1}, {Key: "status", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "legs.listing_id", Value: 1}, {Key: "status", Value: 1}}},
+	}
+
+	_, err := m.DB.Collection(tradeCycleCollection).Indexes().CreateMany(ctx, indexes)
+
+	return err
+}
+
+// ensureWishlistIndexes убеждается что все индексы построены для коллекций списков желаний.
+func (m *Mongo) ensureWishlistIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "category", Value: 1}, {Key: "conditions", Value: 1}}},
+	}
+
+	if _, err := m.DB.Collection(wishlistCollection).Indexes().CreateMany(ctx, indexes); err != nil {
+		return err
+	}
+
+	// Пользователь уведомляется об объявлении не больше одного раза.
+	_, err := m.DB.Collection(wishlistMatchCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "listing_id", Value: 1}, {Key: "user_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+
+	return err
+}
+
+// ensureRatingIndexes убеждается что все индексы построены для коллекции оценок.
+func (m *Mongo) ensureRatingIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		// Каждая сторона оценивает заказ один раз.
+		{
+			Keys:    bson.D{{Key: "order_id", Value: 1}, {Key: "rater_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "ratee_id", Value: 1}, {Key: "created_at", Value: -1}}},
+	}
+
+	_, err := m.DB.Collection(ratingCollection).Indexes().CreateMany(ctx, indexes)
+
+	return err
+}
+
+// ensureConversationIndexes убеждается что все индексы построены для коллекций переписок и сообщений.
+func (m *Mongo) ensureConversationIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		// По одной сделке ведется одна переписка.
+		{
+			Keys:    bson.D{{Key: "subject_type", Value: 1}, {Key: "subject_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "participant_ids", Value: 1}, {Key: "updated_at", Value: -1}}},
+	}
+
+	if _, err := m.DB.Collection(conversationCollection).Indexes().CreateMany(ctx, indexes); err != nil {
+		return err
+	}
+
+	indexes = []mongo.IndexModel{
+		{Keys: bson.D{{Key: "conversation_id", Value: 1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "conversation_id", Value: 1}, {Key: "sender_id", Value: 1}, {Key: "created_at", Value: -1}}},
+	}
+
+	_, err := m.DB.Collection(messageCollection).Indexes().CreateMany(ctx, indexes)
+
+	return err
+}
+
+// ensureMediaIndexes убеждается что все индексы построены для коллекции медиафайлов.
+func (m *Mongo) ensureMediaIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "subject_type", Value: 1}, {Key: "subject_id", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+	}
+
+	_, err := m.DB.Collection(mediaCollection).Indexes().CreateMany(ctx, indexes)
+
+	return err
+}
+
+// ensureNotificationIndexes убеждается что все индексы построены для коллекций устройств и входящих уведомлений.
+func (m *Mongo) ensureNotificationIndexes(ctx context.Context) error {
+	_, err := m.DB.Collection(deviceTokenCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "user_id", Value: 1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Срок хранения задается в каждом уведомлении, поэтому индекс не перестраивается при смене настройки
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "category", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "expires_at", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+	}
+
+	_, err = m.DB.Collection(notificationCollection).Indexes().CreateMany(ctx, indexes)
+
+	return err
+}
+
+// ensureLedgerIndexes убеждается что все индексы построены для коллекции проводок кредитов.
+func (m *Mongo) ensureLedgerIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "account_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "transaction_id", Value: 1}}},
+	}
+
+	_, err := m.DB.Collection(ledgerPostingCollection).Indexes().CreateMany(ctx, indexes)
+
+	return err
+}
+
+// ensurePaymentIndexes убеждается что все индексы построены для коллекций платежей и уведомлений провайдера.
+func (m *Mongo) ensurePaymentIndexes(ctx context.Context) error {
+	// Один платеж на заказ.
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "order_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "provider_payment_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "settlement.next_attempt_at", Value: 1}}, Options: options.Index().SetSparse(true)},
+	}
+
+	if _, err := m.DB.Collection(paymentCollection).Indexes().CreateMany(ctx, indexes); err != nil {
+		return err
+	}
+
+	_, err := m.DB.Collection(paymentEventCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expires_at", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+
+	return err
+}
+
+// ensureDisputeIndexes убеждается что все индексы построены для коллекций споров и сообщений по ним.
+func (m *Mongo) ensureDisputeIndexes(ctx context.Context) error {
+	// Один спор на заказ.
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "order_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "opener_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "respondent_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "due_at", Value: 1}}},
+	}
+
+	if _, err := m.DB.Collection(disputeCollection).Indexes().CreateMany(ctx, indexes); err != nil {
+		return err
+	}
+
+	_, err := m.DB.Collection(disputeMessageCollection).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "dispute_id", Value: 1}, {Key: "created_at", Value: 1}},
+	})
+
+	return err
+}
+
+// ensureAuditIndexes убеждается что все индексы построены для коллекции журнала изменений.
+func (m *Mongo) ensureAuditIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "entity_type", Value: 1}, {Key: "entity_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "actor_id", Value: 1}, {Key: "created_at", Value: -1}}},
+		{Keys: bson.D{{Key: "request_id", Value: 1}}},
+		{Keys: bson.D{{Key: "created_at", Value: -1}}},
+	}
+
+	_, err := m.DB.Collection(auditCollection).Indexes().CreateMany(ctx, indexes)
+
+	return err
+}
+
+// ensureModerationIndexes убеждается что все индексы построены для коллекции дел модерации.
+func (m *Mongo) ensureModerationIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		// Одно открытое дело на сущность.
+		{
+			Keys: bson.D{{Key: "subject_type", Value: 1}, {Key: "subject_id", Value: 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetPartialFilterExpression(bson.D{{Key: "status", Value: entity.ModerationCaseStatusOpen}}),
+		},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "subject_type", Value: 1}, {Key: "created_at", Value: 1}}},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "reported", Value: 1}, {Key: "created_at", Value: 1}}},
+	}
+
+	_, err := m.DB.Collection(moderationCollection).Indexes().CreateMany(ctx, indexes)
+
+	return err
 }
 
 // StartSession создает сессию для транзакции.
@@ -0,0 +1,292 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.com/example/gophers/libs/trace"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// activeDisputeStatuses статусы нерешенных споров.
+var activeDisputeStatuses = bson.D{{Key: "$in", Value: []entity.DisputeStatus{entity.DisputeStatusOpen, entity.DisputeStatusInReview}}}
+
+// disputeRepository репозиторий споров по заказам.
+type disputeRepository struct {
+	disputes *mongo.Collection    // Коллекция споров
+	messages *mongo.Collection    // Коллекция сообщений по спорам
+	tracer   trace.TracerProvider // Отслеживает запросы между слоями и микросервисами
+}
+
+// NewDisputeRepository возвращает новый экземпляр репозитория споров.
+func NewDisputeRepository(disputes, messages *mongo.Collection, tracer trace.TracerProvider) repository.DisputeRepository {
+	return &disputeRepository{disputes: disputes, messages: messages, tracer: tracer}
+}
+
+// CreateDispute сохраняет спор. Уникальный индекс по заказу не дает открыть второй спор.
+func (r disputeRepository) CreateDispute(ctx context.Context, dispute *entity.Dispute) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "DisputeRepository.CreateDispute")
+	defer span.End()
+
+	document := bson.D{
+		{Key: "order_id", Value: dispute.OrderID},
+		{Key: "opener_id", Value: dispute.OpenerID},
+		{Key: "respondent_id", Value: dispute.RespondentID},
+		{Key: "reason", Value: dispute.Reason},
+		{Key: "description", Value: dispute.Description},
+		{Key: "status", Value: dispute.Status},
+		{Key: "response_due_at", Value: dispute.ResponseDueAt},
+		{Key: "resolution_due_at", Value: dispute.ResolutionDueAt},
+		{Key: "due_at", Value: dispute.DueAt},
+		{Key: "overdue", Value: dispute.Overdue},
+		{Key: "version", Value: dispute.Version},
+		{Key: "updated_at", Value: dispute.UpdatedAt},
+		{Key: "created_at", Value: dispute.CreatedAt},
+	}
+
+	res, err := r.disputes.InsertOne(ctx, document)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return entity.ErrDisputeAlreadyExists
+		}
+
+		return fmt.Errorf("сохранение спора: %w", err)
+	}
+
+	objID, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidObjectID, res.InsertedID)
+	}
+
+	dispute.ID = objID.Hex()
+
+	return nil
+}
+
+// GetDisputeByID возвращает спор по идентификатору.
+func (r disputeRepository) GetDisputeByID(ctx context.Context, id string) (*entity.Dispute, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "DisputeRepository.GetDisputeByID")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	var dispute entity.Dispute
+	if err = r.disputes.FindOne(ctx, bson.D{{Key: "_id", Value: idObj}}).Decode(&dispute); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, entity.ErrDisputeNotFound
+		}
+
+		return nil, fmt.Errorf("получение спора: %w", err)
+	}
+
+	return &dispute, nil
+}
+
+// GetDisputes возвращает споры пользователя от новых к старым.
+func (r disputeRepository) GetDisputes(ctx context.Context, filter form.DisputesGet) (entity.Disputes, int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "DisputeRepository.GetDisputes")
+	defer span.End()
+
+	match := bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: "opener_id", Value: filter.UserID}},
+		bson.D{{Key: "respondent_id", Value: filter.UserID}},
+	}}}
+
+	sort := bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}
+
+	return r.findDisputes(ctx, match, sort, filter.Pagination)
+}
+
+// GetDisputeQueue возвращает нерешенные споры: сначала те, у которых срок наступит раньше.
+func (r disputeRepository) GetDisputeQueue(ctx context.Context, filter form.DisputeQueueGet) (entity.Disputes, int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "DisputeRepository.GetDisputeQueue")
+	defer span.End()
+
+	match := bson.D{{Key: "status", Value: activeDisputeStatuses}}
+	if filter.Status != "" {
+		match = bson.D{{Key: "status", Value: filter.Status}}
+	}
+
+	if filter.ModeratorID != "" {
+		match = append(match, bson.E{Key: "moderator_id", Value: filter.ModeratorID})
+	}
+
+	if filter.Overdue {
+		match = append(match, bson.E{Key: "overdue", Value: true})
+	}
+
+	sort := bson.D{{Key: "due_at", Value: 1}, {Key: "_id", Value: 1}}
+
+	return r.findDisputes(ctx, match, sort, filter.Pagination)
+}
+
+// GetDueDisputes возвращает нерешенные споры с наступившим сроком, просрочка которых еще не отмечена.
+func (r disputeRepository) GetDueDisputes(ctx context.Context, dueAt time.Time, limit int64) (entity.Disputes, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "DisputeRepository.GetDueDisputes")
+	defer span.End()
+
+	match := bson.D{
+		{Key: "status", Value: activeDisputeStatuses},
+		{Key: "overdue", Value: false},
+		{Key: "due_at", Value: bson.D{{Key: "$lte", Value: dueAt}}},
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "due_at", Value: 1}}).
+		SetLimit(limit)
+
+	cursor, err := r.disputes.Find(ctx, match, opts)
+	if err != nil {
+		return nil, fmt.Errorf("получение просроченных споров: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	disputes := make(entity.Disputes, 0, cursor.RemainingBatchLength())
+	if err = cursor.All(ctx, &disputes); err != nil {
+		return nil, fmt.Errorf("декодирование просроченных споров: %w", err)
+	}
+
+	return disputes, nil
+}
+
+// UpdateDispute сохраняет изменяемые поля спора и увеличивает его версию.
+func (r disputeRepository) UpdateDispute(ctx context.Context, dispute *entity.Dispute) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "DisputeRepository.UpdateDispute")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(dispute.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	match := bson.D{
+		{Key: "_id", Value: idObj},
+		{Key: "version", Value: dispute.Version},
+	}
+
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "status", Value: dispute.Status},
+		{Key: "moderator_id", Value: dispute.ModeratorID},
+		{Key: "evidence", Value: dispute.Evidence},
+		{Key: "resolution", Value: dispute.Resolution},
+		{Key: "due_at", Value: dispute.DueAt},
+		{Key: "overdue", Value: dispute.Overdue},
+		{Key: "version", Value: dispute.Version + 1},
+		{Key: "updated_at", Value: dispute.UpdatedAt},
+	}}}
+
+	res, err := r.disputes.UpdateOne(ctx, match, update)
+	if err != nil {
+		return fmt.Errorf("обновление спора: %w", err)
+	}
+
+	if res.MatchedCount == 0 {
+		return entity.ErrDisputeConflict
+	}
+
+	dispute.Version++
+
+	return nil
+}
+
+// CreateDisputeMessage сохраняет сообщение по спору.
+func (r disputeRepository) CreateDisputeMessage(ctx context.Context, message *entity.DisputeMessage) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "DisputeRepository.CreateDisputeMessage")
+	defer span.End()
+
+	res, err := r.messages.InsertOne(ctx, bson.D{
+		{Key: "dispute_id", Value: message.DisputeID},
+		{Key: "author_id", Value: message.AuthorID},
+		{Key: "moderator", Value: message.Moderator},
+		{Key: "text", Value: message.Text},
+		{Key: "created_at", Value: message.CreatedAt},
+	})
+	if err != nil {
+		return fmt.Errorf("сохранение сообщения по спору: %w", err)
+	}
+
+	objID, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidObjectID, res.InsertedID)
+	}
+
+	message.ID = objID.Hex()
+
+	return nil
+}
+
+// GetDisputeMessages возвращает сообщения по спору от старых к новым.
+func (r disputeRepository) GetDisputeMessages(
+	ctx context.Context,
+	filter form.DisputeMessagesGet,
+) (entity.DisputeMessages, int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "DisputeRepository.GetDisputeMessages")
+	defer span.End()
+
+	match := bson.D{{Key: "dispute_id", Value: filter.DisputeID}}
+
+	count, err := r.messages.CountDocuments(ctx, match)
+	if err != nil {
+		return nil, 0, fmt.Errorf("подсчет сообщений по спору: %w", err)
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}).
+		SetSkip(int64(filter.Pagination.Offset())).
+		SetLimit(int64(filter.Pagination.Limit))
+
+	cursor, err := r.messages.Find(ctx, match, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение сообщений по спору: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	messages := make(entity.DisputeMessages, 0, cursor.RemainingBatchLength())
+	if err = cursor.All(ctx, &messages); err != nil {
+		return nil, 0, fmt.Errorf("декодирование сообщений по спору: %w", err)
+	}
+
+	return messages, count, nil
+}
+
+// findDisputes возвращает страницу споров по фильтру и общее количество подходящих споров.
+func (r disputeRepository) findDisputes(
+	ctx context.Context,
+	match, sort bson.D,
+	pagination form.Pagination,
+) (entity.Disputes, int64, error) {
+	count, err := r.disputes.CountDocuments(ctx, match)
+	if err != nil {
+		return nil, 0, fmt.Errorf("подсчет споров: %w", err)
+	}
+
+	opts := options.Find().
+		SetSort(sort).
+		SetSkip(int64(pagination.Offset())).
+		SetLimit(int64(pagination.Limit))
+
+	cursor, err := r.disputes.Find(ctx, match, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение списка споров: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	disputes := make(entity.Disputes, 0, cursor.RemainingBatchLength())
+	if err = cursor.All(ctx, &disputes); err != nil {
+		return nil, 0, fmt.Errorf("декодирование списка споров: %w", err)
+	}
+
+	return disputes, count, nil
+}
@@ -0,0 +1,31 @@
+package mongo
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+)
+
+// mongoEarthRadiusKm радиус Земли, которым MongoDB переводит радиус $centerSphere из радиан в километры.
+const mongoEarthRadiusKm = 6378.1
+
+// metersPerKm количество метров в километре.
+const metersPerKm = 1000
+
+// geoWithin возвращает условие «точка в радиусе от центра». В отличие от $nearSphere,
+// его можно использовать в подсчете документов и вместе с $text, но оно не сортирует по расстоянию.
+func geoWithin(near *form.GeoNear) bson.D {
+	center := bson.A{near.Point.Lon, near.Point.Lat}
+
+	return bson.D{{Key: "$geoWithin", Value: bson.D{
+		{Key: "$centerSphere", Value: bson.A{center, near.RadiusKm / mongoEarthRadiusKm}},
+	}}}
+}
+
+// nearSphere возвращает условие «точка в радиусе от центра» с сортировкой от ближних к дальним.
+func nearSphere(near *form.GeoNear) bson.D {
+	return bson.D{{Key: "$nearSphere", Value: bson.D{
+		{Key: "$geometry", Value: near.Point},
+		{Key: "$maxDistance", Value: near.RadiusKm * metersPerKm},
+	}}}
+}
@@ -0,0 +1,214 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.com/example/gophers/libs/trace"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// importRunRepository репозиторий запусков импорта.
+type importRunRepository struct {
+	collection *mongo.Collection    // Коллекция запусков импорта
+	tracer     trace.TracerProvider // Отслеживает запросы между слоями и микросервисами
+}
+
+// NewImportRunRepository возвращает новый экземпляр репозитория запусков импорта.
+func NewImportRunRepository(collection *mongo.Collection, tracer trace.TracerProvider) repository.ImportRunRepository {
+	return &importRunRepository{collection: collection, tracer: tracer}
+}
+
+// CreateImportRun сохраняет новый запуск и заполняет его идентификатор.
+func (r importRunRepository) CreateImportRun(ctx context.Context, run *entity.ImportRun) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ImportRunRepository.CreateImportRun")
+	defer span.End()
+
+	res, err := r.collection.InsertOne(ctx, bson.D{
+		{Key: "kind", Value: run.Kind},
+		{Key: "requester_id", Value: run.RequesterID},
+		{Key: "checkpoint", Value: run.Checkpoint},
+		{Key: "created", Value: run.Created},
+		{Key: "existed", Value: run.Existed},
+		{Key: "failed", Value: run.Failed},
+		{Key: "status", Value: run.Status},
+		{Key: "created_at", Value: run.CreatedAt},
+		{Key: "updated_at", Value: run.UpdatedAt},
+	})
+	if err != nil {
+		return fmt.Errorf("сохранение запуска импорта: %w", err)
+	}
+
+	objID, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidObjectID, res.InsertedID)
+	}
+
+	run.ID = objID.Hex()
+
+	return nil
+}
+
+// GetImportRun возвращает запуск по идентификатору.
+func (r importRunRepository) GetImportRun(ctx context.Context, id string) (*entity.ImportRun, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ImportRunRepository.GetImportRun")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	var run entity.ImportRun
+	if err = r.collection.FindOne(ctx, bson.D{{Key: "_id", Value: idObj}}).Decode(&run); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, entity.ErrImportRunNotFound
+		}
+
+		return nil, fmt.Errorf("получение запуска импорта: %w", err)
+	}
+
+	return &run, nil
+}
+
+// UpdateImportRun сохраняет счетчики, номер последней обработанной строки и статус запуска.
+func (r importRunRepository) UpdateImportRun(ctx context.Context, run *entity.ImportRun) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ImportRunRepository.UpdateImportRun")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(run.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "checkpoint", Value: run.Checkpoint},
+		{Key: "created", Value: run.Created},
+		{Key: "existed", Value: run.Existed},
+		{Key: "failed", Value: run.Failed},
+		{Key: "status", Value: run.Status},
+		{Key: "updated_at", Value: run.UpdatedAt},
+	}}}
+
+	res, err := r.collection.UpdateOne(ctx, bson.D{{Key: "_id", Value: idObj}}, update)
+	if err != nil {
+		return fmt.Errorf("обновление запуска импорта: %w", err)
+	}
+
+	if res.MatchedCount == 0 {
+		return entity.ErrImportRunNotFound
+	}
+
+	return nil
+}
+
+// insertBatch сохраняет документы с идентификаторами ids одной командой, не останавливаясь на первой ошибке.
+// Ошибки отдельных документов возвращаются по индексу, уже сохраненный документ - как entity.ErrImportRowExists.
+// Существующие документы отбираются до вставки: в транзакции ошибка дубля ключа прерывает всю транзакцию.
+func insertBatch(
+	ctx context.Context,
+	collection *mongo.Collection,
+	ids []primitive.ObjectID,
+	documents []interface{},
+) (map[int]error, error) {
+	if len(documents) == 0 {
+		return nil, nil
+	}
+
+	existing, err := existingIDs(ctx, collection, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := make(map[int]error, len(existing))
+	inserts := make([]interface{}, 0, len(documents))
+	indexes := make([]int, 0, len(documents))
+
+	for i, document := range documents {
+		if _, ok := existing[ids[i]]; ok {
+			errs[i] = entity.ErrImportRowExists
+
+			continue
+		}
+
+		inserts = append(inserts, document)
+		indexes = append(indexes, i)
+	}
+
+	if len(inserts) == 0 {
+		return errs, nil
+	}
+
+	_, err = collection.InsertMany(ctx, inserts, options.InsertMany().SetOrdered(false))
+	if err == nil {
+		return errs, nil
+	}
+
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil {
+		return nil, err
+	}
+
+	for _, writeErr := range bulkErr.WriteErrors {
+		if mongo.IsDuplicateKeyError(writeErr.WriteError) {
+			errs[indexes[writeErr.Index]] = entity.ErrImportRowExists
+
+			continue
+		}
+
+		errs[indexes[writeErr.Index]] = writeErr.WriteError
+	}
+
+	return errs, nil
+}
+
+// existingIDs возвращает идентификаторы из ids, документы с которыми уже есть в коллекции.
+func existingIDs(ctx context.Context, collection *mongo.Collection, ids []primitive.ObjectID) (map[primitive.ObjectID]struct{}, error) {
+	cursor, err := collection.Find(ctx,
+		bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}},
+		options.Find().SetProjection(bson.D{{Key: "_id", Value: 1}}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("поиск сохраненных документов: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var documents []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+
+	if err = cursor.All(ctx, &documents); err != nil {
+		return nil, fmt.Errorf("декодирование сохраненных документов: %w", err)
+	}
+
+	existing := make(map[primitive.ObjectID]struct{}, len(documents))
+	for _, document := range documents {
+		existing[document.ID] = struct{}{}
+	}
+
+	return existing, nil
+}
+
+// createdPeriod возвращает условие на дату создания: from включается, to нет.
+// Если границ нет, возвращает nil.
+func createdPeriod(from, to *time.Time) bson.D {
+	var period bson.D
+
+	if from != nil {
+		period = append(period, bson.E{Key: "$gte", Value: *from})
+	}
+
+	if to != nil {
+		period = append(period, bson.E{Key: "$lt", Value: *to})
+	}
+
+	return period
+}
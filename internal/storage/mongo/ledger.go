@@ -0,0 +1,161 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitlab.com/example/gophers/libs/trace"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// transientTransactionLabel метка ошибки, после которой транзакцию можно повторить.
+// Ее ставит MongoDB при конфликте записи параллельных транзакций.
+const transientTransactionLabel = "TransientTransactionError"
+
+// ledgerRepository репозиторий счетов и проводок кредитов.
+type ledgerRepository struct {
+	accounts *mongo.Collection    // Коллекция счетов
+	postings *mongo.Collection    // Коллекция проводок
+	tracer   trace.TracerProvider // Отслеживает запросы между слоями и микросервисами
+}
+
+// NewLedgerRepository возвращает новый экземпляр репозитория счетов и проводок кредитов.
+func NewLedgerRepository(accounts, postings *mongo.Collection, tracer trace.TracerProvider) repository.LedgerRepository {
+	return &ledgerRepository{accounts: accounts, postings: postings, tracer: tracer}
+}
+
+// GetLedgerAccounts возвращает найденные счета по идентификаторам.
+func (r ledgerRepository) GetLedgerAccounts(ctx context.Context, ids []string) (entity.LedgerAccounts, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "LedgerRepository.GetLedgerAccounts")
+	defer span.End()
+
+	cursor, err := r.accounts.Find(ctx, bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: ids}}}})
+	if err != nil {
+		return nil, fmt.Errorf("получение счетов кредитов: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	accounts := make(entity.LedgerAccounts, 0, len(ids))
+	if err = cursor.All(ctx, &accounts); err != nil {
+		return nil, fmt.Errorf("декодирование счетов кредитов: %w", err)
+	}
+
+	return accounts, nil
+}
+
+// SaveLedgerAccount сохраняет баланс счета, если в базе лежит предыдущая версия.
+// Новый счет создается с первой версией. Если счет успели изменить, upsert упирается в уникальный _id.
+func (r ledgerRepository) SaveLedgerAccount(ctx context.Context, account *entity.LedgerAccount) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "LedgerRepository.SaveLedgerAccount")
+	defer span.End()
+
+	match := bson.D{
+		{Key: "_id", Value: account.ID},
+		{Key: "version", Value: account.Version - 1},
+	}
+
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "type", Value: account.Type},
+		{Key: "balance", Value: account.Balance},
+		{Key: "allow_negative", Value: account.AllowNegative},
+		{Key: "version", Value: account.Version},
+		{Key: "updated_at", Value: account.UpdatedAt},
+	}}}
+
+	_, err := r.accounts.UpdateOne(ctx, match, update, options.Update().SetUpsert(true))
+	if err != nil {
+		var serverErr mongo.ServerError
+		if mongo.IsDuplicateKeyError(err) || (errors.As(err, &serverErr) && serverErr.HasErrorLabel(transientTransactionLabel)) {
+			return entity.ErrLedgerConflict
+		}
+
+		return fmt.Errorf("сохранение счета кредитов: %w", err)
+	}
+
+	return nil
+}
+
+// CreateLedgerTransaction сохраняет проводки транзакции одним запросом.
+func (r ledgerRepository) CreateLedgerTransaction(ctx context.Context, transaction *entity.LedgerTransaction) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "LedgerRepository.CreateLedgerTransaction")
+	defer span.End()
+
+	transaction.ID = primitive.NewObjectID().Hex()
+
+	documents := make([]interface{}, 0, len(transaction.Postings))
+	ids := make([]primitive.ObjectID, 0, len(transaction.Postings))
+
+	for _, posting := range transaction.Postings {
+		id := primitive.NewObjectID()
+		ids = append(ids, id)
+
+		document := bson.D{
+			{Key: "_id", Value: id},
+			{Key: "transaction_id", Value: transaction.ID},
+			{Key: "account_id", Value: posting.AccountID},
+			{Key: "amount", Value: posting.Amount},
+			{Key: "balance_after", Value: posting.BalanceAfter},
+			{Key: "kind", Value: posting.Kind},
+			{Key: "created_at", Value: posting.CreatedAt},
+		}
+
+		if posting.Comment != "" {
+			document = append(document, bson.E{Key: "comment", Value: posting.Comment})
+		}
+
+		documents = append(documents, document)
+	}
+
+	if _, err := r.postings.InsertMany(ctx, documents); err != nil {
+		return fmt.Errorf("сохранение проводок: %w", err)
+	}
+
+	for i, posting := range transaction.Postings {
+		posting.ID = ids[i].Hex()
+		posting.TransactionID = transaction.ID
+	}
+
+	return nil
+}
+
+// GetLedgerPostings возвращает проводки по счету пользователя от новых к старым и их общее количество.
+func (r ledgerRepository) GetLedgerPostings(
+	ctx context.Context,
+	filter form.WalletStatementGet,
+) (entity.LedgerPostings, int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "LedgerRepository.GetLedgerPostings")
+	defer span.End()
+
+	match := bson.D{{Key: "account_id", Value: filter.UserID}}
+
+	count, err := r.postings.CountDocuments(ctx, match)
+	if err != nil {
+		return nil, 0, fmt.Errorf("подсчет проводок: %w", err)
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(int64(filter.Pagination.Offset())).
+		SetLimit(int64(filter.Pagination.Limit))
+
+	cursor, err := r.postings.Find(ctx, match, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение списка проводок: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	postings := make(entity.LedgerPostings, 0, cursor.RemainingBatchLength())
+	if err = cursor.All(ctx, &postings); err != nil {
+		return nil, 0, fmt.Errorf("декодирование списка проводок: %w", err)
+	}
+
+	return postings, count, nil
+}
@@ -0,0 +1,410 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gitlab.com/example/gophers/libs/trace"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// listingRepository репозиторий объявлений.
+type listingRepository struct {
+	collection *mongo.Collection    // Коллекция объявлений
+	tracer     trace.TracerProvider // Отслеживает запросы между слоями и микросервисами
+}
+
+// NewListingRepository возвращает новый экземпляр репозитория объявлений.
+func NewListingRepository(collection *mongo.Collection, tracer trace.TracerProvider) repository.ListingRepository {
+	return &listingRepository{collection: collection, tracer: tracer}
+}
+
+// CreateListing сохраняет объявление.
+func (r listingRepository) CreateListing(ctx context.Context, listing *entity.Listing) (string, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ListingRepository.CreateListing")
+	defer span.End()
+
+	document := bson.D{
+		{Key: "owner_id", Value: listing.OwnerID},
+		{Key: "title", Value: listing.Title},
+		{Key: "description", Value: listing.Description},
+		{Key: "category", Value: listing.Category},
+		{Key: "condition", Value: listing.Condition},
+		{Key: "photos", Value: listing.Photos},
+		{Key: "desired_tags", Value: listing.DesiredTags},
+		{Key: "status", Value: listing.Status},
+		{Key: "moderation", Value: listing.Moderation},
+		{Key: "updated_at", Value: listing.UpdatedAt},
+		{Key: "created_at", Value: listing.CreatedAt},
+	}
+
+	if listing.Location != nil {
+		document = append(document, bson.E{Key: "location", Value: listing.Location})
+	}
+
+	if listing.City != "" {
+		document = append(document, bson.E{Key: "city", Value: listing.City})
+	}
+
+	if listing.Valuation != nil {
+		document = append(document, bson.E{Key: "valuation", Value: listing.Valuation})
+	}
+
+	res, err := r.collection.InsertOne(ctx, document)
+	if err != nil {
+		return "", fmt.Errorf("сохранение объявления: %w", err)
+	}
+
+	objID, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return "", fmt.Errorf("%w: %v", entity.ErrInvalidObjectID, res.InsertedID)
+	}
+
+	listing.ID = objID.Hex()
+
+	return listing.ID, nil
+}
+
+// GetListingByID возвращает объявление по идентификатору.
+func (r listingRepository) GetListingByID(ctx context.Context, id string) (*entity.Listing, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ListingRepository.GetListingByID")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	var listing entity.Listing
+	if err = r.collection.FindOne(ctx, bson.D{{Key: "_id", Value: idObj}}).Decode(&listing); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, entity.ErrListingNotFound
+		}
+
+		return nil, fmt.Errorf("получение объявления: %w", err)
+	}
+
+	return &listing, nil
+}
+
+// GetListings возвращает список объявлений по фильтру и их общее количество.
+// С фильтром near у объявлений заполняется расстояние до точки.
+func (r listingRepository) GetListings(ctx context.Context, filter form.ListingsGet) (entity.Listings, int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ListingRepository.GetListings")
+	defer span.End()
+
+	// Отклоненные модератором объявления скрыты из публичного списка.
+	match := bson.D{notRejected}
+
+	if filter.OwnerID != "" {
+		match = append(match, bson.E{Key: "owner_id", Value: filter.OwnerID})
+	}
+
+	if filter.Category != "" {
+		match = append(match, bson.E{Key: "category", Value: filter.Category})
+	}
+
+	if filter.Status != "" {
+		match = append(match, bson.E{Key: "status", Value: filter.Status})
+	}
+
+	if filter.Tag != "" {
+		match = append(match, bson.E{Key: "desired_tags", Value: strings.ToLower(strings.TrimSpace(filter.Tag))})
+	}
+
+	if filter.City != "" {
+		match = append(match, bson.E{Key: "city", Value: filter.City})
+	}
+
+	findMatch := match
+
+	if filter.Near != nil {
+		// $nearSphere нельзя использовать в подсчете, поэтому считаем через $geoWithin.
+		findMatch = append(bson.D{}, match...)
+		match = append(match, bson.E{Key: "location", Value: geoWithin(filter.Near)})
+		findMatch = append(findMatch, bson.E{Key: "location", Value: geoWithin(filter.Near)})
+	}
+
+	count, err := r.collection.CountDocuments(ctx, match)
+	if err != nil {
+		return nil, 0, fmt.Errorf("подсчет объявлений: %w", err)
+	}
+
+	opts := options.Find().
+		SetSkip(int64(filter.Pagination.Offset())).
+		SetLimit(int64(filter.Pagination.Limit))
+
+	if filter.SortBy == form.SortByDistance && filter.Near != nil {
+		findMatch[len(findMatch)-1].Value = nearSphere(filter.Near)
+	} else {
+		opts.SetSort(bson.D{{Key: "created_at", Value: filter.Pagination.SortToInt()}})
+	}
+
+	cursor, err := r.collection.Find(ctx, findMatch, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение списка объявлений: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	listings := make(entity.Listings, 0, cursor.RemainingBatchLength())
+	if err = cursor.All(ctx, &listings); err != nil {
+		return nil, 0, fmt.Errorf("декодирование списка объявлений: %w", err)
+	}
+
+	if filter.Near != nil {
+		listings.FillDistance(filter.Near.Point)
+	}
+
+	return listings, count, nil
+}
+
+// UpdateListing обновляет объявление, если его статус не изменился с момента чтения.
+// Если объявление не найдено, возвращает entity.ErrListingConflict: сервис проверяет владельца и статус
+// перед обновлением, поэтому объявление было изменено или удалено параллельно.
+func (r listingRepository) UpdateListing(ctx context.Context, listing *entity.Listing, from entity.ListingStatus) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ListingRepository.UpdateListing")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(listing.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	// Статус прочитанного объявления не дает затереть резервирование, сделанное обменом параллельно.
+	match := bson.D{
+		{Key: "_id", Value: idObj},
+		{Key: "owner_id", Value: listing.OwnerID},
+		{Key: "status", Value: from},
+	}
+	set := bson.D{
+		{Key: "title", Value: listing.Title},
+		{Key: "description", Value: listing.Description},
+		{Key: "category", Value: listing.Category},
+		{Key: "condition", Value: listing.Condition},
+		{Key: "photos", Value: listing.Photos},
+		{Key: "desired_tags", Value: listing.DesiredTags},
+		{Key: "moderation", Value: listing.Moderation},
+		{Key: "updated_at", Value: listing.UpdatedAt},
+	}
+
+	if listing.Status != from {
+		set = append(set, bson.E{Key: "status", Value: listing.Status})
+	}
+
+	if listing.Location != nil {
+		set = append(set, bson.E{Key: "location", Value: listing.Location})
+	}
+
+	if listing.City != "" {
+		set = append(set, bson.E{Key: "city", Value: listing.City})
+	}
+
+	if listing.Valuation != nil {
+		set = append(set, bson.E{Key: "valuation", Value: listing.Valuation})
+	}
+
+	update := bson.D{{Key: "$set", Value: set}}
+
+	res, err := r.collection.UpdateOne(ctx, match, update)
+	if err != nil {
+		return fmt.Errorf("обновление объявления: %w", err)
+	}
+
+	if res.MatchedCount == 0 {
+		return entity.ErrListingConflict
+	}
+
+	return nil
+}
+
+// DeleteListing удаляет объявление владельца, если оно не участвует в сделке.
+// Если объявление не удалено, возвращает entity.ErrListingConflict: сервис проверяет владельца и статус
+// перед удалением, поэтому объявление было изменено или удалено параллельно.
+func (r listingRepository) DeleteListing(ctx context.Context, filter form.ListingDelete) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ListingRepository.DeleteListing")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(filter.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	match := bson.D{
+		{Key: "_id", Value: idObj},
+		{Key: "owner_id", Value: filter.OwnerID},
+		{Key: "status", Value: bson.D{{Key: "$in", Value: entity.EditableListingStatuses}}},
+	}
+
+	res, err := r.collection.DeleteOne(ctx, match)
+	if err != nil {
+		return fmt.Errorf("удаление объявления: %w", err)
+	}
+
+	if res.DeletedCount == 0 {
+		return entity.ErrListingConflict
+	}
+
+	return nil
+}
+
+// GetListingsByIDs возвращает объявления по списку идентификаторов.
+func (r listingRepository) GetListingsByIDs(ctx context.Context, ids []string) (entity.Listings, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ListingRepository.GetListingsByIDs")
+	defer span.End()
+
+	objIDs, err := toObjectIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.D{{Key: "_id", Value: bson.D{{Key: "$in", Value: objIDs}}}})
+	if err != nil {
+		return nil, fmt.Errorf("получение объявлений: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	listings := make(entity.Listings, 0, len(ids))
+	if err = cursor.All(ctx, &listings); err != nil {
+		return nil, fmt.Errorf("декодирование объявлений: %w", err)
+	}
+
+	return listings, nil
+}
+
+// UpdateListingsStatus переводит объявления из статуса from в статус to.
+// Возвращает количество измененных объявлений.
+func (r listingRepository) UpdateListingsStatus(
+	ctx context.Context,
+	ids []string,
+	from, to entity.ListingStatus,
+	currentTime time.Time,
+) (int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ListingRepository.UpdateListingsStatus")
+	defer span.End()
+
+	objIDs, err := toObjectIDs(ids)
+	if err != nil {
+		return 0, err
+	}
+
+	match := bson.D{
+		{Key: "_id", Value: bson.D{{Key: "$in", Value: objIDs}}},
+		{Key: "status", Value: from},
+	}
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "status", Value: to},
+		{Key: "updated_at", Value: currentTime},
+	}}}
+
+	res, err := r.collection.UpdateMany(ctx, match, update)
+	if err != nil {
+		return 0, fmt.Errorf("обновление статуса объявлений: %w", err)
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// GetListingsByDesiredTags возвращает активные объявления, владельцы которых хотят получить любой из тегов.
+func (r listingRepository) GetListingsByDesiredTags(ctx context.Context, tags []string, limit int64) (entity.Listings, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ListingRepository.GetListingsByDesiredTags")
+	defer span.End()
+
+	match := bson.D{
+		{Key: "desired_tags", Value: bson.D{{Key: "$in", Value: tags}}},
+		{Key: "status", Value: entity.ListingStatusActive},
+		notRejected,
+	}
+
+	// Сортировка по идентификатору делает выборку детерминированной при срабатывании лимита.
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(limit)
+
+	cursor, err := r.collection.Find(ctx, match, opts)
+	if err != nil {
+		return nil, fmt.Errorf("получение объявлений по желаемым тегам: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	listings := make(entity.Listings, 0, cursor.RemainingBatchLength())
+	if err = cursor.All(ctx, &listings); err != nil {
+		return nil, fmt.Errorf("декодирование объявлений: %w", err)
+	}
+
+	return listings, nil
+}
+
+// UpdateListingModeration сохраняет статус модерации объявления.
+func (r listingRepository) UpdateListingModeration(ctx context.Context, listing *entity.Listing) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ListingRepository.UpdateListingModeration")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(listing.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "moderation", Value: listing.Moderation},
+		{Key: "updated_at", Value: listing.UpdatedAt},
+	}}}
+
+	res, err := r.collection.UpdateOne(ctx, bson.D{{Key: "_id", Value: idObj}}, update)
+	if err != nil {
+		return fmt.Errorf("обновление статуса модерации объявления: %w", err)
+	}
+
+	if res.MatchedCount == 0 {
+		return entity.ErrListingNotFound
+	}
+
+	return nil
+}
+
+// RejectOwnerListings скрывает все объявления владельца. Возвращает количество измененных объявлений.
+func (r listingRepository) RejectOwnerListings(ctx context.Context, ownerID string, currentTime time.Time) (int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ListingRepository.RejectOwnerListings")
+	defer span.End()
+
+	match := bson.D{
+		{Key: "owner_id", Value: ownerID},
+		notRejected,
+	}
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "moderation", Value: entity.ModerationStatusRejected},
+		{Key: "updated_at", Value: currentTime},
+	}}}
+
+	res, err := r.collection.UpdateMany(ctx, match, update)
+	if err != nil {
+		return 0, fmt.Errorf("скрытие объявлений владельца: %w", err)
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// toObjectIDs преобразует строковые идентификаторы в ObjectID.
+func toObjectIDs(ids []string) ([]primitive.ObjectID, error) {
+	objIDs := make([]primitive.ObjectID, 0, len(ids))
+
+	for _, id := range ids {
+		objID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+		}
+
+		objIDs = append(objIDs, objID)
+	}
+
+	return objIDs, nil
+}
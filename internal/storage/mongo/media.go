@@ -0,0 +1,169 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitlab.com/example/gophers/libs/trace"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// mediaRepository репозиторий медиафайлов.
+type mediaRepository struct {
+	collection *mongo.Collection    // Коллекция медиафайлов
+	tracer     trace.TracerProvider // Отслеживает запросы между слоями и микросервисами
+}
+
+// NewMediaRepository возвращает новый экземпляр репозитория медиафайлов.
+func NewMediaRepository(collection *mongo.Collection, tracer trace.TracerProvider) repository.MediaRepository {
+	return &mediaRepository{collection: collection, tracer: tracer}
+}
+
+// CreateMedia сохраняет медиафайл.
+func (r mediaRepository) CreateMedia(ctx context.Context, media *entity.Media) (string, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "MediaRepository.CreateMedia")
+	defer span.End()
+
+	document := bson.D{
+		{Key: "owner_id", Value: media.OwnerID},
+		{Key: "content_type", Value: media.ContentType},
+		{Key: "size", Value: media.Size},
+		{Key: "width", Value: media.Width},
+		{Key: "height", Value: media.Height},
+		{Key: "key", Value: media.Key},
+		{Key: "thumbnail_key", Value: media.ThumbnailKey},
+		{Key: "created_at", Value: media.CreatedAt},
+	}
+
+	res, err := r.collection.InsertOne(ctx, document)
+	if err != nil {
+		return "", fmt.Errorf("сохранение медиафайла: %w", err)
+	}
+
+	objID, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return "", fmt.Errorf("%w: %v", entity.ErrInvalidObjectID, res.InsertedID)
+	}
+
+	media.ID = objID.Hex()
+
+	return media.ID, nil
+}
+
+// GetMediaByID возвращает медиафайл по идентификатору.
+func (r mediaRepository) GetMediaByID(ctx context.Context, id string) (*entity.Media, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "MediaRepository.GetMediaByID")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	var media entity.Media
+	if err = r.collection.FindOne(ctx, bson.D{{Key: "_id", Value: idObj}}).Decode(&media); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, entity.ErrMediaNotFound
+		}
+
+		return nil, fmt.Errorf("получение медиафайла: %w", err)
+	}
+
+	return &media, nil
+}
+
+// GetMediaBySubject возвращает медиафайлы, прикрепленные к сущности, в порядке загрузки.
+func (r mediaRepository) GetMediaBySubject(
+	ctx context.Context,
+	subjectType entity.MediaSubject,
+	subjectID string,
+) (entity.MediaList, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "MediaRepository.GetMediaBySubject")
+	defer span.End()
+
+	match := bson.D{
+		{Key: "subject_type", Value: subjectType},
+		{Key: "subject_id", Value: subjectID},
+	}
+
+	cursor, err := r.collection.Find(ctx, match, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("получение медиафайлов сущности: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	list := make(entity.MediaList, 0, cursor.RemainingBatchLength())
+	if err = cursor.All(ctx, &list); err != nil {
+		return nil, fmt.Errorf("декодирование медиафайлов: %w", err)
+	}
+
+	return list, nil
+}
+
+// AttachMedia прикрепляет медиафайл к сущности. Условие на subject_id делает прикрепление
+// атомарным: из двух параллельных запросов к разным сущностям успешен только один.
+func (r mediaRepository) AttachMedia(ctx context.Context, id string, subjectType entity.MediaSubject, subjectID string) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "MediaRepository.AttachMedia")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	match := bson.D{
+		{Key: "_id", Value: idObj},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "subject_id", Value: bson.D{{Key: "$exists", Value: false}}}},
+			bson.D{{Key: "subject_type", Value: subjectType}, {Key: "subject_id", Value: subjectID}},
+		}},
+	}
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "subject_type", Value: subjectType},
+		{Key: "subject_id", Value: subjectID},
+	}}}
+
+	res, err := r.collection.UpdateOne(ctx, match, update)
+	if err != nil {
+		return fmt.Errorf("прикрепление медиафайла: %w", err)
+	}
+
+	if res.MatchedCount == 0 {
+		count, cErr := r.collection.CountDocuments(ctx, bson.D{{Key: "_id", Value: idObj}})
+		if cErr != nil {
+			return fmt.Errorf("проверка медиафайла: %w", cErr)
+		}
+
+		if count == 0 {
+			return entity.ErrMediaNotFound
+		}
+
+		return entity.ErrMediaAttached
+	}
+
+	return nil
+}
+
+// DeleteMedia удаляет медиафайл. Отсутствующий файл не считается ошибкой.
+func (r mediaRepository) DeleteMedia(ctx context.Context, id string) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "MediaRepository.DeleteMedia")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	if _, err = r.collection.DeleteOne(ctx, bson.D{{Key: "_id", Value: idObj}}); err != nil {
+		return fmt.Errorf("удаление медиафайла: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitlab.com/example/gophers/libs/trace"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// notRejected условие публичного документа: текст не отклонен модератором.
+// Документы без статуса модерации сохранены до ее появления и считаются одобренными.
+var notRejected = bson.E{Key: "moderation", Value: bson.D{{Key: "$ne", Value: entity.ModerationStatusRejected}}}
+
+// moderationRepository репозиторий очереди модерации.
+type moderationRepository struct {
+	collection *mongo.Collection    // Коллекция дел модерации
+	tracer     trace.TracerProvider // Отслеживает запросы между слоями и микросервисами
+}
+
+// NewModerationRepository возвращает новый экземпляр репозитория очереди модерации.
+func NewModerationRepository(collection *mongo.Collection, tracer trace.TracerProvider) repository.ModerationRepository {
+	return &moderationRepository{collection: collection, tracer: tracer}
+}
+
+// CreateCase сохраняет дело. Уникальный частичный индекс не дает открыть второе дело по сущности.
+func (r moderationRepository) CreateCase(ctx context.Context, moderationCase *entity.ModerationCase) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ModerationRepository.CreateCase")
+	defer span.End()
+
+	res, err := r.collection.InsertOne(ctx, bson.D{
+		{Key: "subject_type", Value: moderationCase.SubjectType},
+		{Key: "subject_id", Value: moderationCase.SubjectID},
+		{Key: "author_id", Value: moderationCase.AuthorID},
+		{Key: "text", Value: moderationCase.Text},
+		{Key: "violations", Value: moderationCase.Violations},
+		{Key: "reports", Value: moderationCase.Reports},
+		{Key: "reported", Value: len(moderationCase.Reports) > 0},
+		{Key: "status", Value: moderationCase.Status},
+		{Key: "version", Value: moderationCase.Version},
+		{Key: "updated_at", Value: moderationCase.UpdatedAt},
+		{Key: "created_at", Value: moderationCase.CreatedAt},
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return entity.ErrModerationConflict
+		}
+
+		return fmt.Errorf("сохранение дела модерации: %w", err)
+	}
+
+	objID, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidObjectID, res.InsertedID)
+	}
+
+	moderationCase.ID = objID.Hex()
+
+	return nil
+}
+
+// GetCaseByID возвращает дело по идентификатору.
+func (r moderationRepository) GetCaseByID(ctx context.Context, id string) (*entity.ModerationCase, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ModerationRepository.GetCaseByID")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	return r.findOne(ctx, bson.D{{Key: "_id", Value: idObj}})
+}
+
+// GetOpenCase возвращает открытое дело по сущности.
+func (r moderationRepository) GetOpenCase(
+	ctx context.Context,
+	subjectType entity.ModerationSubjectType,
+	subjectID string,
+) (*entity.ModerationCase, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ModerationRepository.GetOpenCase")
+	defer span.End()
+
+	return r.findOne(ctx, bson.D{
+		{Key: "subject_type", Value: subjectType},
+		{Key: "subject_id", Value: subjectID},
+		{Key: "status", Value: entity.ModerationCaseStatusOpen},
+	})
+}
+
+// GetQueue возвращает дела по фильтру: первыми те, что ждут решения дольше.
+func (r moderationRepository) GetQueue(ctx context.Context, filter form.ModerationQueueGet) (entity.ModerationCases, int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ModerationRepository.GetQueue")
+	defer span.End()
+
+	match := bson.D{{Key: "status", Value: filter.Status}}
+
+	if filter.SubjectType != "" {
+		match = append(match, bson.E{Key: "subject_type", Value: filter.SubjectType})
+	}
+
+	if filter.Reported {
+		match = append(match, bson.E{Key: "reported", Value: true})
+	}
+
+	count, err := r.collection.CountDocuments(ctx, match)
+	if err != nil {
+		return nil, 0, fmt.Errorf("подсчет дел модерации: %w", err)
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}).
+		SetSkip(int64(filter.Pagination.Offset())).
+		SetLimit(int64(filter.Pagination.Limit))
+
+	cursor, err := r.collection.Find(ctx, match, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение дел модерации: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	cases := make(entity.ModerationCases, 0, cursor.RemainingBatchLength())
+	if err = cursor.All(ctx, &cases); err != nil {
+		return nil, 0, fmt.Errorf("декодирование дел модерации: %w", err)
+	}
+
+	return cases, count, nil
+}
+
+// UpdateCase сохраняет изменяемые поля дела и увеличивает его версию.
+func (r moderationRepository) UpdateCase(ctx context.Context, moderationCase *entity.ModerationCase) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "ModerationRepository.UpdateCase")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(moderationCase.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	match := bson.D{
+		{Key: "_id", Value: idObj},
+		{Key: "version", Value: moderationCase.Version},
+	}
+
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "text", Value: moderationCase.Text},
+		{Key: "violations", Value: moderationCase.Violations},
+		{Key: "reports", Value: moderationCase.Reports},
+		{Key: "reported", Value: len(moderationCase.Reports) > 0},
+		{Key: "status", Value: moderationCase.Status},
+		{Key: "decision", Value: moderationCase.Decision},
+		{Key: "version", Value: moderationCase.Version + 1},
+		{Key: "updated_at", Value: moderationCase.UpdatedAt},
+	}}}
+
+	res, err := r.collection.UpdateOne(ctx, match, update)
+	if err != nil {
+		return fmt.Errorf("обновление дела модерации: %w", err)
+	}
+
+	if res.MatchedCount == 0 {
+		return entity.ErrModerationConflict
+	}
+
+	moderationCase.Version++
+
+	return nil
+}
+
+// findOne возвращает одно дело по условию.
+func (r moderationRepository) findOne(ctx context.Context, match bson.D) (*entity.ModerationCase, error) {
+	var moderationCase entity.ModerationCase
+	if err := r.collection.FindOne(ctx, match).Decode(&moderationCase); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, entity.ErrModerationCaseNotFound
+		}
+
+		return nil, fmt.Errorf("получение дела модерации: %w", err)
+	}
+
+	return &moderationCase, nil
+}
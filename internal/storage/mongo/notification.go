@@ -0,0 +1,261 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.com/example/gophers/libs/trace"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// unreadFilter условие отбора непрочитанных уведомлений.
+var unreadFilter = bson.E{Key: "read_at", Value: bson.D{{Key: "$exists", Value: false}}}
+
+// notificationRepository репозиторий уведомлений.
+type notificationRepository struct {
+	preferences *mongo.Collection    // Коллекция настроек уведомлений
+	devices     *mongo.Collection    // Коллекция токенов устройств
+	inbox       *mongo.Collection    // Коллекция входящих уведомлений
+	tracer      trace.TracerProvider // Отслеживает запросы между слоями и микросервисами
+}
+
+// NewNotificationRepository возвращает новый экземпляр репозитория уведомлений.
+func NewNotificationRepository(
+	preferences, devices, inbox *mongo.Collection,
+	tracer trace.TracerProvider,
+) repository.NotificationRepository {
+	return &notificationRepository{preferences: preferences, devices: devices, inbox: inbox, tracer: tracer}
+}
+
+// GetNotificationPreferences возвращает настройки уведомлений пользователя.
+func (r notificationRepository) GetNotificationPreferences(ctx context.Context, userID string) (*entity.NotificationPreferences, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "NotificationRepository.GetNotificationPreferences")
+	defer span.End()
+
+	var preferences entity.NotificationPreferences
+
+	err := r.preferences.FindOne(ctx, bson.D{{Key: "_id", Value: userID}}).Decode(&preferences)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, entity.ErrNotificationPreferencesNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("получение настроек уведомлений: %w", err)
+	}
+
+	if preferences.Channels == nil {
+		preferences.Channels = map[entity.NotificationKind]entity.NotificationChannels{}
+	}
+
+	return &preferences, nil
+}
+
+// SaveNotificationPreferences сохраняет настройки уведомлений пользователя целиком.
+func (r notificationRepository) SaveNotificationPreferences(ctx context.Context, preferences *entity.NotificationPreferences) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "NotificationRepository.SaveNotificationPreferences")
+	defer span.End()
+
+	_, err := r.preferences.ReplaceOne(ctx,
+		bson.D{{Key: "_id", Value: preferences.UserID}},
+		preferences,
+		options.Replace().SetUpsert(true),
+	)
+	if err != nil {
+		return fmt.Errorf("сохранение настроек уведомлений: %w", err)
+	}
+
+	return nil
+}
+
+// SaveDeviceToken сохраняет токен устройства. Токен другого пользователя переходит к новому владельцу.
+func (r notificationRepository) SaveDeviceToken(ctx context.Context, device *entity.DeviceToken) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "NotificationRepository.SaveDeviceToken")
+	defer span.End()
+
+	_, err := r.devices.ReplaceOne(ctx,
+		bson.D{{Key: "_id", Value: device.Token}},
+		device,
+		options.Replace().SetUpsert(true),
+	)
+	if err != nil {
+		return fmt.Errorf("сохранение устройства: %w", err)
+	}
+
+	return nil
+}
+
+// GetDeviceTokens возвращает устройства пользователя.
+func (r notificationRepository) GetDeviceTokens(ctx context.Context, userID string) (entity.DeviceTokens, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "NotificationRepository.GetDeviceTokens")
+	defer span.End()
+
+	cursor, err := r.devices.Find(ctx, bson.D{{Key: "user_id", Value: userID}})
+	if err != nil {
+		return nil, fmt.Errorf("получение устройств: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	devices := make(entity.DeviceTokens, 0, cursor.RemainingBatchLength())
+	if err = cursor.All(ctx, &devices); err != nil {
+		return nil, fmt.Errorf("декодирование устройств: %w", err)
+	}
+
+	return devices, nil
+}
+
+// DeleteDeviceToken удаляет устройство пользователя.
+func (r notificationRepository) DeleteDeviceToken(ctx context.Context, userID, token string) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "NotificationRepository.DeleteDeviceToken")
+	defer span.End()
+
+	res, err := r.devices.DeleteOne(ctx, bson.D{{Key: "_id", Value: token}, {Key: "user_id", Value: userID}})
+	if err != nil {
+		return fmt.Errorf("удаление устройства: %w", err)
+	}
+
+	if res.DeletedCount == 0 {
+		return entity.ErrDeviceTokenNotFound
+	}
+
+	return nil
+}
+
+// CreateInboxNotification сохраняет уведомление во входящих пользователя.
+func (r notificationRepository) CreateInboxNotification(ctx context.Context, notification *entity.InboxNotification) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "NotificationRepository.CreateInboxNotification")
+	defer span.End()
+
+	document := bson.D{
+		{Key: "user_id", Value: notification.UserID},
+		{Key: "kind", Value: notification.Kind},
+		{Key: "category", Value: notification.Category},
+		{Key: "title", Value: notification.Title},
+		{Key: "body", Value: notification.Body},
+		{Key: "created_at", Value: notification.CreatedAt},
+		{Key: "expires_at", Value: notification.ExpiresAt},
+	}
+
+	if len(notification.Data) > 0 {
+		document = append(document, bson.E{Key: "data", Value: notification.Data})
+	}
+
+	res, err := r.inbox.InsertOne(ctx, document)
+	if err != nil {
+		return fmt.Errorf("сохранение уведомления: %w", err)
+	}
+
+	objID, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidObjectID, res.InsertedID)
+	}
+
+	notification.ID = objID.Hex()
+
+	return nil
+}
+
+// GetInboxNotifications возвращает входящие пользователя от новых к старым и их общее количество.
+func (r notificationRepository) GetInboxNotifications(
+	ctx context.Context,
+	filter form.InboxGet,
+) (entity.InboxNotifications, int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "NotificationRepository.GetInboxNotifications")
+	defer span.End()
+
+	match := bson.D{{Key: "user_id", Value: filter.UserID}}
+
+	if filter.Category != "" {
+		match = append(match, bson.E{Key: "category", Value: filter.Category})
+	}
+
+	if filter.UnreadOnly {
+		match = append(match, unreadFilter)
+	}
+
+	count, err := r.inbox.CountDocuments(ctx, match)
+	if err != nil {
+		return nil, 0, fmt.Errorf("подсчет уведомлений: %w", err)
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(int64(filter.Pagination.Offset())).
+		SetLimit(int64(filter.Pagination.Limit))
+
+	cursor, err := r.inbox.Find(ctx, match, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение списка уведомлений: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	notifications := make(entity.InboxNotifications, 0, cursor.RemainingBatchLength())
+	if err = cursor.All(ctx, &notifications); err != nil {
+		return nil, 0, fmt.Errorf("декодирование списка уведомлений: %w", err)
+	}
+
+	return notifications, count, nil
+}
+
+// MarkInboxNotificationRead отмечает уведомление пользователя прочитанным.
+// Дата прочтения уже прочитанного уведомления не меняется.
+func (r notificationRepository) MarkInboxNotificationRead(ctx context.Context, userID, id string, readAt time.Time) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "NotificationRepository.MarkInboxNotificationRead")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	match := bson.D{{Key: "_id", Value: idObj}, {Key: "user_id", Value: userID}}
+	update := bson.D{{Key: "$min", Value: bson.D{{Key: "read_at", Value: readAt}}}}
+
+	res, err := r.inbox.UpdateOne(ctx, match, update)
+	if err != nil {
+		return fmt.Errorf("отметка о прочтении уведомления: %w", err)
+	}
+
+	if res.MatchedCount == 0 {
+		return entity.ErrInboxNotificationNotFound
+	}
+
+	return nil
+}
+
+// MarkAllInboxNotificationsRead отмечает все непрочитанные уведомления пользователя прочитанными.
+func (r notificationRepository) MarkAllInboxNotificationsRead(ctx context.Context, userID string, readAt time.Time) (int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "NotificationRepository.MarkAllInboxNotificationsRead")
+	defer span.End()
+
+	match := bson.D{{Key: "user_id", Value: userID}, unreadFilter}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "read_at", Value: readAt}}}}
+
+	res, err := r.inbox.UpdateMany(ctx, match, update)
+	if err != nil {
+		return 0, fmt.Errorf("отметка о прочтении уведомлений: %w", err)
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// CountUnreadInboxNotifications возвращает количество непрочитанных уведомлений пользователя.
+func (r notificationRepository) CountUnreadInboxNotifications(ctx context.Context, userID string) (int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "NotificationRepository.CountUnreadInboxNotifications")
+	defer span.End()
+
+	count, err := r.inbox.CountDocuments(ctx, bson.D{{Key: "user_id", Value: userID}, unreadFilter})
+	if err != nil {
+		return 0, fmt.Errorf("подсчет непрочитанных уведомлений: %w", err)
+	}
+
+	return count, nil
+}
@@ -9,6 +9,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/alisher-99/LomBarter/internal/domain/entity"
 	"github.com/alisher-99/LomBarter/internal/domain/form"
@@ -31,13 +32,7 @@ func (o ordersRepository) CreateOrder(ctx context.Context, order *entity.Order)
 	ctx, span := o.tracer.Tracer(tracerName).Start(ctx, "OrdersRepository.CreateOrder")
 	defer span.End()
 
-	document := bson.D{
-		{Key: "user_id", Value: order.UserID},
-		{Key: "cost", Value: order.Cost},
-		{Key: "created_at", Value: order.CreatedAt},
-	}
-
-	res, err := o.collection.InsertOne(ctx, document)
+	res, err := o.collection.InsertOne(ctx, orderDocument(order))
 	if err != nil {
 		return fmt.Errorf("добавление документа в коллекцию: %w", err)
 	}
@@ -52,16 +47,111 @@ func (o ordersRepository) CreateOrder(ctx context.Context, order *entity.Order)
 	return nil
 }
 
+// CreateOrders сохраняет пачку заказов с заданными идентификаторами.
+func (o ordersRepository) CreateOrders(ctx context.Context, orders []*entity.Order) (map[int]error, error) {
+	ctx, span := o.tracer.Tracer(tracerName).Start(ctx, "OrdersRepository.CreateOrders")
+	defer span.End()
+
+	ids := make([]primitive.ObjectID, 0, len(orders))
+	documents := make([]interface{}, 0, len(orders))
+
+	for _, order := range orders {
+		idObj, err := primitive.ObjectIDFromHex(order.ID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+		}
+
+		ids = append(ids, idObj)
+		documents = append(documents, append(bson.D{{Key: "_id", Value: idObj}}, orderDocument(order)...))
+	}
+
+	errs, err := insertBatch(ctx, o.collection, ids, documents)
+	if err != nil {
+		return nil, fmt.Errorf("сохранение заказов: %w", err)
+	}
+
+	return errs, nil
+}
+
+// orderDocument возвращает документ нового заказа без идентификатора.
+func orderDocument(order *entity.Order) bson.D {
+	document := bson.D{
+		{Key: "user_id", Value: order.UserID},
+		{Key: "cost", Value: order.Cost},
+		{Key: "status", Value: order.Status},
+		{Key: "history", Value: order.History},
+		{Key: "created_at", Value: order.CreatedAt},
+	}
+
+	if order.CounterpartyID != "" {
+		document = append(document, bson.E{Key: "counterparty_id", Value: order.CounterpartyID})
+	}
+
+	if order.TradeOfferID != "" {
+		document = append(document,
+			bson.E{Key: "trade_offer_id", Value: order.TradeOfferID},
+			bson.E{Key: "legs", Value: order.Legs},
+		)
+	}
+
+	return document
+}
+
+// ExportOrders передает в fn заказы по фильтру в порядке создания.
+// Заказы читаются курсором, поэтому выгрузка не собирается в памяти.
+func (o ordersRepository) ExportOrders(ctx context.Context, filter form.OrdersExport, fn func(*entity.Order) error) error {
+	ctx, span := o.tracer.Tracer(tracerName).Start(ctx, "OrdersRepository.ExportOrders")
+	defer span.End()
+
+	match := bson.D{}
+
+	if filter.UserID != "" {
+		match = append(match, bson.E{Key: "user_id", Value: filter.UserID})
+	}
+
+	if filter.Status != "" {
+		match = append(match, bson.E{Key: "status", Value: filter.Status})
+	}
+
+	if period := createdPeriod(filter.From, filter.To); period != nil {
+		match = append(match, bson.E{Key: "created_at", Value: period})
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetProjection(bson.D{{Key: "history", Value: 0}})
+
+	cursor, err := o.collection.Find(ctx, match, opts)
+	if err != nil {
+		return fmt.Errorf("получение заказов: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var order entity.Order
+		if err = cursor.Decode(&order); err != nil {
+			return fmt.Errorf("декодирование заказа: %w", err)
+		}
+
+		if err = fn(&order); err != nil {
+			return err
+		}
+	}
+
+	return cursor.Err()
+}
+
 // GetOrdersForClient возвращает список заказов для клиента.
 func (o ordersRepository) GetOrdersForClient(ctx context.Context, filter form.OrdersGetForClient) (entity.Orders, error) {
 	ctx, span := o.tracer.Tracer(tracerName).Start(ctx, "OrdersRepository.GetOrdersForClient")
 	defer span.End()
 
-	match := bson.D{
-		{Key: "user_id", Value: filter.UserID},
-	}
+	match := bson.D{participantMatch(filter.UserID)}
+
+	// историю отдаем только по отдельному запросу
+	opts := options.Find().SetProjection(bson.D{{Key: "history", Value: 0}})
 
-	cur, err := o.collection.Find(ctx, match)
+	cur, err := o.collection.Find(ctx, match, opts)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, fmt.Errorf("получение списка заказов: %w", entity.ErrOrderNotFound)
@@ -90,11 +180,16 @@ func (o ordersRepository) GetOrderForClient(ctx context.Context, filter form.Ord
 
 	match := bson.D{
 		{Key: "_id", Value: idObj},
-		{Key: "user_id", Value: filter.UserID},
+		participantMatch(filter.UserID),
+	}
+
+	opts := options.FindOne()
+	if !filter.IsExpanded(form.ExpandHistory) {
+		opts.SetProjection(bson.D{{Key: "history", Value: 0}})
 	}
 
 	var order entity.Order
-	if err = o.collection.FindOne(ctx, match).Decode(&order); err != nil {
+	if err = o.collection.FindOne(ctx, match, opts).Decode(&order); err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, fmt.Errorf("получение списка заказов: %w", entity.ErrOrderNotFound)
 		}
@@ -104,3 +199,75 @@ func (o ordersRepository) GetOrderForClient(ctx context.Context, filter form.Ord
 
 	return &order, nil
 }
+
+// UpdateOrderStatus изменяет статус заказа и добавляет запись в историю.
+// Обновление выполняется только если статус заказа не был изменен параллельно.
+func (o ordersRepository) UpdateOrderStatus(ctx context.Context, order *entity.Order, change entity.OrderStatusChange) error {
+	ctx, span := o.tracer.Tracer(tracerName).Start(ctx, "OrdersRepository.UpdateOrderStatus")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(order.ID)
+	if err != nil {
+		return fmt.Errorf("получение идентификатора заказа: %w", entity.ErrInvalidObjectID)
+	}
+
+	match := bson.D{
+		{Key: "_id", Value: idObj},
+		{Key: "status", Value: change.From},
+	}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{{Key: "status", Value: change.To}}},
+		{Key: "$push", Value: bson.D{{Key: "history", Value: change}}},
+	}
+
+	res, err := o.collection.UpdateOne(ctx, match, update)
+	if err != nil {
+		return fmt.Errorf("обновление статуса заказа: %w", err)
+	}
+
+	if res.MatchedCount == 0 {
+		return entity.ErrOrderStatusConflict
+	}
+
+	return nil
+}
+
+// GetOrderHistory возвращает историю изменения статуса заказа.
+func (o ordersRepository) GetOrderHistory(ctx context.Context, filter form.OrderGetForClient) (entity.OrderHistory, error) {
+	ctx, span := o.tracer.Tracer(tracerName).Start(ctx, "OrdersRepository.GetOrderHistory")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(filter.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("получение идентификатора заказа: %w", entity.ErrInvalidObjectID)
+	}
+
+	match := bson.D{
+		{Key: "_id", Value: idObj},
+		participantMatch(filter.UserID),
+	}
+	opts := options.FindOne().SetProjection(bson.D{{Key: "history", Value: 1}})
+
+	var order entity.Order
+	if err = o.collection.FindOne(ctx, match, opts).Decode(&order); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("получение истории заказа: %w", entity.ErrOrderNotFound)
+		}
+
+		return nil, fmt.Errorf("получение истории заказа: %w", err)
+	}
+
+	if order.History == nil {
+		return entity.OrderHistory{}, nil
+	}
+
+	return order.History, nil
+}
+
+// participantMatch условие выборки заказов, в которых пользователь является одной из сторон.
+func participantMatch(userID string) bson.E {
+	return bson.E{Key: "$or", Value: bson.A{
+		bson.D{{Key: "user_id", Value: userID}},
+		bson.D{{Key: "counterparty_id", Value: userID}},
+	}}
+}
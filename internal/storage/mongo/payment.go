@@ -0,0 +1,192 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.com/example/gophers/libs/trace"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// paymentRepository репозиторий платежей по заказам.
+type paymentRepository struct {
+	payments *mongo.Collection    // Коллекция платежей
+	events   *mongo.Collection    // Коллекция обработанных уведомлений провайдера
+	tracer   trace.TracerProvider // Отслеживает запросы между слоями и микросервисами
+}
+
+// NewPaymentRepository возвращает новый экземпляр репозитория платежей.
+func NewPaymentRepository(payments, events *mongo.Collection, tracer trace.TracerProvider) repository.PaymentRepository {
+	return &paymentRepository{payments: payments, events: events, tracer: tracer}
+}
+
+// CreatePayment сохраняет платеж. Уникальный индекс по заказу не дает оплатить заказ дважды.
+func (r paymentRepository) CreatePayment(ctx context.Context, payment *entity.Payment) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "PaymentRepository.CreatePayment")
+	defer span.End()
+
+	document := bson.D{
+		{Key: "order_id", Value: payment.OrderID},
+		{Key: "provider_payment_id", Value: payment.ProviderPaymentID},
+		{Key: "payer_id", Value: payment.PayerID},
+		{Key: "payee_id", Value: payment.PayeeID},
+		{Key: "amount", Value: payment.Amount},
+		{Key: "refunded", Value: payment.Refunded},
+		{Key: "status", Value: payment.Status},
+		{Key: "version", Value: payment.Version},
+		{Key: "updated_at", Value: payment.UpdatedAt},
+		{Key: "created_at", Value: payment.CreatedAt},
+	}
+
+	if len(payment.Refunds) > 0 {
+		document = append(document, bson.E{Key: "refunds", Value: payment.Refunds})
+	}
+
+	res, err := r.payments.InsertOne(ctx, document)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return entity.ErrPaymentAlreadyExists
+		}
+
+		return fmt.Errorf("сохранение платежа: %w", err)
+	}
+
+	objID, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidObjectID, res.InsertedID)
+	}
+
+	payment.ID = objID.Hex()
+
+	return nil
+}
+
+// GetPaymentByOrderID возвращает платеж по заказу.
+func (r paymentRepository) GetPaymentByOrderID(ctx context.Context, orderID string) (*entity.Payment, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "PaymentRepository.GetPaymentByOrderID")
+	defer span.End()
+
+	return r.findPayment(ctx, bson.D{{Key: "order_id", Value: orderID}})
+}
+
+// GetPaymentByProviderID возвращает платеж по идентификатору у провайдера.
+func (r paymentRepository) GetPaymentByProviderID(ctx context.Context, providerPaymentID string) (*entity.Payment, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "PaymentRepository.GetPaymentByProviderID")
+	defer span.End()
+
+	return r.findPayment(ctx, bson.D{{Key: "provider_payment_id", Value: providerPaymentID}})
+}
+
+// UpdatePayment сохраняет статус, возвраты и ожидающую операцию платежа и увеличивает его версию.
+func (r paymentRepository) UpdatePayment(ctx context.Context, payment *entity.Payment) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "PaymentRepository.UpdatePayment")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(payment.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	match := bson.D{
+		{Key: "_id", Value: idObj},
+		{Key: "version", Value: payment.Version},
+	}
+
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "status", Value: payment.Status},
+		{Key: "refunded", Value: payment.Refunded},
+		{Key: "refunds", Value: payment.Refunds},
+		{Key: "settlement", Value: payment.Settlement},
+		{Key: "version", Value: payment.Version + 1},
+		{Key: "updated_at", Value: payment.UpdatedAt},
+	}}}
+
+	res, err := r.payments.UpdateOne(ctx, match, update)
+	if err != nil {
+		return fmt.Errorf("обновление платежа: %w", err)
+	}
+
+	if res.MatchedCount == 0 {
+		return entity.ErrPaymentConflict
+	}
+
+	payment.Version++
+
+	return nil
+}
+
+// GetPendingSettlements возвращает платежи с операцией, очередная попытка которой наступила, от давних к новым.
+func (r paymentRepository) GetPendingSettlements(ctx context.Context, dueAt time.Time, limit int64) (entity.Payments, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "PaymentRepository.GetPendingSettlements")
+	defer span.End()
+
+	match := bson.D{{Key: "settlement.next_attempt_at", Value: bson.D{{Key: "$lte", Value: dueAt}}}}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "settlement.next_attempt_at", Value: 1}}).
+		SetLimit(limit)
+
+	cursor, err := r.payments.Find(ctx, match, opts)
+	if err != nil {
+		return nil, fmt.Errorf("получение платежей с ожидающей операцией: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	payments := make(entity.Payments, 0, cursor.RemainingBatchLength())
+	if err = cursor.All(ctx, &payments); err != nil {
+		return nil, fmt.Errorf("декодирование платежей с ожидающей операцией: %w", err)
+	}
+
+	return payments, nil
+}
+
+// SaveWebhookEvent запоминает уведомление провайдера. Идентификатор уведомления служит _id.
+// Повтор ищется до вставки: ошибка уникального индекса внутри транзакции прерывает ее целиком,
+// поэтому на нее полагаемся только при параллельной доставке, и тогда транзакция откатывается.
+// Записи удаляются по TTL-индексу после expiresAt.
+func (r paymentRepository) SaveWebhookEvent(ctx context.Context, eventID string, receivedAt, expiresAt time.Time) (bool, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "PaymentRepository.SaveWebhookEvent")
+	defer span.End()
+
+	err := r.events.FindOne(ctx, bson.D{{Key: "_id", Value: eventID}}).Err()
+	if err == nil {
+		return false, nil
+	}
+
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return false, fmt.Errorf("поиск уведомления провайдера: %w", err)
+	}
+
+	_, err = r.events.InsertOne(ctx, bson.D{
+		{Key: "_id", Value: eventID},
+		{Key: "received_at", Value: receivedAt},
+		{Key: "expires_at", Value: expiresAt},
+	})
+	if err != nil {
+		return false, fmt.Errorf("сохранение уведомления провайдера: %w", err)
+	}
+
+	return true, nil
+}
+
+// findPayment возвращает первый платеж по фильтру.
+func (r paymentRepository) findPayment(ctx context.Context, match bson.D) (*entity.Payment, error) {
+	var payment entity.Payment
+	if err := r.payments.FindOne(ctx, match).Decode(&payment); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, entity.ErrPaymentNotFound
+		}
+
+		return nil, fmt.Errorf("получение платежа: %w", err)
+	}
+
+	return &payment, nil
+}
@@ -0,0 +1,91 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.com/example/gophers/libs/trace"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// ratingRepository репозиторий оценок.
+type ratingRepository struct {
+	collection *mongo.Collection    // Коллекция оценок
+	tracer     trace.TracerProvider // Отслеживает запросы между слоями и микросервисами
+}
+
+// NewRatingRepository возвращает новый экземпляр репозитория оценок.
+func NewRatingRepository(collection *mongo.Collection, tracer trace.TracerProvider) repository.RatingRepository {
+	return &ratingRepository{collection: collection, tracer: tracer}
+}
+
+// CreateRating сохраняет оценку. Если автор уже оценил заказ, возвращает entity.ErrRatingExists.
+func (r ratingRepository) CreateRating(ctx context.Context, rating *entity.Rating) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "RatingRepository.CreateRating")
+	defer span.End()
+
+	document := bson.D{
+		{Key: "order_id", Value: rating.OrderID},
+		{Key: "rater_id", Value: rating.RaterID},
+		{Key: "ratee_id", Value: rating.RateeID},
+		{Key: "score", Value: rating.Score},
+		{Key: "comment", Value: rating.Comment},
+		{Key: "created_at", Value: rating.CreatedAt},
+	}
+
+	res, err := r.collection.InsertOne(ctx, document)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return entity.ErrRatingExists
+		}
+
+		return fmt.Errorf("сохранение оценки: %w", err)
+	}
+
+	objID, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidObjectID, res.InsertedID)
+	}
+
+	rating.ID = objID.Hex()
+
+	return nil
+}
+
+// GetRatings возвращает оценки, полученные пользователем, и их общее количество.
+func (r ratingRepository) GetRatings(ctx context.Context, filter form.RatingsGet) (entity.Ratings, int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "RatingRepository.GetRatings")
+	defer span.End()
+
+	match := bson.D{{Key: "ratee_id", Value: filter.UserID}}
+
+	count, err := r.collection.CountDocuments(ctx, match)
+	if err != nil {
+		return nil, 0, fmt.Errorf("подсчет оценок: %w", err)
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: filter.Pagination.SortToInt()}}).
+		SetSkip(int64(filter.Pagination.Offset())).
+		SetLimit(int64(filter.Pagination.Limit))
+
+	cursor, err := r.collection.Find(ctx, match, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение списка оценок: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	ratings := make(entity.Ratings, 0, cursor.RemainingBatchLength())
+	if err = cursor.All(ctx, &ratings); err != nil {
+		return nil, 0, fmt.Errorf("декодирование списка оценок: %w", err)
+	}
+
+	return ratings, count, nil
+}
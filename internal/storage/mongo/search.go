@@ -0,0 +1,211 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gitlab.com/example/gophers/libs/trace"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+	"github.com/alisher-99/LomBarter/pkg/textsearch"
+)
+
+// searchTextIndex название текстового индекса. В коллекции MongoDB может быть только один текстовый индекс.
+const searchTextIndex = "search_text"
+
+// searchRepository репозиторий полнотекстового поиска на текстовых индексах MongoDB.
+// Стемминг и ранжирование выполняет MongoDB, индексы строятся для русского языка.
+type searchRepository struct {
+	users    *mongo.Collection    // Коллекция пользователей
+	listings *mongo.Collection    // Коллекция объявлений
+	tracer   trace.TracerProvider // Отслеживает запросы между слоями и микросервисами
+}
+
+// NewSearchRepository возвращает новый экземпляр репозитория полнотекстового поиска.
+func NewSearchRepository(users, listings *mongo.Collection, tracer trace.TracerProvider) repository.SearchRepository {
+	return &searchRepository{users: users, listings: listings, tracer: tracer}
+}
+
+// userSearchDocument пользователь с релевантностью.
+type userSearchDocument struct {
+	entity.User `bson:",inline"`
+	Score       float64 `bson:"score"` // Релевантность
+}
+
+// listingSearchDocument объявление с релевантностью.
+type listingSearchDocument struct {
+	entity.Listing `bson:",inline"`
+	Score          float64 `bson:"score"` // Релевантность
+}
+
+// Search ищет пользователей и объявления по тексту.
+// Из каждой коллекции берутся лучшие документы до конца запрошенной страницы, затем они объединяются по релевантности.
+func (r searchRepository) Search(ctx context.Context, filter form.Search) (entity.SearchResult, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "SearchRepository.Search")
+	defer span.End()
+
+	// Передаем только слова запроса, чтобы кавычки и минусы не превращались в операторы $text.
+	terms := textsearch.Terms(filter.Query)
+	if len(terms) == 0 {
+		return entity.SearchResult{}, entity.ErrSearchQueryEmpty
+	}
+
+	text := bson.E{Key: "$text", Value: bson.D{{Key: "$search", Value: strings.Join(terms, " ")}}}
+	offset := int64(filter.Pagination.Offset())
+	window := offset + int64(filter.Pagination.Limit)
+
+	result := entity.SearchResult{Facets: entity.NewSearchFacets()}
+	hits := make([]*entity.SearchHit, 0, window)
+
+	// Заблокированные пользователи и отклоненные модератором тексты не ищутся.
+	userMatch := bson.D{text, notRejected, {Key: "banned_at", Value: bson.D{{Key: "$exists", Value: false}}}}
+	if filter.Near != nil {
+		userMatch = append(userMatch, bson.E{Key: "location", Value: geoWithin(filter.Near)})
+	}
+
+	users, err := r.users.CountDocuments(ctx, userMatch)
+	if err != nil {
+		return entity.SearchResult{}, fmt.Errorf("подсчет найденных пользователей: %w", err)
+	}
+
+	result.Facets.Kinds[entity.SearchKindUser] = users
+
+	if filter.IncludesKind(entity.SearchKindUser) && users > 0 {
+		result.Count += users
+
+		var docs []userSearchDocument
+		if err = r.findTop(ctx, r.users, userMatch, window, &docs); err != nil {
+			return entity.SearchResult{}, fmt.Errorf("поиск пользователей: %w", err)
+		}
+
+		for i := range docs {
+			hits = append(hits, &entity.SearchHit{
+				Kind:  entity.SearchKindUser,
+				ID:    docs[i].ID,
+				Score: docs[i].Score,
+				User:  &docs[i].User,
+			})
+		}
+	}
+
+	listingMatch := bson.D{text, {Key: "status", Value: entity.ListingStatusActive}, notRejected}
+	if filter.Near != nil {
+		listingMatch = append(listingMatch, bson.E{Key: "location", Value: geoWithin(filter.Near)})
+	}
+
+	if err = r.countCategories(ctx, listingMatch, &result.Facets); err != nil {
+		return entity.SearchResult{}, fmt.Errorf("подсчет найденных объявлений: %w", err)
+	}
+
+	if filter.IncludesKind(entity.SearchKindListing) {
+		listings := result.Facets.Kinds[entity.SearchKindListing]
+		if filter.Category != "" {
+			listings = result.Facets.Categories[filter.Category]
+			listingMatch = append(listingMatch, bson.E{Key: "category", Value: filter.Category})
+		}
+
+		result.Count += listings
+
+		if listings > 0 {
+			var docs []listingSearchDocument
+			if err = r.findTop(ctx, r.listings, listingMatch, window, &docs); err != nil {
+				return entity.SearchResult{}, fmt.Errorf("поиск объявлений: %w", err)
+			}
+
+			for i := range docs {
+				hits = append(hits, &entity.SearchHit{
+					Kind:    entity.SearchKindListing,
+					ID:      docs[i].ID,
+					Score:   docs[i].Score,
+					Listing: &docs[i].Listing,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+
+		return hits[i].ID < hits[j].ID
+	})
+
+	if offset >= int64(len(hits)) {
+		result.Items = []*entity.SearchHit{}
+
+		return result, nil
+	}
+
+	if window > int64(len(hits)) {
+		window = int64(len(hits))
+	}
+
+	result.Items = hits[offset:window]
+
+	if filter.Near != nil {
+		for _, hit := range result.Items {
+			hit.FillDistance(filter.Near.Point)
+		}
+	}
+
+	return result, nil
+}
+
+// findTop декодирует в docs первые limit документов коллекции по релевантности.
+func (r searchRepository) findTop(ctx context.Context, collection *mongo.Collection, match bson.D, limit int64, docs interface{}) error {
+	score := bson.D{{Key: "$meta", Value: "textScore"}}
+
+	opts := options.Find().
+		SetProjection(bson.D{{Key: "score", Value: score}}).
+		SetSort(bson.D{{Key: "score", Value: score}}).
+		SetLimit(limit)
+
+	cursor, err := collection.Find(ctx, match, opts)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	return cursor.All(ctx, docs)
+}
+
+// countCategories заполняет фасеты объявлений: количество по категориям и общее количество.
+func (r searchRepository) countCategories(ctx context.Context, match bson.D, facets *entity.SearchFacets) error {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$category"},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	}
+
+	cursor, err := r.listings.Aggregate(ctx, pipeline)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	var groups []struct {
+		Category string `bson:"_id"`   // Категория
+		Count    int64  `bson:"count"` // Количество объявлений
+	}
+
+	if err = cursor.All(ctx, &groups); err != nil {
+		return err
+	}
+
+	for _, group := range groups {
+		facets.Categories[group.Category] = group.Count
+		facets.Kinds[entity.SearchKindListing] += group.Count
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.com/example/gophers/libs/trace"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// tradeCycleRepository репозиторий циклов обмена.
+type tradeCycleRepository struct {
+	collection *mongo.Collection    // Коллекция циклов обмена
+	tracer     trace.TracerProvider // Отслеживает запросы между слоями и микросервисами
+}
+
+// NewTradeCycleRepository возвращает новый экземпляр репозитория циклов обмена.
+func NewTradeCycleRepository(collection *mongo.Collection, tracer trace.TracerProvider) repository.TradeCycleRepository {
+	return &tradeCycleRepository{collection: collection, tracer: tracer}
+}
+
+// CreateTradeCycle сохраняет цикл обмена. Если такой цикл уже предложен, возвращает entity.ErrTradeCycleExists.
+func (r tradeCycleRepository) CreateTradeCycle(ctx context.Context, cycle *entity.TradeCycle) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "TradeCycleRepository.CreateTradeCycle")
+	defer span.End()
+
+	document := bson.D{
+		{Key: "key", Value: cycle.Key},
+		{Key: "legs", Value: cycle.Legs},
+		{Key: "participant_ids", Value: cycle.ParticipantIDs},
+		{Key: "accepted_by", Value: cycle.AcceptedBy},
+		{Key: "score", Value: cycle.Score},
+		{Key: "status", Value: cycle.Status},
+		{Key: "version", Value: cycle.Version},
+		{Key: "updated_at", Value: cycle.UpdatedAt},
+		{Key: "created_at", Value: cycle.CreatedAt},
+	}
+
+	res, err := r.collection.InsertOne(ctx, document)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return entity.ErrTradeCycleExists
+		}
+
+		return fmt.Errorf("сохранение цикла обмена: %w", err)
+	}
+
+	objID, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidObjectID, res.InsertedID)
+	}
+
+	cycle.ID = objID.Hex()
+
+	return nil
+}
+
+// GetTradeCycleByID возвращает цикл обмена по идентификатору.
+func (r tradeCycleRepository) GetTradeCycleByID(ctx context.Context, id string) (*entity.TradeCycle, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "TradeCycleRepository.GetTradeCycleByID")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	var cycle entity.TradeCycle
+	if err = r.collection.FindOne(ctx, bson.D{{Key: "_id", Value: idObj}}).Decode(&cycle); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, entity.ErrTradeCycleNotFound
+		}
+
+		return nil, fmt.Errorf("получение цикла обмена: %w", err)
+	}
+
+	return &cycle, nil
+}
+
+// GetTradeCycles возвращает список циклов обмена пользователя и их общее количество.
+func (r tradeCycleRepository) GetTradeCycles(ctx context.Context, filter form.TradeCyclesGet) (entity.TradeCycles, int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "TradeCycleRepository.GetTradeCycles")
+	defer span.End()
+
+	match := bson.D{{Key: "participant_ids", Value: filter.UserID}}
+
+	if filter.Status != "" {
+		match = append(match, bson.E{Key: "status", Value: filter.Status})
+	}
+
+	count, err := r.collection.CountDocuments(ctx, match)
+	if err != nil {
+		return nil, 0, fmt.Errorf("подсчет циклов обмена: %w", err)
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: filter.Pagination.SortToInt()}}).
+		SetSkip(int64(filter.Pagination.Offset())).
+		SetLimit(int64(filter.Pagination.Limit))
+
+	cursor, err := r.collection.Find(ctx, match, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение списка циклов обмена: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	cycles := make(entity.TradeCycles, 0, cursor.RemainingBatchLength())
+	if err = cursor.All(ctx, &cycles); err != nil {
+		return nil, 0, fmt.Errorf("декодирование списка циклов обмена: %w", err)
+	}
+
+	return cycles, count, nil
+}
+
+// UpdateTradeCycle сохраняет цикл обмена, если его версия не изменилась с момента чтения.
+func (r tradeCycleRepository) UpdateTradeCycle(ctx context.Context, cycle *entity.TradeCycle) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "TradeCycleRepository.UpdateTradeCycle")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(cycle.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	match := bson.D{
+		{Key: "_id", Value: idObj},
+		{Key: "version", Value: cycle.Version},
+	}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "accepted_by", Value: cycle.AcceptedBy},
+			{Key: "status", Value: cycle.Status},
+			{Key: "updated_at", Value: cycle.UpdatedAt},
+		}},
+		{Key: "$inc", Value: bson.D{{Key: "version", Value: 1}}},
+	}
+
+	res, err := r.collection.UpdateOne(ctx, match, update)
+	if err != nil {
+		return fmt.Errorf("обновление цикла обмена: %w", err)
+	}
+
+	if res.MatchedCount == 0 {
+		return entity.ErrTradeCycleConflict
+	}
+
+	cycle.Version++
+
+	return nil
+}
+
+// ExpireTradeCycles переводит предложенные циклы с объявлением в статус expired.
+// Возвращает количество измененных циклов.
+func (r tradeCycleRepository) ExpireTradeCycles(ctx context.Context, listingID string, currentTime time.Time) (int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "TradeCycleRepository.ExpireTradeCycles")
+	defer span.End()
+
+	match := bson.D{
+		{Key: "legs.listing_id", Value: listingID},
+		{Key: "status", Value: entity.TradeCycleStatusProposed},
+	}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "status", Value: entity.TradeCycleStatusExpired},
+			{Key: "updated_at", Value: currentTime},
+		}},
+		{Key: "$inc", Value: bson.D{{Key: "version", Value: 1}}},
+	}
+
+	res, err := r.collection.UpdateMany(ctx, match, update)
+	if err != nil {
+		return 0, fmt.Errorf("устаревание циклов обмена: %w", err)
+	}
+
+	return res.ModifiedCount, nil
+}
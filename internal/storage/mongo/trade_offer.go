@@ -0,0 +1,184 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitlab.com/example/gophers/libs/trace"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// tradeOfferRepository репозиторий предложений обмена.
+type tradeOfferRepository struct {
+	collection *mongo.Collection    // Коллекция предложений обмена
+	tracer     trace.TracerProvider // Отслеживает запросы между слоями и микросервисами
+}
+
+// NewTradeOfferRepository возвращает новый экземпляр репозитория предложений обмена.
+func NewTradeOfferRepository(collection *mongo.Collection, tracer trace.TracerProvider) repository.TradeOfferRepository {
+	return &tradeOfferRepository{collection: collection, tracer: tracer}
+}
+
+// CreateTradeOffer сохраняет предложение обмена.
+func (r tradeOfferRepository) CreateTradeOffer(ctx context.Context, offer *entity.TradeOffer) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "TradeOfferRepository.CreateTradeOffer")
+	defer span.End()
+
+	document := bson.D{
+		{Key: "proposer_id", Value: offer.ProposerID},
+		{Key: "recipient_id", Value: offer.RecipientID},
+		{Key: "offered_listing_ids", Value: offer.OfferedListingIDs},
+		{Key: "requested_listing_ids", Value: offer.RequestedListingIDs},
+		{Key: "message", Value: offer.Message},
+		{Key: "status", Value: offer.Status},
+		{Key: "updated_at", Value: offer.UpdatedAt},
+		{Key: "created_at", Value: offer.CreatedAt},
+	}
+
+	if offer.ParentID != "" {
+		document = append(document, bson.E{Key: "parent_id", Value: offer.ParentID})
+	}
+
+	if offer.Cash != nil {
+		document = append(document, bson.E{Key: "cash", Value: offer.Cash})
+	}
+
+	if offer.Fairness != nil {
+		document = append(document, bson.E{Key: "fairness", Value: offer.Fairness})
+	}
+
+	res, err := r.collection.InsertOne(ctx, document)
+	if err != nil {
+		return fmt.Errorf("сохранение предложения обмена: %w", err)
+	}
+
+	objID, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidObjectID, res.InsertedID)
+	}
+
+	offer.ID = objID.Hex()
+
+	return nil
+}
+
+// GetTradeOfferByID возвращает предложение обмена по идентификатору.
+func (r tradeOfferRepository) GetTradeOfferByID(ctx context.Context, id string) (*entity.TradeOffer, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "TradeOfferRepository.GetTradeOfferByID")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	var offer entity.TradeOffer
+	if err = r.collection.FindOne(ctx, bson.D{{Key: "_id", Value: idObj}}).Decode(&offer); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, entity.ErrTradeOfferNotFound
+		}
+
+		return nil, fmt.Errorf("получение предложения обмена: %w", err)
+	}
+
+	return &offer, nil
+}
+
+// GetTradeOffers возвращает список предложений обмена пользователя и их общее количество.
+func (r tradeOfferRepository) GetTradeOffers(ctx context.Context, filter form.TradeOffersGet) (entity.TradeOffers, int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "TradeOfferRepository.GetTradeOffers")
+	defer span.End()
+
+	var match bson.D
+
+	switch filter.Role {
+	case form.TradeOfferRoleIncoming:
+		match = bson.D{{Key: "recipient_id", Value: filter.UserID}}
+	case form.TradeOfferRoleOutgoing:
+		match = bson.D{{Key: "proposer_id", Value: filter.UserID}}
+	default:
+		match = bson.D{{Key: "$or", Value: bson.A{
+			bson.D{{Key: "recipient_id", Value: filter.UserID}},
+			bson.D{{Key: "proposer_id", Value: filter.UserID}},
+		}}}
+	}
+
+	if filter.Status != "" {
+		match = append(match, bson.E{Key: "status", Value: filter.Status})
+	}
+
+	count, err := r.collection.CountDocuments(ctx, match)
+	if err != nil {
+		return nil, 0, fmt.Errorf("подсчет предложений обмена: %w", err)
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: filter.Pagination.SortToInt()}}).
+		SetSkip(int64(filter.Pagination.Offset())).
+		SetLimit(int64(filter.Pagination.Limit))
+
+	cursor, err := r.collection.Find(ctx, match, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение списка предложений обмена: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	offers := make(entity.TradeOffers, 0, cursor.RemainingBatchLength())
+	if err = cursor.All(ctx, &offers); err != nil {
+		return nil, 0, fmt.Errorf("декодирование списка предложений обмена: %w", err)
+	}
+
+	return offers, count, nil
+}
+
+// UpdateTradeOfferStatus сохраняет новый статус предложения, если текущий статус равен from.
+func (r tradeOfferRepository) UpdateTradeOfferStatus(ctx context.Context, offer *entity.TradeOffer, from entity.TradeOfferStatus) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "TradeOfferRepository.UpdateTradeOfferStatus")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(offer.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	match := bson.D{
+		{Key: "_id", Value: idObj},
+		{Key: "status", Value: from},
+	}
+
+	set := bson.D{
+		{Key: "status", Value: offer.Status},
+		{Key: "updated_at", Value: offer.UpdatedAt},
+	}
+
+	if offer.CounterOfferID != "" {
+		set = append(set, bson.E{Key: "counter_offer_id", Value: offer.CounterOfferID})
+	}
+
+	if offer.OrderID != "" {
+		set = append(set, bson.E{Key: "order_id", Value: offer.OrderID})
+	}
+
+	if offer.Cash != nil && offer.Cash.PaymentID != "" {
+		set = append(set, bson.E{Key: "cash.payment_id", Value: offer.Cash.PaymentID})
+	}
+
+	res, err := r.collection.UpdateOne(ctx, match, bson.D{{Key: "$set", Value: set}})
+	if err != nil {
+		return fmt.Errorf("обновление статуса предложения обмена: %w", err)
+	}
+
+	if res.MatchedCount == 0 {
+		return entity.ErrTradeOfferConflict
+	}
+
+	return nil
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"gitlab.com/example/gophers/libs/trace"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/alisher-99/LomBarter/internal/domain/entity"
 	"github.com/alisher-99/LomBarter/internal/domain/form"
@@ -31,7 +33,12 @@ func (r userRepository) GetUsersByBio(ctx context.Context, filter form.UsersGetB
 	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "UserRepository.GetUsersByBio")
 	defer span.End()
 
-	match := bson.D{{Key: "bio", Value: filter.Bio}}
+	// Заблокированные пользователи и отклоненные биографии в поиск не попадают.
+	match := bson.D{
+		{Key: "bio", Value: filter.Bio},
+		notRejected,
+		{Key: "banned_at", Value: bson.D{{Key: "$exists", Value: false}}},
+	}
 
 	cursor, err := r.collection.Find(ctx, match)
 	if err != nil {
@@ -76,19 +83,95 @@ func (r userRepository) CreateUser(ctx context.Context, user *entity.User) (stri
 	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "UserRepository.CreateUser")
 	defer span.End()
 
+	res, err := r.collection.InsertOne(ctx, userDocument(user))
+	if err != nil {
+		return "", fmt.Errorf("сохранение пользователя: %w", err)
+	}
+
+	return res.InsertedID.(primitive.ObjectID).Hex(), nil
+}
+
+// CreateUsers сохраняет пачку пользователей с заданными идентификаторами.
+func (r userRepository) CreateUsers(ctx context.Context, users []*entity.User) (map[int]error, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "UserRepository.CreateUsers")
+	defer span.End()
+
+	ids := make([]primitive.ObjectID, 0, len(users))
+	documents := make([]interface{}, 0, len(users))
+
+	for _, user := range users {
+		idObj, err := primitive.ObjectIDFromHex(user.ID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+		}
+
+		ids = append(ids, idObj)
+		documents = append(documents, append(bson.D{{Key: "_id", Value: idObj}}, userDocument(user)...))
+	}
+
+	errs, err := insertBatch(ctx, r.collection, ids, documents)
+	if err != nil {
+		return nil, fmt.Errorf("сохранение пользователей: %w", err)
+	}
+
+	return errs, nil
+}
+
+// userDocument возвращает документ нового пользователя без идентификатора.
+func userDocument(user *entity.User) bson.D {
 	document := bson.D{
 		{Key: "name", Value: user.Name},
 		{Key: "bio", Value: user.Bio},
+		{Key: "moderation", Value: user.Moderation},
 		{Key: "created_at", Value: user.CreatedAt},
 		{Key: "updated_at", Value: user.UpdatedAt},
 	}
 
-	res, err := r.collection.InsertOne(ctx, document)
+	if user.City != "" {
+		document = append(document, bson.E{Key: "city", Value: user.City})
+	}
+
+	if user.Location != nil {
+		document = append(document, bson.E{Key: "location", Value: user.Location})
+	}
+
+	return document
+}
+
+// ExportUsers передает в fn пользователей по фильтру в порядке создания.
+// Пользователи читаются курсором, поэтому выгрузка не собирается в памяти.
+func (r userRepository) ExportUsers(ctx context.Context, filter form.UsersExport, fn func(*entity.User) error) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "UserRepository.ExportUsers")
+	defer span.End()
+
+	match := bson.D{}
+
+	if filter.City != "" {
+		match = append(match, bson.E{Key: "city", Value: filter.City})
+	}
+
+	if period := createdPeriod(filter.From, filter.To); period != nil {
+		match = append(match, bson.E{Key: "created_at", Value: period})
+	}
+
+	cursor, err := r.collection.Find(ctx, match, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
 	if err != nil {
-		return "", fmt.Errorf("сохранение пользователя: %w", err)
+		return fmt.Errorf("получение пользователей: %w", err)
 	}
+	defer cursor.Close(ctx)
 
-	return res.InsertedID.(primitive.ObjectID).Hex(), nil
+	for cursor.Next(ctx) {
+		var user entity.User
+		if err = cursor.Decode(&user); err != nil {
+			return fmt.Errorf("декодирование пользователя: %w", err)
+		}
+
+		if err = fn(&user); err != nil {
+			return err
+		}
+	}
+
+	return cursor.Err()
 }
 
 // UpdateUser обновляет пользователя.
@@ -102,13 +185,115 @@ func (r userRepository) UpdateUser(ctx context.Context, user *entity.User) error
 	}
 
 	match := bson.D{{Key: "_id", Value: idObj}}
-	update := bson.D{{Key: "$set", Value: bson.D{
+	set := bson.D{
 		{Key: "name", Value: user.Name},
 		{Key: "bio", Value: user.Bio},
+		{Key: "moderation", Value: user.Moderation},
 		{Key: "updated_at", Value: user.UpdatedAt},
-	}}}
+	}
+
+	if user.City != "" {
+		set = append(set, bson.E{Key: "city", Value: user.City})
+	}
+
+	if user.Location != nil {
+		set = append(set, bson.E{Key: "location", Value: user.Location})
+	}
+
+	update := bson.D{{Key: "$set", Value: set}}
 
 	_, err = r.collection.UpdateOne(ctx, match, update)
 
 	return err
 }
+
+// UpdateUserReputation сохраняет репутацию пользователя.
+func (r userRepository) UpdateUserReputation(ctx context.Context, userID string, reputation entity.Reputation) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "UserRepository.UpdateUserReputation")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	match := bson.D{{Key: "_id", Value: idObj}}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "reputation", Value: reputation}}}}
+
+	res, err := r.collection.UpdateOne(ctx, match, update)
+	if err != nil {
+		return fmt.Errorf("обновление репутации пользователя: %w", err)
+	}
+
+	if res.MatchedCount == 0 {
+		return entity.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// SetUserAvatar сохраняет идентификатор медиафайла аватара пользователя. Пустой идентификатор убирает аватар.
+func (r userRepository) SetUserAvatar(ctx context.Context, userID, mediaID string, currentTime time.Time) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "UserRepository.SetUserAvatar")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	match := bson.D{{Key: "_id", Value: idObj}}
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "avatar_id", Value: mediaID},
+		{Key: "updated_at", Value: currentTime},
+	}}}
+
+	if mediaID == "" {
+		update = bson.D{
+			{Key: "$unset", Value: bson.D{{Key: "avatar_id", Value: ""}}},
+			{Key: "$set", Value: bson.D{{Key: "updated_at", Value: currentTime}}},
+		}
+	}
+
+	res, err := r.collection.UpdateOne(ctx, match, update)
+	if err != nil {
+		return fmt.Errorf("обновление аватара пользователя: %w", err)
+	}
+
+	if res.MatchedCount == 0 {
+		return entity.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// UpdateUserModeration сохраняет статус модерации биографии и блокировку пользователя.
+func (r userRepository) UpdateUserModeration(ctx context.Context, user *entity.User) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "UserRepository.UpdateUserModeration")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(user.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	set := bson.D{
+		{Key: "moderation", Value: user.Moderation},
+		{Key: "updated_at", Value: user.UpdatedAt},
+	}
+
+	if user.BannedAt != nil {
+		set = append(set, bson.E{Key: "banned_at", Value: user.BannedAt})
+	}
+
+	res, err := r.collection.UpdateOne(ctx, bson.D{{Key: "_id", Value: idObj}}, bson.D{{Key: "$set", Value: set}})
+	if err != nil {
+		return fmt.Errorf("обновление статуса модерации пользователя: %w", err)
+	}
+
+	if res.MatchedCount == 0 {
+		return entity.ErrUserNotFound
+	}
+
+	return nil
+}
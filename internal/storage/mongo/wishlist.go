@@ -0,0 +1,214 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.com/example/gophers/libs/trace"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// wishlistRepository репозиторий списков желаний.
+type wishlistRepository struct {
+	items   *mongo.Collection    // Коллекция позиций списков желаний
+	matches *mongo.Collection    // Коллекция совпадений объявлений со списками желаний
+	tracer  trace.TracerProvider // Отслеживает запросы между слоями и микросервисами
+}
+
+// NewWishlistRepository возвращает новый экземпляр репозитория списков желаний.
+func NewWishlistRepository(items, matches *mongo.Collection, tracer trace.TracerProvider) repository.WishlistRepository {
+	return &wishlistRepository{items: items, matches: matches, tracer: tracer}
+}
+
+// CreateWishlistItem сохраняет позицию списка желаний.
+func (r wishlistRepository) CreateWishlistItem(ctx context.Context, item *entity.WishlistItem) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "WishlistRepository.CreateWishlistItem")
+	defer span.End()
+
+	// Пустой список состояний сохраняется массивом, чтобы по нему работал поиск кандидатов.
+	conditions := item.Conditions
+	if conditions == nil {
+		conditions = []entity.ListingCondition{}
+	}
+
+	document := bson.D{
+		{Key: "user_id", Value: item.UserID},
+		{Key: "query", Value: item.Query},
+		{Key: "keywords", Value: item.Keywords},
+		{Key: "category", Value: item.Category},
+		{Key: "conditions", Value: conditions},
+		{Key: "updated_at", Value: item.UpdatedAt},
+		{Key: "created_at", Value: item.CreatedAt},
+	}
+
+	if item.Location != nil {
+		document = append(document,
+			bson.E{Key: "location", Value: item.Location},
+			bson.E{Key: "radius_km", Value: item.RadiusKm},
+		)
+	}
+
+	res, err := r.items.InsertOne(ctx, document)
+	if err != nil {
+		return fmt.Errorf("сохранение позиции списка желаний: %w", err)
+	}
+
+	objID, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidObjectID, res.InsertedID)
+	}
+
+	item.ID = objID.Hex()
+
+	return nil
+}
+
+// CountWishlistItems возвращает количество позиций в списке желаний пользователя.
+func (r wishlistRepository) CountWishlistItems(ctx context.Context, userID string) (int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "WishlistRepository.CountWishlistItems")
+	defer span.End()
+
+	count, err := r.items.CountDocuments(ctx, bson.D{{Key: "user_id", Value: userID}})
+	if err != nil {
+		return 0, fmt.Errorf("подсчет позиций списка желаний: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetWishlistItems возвращает список желаний пользователя и общее количество позиций.
+func (r wishlistRepository) GetWishlistItems(ctx context.Context, filter form.WishlistGet) (entity.WishlistItems, int64, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "WishlistRepository.GetWishlistItems")
+	defer span.End()
+
+	match := bson.D{{Key: "user_id", Value: filter.UserID}}
+
+	count, err := r.items.CountDocuments(ctx, match)
+	if err != nil {
+		return nil, 0, fmt.Errorf("подсчет позиций списка желаний: %w", err)
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: filter.Pagination.SortToInt()}}).
+		SetSkip(int64(filter.Pagination.Offset())).
+		SetLimit(int64(filter.Pagination.Limit))
+
+	cursor, err := r.items.Find(ctx, match, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("получение списка желаний: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	items := make(entity.WishlistItems, 0, cursor.RemainingBatchLength())
+	if err = cursor.All(ctx, &items); err != nil {
+		return nil, 0, fmt.Errorf("декодирование списка желаний: %w", err)
+	}
+
+	return items, count, nil
+}
+
+// DeleteWishlistItem удаляет позицию из списка желаний пользователя.
+func (r wishlistRepository) DeleteWishlistItem(ctx context.Context, userID, itemID string) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "WishlistRepository.DeleteWishlistItem")
+	defer span.End()
+
+	idObj, err := primitive.ObjectIDFromHex(itemID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidObjectID, err.Error())
+	}
+
+	res, err := r.items.DeleteOne(ctx, bson.D{
+		{Key: "_id", Value: idObj},
+		{Key: "user_id", Value: userID},
+	})
+	if err != nil {
+		return fmt.Errorf("удаление позиции списка желаний: %w", err)
+	}
+
+	if res.DeletedCount == 0 {
+		return entity.ErrWishlistItemNotFound
+	}
+
+	return nil
+}
+
+// GetWishlistCandidates возвращает позиции чужих списков желаний, которые могут подойти под объявление.
+func (r wishlistRepository) GetWishlistCandidates(
+	ctx context.Context,
+	listing *entity.Listing,
+	limit int64,
+) (entity.WishlistItems, error) {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "WishlistRepository.GetWishlistCandidates")
+	defer span.End()
+
+	match := bson.D{
+		{Key: "user_id", Value: bson.D{{Key: "$ne", Value: listing.OwnerID}}},
+		// Все слова запроса должны встретиться среди слов объявления.
+		{Key: "keywords", Value: bson.D{{Key: "$not", Value: bson.D{
+			{Key: "$elemMatch", Value: bson.D{{Key: "$nin", Value: listing.SearchTerms()}}},
+		}}}},
+		{Key: "$and", Value: bson.A{
+			bson.D{{Key: "category", Value: bson.D{{Key: "$in", Value: bson.A{"", listing.Category}}}}},
+			bson.D{{Key: "$or", Value: bson.A{
+				bson.D{{Key: "conditions", Value: bson.D{{Key: "$size", Value: 0}}}},
+				bson.D{{Key: "conditions", Value: listing.Condition}},
+			}}},
+		}},
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(limit)
+
+	cursor, err := r.items.Find(ctx, match, opts)
+	if err != nil {
+		return nil, fmt.Errorf("поиск позиций списков желаний: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	items := make(entity.WishlistItems, 0, cursor.RemainingBatchLength())
+	if err = cursor.All(ctx, &items); err != nil {
+		return nil, fmt.Errorf("декодирование позиций списков желаний: %w", err)
+	}
+
+	return items, nil
+}
+
+// CreateWishlistMatch сохраняет совпадение. Если пользователь уже уведомлен об объявлении,
+// возвращает entity.ErrWishlistMatchExists.
+func (r wishlistRepository) CreateWishlistMatch(ctx context.Context, match *entity.WishlistMatch) error {
+	ctx, span := r.tracer.Tracer(tracerName).Start(ctx, "WishlistRepository.CreateWishlistMatch")
+	defer span.End()
+
+	document := bson.D{
+		{Key: "user_id", Value: match.UserID},
+		{Key: "listing_id", Value: match.ListingID},
+		{Key: "item_ids", Value: match.ItemIDs},
+		{Key: "created_at", Value: match.CreatedAt},
+	}
+
+	res, err := r.matches.InsertOne(ctx, document)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return entity.ErrWishlistMatchExists
+		}
+
+		return fmt.Errorf("сохранение совпадения со списком желаний: %w", err)
+	}
+
+	objID, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidObjectID, res.InsertedID)
+	}
+
+	match.ID = objID.Hex()
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// Fake платежный провайдер в памяти процесса. Деньги никуда не списываются, платежи хранятся
+// до перезапуска. Идентификаторы выдаются по порядку, поэтому результаты в тестах предсказуемы.
+// Отказ провайдера имитируется через Decline, уведомления подписываются через Webhook.
+type Fake struct {
+	mu        sync.Mutex                         // Защищает поля ниже
+	seq       int                                // Счетчик идентификаторов
+	payments  map[string]*entity.ProviderPayment // Платежи по идентификатору
+	byKey     map[string]*entity.ProviderPayment // Блокировки по ключу идемпотентности
+	refunds   map[string]*entity.PaymentRefund   // Возвраты по ключу идемпотентности
+	declining map[string]struct{}                // Плательщики, чьи платежи отклоняются
+	signer    WebhookSigner                      // Подпись уведомлений
+	now       func() time.Time                   // Текущее время
+}
+
+// NewFake создает платежного провайдера в памяти процесса.
+func NewFake(signer WebhookSigner) *Fake {
+	return &Fake{
+		payments:  make(map[string]*entity.ProviderPayment),
+		byKey:     make(map[string]*entity.ProviderPayment),
+		refunds:   make(map[string]*entity.PaymentRefund),
+		declining: make(map[string]struct{}),
+		signer:    signer,
+		now:       func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// WithClock подменяет текущее время провайдера.
+func (f *Fake) WithClock(now func() time.Time) *Fake {
+	f.now = now
+
+	return f
+}
+
+// Decline включает отказ во всех следующих платежах плательщика.
+func (f *Fake) Decline(payerID string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.declining[payerID] = struct{}{}
+}
+
+// Payments возвращает копии всех платежей по порядку создания.
+func (f *Fake) Payments() []entity.ProviderPayment {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	payments := make([]entity.ProviderPayment, 0, len(f.payments))
+	for _, payment := range f.payments {
+		payments = append(payments, *payment)
+	}
+
+	sort.Slice(payments, func(i, j int) bool { return payments[i].ID < payments[j].ID })
+
+	return payments
+}
+
+// Authorize блокирует сумму. Повторный запрос с тем же ключом возвращает первую блокировку.
+func (f *Fake) Authorize(_ context.Context, charge entity.PaymentCharge) (*entity.ProviderPayment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if payment, ok := f.byKey[charge.IdempotencyKey]; ok && charge.IdempotencyKey != "" {
+		copied := *payment
+
+		return &copied, nil
+	}
+
+	if _, ok := f.declining[charge.PayerID]; ok {
+		return nil, fmt.Errorf("%w: плательщик %s", entity.ErrPaymentDeclined, charge.PayerID)
+	}
+
+	payment := &entity.ProviderPayment{
+		ID:       f.nextID("pay"),
+		Status:   entity.PaymentStatusAuthorized,
+		Amount:   charge.Amount,
+		Refunded: entity.NewMoney(0, charge.Amount.Currency),
+	}
+
+	f.payments[payment.ID] = payment
+	if charge.IdempotencyKey != "" {
+		f.byKey[charge.IdempotencyKey] = payment
+	}
+
+	copied := *payment
+
+	return &copied, nil
+}
+
+// Capture списывает заблокированную сумму.
+func (f *Fake) Capture(_ context.Context, paymentID string) (*entity.ProviderPayment, error) {
+	return f.transition(paymentID, entity.PaymentStatusCaptured)
+}
+
+// Void снимает блокировку.
+func (f *Fake) Void(_ context.Context, paymentID string) (*entity.ProviderPayment, error) {
+	return f.transition(paymentID, entity.PaymentStatusVoided)
+}
+
+// Refund возвращает часть или всю списанную сумму. Повторный запрос с тем же ключом возвращает первый возврат.
+func (f *Fake) Refund(
+	_ context.Context,
+	paymentID string,
+	amount entity.Money,
+	idempotencyKey string,
+) (*entity.PaymentRefund, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if refund, ok := f.refunds[idempotencyKey]; ok && idempotencyKey != "" {
+		copied := *refund
+
+		return &copied, nil
+	}
+
+	payment, ok := f.payments[paymentID]
+	if !ok {
+		return nil, entity.ErrPaymentNotFound
+	}
+
+	if !payment.Status.IsRefundable() || amount.Currency != payment.Amount.Currency ||
+		amount.Amount <= 0 || amount.Amount > payment.Amount.Amount-payment.Refunded.Amount {
+		return nil, fmt.Errorf("%w: %d %s", entity.ErrPaymentRefundExceeds, amount.Amount, amount.Currency)
+	}
+
+	payment.Refunded.Amount += amount.Amount
+
+	payment.Status = entity.PaymentStatusPartiallyRefunded
+	if payment.Refunded.Amount == payment.Amount.Amount {
+		payment.Status = entity.PaymentStatusRefunded
+	}
+
+	refund := &entity.PaymentRefund{ID: f.nextID("refund"), Amount: amount, CreatedAt: f.now()}
+	if idempotencyKey != "" {
+		f.refunds[idempotencyKey] = refund
+	}
+
+	copied := *refund
+
+	return &copied, nil
+}
+
+// VerifyWebhook проверяет подпись уведомления и декодирует его.
+func (f *Fake) VerifyWebhook(payload []byte, signature string, now time.Time) (*entity.PaymentWebhookEvent, error) {
+	if err := f.signer.Verify(payload, signature, now); err != nil {
+		return nil, err
+	}
+
+	var event entity.PaymentWebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrPaymentWebhookDecode, err)
+	}
+
+	return &event, nil
+}
+
+// Webhook возвращает подписанное уведомление о текущем состоянии платежа, как его отправил бы провайдер.
+func (f *Fake) Webhook(paymentID string) (payload []byte, signature string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	payment, ok := f.payments[paymentID]
+	if !ok {
+		return nil, "", entity.ErrPaymentNotFound
+	}
+
+	now := f.now()
+
+	payload, err = json.Marshal(entity.PaymentWebhookEvent{
+		ID:                f.nextID("evt"),
+		ProviderPaymentID: payment.ID,
+		Status:            payment.Status,
+		Refunded:          payment.Refunded,
+		OccurredAt:        now,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("сериализация уведомления: %w", err)
+	}
+
+	return payload, f.signer.Sign(payload, now), nil
+}
+
+// transition переводит платеж в новый статус. Повторный переход в тот же статус ничего не меняет.
+func (f *Fake) transition(paymentID string, to entity.PaymentStatus) (*entity.ProviderPayment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	payment, ok := f.payments[paymentID]
+	if !ok {
+		return nil, entity.ErrPaymentNotFound
+	}
+
+	if payment.Status != to {
+		if !payment.Status.CanTransitionTo(to) {
+			return nil, fmt.Errorf("%w: %s -> %s", entity.ErrPaymentTransition, payment.Status, to)
+		}
+
+		payment.Status = to
+	}
+
+	copied := *payment
+
+	return &copied, nil
+}
+
+// nextID возвращает следующий идентификатор с префиксом. Вызывается под блокировкой.
+func (f *Fake) nextID(prefix string) string {
+	f.seq++
+
+	return fmt.Sprintf("fake_%s_%06d", prefix, f.seq)
+}
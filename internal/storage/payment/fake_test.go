@@ -0,0 +1,83 @@
+package payment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+func TestFake(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	provider := NewFake(NewWebhookSigner("secret", 5*time.Minute)).WithClock(func() time.Time { return now })
+
+	charge := entity.PaymentCharge{
+		IdempotencyKey: "order:1",
+		PayerID:        "alice",
+		PayeeID:        "bob",
+		Amount:         entity.NewMoney(5000, entity.CurrencyKZT),
+	}
+
+	payment, err := provider.Authorize(ctx, charge)
+	require.NoError(t, err)
+	assert.Equal(t, "fake_pay_000001", payment.ID)
+	assert.Equal(t, entity.PaymentStatusAuthorized, payment.Status)
+
+	// Повтор с тем же ключом не создает второй платеж.
+	repeated, err := provider.Authorize(ctx, charge)
+	require.NoError(t, err)
+	assert.Equal(t, payment.ID, repeated.ID)
+	assert.Len(t, provider.Payments(), 1)
+
+	// Вернуть можно только списанные деньги.
+	_, err = provider.Refund(ctx, payment.ID, entity.NewMoney(1000, entity.CurrencyKZT), "refund:1")
+	assert.ErrorIs(t, err, entity.ErrPaymentRefundExceeds)
+
+	captured, err := provider.Capture(ctx, payment.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.PaymentStatusCaptured, captured.Status)
+
+	_, err = provider.Void(ctx, payment.ID)
+	assert.ErrorIs(t, err, entity.ErrPaymentTransition)
+
+	refund, err := provider.Refund(ctx, payment.ID, entity.NewMoney(1000, entity.CurrencyKZT), "refund:1")
+	require.NoError(t, err)
+
+	repeatedRefund, err := provider.Refund(ctx, payment.ID, entity.NewMoney(1000, entity.CurrencyKZT), "refund:1")
+	require.NoError(t, err)
+	assert.Equal(t, refund.ID, repeatedRefund.ID, "повтор возврата не возвращает деньги дважды")
+
+	_, err = provider.Refund(ctx, payment.ID, entity.NewMoney(4001, entity.CurrencyKZT), "refund:2")
+	assert.ErrorIs(t, err, entity.ErrPaymentRefundExceeds)
+
+	_, err = provider.Refund(ctx, payment.ID, entity.NewMoney(4000, entity.CurrencyKZT), "refund:2")
+	require.NoError(t, err)
+	assert.Equal(t, entity.PaymentStatusRefunded, provider.Payments()[0].Status)
+
+	_, err = provider.Capture(ctx, "unknown")
+	assert.ErrorIs(t, err, entity.ErrPaymentNotFound)
+
+	provider.Decline("alice")
+
+	charge.IdempotencyKey = "order:2"
+	_, err = provider.Authorize(ctx, charge)
+	assert.ErrorIs(t, err, entity.ErrPaymentDeclined)
+	assert.Len(t, provider.Payments(), 1)
+
+	// Уведомление провайдера проходит проверку подписи.
+	payload, signature, err := provider.Webhook(payment.ID)
+	require.NoError(t, err)
+
+	event, err := provider.VerifyWebhook(payload, signature, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, payment.ID, event.ProviderPaymentID)
+	assert.Equal(t, entity.PaymentStatusRefunded, event.Status)
+	assert.Equal(t, int64(5000), event.Refunded.Amount)
+}
@@ -0,0 +1,28 @@
+// Package payment реализует платежных провайдеров для оплаты заказов и доплат к обменам.
+package payment
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/alisher-99/LomBarter/internal/config"
+	"github.com/alisher-99/LomBarter/internal/domain/repository"
+)
+
+// Названия платежных провайдеров.
+const (
+	ProviderFake = "fake" // Провайдер в памяти процесса для разработки и тестов
+)
+
+// ErrInvalidProvider неизвестный платежный провайдер.
+var ErrInvalidProvider = errors.New("неверное название платежного провайдера, доступные: fake")
+
+// New создает платежного провайдера по конфигурации.
+func New(cfg *config.Payment) (repository.PaymentProvider, error) {
+	switch cfg.PaymentProvider {
+	case ProviderFake:
+		return NewFake(NewWebhookSigner(cfg.WebhookSecret, cfg.WebhookTolerance)), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidProvider, cfg.PaymentProvider)
+	}
+}
@@ -0,0 +1,82 @@
+package payment
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// Части заголовка подписи уведомления: t=<время в секундах Unix>,v1=<HMAC-SHA256 в hex>.
+const (
+	timestampPart = "t"
+	signaturePart = "v1"
+)
+
+// WebhookSigner подписывает и проверяет уведомления провайдера HMAC-SHA256.
+// В подпись входит время отправки, поэтому перехваченное уведомление нельзя повторить после tolerance.
+type WebhookSigner struct {
+	key       []byte        // Общий с провайдером секрет
+	tolerance time.Duration // Допустимое расхождение времени отправки и проверки
+}
+
+// NewWebhookSigner создает подписчика уведомлений.
+func NewWebhookSigner(key string, tolerance time.Duration) WebhookSigner {
+	return WebhookSigner{key: []byte(key), tolerance: tolerance}
+}
+
+// Sign возвращает подпись уведомления payload, отправленного в sentAt.
+func (s WebhookSigner) Sign(payload []byte, sentAt time.Time) string {
+	unix := strconv.FormatInt(sentAt.Unix(), 10)
+
+	return timestampPart + "=" + unix + "," + signaturePart + "=" + s.signature(payload, unix)
+}
+
+// Verify проверяет подпись уведомления payload в момент now.
+func (s WebhookSigner) Verify(payload []byte, signature string, now time.Time) error {
+	parts := make(map[string]string, 2)
+
+	for _, part := range strings.Split(signature, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if ok {
+			parts[key] = value
+		}
+	}
+
+	unix := parts[timestampPart]
+
+	sentAt, err := strconv.ParseInt(unix, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: нет времени отправки", entity.ErrPaymentWebhookSignature)
+	}
+
+	given, err := hex.DecodeString(parts[signaturePart])
+	if err != nil {
+		return fmt.Errorf("%w: подпись не в hex", entity.ErrPaymentWebhookSignature)
+	}
+
+	expected, _ := hex.DecodeString(s.signature(payload, unix))
+	if !hmac.Equal(given, expected) {
+		return entity.ErrPaymentWebhookSignature
+	}
+
+	if drift := now.Sub(time.Unix(sentAt, 0)); drift > s.tolerance || drift < -s.tolerance {
+		return fmt.Errorf("%w: уведомление отправлено %s назад", entity.ErrPaymentWebhookSignature, drift)
+	}
+
+	return nil
+}
+
+// signature возвращает подпись времени отправки и тела уведомления.
+func (s WebhookSigner) signature(payload []byte, unix string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(unix + "."))
+	mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
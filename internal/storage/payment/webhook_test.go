@@ -0,0 +1,52 @@
+package payment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+func TestWebhookSigner_Verify(t *testing.T) {
+	t.Parallel()
+
+	sentAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	signer := NewWebhookSigner("secret", 5*time.Minute)
+	payload := []byte(`{"id":"evt_1","status":"captured"}`)
+	signature := signer.Sign(payload, sentAt)
+
+	cases := []struct {
+		name      string
+		payload   []byte
+		signature string
+		now       time.Time
+		wantErr   bool
+	}{
+		{name: "верная подпись", payload: payload, signature: signature, now: sentAt.Add(time.Minute)},
+		{name: "часы провайдера спешат", payload: payload, signature: signature, now: sentAt.Add(-time.Minute)},
+		{name: "измененное тело", payload: []byte(`{"id":"evt_1","status":"refunded"}`), signature: signature, now: sentAt, wantErr: true},
+		{name: "другой ключ", payload: payload, signature: NewWebhookSigner("other", time.Minute).Sign(payload, sentAt), now: sentAt, wantErr: true},
+		{name: "повтор после допуска", payload: payload, signature: signature, now: sentAt.Add(6 * time.Minute), wantErr: true},
+		{name: "пустая подпись", payload: payload, signature: "", now: sentAt, wantErr: true},
+		{name: "подпись не в hex", payload: payload, signature: "t=1704110400,v1=zz", now: sentAt, wantErr: true},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := signer.Verify(s.payload, s.signature, s.now)
+			if s.wantErr {
+				require.ErrorIs(t, err, entity.ErrPaymentWebhookSignature)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
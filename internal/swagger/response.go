@@ -3,10 +3,25 @@ package swagger
 
 // HTTPResponse400 структура, которая отображается как тело ответа при 400 коде возврата от HTTP.
 type HTTPResponse400 struct {
-	Code string `json:"code" example:"TMP_INVALID_USER"` // Код ошибки.
+	Code    string              `json:"code" example:"TMP_INVALID_USER"`              // Код ошибки.
+	Message string              `json:"message" example:"Неверные параметры запроса"` // Сообщение на языке из заголовка Accept-Language.
+	Fields  []HTTPResponseField `json:"fields,omitempty"`                             // Ошибки полей формы.
+}
+
+// HTTPResponseField структура, которая отображается как ошибка поля формы в теле ответа при 400 коде возврата от HTTP.
+type HTTPResponseField struct {
+	Field   string `json:"field" example:"subjectID"`           // Поле формы.
+	Message string `json:"message" example:"Обязательное поле"` // Сообщение на языке из заголовка Accept-Language.
+}
+
+// HTTPResponse404 структура, которая отображается как тело ответа при 404 коде возврата от HTTP.
+type HTTPResponse404 struct {
+	Code    string `json:"code" example:"TMP_USER_NOT_FOUND"`        // Код ошибки.
+	Message string `json:"message" example:"Пользователь не найден"` // Сообщение на языке из заголовка Accept-Language.
 }
 
 // HTTPResponse500 структура, которая отображается как тело ответа при 500 коде возврата от HTTP.
 type HTTPResponse500 struct {
-	Code string `json:"code" example:"TMP_INTERNAL"` // Код ошибки.
+	Code    string `json:"code" example:"TMP_INTERNAL"`                 // Код ошибки.
+	Message string `json:"message" example:"Внутренняя ошибка сервера"` // Сообщение на языке из заголовка Accept-Language.
 }
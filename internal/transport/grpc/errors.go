@@ -0,0 +1,35 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// Status переводит ошибку в статус gRPC: код статуса берется из реестра ошибок entity.LookupError,
+// в сообщении передается код ошибки API. Ошибки, уже содержащие статус gRPC, возвращаются как есть.
+func Status(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	spec := entity.LookupError(err)
+
+	return status.Error(spec.GRPCCode, spec.Code)
+}
+
+// ErrorInterceptor возвращает перехватчик, который переводит ошибки обработчиков в статусы gRPC.
+func ErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+
+		return resp, Status(err)
+	}
+}
@@ -0,0 +1,24 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/middleware"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	v1 "github.com/alisher-99/LomBarter/internal/transport/http/resources/v1"
+)
+
+// auditActor добавляет в контекст запроса автора изменений для журнала изменений.
+// Должен подключаться после middleware.RequestID, чтобы записи журнала ссылались на запрос.
+func auditActor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := entity.WithAuditActor(r.Context(), entity.AuditActor{
+			ID:        r.Header.Get(v1.HeaderXUserID),
+			Source:    entity.AuditSourceHTTP,
+			RequestID: middleware.GetReqID(r.Context()),
+		})
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
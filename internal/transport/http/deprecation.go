@@ -0,0 +1,33 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	headerDeprecation = "Deprecation" // Дата, с которой API устарело, RFC 9745
+	headerSunset      = "Sunset"      // Дата отключения API, RFC 8594
+)
+
+// deprecated добавляет к ответам на запросы с префиксом пути заголовки Deprecation и Sunset.
+// Нулевая дата не выводится.
+func deprecated(prefix string, deprecatedAt, sunsetAt time.Time) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasPrefix(r.URL.Path, prefix) {
+				if !deprecatedAt.IsZero() {
+					w.Header().Set(headerDeprecation, "@"+strconv.FormatInt(deprecatedAt.Unix(), 10))
+				}
+
+				if !sunsetAt.IsZero() {
+					w.Header().Set(headerSunset, sunsetAt.UTC().Format(http.TimeFormat))
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprecated(t *testing.T) {
+	t.Parallel()
+
+	deprecatedAt := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	sunsetAt := time.Date(2027, time.April, 19, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name         string
+		path         string
+		deprecatedAt time.Time
+		sunsetAt     time.Time
+		expDeprec    string
+		expSunset    string
+	}{
+		{
+			name:         "устаревший путь",
+			path:         "/api/v1/users",
+			deprecatedAt: deprecatedAt,
+			sunsetAt:     sunsetAt,
+			expDeprec:    "@1792368000",
+			expSunset:    "Mon, 19 Apr 2027 00:00:00 GMT",
+		},
+		{name: "актуальный путь", path: "/api/v2/users", deprecatedAt: deprecatedAt, sunsetAt: sunsetAt},
+		{name: "дата отключения не задана", path: "/api/v1/users", deprecatedAt: deprecatedAt, expDeprec: "@1792368000"},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := deprecated("/api/v1/", s.deprecatedAt, s.sunsetAt)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, s.path, nil))
+
+			assert.Equal(t, s.expDeprec, w.Header().Get(headerDeprecation))
+			assert.Equal(t, s.expSunset, w.Header().Get(headerSunset))
+		})
+	}
+}
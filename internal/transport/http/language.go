@@ -0,0 +1,18 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+)
+
+// language добавляет в контекст запроса язык сообщений из заголовка Accept-Language.
+// Заголовок разбирается один раз, обработчики и ответы с ошибками берут язык из контекста.
+func language(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := entity.WithLanguage(r.Context(), entity.ParseLanguage(r.Header.Get(detector.HeaderAcceptLanguage)))
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,48 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+)
+
+func TestLanguage(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		header string
+		exp    entity.Language
+	}{
+		{name: "региональный вариант", header: "kk-KZ, en;q=0.5", exp: entity.LanguageKk},
+		{name: "вес языка", header: "ru;q=0.3, en;q=0.8", exp: entity.LanguageEn},
+		{name: "без заголовка", exp: entity.DefaultLanguage},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			var lang entity.Language
+
+			handler := language(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				lang = entity.LanguageFromContext(r.Context())
+			}))
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if s.header != "" {
+				r.Header.Set(detector.HeaderAcceptLanguage, s.header)
+			}
+
+			handler.ServeHTTP(httptest.NewRecorder(), r)
+			assert.Equal(t, s.exp, lang)
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"gitlab.com/example/gophers/libs/trace"
 
 	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources"
+	"github.com/alisher-99/LomBarter/pkg/openapi"
 )
 
 // Option определяет функцию для настройки HTTP сервера.
@@ -24,6 +26,41 @@ func WithOrdersService(ordersService service.OrdersService) Option {
 	}
 }
 
+// WithListingService добавляет сервис объявлений в HTTP сервер.
+func WithListingService(listingService service.ListingService) Option {
+	return func(srv *Server) {
+		srv.listingService = listingService
+	}
+}
+
+// WithTradeOfferService добавляет сервис предложений обмена в HTTP сервер.
+func WithTradeOfferService(tradeOfferService service.TradeOfferService) Option {
+	return func(srv *Server) {
+		srv.tradeOfferService = tradeOfferService
+	}
+}
+
+// WithTradeCycleService добавляет сервис циклов обмена в HTTP сервер.
+func WithTradeCycleService(tradeCycleService service.TradeCycleService) Option {
+	return func(srv *Server) {
+		srv.tradeCycleService = tradeCycleService
+	}
+}
+
+// WithWishlistService добавляет сервис списков желаний в HTTP сервер.
+func WithWishlistService(wishlistService service.WishlistService) Option {
+	return func(srv *Server) {
+		srv.wishlistService = wishlistService
+	}
+}
+
+// WithRatingService добавляет сервис оценок в HTTP сервер.
+func WithRatingService(ratingService service.RatingService) Option {
+	return func(srv *Server) {
+		srv.ratingService = ratingService
+	}
+}
+
 // WithLogger добавляет логгер в HTTP сервер.
 func WithLogger(log logger.Logger) Option {
 	return func(srv *Server) {
@@ -37,3 +74,87 @@ func WithTracer(tracer trace.TracerProvider) Option {
 		srv.tracer = tracer
 	}
 }
+
+// WithConversationService добавляет сервис переписок в HTTP сервер.
+func WithConversationService(conversationService service.ConversationService) Option {
+	return func(srv *Server) {
+		srv.conversationService = conversationService
+	}
+}
+
+// WithSearchService добавляет сервис полнотекстового поиска в HTTP сервер.
+func WithSearchService(searchService service.SearchService) Option {
+	return func(srv *Server) {
+		srv.searchService = searchService
+	}
+}
+
+// WithMediaService добавляет сервис медиафайлов в HTTP сервер.
+func WithMediaService(mediaService service.MediaService) Option {
+	return func(srv *Server) {
+		srv.mediaService = mediaService
+	}
+}
+
+// WithNotificationService добавляет сервис настроек уведомлений в HTTP сервер.
+func WithNotificationService(notificationService service.NotificationService) Option {
+	return func(srv *Server) {
+		srv.notificationService = notificationService
+	}
+}
+
+// WithWalletService добавляет сервис кошельков кредитов в HTTP сервер.
+func WithWalletService(walletService service.WalletService) Option {
+	return func(srv *Server) {
+		srv.walletService = walletService
+	}
+}
+
+// WithPaymentService добавляет сервис оплаты заказов в HTTP сервер.
+func WithPaymentService(paymentService service.PaymentService) Option {
+	return func(srv *Server) {
+		srv.paymentService = paymentService
+	}
+}
+
+// WithDisputeService добавляет сервис споров по заказам в HTTP сервер.
+func WithDisputeService(disputeService service.DisputeService) Option {
+	return func(srv *Server) {
+		srv.disputeService = disputeService
+	}
+}
+
+// WithAuditService добавляет сервис журнала изменений в HTTP сервер.
+func WithAuditService(auditService service.AuditService) Option {
+	return func(srv *Server) {
+		srv.auditService = auditService
+	}
+}
+
+// WithModerationService добавляет сервис модерации пользовательских текстов в HTTP сервер.
+func WithModerationService(moderationService service.ModerationService) Option {
+	return func(srv *Server) {
+		srv.moderationService = moderationService
+	}
+}
+
+// WithBulkService добавляет сервис массового импорта и экспорта в HTTP сервер.
+func WithBulkService(bulkService service.BulkService) Option {
+	return func(srv *Server) {
+		srv.bulkService = bulkService
+	}
+}
+
+// WithBlobFiles включает раздачу файлов локального хранилища по подписанным ссылкам.
+func WithBlobFiles(opener resources.SignedBlobOpener) Option {
+	return func(srv *Server) {
+		srv.blobFiles = opener
+	}
+}
+
+// WithOpenAPI подключает документ OpenAPI 3: раздачу документа и проверку запросов по нему.
+func WithOpenAPI(doc *openapi.Document) Option {
+	return func(srv *Server) {
+		srv.openAPI = doc
+	}
+}
@@ -0,0 +1,53 @@
+package resources
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+)
+
+// SignedBlobOpener открывает файл хранилища по подписанной ссылке.
+type SignedBlobOpener interface {
+	// OpenSigned проверяет подпись ссылки и открывает файл по ключу.
+	OpenSigned(ctx context.Context, key string, query url.Values) (*entity.Blob, error)
+}
+
+// BlobFilesResource для раздачи файлов локального хранилища по подписанным ссылкам.
+// Для S3 ссылки ведут прямо в бакет, и этот ресурс не нужен.
+type BlobFilesResource struct {
+	Opener SignedBlobOpener
+}
+
+// Routes возвращает роутер для раздачи файлов хранилища.
+func (br BlobFilesResource) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/*", br.Get)
+
+	return r
+}
+
+// Get отдает файл хранилища, если подпись ссылки верна и срок ее действия не истек.
+func (br BlobFilesResource) Get(w http.ResponseWriter, r *http.Request) {
+	blob, err := br.Opener.OpenSigned(r.Context(), chi.URLParam(r, "*"), r.URL.Query())
+	if err != nil {
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+	defer blob.Body.Close()
+
+	w.Header().Set("Content-Type", blob.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(blob.Size, 10))
+	w.WriteHeader(http.StatusOK)
+
+	_, _ = io.Copy(w, blob.Body)
+}
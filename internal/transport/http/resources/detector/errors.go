@@ -2,77 +2,76 @@ package detector
 
 import (
 	"errors"
+	"net/http"
 
 	"github.com/go-chi/render"
 	"gitlab.com/example/gophers/libs/errors/httperrors"
 	"gitlab.com/example/gophers/libs/validate"
 
 	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/pkg/openapi"
 )
 
 // Error обрабатывает ошибки, возникающие при работе с ресурсами.
+// Код и HTTP статус берутся из реестра ошибок entity.LookupError.
+// Сообщение об ошибке и ошибки полей формы переводятся на язык запроса при отправке ответа.
 func Error(err error) render.Renderer {
 	if err == nil {
 		return nil
 	}
 
+	return ErrorResponse(err)
+}
+
+// ErrorResponse возвращает ответ с ошибкой так же, как Error. Используется, когда ответ
+// отдается в другом формате, например в конверте API v2.
+func ErrorResponse(err error) *Response {
 	validationErr := validate.ValidationError{}
 	if errors.As(err, &validationErr) {
-		return httperrors.BadRequest(err, validationErr.Code)
-	}
+		rules := make([]fieldRule, 0, len(validationErr.Fields))
+		for _, field := range validationErr.Fields {
+			rules = append(rules, fieldRule{field: field.Field, tag: field.Tag, param: field.Param})
+		}
 
-	renderer := pageDetect(err)
-	if renderer != nil {
-		return renderer
+		return &Response{
+			Response:   httperrors.BadRequest(err, validationErr.Code),
+			status:     http.StatusBadRequest,
+			validation: true,
+			rules:      rules,
+		}
 	}
 
-	renderer = userDetect(err)
-	if renderer != nil {
-		return renderer
-	}
+	spec := entity.LookupError(err)
+	resp := &Response{Response: response(err, spec), status: spec.HTTPStatus}
 
-	renderer = ordersDetect(err)
-	if renderer != nil {
-		return renderer
+	// Нарушения документации OpenAPI переводятся так же, как ошибки полей формы.
+	schemaErrs := openapi.Errors{}
+	if errors.As(err, &schemaErrs) {
+		for _, schemaErr := range schemaErrs {
+			resp.rules = append(resp.rules, fieldRule{field: schemaErr.Field, tag: schemaErr.Rule, param: schemaErr.Param})
+		}
 	}
 
-	return httperrors.Internal(err, entity.InternalCode)
+	return resp
 }
 
-// pageDetect обрабатывает ошибки, возникающие при работе с пагинацией.
-func pageDetect(err error) render.Renderer {
-	switch {
-	case errors.Is(err, entity.ErrPageInvalidLimit):
-		return httperrors.BadRequest(err, entity.PageInvalidLimitCode)
-	case errors.Is(err, entity.ErrPageInvalidState):
-		return httperrors.BadRequest(err, entity.PageInvalidStateCode)
+// response возвращает ответ с кодом и HTTP статусом из описания ошибки.
+func response(err error, spec entity.ErrorSpec) *httperrors.Response {
+	switch spec.HTTPStatus {
+	case http.StatusBadRequest:
+		return httperrors.BadRequest(err, spec.Code)
+	case http.StatusUnauthorized:
+		return httperrors.Unauthorized(err, spec.Code)
+	case http.StatusForbidden:
+		return httperrors.Forbidden(err, spec.Code)
+	case http.StatusNotFound:
+		return httperrors.ResourceNotFound(err, spec.Code)
+	case http.StatusConflict:
+		return httperrors.Conflict(err, spec.Code)
+	case http.StatusTooManyRequests:
+		// В httperrors нет ответа 429: тело как у 400, а статус выставляет Response.Render.
+		return httperrors.BadRequest(err, spec.Code)
 	default:
-		return nil
-	}
-}
-
-// userDetect обрабатывает ошибки, возникающие при работе с пользователями.
-func userDetect(err error) render.Renderer {
-	switch {
-	case errors.Is(err, entity.ErrUserNotFound), errors.Is(err, entity.ErrInvalidObjectID):
-		return httperrors.ResourceNotFound(err, entity.UserNotFoundCode)
-	case errors.Is(err, entity.ErrUserIDEmpty):
-		return httperrors.BadRequest(err, entity.UserIDEmptyCode)
-	case errors.Is(err, entity.ErrUserDecode):
-		return httperrors.BadRequest(err, entity.UserDecodeCode)
-	default:
-		return nil
-	}
-}
-
-// ordersDetect обрабатывает ошибки, возникающие при работе с заказами.
-func ordersDetect(err error) render.Renderer {
-	switch {
-	case errors.Is(err, entity.ErrOrderDecode):
-		return httperrors.BadRequest(err, entity.OrderDecodeCode)
-	case errors.Is(err, entity.ErrOrderNotFound):
-		return httperrors.BadRequest(err, entity.OrderNotFoundCode)
-	default:
-		return nil
+		return httperrors.Internal(err, spec.Code)
 	}
 }
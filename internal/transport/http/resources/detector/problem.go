@@ -0,0 +1,95 @@
+package detector
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/render"
+	jsoniter "github.com/json-iterator/go"
+)
+
+// ContentTypeProblem тип содержимого ошибки по RFC 7807.
+const ContentTypeProblem = "application/problem+json"
+
+// ProblemTypeBase начало URI типа ошибки. Тип ведет на описание кода в каталоге ошибок GET /errors.
+const ProblemTypeBase = "/errors#"
+
+// Problem ошибка в формате RFC 7807.
+type Problem struct {
+	Type     string       `json:"type"`               // URI типа ошибки
+	Title    string       `json:"title"`              // Краткое описание типа ошибки на языке запроса
+	Status   int          `json:"status"`             // HTTP статус ответа
+	Detail   string       `json:"detail,omitempty"`   // Ошибки полей формы одной строкой на языке запроса
+	Instance string       `json:"instance,omitempty"` // Идентификатор запроса
+	Code     string       `json:"code"`               // Код ошибки
+	Errors   []FieldError `json:"errors,omitempty"`   // Ошибки полей формы
+}
+
+// Responder отвечает ошибкой в формате RFC 7807, если клиент запросил application/problem+json
+// в заголовке Accept. Остальные ответы отдаются render.DefaultResponder, поэтому клиенты
+// без этого заголовка получают прежний формат. Устанавливается как render.Respond.
+func Responder(w http.ResponseWriter, r *http.Request, v interface{}) {
+	response, ok := v.(*Response)
+	if !ok || !AcceptsProblem(r.Header.Get("Accept")) {
+		render.DefaultResponder(w, r, v)
+
+		return
+	}
+
+	body, err := jsoniter.ConfigCompatibleWithStandardLibrary.Marshal(response.problem(r))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentTypeProblem)
+	w.WriteHeader(response.status)
+	_, _ = w.Write(body)
+}
+
+// AcceptsProblem проверяет, что заголовок Accept разрешает application/problem+json.
+// Формат выбирается только явно: */* и application/* оставляют прежний формат.
+func AcceptsProblem(accept string) bool {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil || mediaType != ContentTypeProblem {
+			continue
+		}
+
+		if q, ok := params["q"]; ok {
+			weight, err := strconv.ParseFloat(q, 64)
+			if err != nil || weight <= 0 {
+				continue
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// problem возвращает ошибку в формате RFC 7807. Render должен быть вызван раньше.
+func (e *Response) problem(r *http.Request) Problem {
+	problem := Problem{
+		Type:     ProblemTypeBase + e.Code,
+		Title:    e.Message,
+		Status:   e.status,
+		Instance: middleware.GetReqID(r.Context()),
+		Code:     e.Code,
+		Errors:   e.Fields,
+	}
+
+	details := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		details = append(details, field.Field+": "+field.Message)
+	}
+
+	problem.Detail = strings.Join(details, "; ")
+
+	return problem
+}
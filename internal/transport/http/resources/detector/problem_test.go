@@ -0,0 +1,35 @@
+package detector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcceptsProblem(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		accept string
+		exp    bool
+	}{
+		{name: "без заголовка", accept: "", exp: false},
+		{name: "json", accept: "application/json", exp: false},
+		{name: "любой тип", accept: "*/*", exp: false},
+		{name: "problem+json", accept: "application/problem+json", exp: true},
+		{name: "среди других типов", accept: "application/json, application/problem+json;q=0.9", exp: true},
+		{name: "нулевой вес", accept: "application/problem+json;q=0", exp: false},
+		{name: "неверный вес", accept: "application/problem+json;q=abc", exp: false},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, s.exp, AcceptsProblem(s.accept))
+		})
+	}
+}
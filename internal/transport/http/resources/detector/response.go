@@ -0,0 +1,94 @@
+package detector
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"gitlab.com/example/gophers/libs/errors/httperrors"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/presenter"
+)
+
+// HeaderAcceptLanguage заголовок с языком, на котором клиент ожидает сообщения.
+const HeaderAcceptLanguage = "Accept-Language"
+
+// Response ответ с ошибкой: код, сообщение на языке запроса и ошибки полей формы.
+type Response struct {
+	*httperrors.Response
+
+	Message string       `json:"message"`          // Сообщение об ошибке на языке запроса
+	Fields  []FieldError `json:"fields,omitempty"` // Ошибки полей формы
+
+	status     int         // HTTP статус ответа
+	validation bool        // Ошибка валидации формы, сообщение общее для всех кодов валидации
+	rules      []fieldRule // Нарушенные правила полей
+}
+
+// fieldRule нарушенное правило поля. Сообщение переводится при отправке ответа.
+type fieldRule struct {
+	field string // Поле формы или параметр запроса
+	tag   string // Название правила
+	param string // Параметр правила
+}
+
+// FieldError ошибка поля формы.
+type FieldError struct {
+	Field   string `json:"field"`   // Поле формы
+	Message string `json:"message"` // Сообщение об ошибке на языке запроса
+}
+
+// BadRequest возвращает локализуемый ответ 400 с кодом ошибки.
+func BadRequest(err error, code string) *Response {
+	return &Response{Response: httperrors.BadRequest(err, code), status: http.StatusBadRequest}
+}
+
+// EnvelopeErrors возвращает ошибки для конверта API v2: по одной на каждое поле формы
+// или одну общую ошибку. Render или Localize должен быть вызван раньше.
+func (e *Response) EnvelopeErrors() []presenter.EnvelopeError {
+	if len(e.Fields) == 0 {
+		return []presenter.EnvelopeError{{Code: e.Code, Message: e.Message}}
+	}
+
+	errs := make([]presenter.EnvelopeError, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		errs = append(errs, presenter.EnvelopeError{Code: e.Code, Message: field.Message, Field: field.Field})
+	}
+
+	return errs
+}
+
+// Render заполняет сообщения на языке запроса и выставляет статус ответа.
+// Язык определяется один раз middleware языка и берется из контекста запроса.
+func (e *Response) Render(w http.ResponseWriter, r *http.Request) error {
+	e.Localize(entity.LanguageFromContext(r.Context()))
+
+	if err := e.Response.Render(w, r); err != nil {
+		return err
+	}
+
+	// Статус из реестра ошибок главнее статуса ответа httperrors.
+	render.Status(r, e.status)
+
+	return nil
+}
+
+// Localize заполняет сообщение и ошибки полей на языке lang. Используется, когда ошибка
+// отдается не отдельным ответом, а частью другого ответа, например отчета об импорте.
+func (e *Response) Localize(lang entity.Language) {
+	e.Message = entity.ErrorMessage(e.Code, lang)
+	if e.validation {
+		e.Message = entity.ValidationMessage(lang)
+	}
+
+	if len(e.rules) > 0 {
+		e.Fields = make([]FieldError, 0, len(e.rules))
+	}
+
+	for _, rule := range e.rules {
+		e.Fields = append(e.Fields, FieldError{
+			Field:   rule.field,
+			Message: entity.FieldErrorMessage(rule.tag, rule.param, lang),
+		})
+	}
+}
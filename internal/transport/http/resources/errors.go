@@ -0,0 +1,51 @@
+package resources
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+)
+
+// ErrorDescription описание ошибки из реестра.
+type ErrorDescription struct {
+	Code       string `json:"code" example:"TMP_USER_NOT_FOUND"`        // Код ошибки
+	HTTPStatus int    `json:"httpStatus" example:"404"`                 // HTTP статус ответа
+	GRPCCode   string `json:"grpcCode" example:"NotFound"`              // Код статуса gRPC
+	Retryable  bool   `json:"retryable" example:"false"`                // Повтор того же запроса может завершиться успешно
+	Message    string `json:"message" example:"Пользователь не найден"` // Сообщение на языке из заголовка Accept-Language
+}
+
+// ErrorsResource - обработчик каталога ошибок API.
+type ErrorsResource struct{}
+
+// Routes возвращает роутер для каталога ошибок.
+func (er ErrorsResource) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", er.Get)
+
+	return r
+}
+
+// Get возвращает каталог ошибок API с сообщениями на языке из заголовка Accept-Language.
+func (er ErrorsResource) Get(w http.ResponseWriter, r *http.Request) {
+	lang := entity.LanguageFromContext(r.Context())
+
+	specs := entity.ErrorSpecs()
+	descriptions := make([]ErrorDescription, 0, len(specs))
+
+	for _, spec := range specs {
+		descriptions = append(descriptions, ErrorDescription{
+			Code:       spec.Code,
+			HTTPStatus: spec.HTTPStatus,
+			GRPCCode:   spec.GRPCCode.String(),
+			Retryable:  spec.Retryable,
+			Message:    entity.ErrorMessage(spec.Code, lang),
+		})
+	}
+
+	render.JSON(w, r, descriptions)
+}
@@ -0,0 +1,29 @@
+package resources
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+
+	"github.com/alisher-99/LomBarter/pkg/openapi"
+)
+
+// OpenAPIResource раздает документ OpenAPI 3, построенный из документации swagger.
+type OpenAPIResource struct {
+	Document *openapi.Document
+}
+
+// Routes возвращает роутер для раздачи документа OpenAPI 3.
+func (or OpenAPIResource) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", or.Get)
+
+	return r
+}
+
+// Get возвращает документ OpenAPI 3.
+func (or OpenAPIResource) Get(w http.ResponseWriter, r *http.Request) {
+	render.JSON(w, r, or.Document)
+}
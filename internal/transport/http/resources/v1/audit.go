@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+)
+
+// AuditResource представляет собой обработчик для журнала изменений.
+type AuditResource struct {
+	auditService service.AuditService // Сервис журнала изменений
+	logger       logger.Logger        // Логирование запросов и ошибок обработчиков
+}
+
+// NewAuditHandler создает новый экземпляр AuditResource.
+func NewAuditHandler(auditService service.AuditService, log logger.Logger) *AuditResource {
+	return &AuditResource{
+		auditService: auditService,
+		logger:       log,
+	}
+}
+
+// AdminRoutes возвращает роутер для просмотра журнала изменений администраторами.
+func (ar AuditResource) AdminRoutes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", ar.getEntries)
+
+	return r
+}
+
+// getEntries возвращает записи журнала изменений.
+// @Summary Журнал изменений
+// @Description Получение записей журнала изменений пользователей, заказов и объявлений, начиная с самых новых. Доступно администраторам
+// @Tags audit
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор администратора"
+// @Param filter query form.AuditEntriesGet false "Фильтр"
+// @Param pagination query form.Pagination false "Пагинация"
+// @Success 200 {object} entity.List{items=entity.AuditEntries}
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/admin/audit [get]
+func (ar AuditResource) getEntries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, err := form.ParseAuditEntriesGet(r.URL.Query())
+	if err != nil {
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	filter.RequesterID = r.Header.Get(HeaderXUserID)
+
+	entries, count, err := ar.auditService.GetAuditEntries(ctx, filter)
+	if err != nil {
+		ar.logger.Errorf("Ошибка при получении журнала изменений: %v", err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.List{
+		Items: entries,
+		Count: count,
+	})
+}
@@ -0,0 +1,274 @@
+package v1
+
+import (
+	"encoding/base64"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	jsoniter "github.com/json-iterator/go"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+)
+
+// ConversationResource представляет собой обработчик для переписок по сделкам.
+type ConversationResource struct {
+	conversationService service.ConversationService // Сервис для работы с переписками
+	logger              logger.Logger               // Логирование запросов и ошибок обработчиков
+	json                jsoniter.API                // JSON-парсер
+}
+
+// NewConversationHandler создает новый экземпляр ConversationResource.
+func NewConversationHandler(conversationService service.ConversationService, log logger.Logger) *ConversationResource {
+	return &ConversationResource{
+		conversationService: conversationService,
+		logger:              log,
+		json:                jsoniter.ConfigCompatibleWithStandardLibrary,
+	}
+}
+
+// Routes возвращает роутер для обработчика переписок.
+func (cr ConversationResource) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", cr.getConversations)
+	r.Post("/", cr.openConversation)
+
+	r.Route("/{id}", func(r chi.Router) {
+		r.Get("/", cr.getConversation)
+		r.Get("/messages", cr.getMessages)
+		r.Post("/messages", cr.sendMessage)
+		r.Post("/read", cr.markRead)
+	})
+
+	return r
+}
+
+// getConversations возвращает переписки пользователя.
+// @Summary Получение списка переписок
+// @Description Получение переписок пользователя. Переписки с недавними сообщениями идут первыми
+// @Tags conversations
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param pagination query form.Pagination false "Пагинация"
+// @Success 200 {object} entity.List{items=entity.Conversations}
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/conversations [get]
+func (cr ConversationResource) getConversations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pagination, err := form.ParsePagination(r.URL.Query())
+	if err != nil {
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	filter := form.ConversationsGet{
+		UserID:     r.Header.Get(HeaderXUserID),
+		Pagination: pagination,
+	}
+
+	conversations, count, err := cr.conversationService.GetConversations(ctx, filter)
+	if err != nil {
+		cr.logger.Errorf("Ошибка при получении переписок пользователя %s: %v", filter.UserID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.List{
+		Items: conversations,
+		Count: count,
+	})
+}
+
+// openConversation открывает переписку по сделке.
+// @Summary Открытие переписки
+// @Description Открытие переписки по заказу или предложению обмена. Если переписка уже есть, возвращается она
+// @Tags conversations
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param conversation body form.ConversationCreate true "Сделка"
+// @Success 200 {object} entity.Conversation
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/conversations [post]
+func (cr ConversationResource) openConversation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var createForm form.ConversationCreate
+	if err := cr.json.NewDecoder(r.Body).Decode(&createForm); err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.ConversationDecodeCode))
+
+		return
+	}
+
+	createForm.UserID = r.Header.Get(HeaderXUserID)
+
+	conversation, err := cr.conversationService.OpenConversation(ctx, createForm, time.Now().UTC())
+	if err != nil {
+		cr.logger.Errorf("Ошибка при открытии переписки по сделке %s: %v", createForm.SubjectID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, conversation)
+}
+
+// getConversation возвращает переписку участнику.
+// @Summary Получение переписки
+// @Description Получение переписки по идентификатору. Доступно только участникам
+// @Tags conversations
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор переписки"
+// @Success 200 {object} entity.Conversation
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/conversations/{id} [get]
+func (cr ConversationResource) getConversation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter := form.ConversationGet{
+		ConversationID: chi.URLParam(r, "id"),
+		UserID:         r.Header.Get(HeaderXUserID),
+	}
+
+	conversation, err := cr.conversationService.GetConversation(ctx, filter)
+	if err != nil {
+		cr.logger.Errorf("Ошибка при получении переписки %s: %v", filter.ConversationID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, conversation)
+}
+
+// getMessages возвращает историю сообщений переписки.
+// @Summary Получение истории сообщений
+// @Description Получение сообщений, начиная с самых новых. Для следующей страницы передайте state из ответа в page_state
+// @Tags conversations
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор переписки"
+// @Param pagination query form.Pagination false "Пагинация"
+// @Success 200 {object} entity.List{items=entity.Messages}
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/conversations/{id}/messages [get]
+func (cr ConversationResource) getMessages(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pagination, err := form.ParsePagination(r.URL.Query())
+	if err != nil {
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	filter := form.MessagesGet{
+		ConversationID: chi.URLParam(r, "id"),
+		UserID:         r.Header.Get(HeaderXUserID),
+		Pagination:     pagination,
+	}
+
+	messages, state, err := cr.conversationService.GetMessages(ctx, filter)
+	if err != nil {
+		cr.logger.Errorf("Ошибка при получении сообщений переписки %s: %v", filter.ConversationID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.List{
+		Items: messages,
+		Count: int64(len(messages)),
+		State: base64.StdEncoding.EncodeToString(state),
+	})
+}
+
+// sendMessage отправляет сообщение в переписку.
+// @Summary Отправка сообщения
+// @Description Отправка сообщения с текстом и вложениями. Остальные участники получают уведомление
+// @Tags conversations
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор переписки"
+// @Param message body form.MessageCreate true "Сообщение"
+// @Success 200 {object} entity.Message
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/conversations/{id}/messages [post]
+func (cr ConversationResource) sendMessage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var createForm form.MessageCreate
+	if err := cr.json.NewDecoder(r.Body).Decode(&createForm); err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.MessageDecodeCode))
+
+		return
+	}
+
+	createForm.ConversationID = chi.URLParam(r, "id")
+	createForm.SenderID = r.Header.Get(HeaderXUserID)
+
+	message, err := cr.conversationService.SendMessage(ctx, createForm, time.Now().UTC())
+	if err != nil {
+		cr.logger.Errorf("Ошибка при отправке сообщения в переписку %s: %v", createForm.ConversationID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, message)
+}
+
+// markRead отмечает переписку прочитанной.
+// @Summary Отметка о прочтении
+// @Description Отметка всех сообщений переписки прочитанными пользователем
+// @Tags conversations
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор переписки"
+// @Success 200 {object} entity.Response
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/conversations/{id}/read [post]
+func (cr ConversationResource) markRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter := form.ConversationGet{
+		ConversationID: chi.URLParam(r, "id"),
+		UserID:         r.Header.Get(HeaderXUserID),
+	}
+
+	if err := cr.conversationService.MarkRead(ctx, filter, time.Now().UTC()); err != nil {
+		cr.logger.Errorf("Ошибка при отметке о прочтении переписки %s: %v", filter.ConversationID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.Response{Detail: "переписка прочитана"})
+}
@@ -0,0 +1,447 @@
+package v1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	jsoniter "github.com/json-iterator/go"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+)
+
+// DisputeResource представляет собой обработчик для споров по заказам.
+type DisputeResource struct {
+	disputeService service.DisputeService // Сервис споров по заказам
+	logger         logger.Logger          // Логирование запросов и ошибок обработчиков
+	json           jsoniter.API           // JSON-парсер
+}
+
+// NewDisputeHandler создает новый экземпляр DisputeResource.
+func NewDisputeHandler(disputeService service.DisputeService, log logger.Logger) *DisputeResource {
+	return &DisputeResource{
+		disputeService: disputeService,
+		logger:         log,
+		json:           jsoniter.ConfigCompatibleWithStandardLibrary,
+	}
+}
+
+// Routes возвращает роутер для споров сторон.
+func (dr DisputeResource) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", dr.getDisputes)
+	r.Post("/", dr.openDispute)
+	r.Get("/{id}", dr.getByID)
+	r.Post("/{id}/evidence", dr.addEvidence)
+	r.Get("/{id}/messages", dr.getMessages)
+	r.Post("/{id}/messages", dr.sendMessage)
+	r.Post("/{id}/withdraw", dr.withdrawDispute)
+
+	return r
+}
+
+// AdminRoutes возвращает роутер для рассмотрения споров модераторами.
+func (dr DisputeResource) AdminRoutes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", dr.getQueue)
+	r.Post("/{id}/assign", dr.assignDispute)
+	r.Post("/{id}/resolve", dr.resolveDispute)
+
+	return r
+}
+
+// getDisputes возвращает споры пользователя.
+// @Summary Получение списка споров
+// @Description Получение споров, которые пользователь открыл или которые открыты против него, начиная с самых новых
+// @Tags disputes
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param pagination query form.Pagination false "Пагинация"
+// @Success 200 {object} entity.List{items=entity.Disputes}
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/disputes [get]
+func (dr DisputeResource) getDisputes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pagination, err := form.ParsePagination(r.URL.Query())
+	if err != nil {
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	filter := form.DisputesGet{
+		UserID:     r.Header.Get(HeaderXUserID),
+		Pagination: pagination,
+	}
+
+	disputes, count, err := dr.disputeService.GetDisputes(ctx, filter)
+	if err != nil {
+		dr.logger.Errorf("Ошибка при получении списка споров: %v", err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.List{
+		Items: disputes,
+		Count: count,
+	})
+}
+
+// openDispute открывает спор по заказу.
+// @Summary Открытие спора
+// @Description Открытие спора участником заказа против второй стороны. По заказу открывается только один спор
+// @Tags disputes
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param dispute body form.DisputeCreate true "Спор"
+// @Success 200 {object} entity.Dispute
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/disputes [post]
+func (dr DisputeResource) openDispute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var createForm form.DisputeCreate
+	if err := dr.json.NewDecoder(r.Body).Decode(&createForm); err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.DisputeDecodeCode))
+
+		return
+	}
+
+	createForm.UserID = r.Header.Get(HeaderXUserID)
+
+	dispute, err := dr.disputeService.OpenDispute(ctx, createForm, time.Now().UTC())
+	if err != nil {
+		dr.logger.Errorf("Ошибка при открытии спора по заказу %s: %v", createForm.OrderID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, dispute)
+}
+
+// getByID возвращает спор по идентификатору.
+// @Summary Получение спора
+// @Description Получение спора с доказательствами и решением. Доступно сторонам спора и модераторам
+// @Tags disputes
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор спора"
+// @Success 200 {object} entity.Dispute
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 404 {object} swagger.HTTPResponse404 "Спор не найден"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/disputes/{id} [get]
+func (dr DisputeResource) getByID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter := dr.parseGet(r)
+
+	dispute, err := dr.disputeService.GetDispute(ctx, filter)
+	if err != nil {
+		dr.logger.Errorf("Ошибка при получении спора %s: %v", filter.DisputeID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, dispute)
+}
+
+// addEvidence прикладывает доказательство к спору.
+// @Summary Добавление доказательства
+// @Description Добавление к спору медиафайла, заранее загруженного стороной спора. Файл прикрепляется к спору и больше не может быть удален
+// @Tags disputes
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор спора"
+// @Param evidence body form.DisputeEvidenceAdd true "Доказательство"
+// @Success 200 {object} entity.Dispute
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/disputes/{id}/evidence [post]
+func (dr DisputeResource) addEvidence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var evidenceForm form.DisputeEvidenceAdd
+	if err := dr.json.NewDecoder(r.Body).Decode(&evidenceForm); err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.DisputeDecodeCode))
+
+		return
+	}
+
+	evidenceForm.DisputeID = chi.URLParam(r, "id")
+	evidenceForm.UserID = r.Header.Get(HeaderXUserID)
+
+	dispute, err := dr.disputeService.AddEvidence(ctx, evidenceForm, time.Now().UTC())
+	if err != nil {
+		dr.logger.Errorf("Ошибка при добавлении доказательства к спору %s: %v", evidenceForm.DisputeID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, dispute)
+}
+
+// getMessages возвращает переписку по спору.
+// @Summary Получение переписки по спору
+// @Description Получение сообщений сторон и модератора, начиная с самых старых
+// @Tags disputes
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор спора"
+// @Param pagination query form.Pagination false "Пагинация"
+// @Success 200 {object} entity.List{items=entity.DisputeMessages}
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/disputes/{id}/messages [get]
+func (dr DisputeResource) getMessages(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pagination, err := form.ParsePagination(r.URL.Query())
+	if err != nil {
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	filter := form.DisputeMessagesGet{
+		DisputeID:  chi.URLParam(r, "id"),
+		UserID:     r.Header.Get(HeaderXUserID),
+		Pagination: pagination,
+	}
+
+	messages, count, err := dr.disputeService.GetMessages(ctx, filter)
+	if err != nil {
+		dr.logger.Errorf("Ошибка при получении переписки по спору %s: %v", filter.DisputeID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.List{
+		Items: messages,
+		Count: count,
+	})
+}
+
+// sendMessage отправляет сообщение в переписку по спору.
+// @Summary Отправка сообщения по спору
+// @Description Отправка сообщения стороной спора или модератором, пока спор не решен
+// @Tags disputes
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор спора"
+// @Param message body form.DisputeMessageCreate true "Сообщение"
+// @Success 200 {object} entity.DisputeMessage
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/disputes/{id}/messages [post]
+func (dr DisputeResource) sendMessage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var messageForm form.DisputeMessageCreate
+	if err := dr.json.NewDecoder(r.Body).Decode(&messageForm); err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.DisputeDecodeCode))
+
+		return
+	}
+
+	messageForm.DisputeID = chi.URLParam(r, "id")
+	messageForm.UserID = r.Header.Get(HeaderXUserID)
+
+	message, err := dr.disputeService.SendMessage(ctx, messageForm, time.Now().UTC())
+	if err != nil {
+		dr.logger.Errorf("Ошибка при отправке сообщения по спору %s: %v", messageForm.DisputeID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, message)
+}
+
+// withdrawDispute отзывает спор.
+// @Summary Отзыв спора
+// @Description Отзыв спора автором до решения модератора
+// @Tags disputes
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор спора"
+// @Success 200 {object} entity.Dispute
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/disputes/{id}/withdraw [post]
+func (dr DisputeResource) withdrawDispute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	action := dr.parseGet(r)
+
+	dispute, err := dr.disputeService.WithdrawDispute(ctx, action, time.Now().UTC())
+	if err != nil {
+		dr.logger.Errorf("Ошибка при отзыве спора %s: %v", action.DisputeID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, dispute)
+}
+
+// getQueue возвращает очередь споров модератору.
+// @Summary Очередь споров
+// @Description Получение нерешенных споров, начиная с тех, срок рассмотрения которых наступит раньше. Доступно администраторам
+// @Tags disputes
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор администратора"
+// @Param filter query form.DisputeQueueGet false "Фильтр"
+// @Param pagination query form.Pagination false "Пагинация"
+// @Success 200 {object} entity.List{items=entity.Disputes}
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/admin/disputes [get]
+func (dr DisputeResource) getQueue(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pagination, err := form.ParsePagination(r.URL.Query())
+	if err != nil {
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	filter := form.DisputeQueueGet{
+		RequesterID: r.Header.Get(HeaderXUserID),
+		Status:      entity.DisputeStatus(r.URL.Query().Get("status")),
+		ModeratorID: r.URL.Query().Get("moderatorID"),
+		Overdue:     r.URL.Query().Get("overdue") == "true",
+		Pagination:  pagination,
+	}
+
+	disputes, count, err := dr.disputeService.GetQueue(ctx, filter)
+	if err != nil {
+		dr.logger.Errorf("Ошибка при получении очереди споров: %v", err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.List{
+		Items: disputes,
+		Count: count,
+	})
+}
+
+// assignDispute передает спор модератору.
+// @Summary Назначение модератора
+// @Description Передача спора модератору. Без модератора в теле спор берет себе автор запроса. Доступно администраторам
+// @Tags disputes
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор администратора"
+// @Param id path string true "Идентификатор спора"
+// @Param assign body form.DisputeAssign false "Модератор"
+// @Success 200 {object} entity.Dispute
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/admin/disputes/{id}/assign [post]
+func (dr DisputeResource) assignDispute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var assignForm form.DisputeAssign
+	if r.ContentLength != 0 {
+		if err := dr.json.NewDecoder(r.Body).Decode(&assignForm); err != nil {
+			_ = render.Render(w, r, detector.BadRequest(err, entity.DisputeDecodeCode))
+
+			return
+		}
+	}
+
+	assignForm.DisputeID = chi.URLParam(r, "id")
+	assignForm.RequesterID = r.Header.Get(HeaderXUserID)
+
+	dispute, err := dr.disputeService.AssignDispute(ctx, assignForm, time.Now().UTC())
+	if err != nil {
+		dr.logger.Errorf("Ошибка при назначении модератора спора %s: %v", assignForm.DisputeID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, dispute)
+}
+
+// resolveDispute выносит решение по спору.
+// @Summary Решение по спору
+// @Description Решение назначенного модератора. В пользу автора можно вернуть оплату заказа, списать кредиты второй стороны и оштрафовать ее репутацию
+// @Tags disputes
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор администратора"
+// @Param id path string true "Идентификатор спора"
+// @Param resolution body form.DisputeResolve true "Решение"
+// @Success 200 {object} entity.Dispute
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/admin/disputes/{id}/resolve [post]
+func (dr DisputeResource) resolveDispute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var resolveForm form.DisputeResolve
+	if err := dr.json.NewDecoder(r.Body).Decode(&resolveForm); err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.DisputeDecodeCode))
+
+		return
+	}
+
+	resolveForm.DisputeID = chi.URLParam(r, "id")
+	resolveForm.RequesterID = r.Header.Get(HeaderXUserID)
+
+	dispute, err := dr.disputeService.ResolveDispute(ctx, resolveForm, time.Now().UTC())
+	if err != nil {
+		dr.logger.Errorf("Ошибка при решении спора %s: %v", resolveForm.DisputeID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, dispute)
+}
+
+// parseGet возвращает форму получения спора из пути и заголовков запроса.
+func (dr DisputeResource) parseGet(r *http.Request) form.DisputeGet {
+	return form.DisputeGet{
+		DisputeID: chi.URLParam(r, "id"),
+		UserID:    r.Header.Get(HeaderXUserID),
+	}
+}
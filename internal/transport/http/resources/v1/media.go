@@ -0,0 +1,370 @@
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	jsoniter "github.com/json-iterator/go"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+)
+
+// mediaFileField имя поля multipart-формы с загружаемым файлом.
+const mediaFileField = "file"
+
+// MediaResource представляет собой обработчик для медиафайлов.
+type MediaResource struct {
+	mediaService service.MediaService // Сервис для работы с медиафайлами
+	logger       logger.Logger        // Логирование запросов и ошибок обработчиков
+	json         jsoniter.API         // JSON-парсер
+}
+
+// NewMediaHandler создает новый экземпляр MediaResource.
+func NewMediaHandler(mediaService service.MediaService, log logger.Logger) *MediaResource {
+	return &MediaResource{
+		mediaService: mediaService,
+		logger:       log,
+		json:         jsoniter.ConfigCompatibleWithStandardLibrary,
+	}
+}
+
+// Routes возвращает роутер для обработчика медиафайлов.
+func (mr MediaResource) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Post("/", mr.uploadMedia)
+	r.Get("/", mr.getSubjectMedia)
+	r.Get("/{id}", mr.getMedia)
+	r.Post("/{id}/attach", mr.attachMedia)
+	r.Delete("/{id}", mr.deleteMedia)
+
+	return r
+}
+
+// uploadMedia загружает изображение.
+// @Summary Загрузка изображения
+// @Description Загрузка изображения в формате JPEG, PNG или GIF. Тип определяется по содержимому файла, для изображения строится миниатюра
+// @Tags media
+// @Accept multipart/form-data
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param file formData file true "Изображение"
+// @Success 200 {object} entity.Media
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/media [post]
+func (mr MediaResource) uploadMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	file, err := multipartFile(r, mediaFileField)
+	if err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.MediaDecodeCode))
+
+		return
+	}
+
+	uploadForm := form.MediaUpload{
+		OwnerID: r.Header.Get(HeaderXUserID),
+		File:    file,
+	}
+
+	media, err := mr.mediaService.Upload(ctx, uploadForm, time.Now().UTC())
+	if err != nil {
+		mr.logger.Errorf("Ошибка при загрузке медиафайла: %v", err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, media)
+}
+
+// getSubjectMedia возвращает медиафайлы, прикрепленные к сущности.
+// @Summary Получение медиафайлов сущности
+// @Description Получение медиафайлов, прикрепленных к пользователю или объявлению, с подписанными ссылками
+// @Tags media
+// @Accept json
+// @Produce json
+// @Param subjectType query string true "Тип сущности" Enums(user, listing)
+// @Param subjectID query string true "Идентификатор сущности"
+// @Success 200 {object} entity.List{items=entity.MediaList}
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/media [get]
+func (mr MediaResource) getSubjectMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter := form.MediaGet{
+		SubjectType: entity.MediaSubject(r.URL.Query().Get("subjectType")),
+		SubjectID:   r.URL.Query().Get("subjectID"),
+	}
+
+	list, err := mr.mediaService.GetSubjectMedia(ctx, filter)
+	if err != nil {
+		mr.logger.Errorf("Ошибка при получении медиафайлов %s %s: %v", filter.SubjectType, filter.SubjectID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.List{
+		Items: list,
+		Count: int64(len(list)),
+	})
+}
+
+// getMedia возвращает медиафайл по идентификатору.
+// @Summary Получение медиафайла
+// @Description Получение медиафайла с подписанными ссылками на файл и миниатюру
+// @Tags media
+// @Accept json
+// @Produce json
+// @Param id path string true "Идентификатор медиафайла"
+// @Success 200 {object} entity.Media
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/media/{id} [get]
+func (mr MediaResource) getMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	media, err := mr.mediaService.GetMedia(ctx, id)
+	if err != nil {
+		mr.logger.Errorf("Ошибка при получении медиафайла %s: %v", id, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, media)
+}
+
+// attachMedia прикрепляет медиафайл к объявлению.
+// @Summary Прикрепление медиафайла
+// @Description Прикрепление загруженного медиафайла к объявлению. Доступно только владельцу файла и объявления
+// @Tags media
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор медиафайла"
+// @Param attach body form.MediaAttach true "Сущность, к которой прикрепляется файл"
+// @Success 200 {object} entity.Media
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/media/{id}/attach [post]
+func (mr MediaResource) attachMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var attachForm form.MediaAttach
+	if err := mr.json.NewDecoder(r.Body).Decode(&attachForm); err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.MediaDecodeCode))
+
+		return
+	}
+
+	attachForm.ID = chi.URLParam(r, "id")
+	attachForm.OwnerID = r.Header.Get(HeaderXUserID)
+
+	media, err := mr.mediaService.AttachMedia(ctx, attachForm)
+	if err != nil {
+		mr.logger.Errorf("Ошибка при прикреплении медиафайла %s: %v", attachForm.ID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, media)
+}
+
+// deleteMedia удаляет медиафайл.
+// @Summary Удаление медиафайла
+// @Description Удаление медиафайла вместе с файлами в хранилище. Доступно только владельцу
+// @Tags media
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор медиафайла"
+// @Success 200 {object} entity.Response
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/media/{id} [delete]
+func (mr MediaResource) deleteMedia(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	deleteForm := form.MediaDelete{
+		ID:      chi.URLParam(r, "id"),
+		OwnerID: r.Header.Get(HeaderXUserID),
+	}
+
+	if err := mr.mediaService.DeleteMedia(ctx, deleteForm, time.Now().UTC()); err != nil {
+		mr.logger.Errorf("Ошибка при удалении медиафайла %s: %v", deleteForm.ID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.Response{Detail: "медиафайл удален"})
+}
+
+// AvatarResource представляет собой обработчик для аватаров пользователей.
+type AvatarResource struct {
+	mediaService service.MediaService // Сервис для работы с медиафайлами
+	logger       logger.Logger        // Логирование запросов и ошибок обработчиков
+	json         jsoniter.API         // JSON-парсер
+}
+
+// NewAvatarHandler создает новый экземпляр AvatarResource.
+func NewAvatarHandler(mediaService service.MediaService, log logger.Logger) *AvatarResource {
+	return &AvatarResource{
+		mediaService: mediaService,
+		logger:       log,
+		json:         jsoniter.ConfigCompatibleWithStandardLibrary,
+	}
+}
+
+// Routes возвращает роутер для обработчика аватаров.
+// Монтируется под /users/{id}/avatar, идентификатор пользователя берется из пути.
+func (ar AvatarResource) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", ar.getAvatar)
+	r.Put("/", ar.setAvatar)
+	r.Delete("/", ar.deleteAvatar)
+
+	return r
+}
+
+// getAvatar перенаправляет на аватар пользователя.
+// @Summary Получение аватара
+// @Description Перенаправление на подписанную ссылку на аватар пользователя. С size=thumb — на миниатюру
+// @Tags media
+// @Param id path string true "Идентификатор пользователя"
+// @Param size query string false "Размер аватара" Enums(thumb)
+// @Success 302
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/users/{id}/avatar [get]
+func (ar AvatarResource) getAvatar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID := chi.URLParam(r, "id")
+
+	url, err := ar.mediaService.AvatarURL(ctx, userID, r.URL.Query().Get("size") == "thumb")
+	if err != nil {
+		ar.logger.Errorf("Ошибка при получении аватара пользователя %s: %v", userID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	http.Redirect(w, r, url, http.StatusFound)
+}
+
+// setAvatar устанавливает аватар пользователя.
+// @Summary Установка аватара
+// @Description Установка загруженного пользователем медиафайла аватаром. Прежний аватар удаляется. Доступно только самому пользователю
+// @Tags media
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор пользователя"
+// @Param avatar body form.AvatarSet true "Медиафайл аватара"
+// @Success 200 {object} entity.Media
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/users/{id}/avatar [put]
+func (ar AvatarResource) setAvatar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var avatarForm form.AvatarSet
+	if err := ar.json.NewDecoder(r.Body).Decode(&avatarForm); err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.MediaDecodeCode))
+
+		return
+	}
+
+	avatarForm.UserID = chi.URLParam(r, "id")
+	avatarForm.RequesterID = r.Header.Get(HeaderXUserID)
+
+	media, err := ar.mediaService.SetAvatar(ctx, avatarForm, time.Now().UTC())
+	if err != nil {
+		ar.logger.Errorf("Ошибка при установке аватара пользователя %s: %v", avatarForm.UserID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, media)
+}
+
+// deleteAvatar удаляет аватар пользователя.
+// @Summary Удаление аватара
+// @Description Удаление аватара пользователя вместе с файлами в хранилище. Доступно только самому пользователю
+// @Tags media
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор пользователя"
+// @Success 200 {object} entity.Response
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/users/{id}/avatar [delete]
+func (ar AvatarResource) deleteAvatar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	deleteForm := form.AvatarDelete{
+		UserID:      chi.URLParam(r, "id"),
+		RequesterID: r.Header.Get(HeaderXUserID),
+	}
+
+	if err := ar.mediaService.DeleteAvatar(ctx, deleteForm, time.Now().UTC()); err != nil {
+		ar.logger.Errorf("Ошибка при удалении аватара пользователя %s: %v", deleteForm.UserID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.Response{Detail: "аватар удален"})
+}
+
+// multipartFile возвращает содержимое поля name multipart-формы или nil, если поля нет. Форма читается
+// потоком, поэтому файл не буферизуется целиком ни в памяти, ни на диске.
+func multipartFile(r *http.Request, name string) (io.Reader, error) {
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, fmt.Errorf("чтение multipart-формы: %w", err)
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("чтение multipart-формы: %w", err)
+		}
+
+		if part.FormName() == name {
+			return part, nil
+		}
+	}
+}
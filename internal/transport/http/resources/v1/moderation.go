@@ -0,0 +1,173 @@
+package v1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	jsoniter "github.com/json-iterator/go"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+)
+
+// ModerationResource представляет собой обработчик для жалоб и очереди модерации.
+type ModerationResource struct {
+	moderationService service.ModerationService // Сервис модерации пользовательских текстов
+	logger            logger.Logger             // Логирование запросов и ошибок обработчиков
+	json              jsoniter.API              // JSON-парсер
+}
+
+// NewModerationHandler создает новый экземпляр ModerationResource.
+func NewModerationHandler(moderationService service.ModerationService, log logger.Logger) *ModerationResource {
+	return &ModerationResource{
+		moderationService: moderationService,
+		logger:            log,
+		json:              jsoniter.ConfigCompatibleWithStandardLibrary,
+	}
+}
+
+// Routes возвращает роутер для жалоб пользователей.
+func (mr ModerationResource) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Post("/", mr.report)
+
+	return r
+}
+
+// AdminRoutes возвращает роутер для очереди модерации.
+func (mr ModerationResource) AdminRoutes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", mr.getQueue)
+	r.Post("/{id}/decision", mr.decide)
+
+	return r
+}
+
+// report принимает жалобу на профиль или объявление.
+// @Summary Жалоба на текст
+// @Description Жалоба на профиль или объявление. Текст попадает в очередь модерации и остается виден до решения модератора
+// @Tags moderation
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param report body form.ModerationReportCreate true "Жалоба"
+// @Success 200 {object} entity.ModerationCase
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 404 {object} swagger.HTTPResponse404 "Профиль или объявление не найдены"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/reports [post]
+func (mr ModerationResource) report(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var reportForm form.ModerationReportCreate
+	if err := mr.json.NewDecoder(r.Body).Decode(&reportForm); err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.ModerationDecodeCode))
+
+		return
+	}
+
+	reportForm.ReporterID = r.Header.Get(HeaderXUserID)
+
+	moderationCase, err := mr.moderationService.Report(ctx, reportForm, time.Now().UTC())
+	if err != nil {
+		mr.logger.Errorf("Ошибка при отправке жалобы на %s %s: %v", reportForm.SubjectType, reportForm.SubjectID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, moderationCase)
+}
+
+// getQueue возвращает очередь модерации.
+// @Summary Очередь модерации
+// @Description Получение дел модерации, начиная с тех, что ждут решения дольше. Без статуса возвращаются открытые дела. Доступно администраторам
+// @Tags moderation
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор администратора"
+// @Param filter query form.ModerationQueueGet false "Фильтр"
+// @Param pagination query form.Pagination false "Пагинация"
+// @Success 200 {object} entity.List{items=entity.ModerationCases}
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/admin/moderation [get]
+func (mr ModerationResource) getQueue(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pagination, err := form.ParsePagination(r.URL.Query())
+	if err != nil {
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	filter := form.ModerationQueueGet{
+		RequesterID: r.Header.Get(HeaderXUserID),
+		Status:      entity.ModerationCaseStatus(r.URL.Query().Get("status")),
+		SubjectType: entity.ModerationSubjectType(r.URL.Query().Get("subjectType")),
+		Reported:    r.URL.Query().Get("reported") == "true",
+		Pagination:  pagination,
+	}
+
+	cases, count, err := mr.moderationService.GetQueue(ctx, filter)
+	if err != nil {
+		mr.logger.Errorf("Ошибка при получении очереди модерации: %v", err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.List{
+		Items: cases,
+		Count: count,
+	})
+}
+
+// decide выносит решение по делу модерации.
+// @Summary Решение модератора
+// @Description Одобрение текста, его скрытие или скрытие с блокировкой автора. Блокировка скрывает профиль и все объявления автора. Доступно администраторам
+// @Tags moderation
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор администратора"
+// @Param id path string true "Идентификатор дела"
+// @Param decision body form.ModerationDecide true "Решение"
+// @Success 200 {object} entity.ModerationCase
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 404 {object} swagger.HTTPResponse404 "Дело не найдено"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/admin/moderation/{id}/decision [post]
+func (mr ModerationResource) decide(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var decideForm form.ModerationDecide
+	if err := mr.json.NewDecoder(r.Body).Decode(&decideForm); err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.ModerationDecodeCode))
+
+		return
+	}
+
+	decideForm.CaseID = chi.URLParam(r, "id")
+	decideForm.RequesterID = r.Header.Get(HeaderXUserID)
+
+	moderationCase, err := mr.moderationService.Decide(ctx, decideForm, time.Now().UTC())
+	if err != nil {
+		mr.logger.Errorf("Ошибка при решении по делу модерации %s: %v", decideForm.CaseID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, moderationCase)
+}
@@ -0,0 +1,173 @@
+package v1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+)
+
+// NotificationResource представляет собой обработчик для входящих уведомлений пользователя.
+type NotificationResource struct {
+	notificationService service.NotificationService // Сервис входящих уведомлений
+	logger              logger.Logger               // Логирование запросов и ошибок обработчиков
+}
+
+// NewNotificationHandler создает новый экземпляр NotificationResource.
+func NewNotificationHandler(notificationService service.NotificationService, log logger.Logger) *NotificationResource {
+	return &NotificationResource{
+		notificationService: notificationService,
+		logger:              log,
+	}
+}
+
+// Routes возвращает роутер для обработчика входящих уведомлений.
+func (nr NotificationResource) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", nr.getInbox)
+	r.Get("/unread-count", nr.getUnreadCount)
+	r.Post("/read-all", nr.markAllRead)
+	r.Post("/{id}/read", nr.markRead)
+
+	return r
+}
+
+// getInbox возвращает входящие уведомления пользователя.
+// @Summary Получение входящих уведомлений
+// @Description Получение уведомлений пользователя, начиная с самых новых. Уведомления старше срока хранения удаляются
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param category query string false "Категория уведомлений" Enums(listings, messages, account)
+// @Param unread query bool false "Только непрочитанные"
+// @Param pagination query form.Pagination false "Пагинация"
+// @Success 200 {object} entity.List{items=entity.InboxNotifications}
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/notifications [get]
+func (nr NotificationResource) getInbox(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pagination, err := form.ParsePagination(r.URL.Query())
+	if err != nil {
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	filter := form.InboxGet{
+		UserID:     r.Header.Get(HeaderXUserID),
+		Category:   entity.NotificationCategory(r.URL.Query().Get("category")),
+		UnreadOnly: r.URL.Query().Get("unread") == "true",
+		Pagination: pagination,
+	}
+
+	notifications, count, err := nr.notificationService.GetInbox(ctx, filter)
+	if err != nil {
+		nr.logger.Errorf("Ошибка при получении входящих уведомлений пользователя %s: %v", filter.UserID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.List{
+		Items: notifications,
+		Count: count,
+	})
+}
+
+// getUnreadCount возвращает количество непрочитанных уведомлений.
+// @Summary Количество непрочитанных уведомлений
+// @Description Получение количества непрочитанных уведомлений пользователя. Счетчик хранится в кэше, эндпоинт можно опрашивать
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Success 200 {object} entity.UnreadCount
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/notifications/unread-count [get]
+func (nr NotificationResource) getUnreadCount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter := form.InboxUser{UserID: r.Header.Get(HeaderXUserID)}
+
+	count, err := nr.notificationService.UnreadCount(ctx, filter)
+	if err != nil {
+		nr.logger.Errorf("Ошибка при подсчете непрочитанных уведомлений пользователя %s: %v", filter.UserID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, count)
+}
+
+// markAllRead отмечает все уведомления прочитанными.
+// @Summary Отметка о прочтении всех уведомлений
+// @Description Отметка всех входящих уведомлений пользователя прочитанными
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Success 200 {object} entity.Response
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/notifications/read-all [post]
+func (nr NotificationResource) markAllRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	markForm := form.InboxUser{UserID: r.Header.Get(HeaderXUserID)}
+
+	if _, err := nr.notificationService.MarkAllRead(ctx, markForm, time.Now().UTC()); err != nil {
+		nr.logger.Errorf("Ошибка при отметке о прочтении уведомлений пользователя %s: %v", markForm.UserID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.Response{Detail: "уведомления прочитаны"})
+}
+
+// markRead отмечает уведомление прочитанным.
+// @Summary Отметка о прочтении уведомления
+// @Description Отметка уведомления из входящих пользователя прочитанным. Повторная отметка не меняет дату прочтения
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор уведомления"
+// @Success 200 {object} entity.Response
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/notifications/{id}/read [post]
+func (nr NotificationResource) markRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	markForm := form.InboxMarkRead{
+		UserID: r.Header.Get(HeaderXUserID),
+		ID:     chi.URLParam(r, "id"),
+	}
+
+	if err := nr.notificationService.MarkRead(ctx, markForm, time.Now().UTC()); err != nil {
+		nr.logger.Errorf("Ошибка при отметке о прочтении уведомления %s: %v", markForm.ID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.Response{Detail: "уведомление прочитано"})
+}
@@ -0,0 +1,186 @@
+package v1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	jsoniter "github.com/json-iterator/go"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+)
+
+// NotificationSettingsResource представляет собой обработчик для настроек уведомлений и устройств пользователя.
+type NotificationSettingsResource struct {
+	notificationService service.NotificationService // Сервис настроек уведомлений
+	logger              logger.Logger               // Логирование запросов и ошибок обработчиков
+	json                jsoniter.API                // JSON-парсер
+}
+
+// NewNotificationSettingsHandler создает новый экземпляр NotificationSettingsResource.
+func NewNotificationSettingsHandler(notificationService service.NotificationService, log logger.Logger) *NotificationSettingsResource {
+	return &NotificationSettingsResource{
+		notificationService: notificationService,
+		logger:              log,
+		json:                jsoniter.ConfigCompatibleWithStandardLibrary,
+	}
+}
+
+// Routes возвращает роутер для обработчика настроек уведомлений.
+// Монтируется под /users/{id}/notifications, идентификатор пользователя берется из пути.
+func (nr NotificationSettingsResource) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/preferences", nr.getPreferences)
+	r.Put("/preferences", nr.updatePreferences)
+	r.Post("/devices", nr.registerDevice)
+	r.Delete("/devices/{token}", nr.unregisterDevice)
+
+	return r
+}
+
+// getPreferences возвращает настройки уведомлений пользователя.
+// @Summary Получение настроек уведомлений
+// @Description Получение каналов доставки по типам уведомлений и адреса для писем. Доступно только самому пользователю
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор пользователя"
+// @Success 200 {object} entity.NotificationPreferences
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/users/{id}/notifications/preferences [get]
+func (nr NotificationSettingsResource) getPreferences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter := form.NotificationPreferencesGet{
+		UserID:      chi.URLParam(r, "id"),
+		RequesterID: r.Header.Get(HeaderXUserID),
+	}
+
+	preferences, err := nr.notificationService.GetPreferences(ctx, filter)
+	if err != nil {
+		nr.logger.Errorf("Ошибка при получении настроек уведомлений %s: %v", filter.UserID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, preferences)
+}
+
+// updatePreferences сохраняет настройки уведомлений пользователя.
+// @Summary Сохранение настроек уведомлений
+// @Description Сохранение каналов доставки по типам уведомлений и адреса для писем. Настройки заменяются целиком, для типов без настройки используются все каналы
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор пользователя"
+// @Param preferences body form.NotificationPreferencesUpdate true "Настройки уведомлений"
+// @Success 200 {object} entity.NotificationPreferences
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/users/{id}/notifications/preferences [put]
+func (nr NotificationSettingsResource) updatePreferences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var updateForm form.NotificationPreferencesUpdate
+	if err := nr.json.NewDecoder(r.Body).Decode(&updateForm); err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.NotificationPreferencesDecodeCode))
+
+		return
+	}
+
+	updateForm.UserID = chi.URLParam(r, "id")
+	updateForm.RequesterID = r.Header.Get(HeaderXUserID)
+
+	preferences, err := nr.notificationService.UpdatePreferences(ctx, updateForm, time.Now().UTC())
+	if err != nil {
+		nr.logger.Errorf("Ошибка при сохранении настроек уведомлений %s: %v", updateForm.UserID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, preferences)
+}
+
+// registerDevice регистрирует устройство для push-уведомлений.
+// @Summary Регистрация устройства
+// @Description Регистрация токена устройства для push-уведомлений. Повторная регистрация токена обновляет его владельца
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор пользователя"
+// @Param device body form.DeviceRegister true "Устройство"
+// @Success 200 {object} entity.DeviceToken
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/users/{id}/notifications/devices [post]
+func (nr NotificationSettingsResource) registerDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var registerForm form.DeviceRegister
+	if err := nr.json.NewDecoder(r.Body).Decode(&registerForm); err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.DeviceTokenDecodeCode))
+
+		return
+	}
+
+	registerForm.UserID = chi.URLParam(r, "id")
+	registerForm.RequesterID = r.Header.Get(HeaderXUserID)
+
+	device, err := nr.notificationService.RegisterDevice(ctx, registerForm, time.Now().UTC())
+	if err != nil {
+		nr.logger.Errorf("Ошибка при регистрации устройства пользователя %s: %v", registerForm.UserID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, device)
+}
+
+// unregisterDevice удаляет устройство.
+// @Summary Удаление устройства
+// @Description Удаление токена устройства. На устройство перестанут приходить push-уведомления
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор пользователя"
+// @Param token path string true "Токен устройства"
+// @Success 200 {object} entity.Response
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/users/{id}/notifications/devices/{token} [delete]
+func (nr NotificationSettingsResource) unregisterDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	unregisterForm := form.DeviceUnregister{
+		UserID:      chi.URLParam(r, "id"),
+		RequesterID: r.Header.Get(HeaderXUserID),
+		Token:       chi.URLParam(r, "token"),
+	}
+
+	if err := nr.notificationService.UnregisterDevice(ctx, unregisterForm); err != nil {
+		nr.logger.Errorf("Ошибка при удалении устройства пользователя %s: %v", unregisterForm.UserID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.Response{Detail: "устройство удалено"})
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/go-chi/chi"
 	"github.com/go-chi/render"
 	jsoniter "github.com/json-iterator/go"
-	"gitlab.com/example/gophers/libs/errors/httperrors"
 	"gitlab.com/example/gophers/libs/logger"
 
 	"github.com/alisher-99/LomBarter/internal/domain/entity"
@@ -41,6 +40,8 @@ func (vr OrdersResource) Routes() chi.Router {
 	r.Post("/", vr.createOrder)
 	r.Get("/", vr.getOrderList)
 	r.Get("/{orderID}", vr.getOrderInfo)
+	r.Put("/{orderID}/status", vr.updateOrderStatus)
+	r.Get("/{orderID}/history", vr.getOrderHistory)
 
 	return r
 }
@@ -56,13 +57,14 @@ func (vr OrdersResource) Routes() chi.Router {
 // @Success 200 {object} presenter.CreatedOrder
 // @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
 // @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
 // @Router /v1/orders [post]
 func (vr OrdersResource) createOrder(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var order form.OrderCreate
 	if err := vr.json.NewDecoder(r.Body).Decode(&order); err != nil {
-		_ = render.Render(w, r, httperrors.BadRequest(err, entity.OrderDecodeCode))
+		_ = render.Render(w, r, detector.BadRequest(err, entity.OrderDecodeCode))
 
 		return
 	}
@@ -90,6 +92,7 @@ func (vr OrdersResource) createOrder(w http.ResponseWriter, r *http.Request) {
 // @Success 200 {object} entity.List{items=entity.Orders}
 // @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
 // @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
 // @Router /v1/orders [get]
 func (vr OrdersResource) getOrderList(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -122,10 +125,12 @@ func (vr OrdersResource) getOrderList(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param X-User-Id header string true "Идентификатор пользователя"
-// @Param id path string true "Идентификатор заказа"
+// @Param orderID path string true "Идентификатор заказа"
+// @Param expand query string false "Связанные данные через запятую" Enums(history)
 // @Success 200 {object} entity.Order
 // @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
 // @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
 // @Router /v1/orders/{orderID} [get]
 func (vr OrdersResource) getOrderInfo(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -133,6 +138,7 @@ func (vr OrdersResource) getOrderInfo(w http.ResponseWriter, r *http.Request) {
 	filter := form.OrderGetForClient{
 		OrderID: chi.URLParam(r, "orderID"),
 		UserID:  r.Header.Get(HeaderXUserID),
+		Expand:  form.ParseExpand(r.URL.Query()),
 	}
 
 	if err := filter.Validate(); err != nil {
@@ -151,3 +157,77 @@ func (vr OrdersResource) getOrderInfo(w http.ResponseWriter, r *http.Request) {
 
 	render.JSON(w, r, order)
 }
+
+// updateOrderStatus изменяет статус заказа.
+// @Summary Изменение статуса заказа
+// @Description Изменение статуса заказа. Каждый переход записывается в историю заказа.
+// @Description Подтверждение списывает оплату заказа, отмена снимает блокировку или возвращает оплату целиком либо сумму refund
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param orderID path string true "Идентификатор заказа"
+// @Param status body form.OrderStatusUpdate true "Новый статус"
+// @Success 200 {object} entity.Order
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/orders/{orderID}/status [put]
+func (vr OrdersResource) updateOrderStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var updateForm form.OrderStatusUpdate
+	if err := vr.json.NewDecoder(r.Body).Decode(&updateForm); err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.OrderDecodeCode))
+
+		return
+	}
+
+	updateForm.OrderID = chi.URLParam(r, "orderID")
+	updateForm.UserID = r.Header.Get(HeaderXUserID)
+
+	order, err := vr.ordersService.ChangeOrderStatus(ctx, updateForm, time.Now().UTC())
+	if err != nil {
+		vr.logger.Error("ошибка изменения статуса заказа", err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, order)
+}
+
+// getOrderHistory возвращает историю изменения статуса заказа.
+// @Summary История заказа
+// @Description История изменения статуса заказа: кто, когда и почему изменил статус
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param orderID path string true "Идентификатор заказа"
+// @Success 200 {object} entity.List{items=entity.OrderHistory}
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/orders/{orderID}/history [get]
+func (vr OrdersResource) getOrderHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter := form.OrderGetForClient{
+		OrderID: chi.URLParam(r, "orderID"),
+		UserID:  r.Header.Get(HeaderXUserID),
+	}
+
+	history, err := vr.ordersService.GetOrderHistory(ctx, filter)
+	if err != nil {
+		vr.logger.Error("ошибка получения истории заказа", err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.List{
+		Items: history,
+		Count: int64(len(history)),
+	})
+}
@@ -0,0 +1,159 @@
+package v1
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+)
+
+// HeaderPaymentSignature заголовок с подписью уведомления платежного провайдера.
+const HeaderPaymentSignature = "X-Payment-Signature"
+
+// maxWebhookSize максимальный размер уведомления платежного провайдера в байтах.
+const maxWebhookSize = 1 << 20
+
+// PaymentResource представляет собой обработчик для оплаты заказов.
+type PaymentResource struct {
+	paymentService service.PaymentService // Сервис оплаты заказов
+	logger         logger.Logger          // Логирование запросов и ошибок обработчиков
+}
+
+// NewPaymentHandler создает новый экземпляр PaymentResource.
+func NewPaymentHandler(paymentService service.PaymentService, log logger.Logger) *PaymentResource {
+	return &PaymentResource{
+		paymentService: paymentService,
+		logger:         log,
+	}
+}
+
+// Routes возвращает роутер для оплаты заказа.
+func (pr PaymentResource) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", pr.getPayment)
+	r.Post("/", pr.pay)
+
+	return r
+}
+
+// WebhookRoutes возвращает роутер для уведомлений платежного провайдера.
+func (pr PaymentResource) WebhookRoutes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Post("/", pr.webhook)
+
+	return r
+}
+
+// getPayment возвращает платеж по заказу.
+// @Summary Получение платежа по заказу
+// @Description Получение платежа по заказу вместе с возвратами. Доступно участникам заказа
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param orderID path string true "Идентификатор заказа"
+// @Success 200 {object} entity.Payment
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/orders/{orderID}/payment [get]
+func (pr PaymentResource) getPayment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter := form.OrderGetForClient{
+		OrderID: chi.URLParam(r, "orderID"),
+		UserID:  r.Header.Get(HeaderXUserID),
+	}
+
+	payment, err := pr.paymentService.GetOrderPayment(ctx, filter)
+	if err != nil {
+		pr.logger.Errorf("Ошибка при получении платежа по заказу %s: %v", filter.OrderID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, payment)
+}
+
+// pay оплачивает заказ.
+// @Summary Оплата заказа
+// @Description Блокировка стоимости заказа на счете покупателя. Деньги списываются при подтверждении заказа
+// @Description и возвращаются при отмене. Подтвержденный заказ оплачивается сразу
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор покупателя"
+// @Param orderID path string true "Идентификатор заказа"
+// @Success 200 {object} entity.Payment
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/orders/{orderID}/payment [post]
+func (pr PaymentResource) pay(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	payForm := form.OrderPay{
+		OrderID: chi.URLParam(r, "orderID"),
+		UserID:  r.Header.Get(HeaderXUserID),
+	}
+
+	payment, err := pr.paymentService.PayOrder(ctx, payForm, time.Now().UTC())
+	if err != nil {
+		pr.logger.Errorf("Ошибка при оплате заказа %s: %v", payForm.OrderID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, payment)
+}
+
+// webhook принимает уведомление платежного провайдера.
+// @Summary Уведомление платежного провайдера
+// @Description Изменение платежа на стороне провайдера. Тело подписывается HMAC-SHA256 вместе со временем отправки:
+// @Description X-Payment-Signature: t=<unix>,v1=<hex>. Устаревшие и повторные уведомления не применяются
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Param X-Payment-Signature header string true "Подпись уведомления"
+// @Param event body entity.PaymentWebhookEvent true "Уведомление"
+// @Success 200 {object} entity.Response
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/payments/webhook [post]
+func (pr PaymentResource) webhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookSize))
+	if err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.PaymentWebhookDecodeCode))
+
+		return
+	}
+
+	webhook := form.PaymentWebhook{
+		Payload:   payload,
+		Signature: r.Header.Get(HeaderPaymentSignature),
+	}
+
+	if err = pr.paymentService.HandleWebhook(ctx, webhook, time.Now().UTC()); err != nil {
+		pr.logger.Errorf("Ошибка при обработке уведомления платежного провайдера: %v", err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.Response{Detail: "уведомление обработано"})
+}
@@ -0,0 +1,120 @@
+package v1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	jsoniter "github.com/json-iterator/go"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+)
+
+// RatingResource представляет собой обработчик для оценок.
+type RatingResource struct {
+	ratingService service.RatingService // Сервис для работы с оценками
+	logger        logger.Logger         // Логирование запросов и ошибок обработчиков
+	json          jsoniter.API          // JSON-парсер
+}
+
+// NewRatingHandler создает новый экземпляр RatingResource.
+func NewRatingHandler(ratingService service.RatingService, log logger.Logger) *RatingResource {
+	return &RatingResource{
+		ratingService: ratingService,
+		logger:        log,
+		json:          jsoniter.ConfigCompatibleWithStandardLibrary,
+	}
+}
+
+// Routes возвращает роутер для обработчика оценок.
+func (rr RatingResource) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", rr.getRatings)
+	r.Post("/", rr.rateOrder)
+
+	return r
+}
+
+// getRatings возвращает оценки, полученные пользователем.
+// @Summary Получение оценок пользователя
+// @Description Получение оценок, которые пользователь получил по завершенным заказам
+// @Tags ratings
+// @Accept json
+// @Produce json
+// @Param userID query string true "Идентификатор оцененного пользователя"
+// @Param pagination query form.Pagination false "Пагинация"
+// @Success 200 {object} entity.List{items=entity.Ratings}
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/ratings [get]
+func (rr RatingResource) getRatings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pagination, err := form.ParsePagination(r.URL.Query())
+	if err != nil {
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	filter := form.RatingsGet{
+		UserID:     r.URL.Query().Get("userID"),
+		Pagination: pagination,
+	}
+
+	ratings, count, err := rr.ratingService.GetRatings(ctx, filter)
+	if err != nil {
+		rr.logger.Errorf("Ошибка при получении оценок пользователя %s: %v", filter.UserID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.List{
+		Items: ratings,
+		Count: count,
+	})
+}
+
+// rateOrder оценивает вторую сторону завершенного заказа.
+// @Summary Оценка сделки
+// @Description Оценка второй стороны завершенного заказа. Каждая сторона оценивает заказ один раз
+// @Tags ratings
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param rating body form.RatingCreate true "Оценка"
+// @Success 200 {object} entity.Rating
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/ratings [post]
+func (rr RatingResource) rateOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var createForm form.RatingCreate
+	if err := rr.json.NewDecoder(r.Body).Decode(&createForm); err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.RatingDecodeCode))
+
+		return
+	}
+
+	createForm.RaterID = r.Header.Get(HeaderXUserID)
+
+	rating, err := rr.ratingService.RateOrder(ctx, createForm, time.Now().UTC())
+	if err != nil {
+		rr.logger.Errorf("Ошибка при оценке заказа %s: %v", createForm.OrderID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, rating)
+}
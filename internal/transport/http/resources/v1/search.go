@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+)
+
+// SearchResource представляет собой обработчик для полнотекстового поиска.
+type SearchResource struct {
+	searchService service.SearchService // Сервис полнотекстового поиска
+	logger        logger.Logger         // Логирование запросов и ошибок обработчиков
+}
+
+// NewSearchHandler создает новый экземпляр SearchResource.
+func NewSearchHandler(searchService service.SearchService, log logger.Logger) *SearchResource {
+	return &SearchResource{
+		searchService: searchService,
+		logger:        log,
+	}
+}
+
+// Routes возвращает роутер для обработчика поиска.
+func (sr SearchResource) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", sr.search)
+
+	return r
+}
+
+// search ищет пользователей и объявления по тексту.
+// @Summary Полнотекстовый поиск
+// @Description Поиск пользователей и доступных для обмена объявлений с учетом словоформ на русском и английском.
+// @Description Результаты отсортированы по релевантности, найденные слова выделены тегом em.
+// @Description Фасеты считаются без учета фильтров kind и category. Пролистать можно первые 1000 результатов
+// @Tags search
+// @Accept json
+// @Produce json
+// @Param q query string true "Поисковый запрос"
+// @Param kind query string false "Тип документов" Enums(user, listing)
+// @Param category query string false "Категория объявлений"
+// @Param near query string false "Только документы рядом с точкой в формате широта,долгота" example(43.238,76.945)
+// @Param radius_km query number false "Радиус поиска от точки near в километрах, по умолчанию 10"
+// @Param pagination query form.Pagination false "Пагинация"
+// @Success 200 {object} entity.SearchResult
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/search [get]
+func (sr SearchResource) search(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pagination, err := form.ParsePagination(r.URL.Query())
+	if err != nil {
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	near, err := form.ParseGeoNear(r.URL.Query())
+	if err != nil {
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	filter := form.Search{
+		Query:      r.URL.Query().Get("q"),
+		Kind:       entity.SearchKind(r.URL.Query().Get("kind")),
+		Category:   r.URL.Query().Get("category"),
+		Near:       near,
+		Pagination: pagination,
+	}
+
+	result, err := sr.searchService.Search(ctx, filter)
+	if err != nil {
+		sr.logger.Errorf("Ошибка при поиске по запросу %q: %v", filter.Query, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, result)
+}
@@ -0,0 +1,180 @@
+package v1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+)
+
+// TradeCycleResource представляет собой обработчик для циклов обмена.
+type TradeCycleResource struct {
+	tradeCycleService service.TradeCycleService // Сервис для работы с циклами обмена
+	logger            logger.Logger             // Логирование запросов и ошибок обработчиков
+}
+
+// NewTradeCycleHandler создает новый экземпляр TradeCycleResource.
+func NewTradeCycleHandler(tradeCycleService service.TradeCycleService, log logger.Logger) *TradeCycleResource {
+	return &TradeCycleResource{
+		tradeCycleService: tradeCycleService,
+		logger:            log,
+	}
+}
+
+// Routes возвращает роутер для обработчика циклов обмена.
+func (cr TradeCycleResource) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", cr.getTradeCycles)
+	r.Get("/{id}", cr.getByID)
+	r.Post("/{id}/accept", cr.acceptTradeCycle)
+	r.Post("/{id}/decline", cr.declineTradeCycle)
+
+	return r
+}
+
+// getTradeCycles возвращает список циклов обмена пользователя.
+// @Summary Получение списка циклов обмена
+// @Description Получение циклов обмена, в которых участвует пользователь
+// @Tags cycles
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param filter query form.TradeCyclesGet false "Фильтр"
+// @Param pagination query form.Pagination false "Пагинация"
+// @Success 200 {object} entity.List{items=entity.TradeCycles}
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/cycles [get]
+func (cr TradeCycleResource) getTradeCycles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pagination, err := form.ParsePagination(r.URL.Query())
+	if err != nil {
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	filter := form.TradeCyclesGet{
+		UserID:     r.Header.Get(HeaderXUserID),
+		Status:     r.URL.Query().Get("status"),
+		Pagination: pagination,
+	}
+
+	cycles, count, err := cr.tradeCycleService.GetTradeCycles(ctx, filter)
+	if err != nil {
+		cr.logger.Errorf("Ошибка при получении списка циклов обмена: %v", err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.List{
+		Items: cycles,
+		Count: count,
+	})
+}
+
+// getByID возвращает цикл обмена по его идентификатору.
+// @Summary Получение цикла обмена по идентификатору
+// @Description Получение цикла обмена по идентификатору. Доступно только участникам
+// @Tags cycles
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор цикла"
+// @Success 200 {object} entity.TradeCycle
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/cycles/{id} [get]
+func (cr TradeCycleResource) getByID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	action := cr.parseAction(r)
+
+	cycle, err := cr.tradeCycleService.GetTradeCycle(ctx, action)
+	if err != nil {
+		cr.logger.Errorf("Ошибка при получении цикла обмена %s: %v", action.CycleID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, cycle)
+}
+
+// acceptTradeCycle фиксирует согласие участника с циклом обмена.
+// @Summary Согласие с циклом обмена
+// @Description Согласие участника. Когда согласны все, объявления цикла резервируются
+// @Tags cycles
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор цикла"
+// @Success 200 {object} entity.TradeCycle
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/cycles/{id}/accept [post]
+func (cr TradeCycleResource) acceptTradeCycle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	action := cr.parseAction(r)
+
+	cycle, err := cr.tradeCycleService.AcceptTradeCycle(ctx, action, time.Now().UTC())
+	if err != nil {
+		cr.logger.Errorf("Ошибка при согласии с циклом обмена %s: %v", action.CycleID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, cycle)
+}
+
+// declineTradeCycle отклоняет цикл обмена.
+// @Summary Отказ от цикла обмена
+// @Description Отказ участника от цикла обмена
+// @Tags cycles
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор цикла"
+// @Success 200 {object} entity.TradeCycle
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/cycles/{id}/decline [post]
+func (cr TradeCycleResource) declineTradeCycle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	action := cr.parseAction(r)
+
+	cycle, err := cr.tradeCycleService.DeclineTradeCycle(ctx, action, time.Now().UTC())
+	if err != nil {
+		cr.logger.Errorf("Ошибка при отказе от цикла обмена %s: %v", action.CycleID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, cycle)
+}
+
+// parseAction возвращает форму действия над циклом из пути и заголовков запроса.
+func (cr TradeCycleResource) parseAction(r *http.Request) form.TradeCycleAction {
+	return form.TradeCycleAction{
+		CycleID: chi.URLParam(r, "id"),
+		UserID:  r.Header.Get(HeaderXUserID),
+	}
+}
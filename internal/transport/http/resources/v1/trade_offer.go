@@ -0,0 +1,322 @@
+package v1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	jsoniter "github.com/json-iterator/go"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+)
+
+// TradeOfferResource представляет собой обработчик для предложений обмена.
+type TradeOfferResource struct {
+	tradeOfferService service.TradeOfferService // Сервис для работы с предложениями обмена
+	logger            logger.Logger             // Логирование запросов и ошибок обработчиков
+	json              jsoniter.API              // JSON-парсер
+}
+
+// NewTradeOfferHandler создает новый экземпляр TradeOfferResource.
+func NewTradeOfferHandler(tradeOfferService service.TradeOfferService, log logger.Logger) *TradeOfferResource {
+	return &TradeOfferResource{
+		tradeOfferService: tradeOfferService,
+		logger:            log,
+		json:              jsoniter.ConfigCompatibleWithStandardLibrary,
+	}
+}
+
+// Routes возвращает роутер для обработчика предложений обмена.
+func (tr TradeOfferResource) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", tr.getTradeOffers)
+	r.Post("/", tr.createTradeOffer)
+	r.Get("/{id}", tr.getByID)
+	r.Post("/{id}/accept", tr.acceptTradeOffer)
+	r.Post("/{id}/reject", tr.rejectTradeOffer)
+	r.Post("/{id}/cancel", tr.cancelTradeOffer)
+	r.Post("/{id}/complete", tr.completeTradeOffer)
+	r.Post("/{id}/counter", tr.counterTradeOffer)
+
+	return r
+}
+
+// getTradeOffers возвращает список предложений обмена пользователя.
+// @Summary Получение списка предложений обмена
+// @Description Получение входящих и исходящих предложений обмена пользователя
+// @Tags offers
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param filter query form.TradeOffersGet false "Фильтр"
+// @Param pagination query form.Pagination false "Пагинация"
+// @Success 200 {object} entity.List{items=entity.TradeOffers}
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/offers [get]
+func (tr TradeOfferResource) getTradeOffers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pagination, err := form.ParsePagination(r.URL.Query())
+	if err != nil {
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	filter := form.TradeOffersGet{
+		UserID:     r.Header.Get(HeaderXUserID),
+		Role:       r.URL.Query().Get("role"),
+		Status:     r.URL.Query().Get("status"),
+		Pagination: pagination,
+	}
+
+	offers, count, err := tr.tradeOfferService.GetTradeOffers(ctx, filter)
+	if err != nil {
+		tr.logger.Errorf("Ошибка при получении списка предложений обмена: %v", err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.List{
+		Items: offers,
+		Count: count,
+	})
+}
+
+// createTradeOffer создает новое предложение обмена.
+// @Summary Создание предложения обмена
+// @Description Создание предложения обмена своих объявлений на объявления другого пользователя
+// @Tags offers
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param offer body form.TradeOfferCreate true "Предложение обмена"
+// @Success 200 {object} entity.TradeOffer
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/offers [post]
+func (tr TradeOfferResource) createTradeOffer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var createForm form.TradeOfferCreate
+	if err := tr.json.NewDecoder(r.Body).Decode(&createForm); err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.TradeOfferDecodeCode))
+
+		return
+	}
+
+	createForm.ProposerID = r.Header.Get(HeaderXUserID)
+
+	offer, err := tr.tradeOfferService.CreateTradeOffer(ctx, createForm, time.Now().UTC())
+	if err != nil {
+		tr.logger.Errorf("Ошибка при создании предложения обмена: %v", err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, offer)
+}
+
+// getByID возвращает предложение обмена по его идентификатору.
+// @Summary Получение предложения обмена по идентификатору
+// @Description Получение предложения обмена по идентификатору. Доступно только участникам
+// @Tags offers
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор предложения"
+// @Success 200 {object} entity.TradeOffer
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/offers/{id} [get]
+func (tr TradeOfferResource) getByID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	action := tr.parseAction(r)
+
+	offer, err := tr.tradeOfferService.GetTradeOffer(ctx, action)
+	if err != nil {
+		tr.logger.Errorf("Ошибка при получении предложения обмена %s: %v", action.OfferID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, offer)
+}
+
+// acceptTradeOffer принимает предложение обмена.
+// @Summary Принятие предложения обмена
+// @Description Принятие предложения обмена получателем. Объявления обеих сторон резервируются
+// @Tags offers
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор предложения"
+// @Success 200 {object} entity.TradeOffer
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/offers/{id}/accept [post]
+func (tr TradeOfferResource) acceptTradeOffer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	action := tr.parseAction(r)
+
+	offer, err := tr.tradeOfferService.AcceptTradeOffer(ctx, action, time.Now().UTC())
+	if err != nil {
+		tr.logger.Errorf("Ошибка при принятии предложения обмена %s: %v", action.OfferID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, offer)
+}
+
+// rejectTradeOffer отклоняет предложение обмена.
+// @Summary Отклонение предложения обмена
+// @Description Отклонение предложения обмена получателем
+// @Tags offers
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор предложения"
+// @Success 200 {object} entity.TradeOffer
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/offers/{id}/reject [post]
+func (tr TradeOfferResource) rejectTradeOffer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	action := tr.parseAction(r)
+
+	offer, err := tr.tradeOfferService.RejectTradeOffer(ctx, action, time.Now().UTC())
+	if err != nil {
+		tr.logger.Errorf("Ошибка при отклонении предложения обмена %s: %v", action.OfferID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, offer)
+}
+
+// cancelTradeOffer отзывает предложение обмена.
+// @Summary Отзыв предложения обмена
+// @Description Отзыв ожидающего предложения обмена автором или отмена принятого любым участником.
+// @Description Отмена принятого предложения возвращает объявления в статус active, отменяет заказ обмена и снимает блокировку доплаты
+// @Tags offers
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор предложения"
+// @Success 200 {object} entity.TradeOffer
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/offers/{id}/cancel [post]
+func (tr TradeOfferResource) cancelTradeOffer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	action := tr.parseAction(r)
+
+	offer, err := tr.tradeOfferService.CancelTradeOffer(ctx, action, time.Now().UTC())
+	if err != nil {
+		tr.logger.Errorf("Ошибка при отзыве предложения обмена %s: %v", action.OfferID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, offer)
+}
+
+// completeTradeOffer отмечает обмен состоявшимся.
+// @Summary Завершение обмена
+// @Description Отметка принятого обмена состоявшимся любым участником. Объявления обеих сторон переходят в статус exchanged,
+// @Description заказ обмена завершается, а заблокированная доплата списывается
+// @Tags offers
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор предложения"
+// @Success 200 {object} entity.TradeOffer
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/offers/{id}/complete [post]
+func (tr TradeOfferResource) completeTradeOffer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	action := tr.parseAction(r)
+
+	offer, err := tr.tradeOfferService.CompleteTradeOffer(ctx, action, time.Now().UTC())
+	if err != nil {
+		tr.logger.Errorf("Ошибка при завершении обмена %s: %v", action.OfferID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, offer)
+}
+
+// counterTradeOffer создает встречное предложение обмена.
+// @Summary Встречное предложение обмена
+// @Description Создание встречного предложения получателем. Исходное предложение переходит в статус countered
+// @Tags offers
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор исходного предложения"
+// @Param offer body form.TradeOfferCounter true "Встречное предложение"
+// @Success 200 {object} entity.TradeOffer
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/offers/{id}/counter [post]
+func (tr TradeOfferResource) counterTradeOffer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var counterForm form.TradeOfferCounter
+	if err := tr.json.NewDecoder(r.Body).Decode(&counterForm); err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.TradeOfferDecodeCode))
+
+		return
+	}
+
+	counterForm.OfferID = chi.URLParam(r, "id")
+	counterForm.UserID = r.Header.Get(HeaderXUserID)
+
+	offer, err := tr.tradeOfferService.CounterTradeOffer(ctx, counterForm, time.Now().UTC())
+	if err != nil {
+		tr.logger.Errorf("Ошибка при создании встречного предложения на %s: %v", counterForm.OfferID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, offer)
+}
+
+// parseAction возвращает форму действия над предложением из пути и заголовков запроса.
+func (tr TradeOfferResource) parseAction(r *http.Request) form.TradeOfferAction {
+	return form.TradeOfferAction{
+		OfferID: chi.URLParam(r, "id"),
+		UserID:  r.Header.Get(HeaderXUserID),
+	}
+}
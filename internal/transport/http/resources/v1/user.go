@@ -7,7 +7,6 @@ import (
 	"github.com/go-chi/chi"
 	"github.com/go-chi/render"
 	jsoniter "github.com/json-iterator/go"
-	"gitlab.com/example/gophers/libs/errors/httperrors"
 	"gitlab.com/example/gophers/libs/logger"
 
 	"github.com/alisher-99/LomBarter/internal/domain/entity"
@@ -53,6 +52,7 @@ func (vr UserResource) Routes() chi.Router {
 // @Success 200 {object} entity.List{items=entity.Users}
 // @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
 // @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
 // @Router /v1/users [get]
 func (vr UserResource) getUsers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -84,13 +84,14 @@ func (vr UserResource) getUsers(w http.ResponseWriter, r *http.Request) {
 // @Success 200 {string} string
 // @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
 // @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
 // @Router /v1/users [post]
 func (vr UserResource) createUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var createForm form.UserCreate
 	if err := vr.json.NewDecoder(r.Body).Decode(&createForm); err != nil {
-		_ = render.Render(w, r, httperrors.BadRequest(err, entity.UserDecodeCode))
+		_ = render.Render(w, r, detector.BadRequest(err, entity.UserDecodeCode))
 
 		return
 	}
@@ -116,6 +117,7 @@ func (vr UserResource) createUser(w http.ResponseWriter, r *http.Request) {
 // @Success 200 {object} entity.User
 // @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
 // @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
 // @Router /v1/users/{id} [get]
 func (vr UserResource) getByID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
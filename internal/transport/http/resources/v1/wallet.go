@@ -0,0 +1,194 @@
+package v1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	jsoniter "github.com/json-iterator/go"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+)
+
+// WalletResource представляет собой обработчик для кошельков кредитов.
+type WalletResource struct {
+	walletService service.WalletService // Сервис кошельков кредитов
+	logger        logger.Logger         // Логирование запросов и ошибок обработчиков
+	json          jsoniter.API          // JSON-парсер
+}
+
+// NewWalletHandler создает новый экземпляр WalletResource.
+func NewWalletHandler(walletService service.WalletService, log logger.Logger) *WalletResource {
+	return &WalletResource{
+		walletService: walletService,
+		logger:        log,
+		json:          jsoniter.ConfigCompatibleWithStandardLibrary,
+	}
+}
+
+// Routes возвращает роутер для обработчика кошелька пользователя.
+func (wr WalletResource) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", wr.getWallet)
+	r.Get("/statement", wr.getStatement)
+	r.Post("/transfers", wr.transfer)
+
+	return r
+}
+
+// AdminRoutes возвращает роутер для начислений кредитов администраторами.
+func (wr WalletResource) AdminRoutes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Post("/grants", wr.grant)
+
+	return r
+}
+
+// getWallet возвращает кошелек пользователя.
+// @Summary Получение баланса кредитов
+// @Description Получение баланса кредитов пользователя. До первой проводки баланс нулевой
+// @Tags wallet
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Success 200 {object} entity.LedgerAccount
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/wallet [get]
+func (wr WalletResource) getWallet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter := form.WalletGet{UserID: r.Header.Get(HeaderXUserID)}
+
+	wallet, err := wr.walletService.GetWallet(ctx, filter)
+	if err != nil {
+		wr.logger.Errorf("Ошибка при получении кошелька пользователя %s: %v", filter.UserID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, wallet)
+}
+
+// getStatement возвращает выписку по кошельку пользователя.
+// @Summary Получение выписки по кредитам
+// @Description Получение проводок по счету пользователя, начиная с самых новых, с балансом после каждой проводки
+// @Tags wallet
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param pagination query form.Pagination false "Пагинация"
+// @Success 200 {object} entity.List{items=entity.LedgerPostings}
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/wallet/statement [get]
+func (wr WalletResource) getStatement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pagination, err := form.ParsePagination(r.URL.Query())
+	if err != nil {
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	filter := form.WalletStatementGet{
+		UserID:     r.Header.Get(HeaderXUserID),
+		Pagination: pagination,
+	}
+
+	postings, count, err := wr.walletService.GetStatement(ctx, filter)
+	if err != nil {
+		wr.logger.Errorf("Ошибка при получении выписки пользователя %s: %v", filter.UserID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.List{
+		Items: postings,
+		Count: count,
+	})
+}
+
+// transfer переводит кредиты другому пользователю.
+// @Summary Перевод кредитов
+// @Description Перевод кредитов другому пользователю, например доплата за неравноценный обмен. Баланс отправителя не может стать отрицательным
+// @Tags wallet
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param transfer body form.WalletTransfer true "Перевод"
+// @Success 200 {object} entity.LedgerTransaction
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/wallet/transfers [post]
+func (wr WalletResource) transfer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var transferForm form.WalletTransfer
+	if err := wr.json.NewDecoder(r.Body).Decode(&transferForm); err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.WalletTransferDecodeCode))
+
+		return
+	}
+
+	transferForm.FromUserID = r.Header.Get(HeaderXUserID)
+
+	transaction, err := wr.walletService.Transfer(ctx, transferForm, time.Now().UTC())
+	if err != nil {
+		wr.logger.Errorf("Ошибка при переводе кредитов от %s к %s: %v", transferForm.FromUserID, transferForm.ToUserID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, transaction)
+}
+
+// grant начисляет кредиты пользователю.
+// @Summary Начисление кредитов
+// @Description Начисление кредитов пользователю администратором. Отрицательная сумма списывает кредиты
+// @Tags wallet
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор администратора"
+// @Param grant body form.WalletGrant true "Начисление"
+// @Success 200 {object} entity.LedgerTransaction
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/admin/wallet/grants [post]
+func (wr WalletResource) grant(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var grantForm form.WalletGrant
+	if err := wr.json.NewDecoder(r.Body).Decode(&grantForm); err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.WalletGrantDecodeCode))
+
+		return
+	}
+
+	grantForm.RequesterID = r.Header.Get(HeaderXUserID)
+
+	transaction, err := wr.walletService.Grant(ctx, grantForm, time.Now().UTC())
+	if err != nil {
+		wr.logger.Errorf("Ошибка при начислении кредитов пользователю %s: %v", grantForm.UserID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, transaction)
+}
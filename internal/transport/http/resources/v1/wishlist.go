@@ -0,0 +1,159 @@
+package v1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-chi/render"
+	jsoniter "github.com/json-iterator/go"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+)
+
+// WishlistResource представляет собой обработчик для списков желаний.
+type WishlistResource struct {
+	wishlistService service.WishlistService // Сервис для работы со списками желаний
+	logger          logger.Logger           // Логирование запросов и ошибок обработчиков
+	json            jsoniter.API            // JSON-парсер
+}
+
+// NewWishlistHandler создает новый экземпляр WishlistResource.
+func NewWishlistHandler(wishlistService service.WishlistService, log logger.Logger) *WishlistResource {
+	return &WishlistResource{
+		wishlistService: wishlistService,
+		logger:          log,
+		json:            jsoniter.ConfigCompatibleWithStandardLibrary,
+	}
+}
+
+// Routes возвращает роутер для обработчика списков желаний.
+// Монтируется под /users/{id}/wishlist, идентификатор пользователя берется из пути.
+func (wr WishlistResource) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", wr.getWishlist)
+	r.Post("/", wr.addWishlistItem)
+	r.Delete("/{itemID}", wr.deleteWishlistItem)
+
+	return r
+}
+
+// getWishlist возвращает список желаний пользователя.
+// @Summary Получение списка желаний
+// @Description Получение списка желаний пользователя. Доступно только владельцу
+// @Tags wishlist
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор пользователя"
+// @Param pagination query form.Pagination false "Пагинация"
+// @Success 200 {object} entity.List{items=entity.WishlistItems}
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/users/{id}/wishlist [get]
+func (wr WishlistResource) getWishlist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pagination, err := form.ParsePagination(r.URL.Query())
+	if err != nil {
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	filter := form.WishlistGet{
+		UserID:      chi.URLParam(r, "id"),
+		RequesterID: r.Header.Get(HeaderXUserID),
+		Pagination:  pagination,
+	}
+
+	items, count, err := wr.wishlistService.GetWishlist(ctx, filter)
+	if err != nil {
+		wr.logger.Errorf("Ошибка при получении списка желаний %s: %v", filter.UserID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.List{
+		Items: items,
+		Count: count,
+	})
+}
+
+// addWishlistItem добавляет позицию в список желаний.
+// @Summary Добавление позиции в список желаний
+// @Description Добавление позиции в список желаний. О новых подходящих объявлениях пользователь получит уведомление
+// @Tags wishlist
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор пользователя"
+// @Param item body form.WishlistItemCreate true "Позиция списка желаний"
+// @Success 200 {object} entity.WishlistItem
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/users/{id}/wishlist [post]
+func (wr WishlistResource) addWishlistItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var createForm form.WishlistItemCreate
+	if err := wr.json.NewDecoder(r.Body).Decode(&createForm); err != nil {
+		_ = render.Render(w, r, detector.BadRequest(err, entity.WishlistItemDecodeCode))
+
+		return
+	}
+
+	createForm.UserID = chi.URLParam(r, "id")
+	createForm.RequesterID = r.Header.Get(HeaderXUserID)
+
+	item, err := wr.wishlistService.AddWishlistItem(ctx, createForm, time.Now().UTC())
+	if err != nil {
+		wr.logger.Errorf("Ошибка при добавлении позиции в список желаний %s: %v", createForm.UserID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, item)
+}
+
+// deleteWishlistItem удаляет позицию из списка желаний.
+// @Summary Удаление позиции из списка желаний
+// @Description Удаление позиции из списка желаний. Доступно только владельцу
+// @Tags wishlist
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор пользователя"
+// @Param itemID path string true "Идентификатор позиции"
+// @Success 200 {object} entity.Response
+// @Failure 400 {object} swagger.HTTPResponse400 "Код ошибки"
+// @Failure 500 {object} swagger.HTTPResponse500 "Внутренняя ошибка сервера"
+// @Deprecated
+// @Router /v1/users/{id}/wishlist/{itemID} [delete]
+func (wr WishlistResource) deleteWishlistItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	deleteForm := form.WishlistItemDelete{
+		UserID:      chi.URLParam(r, "id"),
+		RequesterID: r.Header.Get(HeaderXUserID),
+		ItemID:      chi.URLParam(r, "itemID"),
+	}
+
+	if err := wr.wishlistService.DeleteWishlistItem(ctx, deleteForm); err != nil {
+		wr.logger.Errorf("Ошибка при удалении позиции списка желаний %s: %v", deleteForm.ItemID, err)
+		_ = render.Render(w, r, detector.Error(err))
+
+		return
+	}
+
+	render.JSON(w, r, entity.Response{Detail: "позиция удалена из списка желаний"})
+}
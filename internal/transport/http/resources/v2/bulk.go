@@ -0,0 +1,322 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/presenter"
+	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+	v1 "github.com/alisher-99/LomBarter/internal/transport/http/resources/v1"
+)
+
+// HeaderXImportRun заголовок ответа с идентификатором запуска импорта. Отправляется до отчета,
+// поэтому известен, даже если соединение оборвется посреди импорта.
+const HeaderXImportRun = "X-Import-Run"
+
+// importFunc импортирует строки в рамках запуска.
+type importFunc func(
+	ctx context.Context,
+	run *entity.ImportRun,
+	rows *form.BulkDecoder,
+	report service.ImportReporter,
+	currentTime time.Time,
+) error
+
+// BulkResource представляет собой обработчик массового импорта и экспорта.
+type BulkResource struct {
+	bulkService service.BulkService // Сервис массового импорта и экспорта
+	logger      logger.Logger       // Логирование запросов и ошибок обработчиков
+}
+
+// NewBulkHandler создает новый экземпляр BulkResource.
+func NewBulkHandler(bulkService service.BulkService, log logger.Logger) *BulkResource {
+	return &BulkResource{
+		bulkService: bulkService,
+		logger:      log,
+	}
+}
+
+// UserRoutes возвращает роутер импорта и экспорта пользователей администраторами.
+func (br BulkResource) UserRoutes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Post("/import", br.importUsers)
+	r.Get("/export", br.exportUsers)
+
+	return r
+}
+
+// OrderRoutes возвращает роутер импорта и экспорта заказов администраторами.
+func (br BulkResource) OrderRoutes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Post("/import", br.importOrders)
+	r.Get("/export", br.exportOrders)
+
+	return r
+}
+
+// importUsers импортирует пользователей.
+// @Summary Импорт пользователей
+// @Description Импорт пользователей из CSV (колонки name, bio, city, lat, lon) или NDJSON (объекты form.UserCreate).
+// @Description Формат задается заголовком Content-Type. Каждая строка проверяется как при создании пользователя, строки сохраняются пачками.
+// @Description Отчет отдается в NDJSON по мере сохранения пачек: результат каждой строки, в последней строке итог запуска.
+// @Description Идентификатор запуска возвращается в заголовке X-Import-Run. Прерванный импорт продолжается повторной отправкой того же файла с параметром run. Доступно администраторам
+// @Tags bulk
+// @Accept text/csv,application/x-ndjson
+// @Produce application/x-ndjson
+// @Param X-User-Id header string true "Идентификатор администратора"
+// @Param run query string false "Идентификатор прерванного запуска"
+// @Param rows body string true "Строки CSV с заголовком или NDJSON"
+// @Success 200 {object} presenter.ImportReport "Строка отчета"
+// @Header 200 {string} X-Import-Run "Идентификатор запуска импорта"
+// @Failure 400 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Код ошибки"
+// @Failure 404 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Запуск импорта не найден"
+// @Failure 500 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Внутренняя ошибка сервера"
+// @Router /v2/admin/users/import [post]
+func (br BulkResource) importUsers(w http.ResponseWriter, r *http.Request) {
+	br.importRows(w, r, entity.BulkKindUsers, br.bulkService.ImportUsers)
+}
+
+// importOrders импортирует заказы.
+// @Summary Импорт заказов
+// @Description Импорт заказов из CSV (колонки userID, counterpartyID, amount, currency) или NDJSON (объекты form.OrderImport).
+// @Description Формат задается заголовком Content-Type. Каждая строка проверяется как при создании заказа, строки сохраняются пачками.
+// @Description Отчет отдается в NDJSON по мере сохранения пачек: результат каждой строки, в последней строке итог запуска.
+// @Description Идентификатор запуска возвращается в заголовке X-Import-Run. Прерванный импорт продолжается повторной отправкой того же файла с параметром run. Доступно администраторам
+// @Tags bulk
+// @Accept text/csv,application/x-ndjson
+// @Produce application/x-ndjson
+// @Param X-User-Id header string true "Идентификатор администратора"
+// @Param run query string false "Идентификатор прерванного запуска"
+// @Param rows body string true "Строки CSV с заголовком или NDJSON"
+// @Success 200 {object} presenter.ImportReport "Строка отчета"
+// @Header 200 {string} X-Import-Run "Идентификатор запуска импорта"
+// @Failure 400 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Код ошибки"
+// @Failure 404 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Запуск импорта не найден"
+// @Failure 500 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Внутренняя ошибка сервера"
+// @Router /v2/admin/orders/import [post]
+func (br BulkResource) importOrders(w http.ResponseWriter, r *http.Request) {
+	br.importRows(w, r, entity.BulkKindOrders, br.bulkService.ImportOrders)
+}
+
+// importRows запускает импорт и отдает отчет в NDJSON. Ошибки до начала импорта отдаются
+// обычным ответом в конверте, после начала - последней строкой отчета.
+func (br BulkResource) importRows(w http.ResponseWriter, r *http.Request, kind entity.BulkKind, importRows importFunc) {
+	ctx := r.Context()
+
+	format, err := entity.ParseBulkFormat(r.Header.Get("Content-Type"))
+	if err != nil {
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	}
+
+	startForm := form.ImportStart{
+		RequesterID: r.Header.Get(v1.HeaderXUserID),
+		RunID:       r.URL.Query().Get("run"),
+		Kind:        kind,
+	}
+
+	run, err := br.bulkService.StartImport(ctx, startForm, time.Now().UTC())
+	if err != nil {
+		br.logger.Errorf("Ошибка при запуске импорта %s: %v", kind, err)
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	}
+
+	rows, err := form.NewBulkDecoder(r.Body, format)
+	if err != nil {
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	}
+
+	// Отчет пишется, пока тело запроса еще читается.
+	controller := http.NewResponseController(w)
+	if dErr := controller.EnableFullDuplex(); dErr != nil && !errors.Is(dErr, http.ErrNotSupported) {
+		br.logger.Errorf("Ошибка при включении full duplex: %v", dErr)
+	}
+
+	w.Header().Set("Content-Type", entity.BulkFormatNDJSON.ContentType())
+	w.Header().Set(HeaderXImportRun, run.ID)
+	w.WriteHeader(http.StatusOK)
+
+	lang := entity.LanguageFromContext(r.Context())
+	encoder := json.NewEncoder(w)
+
+	report := func(result entity.ImportRowResult) error {
+		row := presenter.NewImportRow(result, rowErrors(result, lang))
+		if err := encoder.Encode(presenter.ImportReport{Result: &row}); err != nil {
+			return err
+		}
+
+		// Без сброса буфера клиент увидит отчет только после импорта всего файла.
+		if err := controller.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+
+		return nil
+	}
+
+	err = importRows(ctx, run, rows, report, time.Now().UTC())
+
+	summary := presenter.ImportReport{Run: presenter.NewImportRun(run)}
+	if err != nil {
+		br.logger.Errorf("Ошибка при импорте %s, запуск %s: %v", kind, run.ID, err)
+
+		response := detector.ErrorResponse(err)
+		response.Localize(lang)
+		summary.Errors = response.EnvelopeErrors()
+	}
+
+	_ = encoder.Encode(summary)
+}
+
+// rowErrors возвращает ошибки строки на языке lang.
+func rowErrors(result entity.ImportRowResult, lang entity.Language) []presenter.EnvelopeError {
+	if result.Err == nil {
+		return nil
+	}
+
+	response := detector.ErrorResponse(result.Err)
+	response.Localize(lang)
+
+	return response.EnvelopeErrors()
+}
+
+// exportUsers выгружает пользователей.
+// @Summary Экспорт пользователей
+// @Description Выгрузка пользователей по фильтру в порядке создания в CSV или NDJSON. Выгрузка отдается по мере чтения из базы.
+// @Description Колонки CSV: id, name, bio, city, lat, lon, moderation, createdAt. Файл выгрузки можно импортировать обратно. Доступно администраторам
+// @Tags bulk
+// @Accept json
+// @Produce text/csv,application/x-ndjson
+// @Param X-User-Id header string true "Идентификатор администратора"
+// @Param format query string false "Формат выгрузки, по умолчанию ndjson" Enums(csv, ndjson)
+// @Param filter query form.UsersExport false "Фильтр"
+// @Success 200 {object} presenter.User "Строка выгрузки"
+// @Failure 400 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Код ошибки"
+// @Failure 500 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Внутренняя ошибка сервера"
+// @Router /v2/admin/users/export [get]
+func (br BulkResource) exportUsers(w http.ResponseWriter, r *http.Request) {
+	filter, err := form.ParseUsersExport(r.URL.Query())
+	if err != nil {
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	}
+
+	filter.RequesterID = r.Header.Get(v1.HeaderXUserID)
+
+	br.export(w, r, presenter.UserColumns, func(ctx context.Context, encode func(presenter.BulkRecord) error) error {
+		return br.bulkService.ExportUsers(ctx, filter, func(user *entity.User) error {
+			return encode(presenter.NewUser(user))
+		})
+	})
+}
+
+// exportOrders выгружает заказы.
+// @Summary Экспорт заказов
+// @Description Выгрузка заказов по фильтру в порядке создания в CSV или NDJSON без истории статусов. Выгрузка отдается по мере чтения из базы.
+// @Description Колонки CSV: id, userID, counterpartyID, amount, currency, status, createdAt. Файл выгрузки можно импортировать обратно. Доступно администраторам
+// @Tags bulk
+// @Accept json
+// @Produce text/csv,application/x-ndjson
+// @Param X-User-Id header string true "Идентификатор администратора"
+// @Param format query string false "Формат выгрузки, по умолчанию ndjson" Enums(csv, ndjson)
+// @Param filter query form.OrdersExport false "Фильтр"
+// @Success 200 {object} presenter.Order "Строка выгрузки"
+// @Failure 400 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Код ошибки"
+// @Failure 500 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Внутренняя ошибка сервера"
+// @Router /v2/admin/orders/export [get]
+func (br BulkResource) exportOrders(w http.ResponseWriter, r *http.Request) {
+	filter, err := form.ParseOrdersExport(r.URL.Query())
+	if err != nil {
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	}
+
+	filter.RequesterID = r.Header.Get(v1.HeaderXUserID)
+
+	br.export(w, r, presenter.OrderColumns, func(ctx context.Context, encode func(presenter.BulkRecord) error) error {
+		return br.bulkService.ExportOrders(ctx, filter, func(order *entity.Order) error {
+			return encode(presenter.NewOrder(order))
+		})
+	})
+}
+
+// export отдает выгрузку в формате из параметра format. Заголовки ответа отправляются с первой записью,
+// поэтому ошибка проверки фильтра или доступа отдается обычным ответом в конверте.
+func (br BulkResource) export(
+	w http.ResponseWriter,
+	r *http.Request,
+	columns []string,
+	exportRows func(ctx context.Context, encode func(presenter.BulkRecord) error) error,
+) {
+	format := entity.BulkFormatNDJSON
+	if str := r.URL.Query().Get("format"); str != "" {
+		var err error
+		if format, err = entity.ParseBulkFormat(str); err != nil {
+			respondError(w, r, detector.ErrorResponse(err))
+
+			return
+		}
+	}
+
+	var encoder *presenter.BulkEncoder
+
+	// start отправляет заголовки ответа и заголовок CSV.
+	start := func() error {
+		w.Header().Set("Content-Type", format.ContentType())
+
+		var err error
+		encoder, err = presenter.NewBulkEncoder(w, format, columns)
+
+		return err
+	}
+
+	err := exportRows(r.Context(), func(record presenter.BulkRecord) error {
+		if encoder == nil {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+
+		return encoder.Encode(record)
+	})
+
+	switch {
+	case err != nil && encoder == nil:
+		br.logger.Errorf("Ошибка при экспорте: %v", err)
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	case err != nil:
+		// Часть выгрузки уже отправлена, статус не изменить: обрываем ответ, чтобы клиент не принял его за полный.
+		br.logger.Errorf("Ошибка при экспорте, выгрузка оборвана: %v", err)
+		panic(http.ErrAbortHandler)
+	case encoder == nil:
+		// Пустая выгрузка: в CSV остается только заголовок.
+		if err = start(); err != nil {
+			br.logger.Errorf("Ошибка при экспорте: %v", err)
+
+			return
+		}
+	}
+
+	if err = encoder.Flush(); err != nil {
+		br.logger.Errorf("Ошибка при экспорте: %v", err)
+	}
+}
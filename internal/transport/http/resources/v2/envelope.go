@@ -0,0 +1,52 @@
+// Package v2 содержит обработчики API v2. Каждый ответ отдается в конверте presenter.Envelope:
+// данные в data, состояние пагинации списков в meta, ошибки в errors. Сущности отдаются
+// через презентеры, а не напрямую.
+package v2
+
+import (
+	"encoding/json"
+	"net/http"
+	"path"
+
+	"github.com/go-chi/render"
+
+	"github.com/alisher-99/LomBarter/internal/domain/presenter"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+)
+
+// respond отвечает данными в конверте.
+func respond(w http.ResponseWriter, r *http.Request, data interface{}) {
+	render.JSON(w, r, presenter.NewData(data))
+}
+
+// respondList отвечает списком с состоянием пагинации в конверте.
+func respondList(w http.ResponseWriter, r *http.Request, items interface{}, meta presenter.Meta) {
+	render.JSON(w, r, presenter.NewList(items, meta))
+}
+
+// respondCreated отвечает статусом 201 и ссылкой на созданный ресурс в заголовке Location.
+func respondCreated(w http.ResponseWriter, r *http.Request, id string) {
+	w.Header().Set("Location", path.Join(r.URL.Path, id))
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, presenter.NewData(presenter.NewResource(id)))
+}
+
+// respondError отвечает ошибкой в конверте. Код, статус и сообщения берутся так же, как в API v1.
+func respondError(w http.ResponseWriter, r *http.Request, response *detector.Response) {
+	_ = render.Render(w, r, errorEnvelope{response: response})
+}
+
+// errorEnvelope ответ с ошибкой в конверте.
+type errorEnvelope struct {
+	response *detector.Response
+}
+
+// Render переводит сообщения на язык запроса и выставляет статус ответа.
+func (e errorEnvelope) Render(w http.ResponseWriter, r *http.Request) error {
+	return e.response.Render(w, r)
+}
+
+// MarshalJSON возвращает конверт с ошибками.
+func (e errorEnvelope) MarshalJSON() ([]byte, error) {
+	return json.Marshal(presenter.NewErrors(e.response.EnvelopeErrors()...))
+}
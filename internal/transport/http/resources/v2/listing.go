@@ -0,0 +1,235 @@
+package v2
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	jsoniter "github.com/json-iterator/go"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/presenter"
+	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+	v1 "github.com/alisher-99/LomBarter/internal/transport/http/resources/v1"
+)
+
+// ListingResource представляет собой обработчик для объявлений.
+type ListingResource struct {
+	listingService service.ListingService // Сервис для работы с объявлениями
+	logger         logger.Logger          // Логирование запросов и ошибок обработчиков
+	json           jsoniter.API           // JSON-парсер
+}
+
+// NewListingHandler создает новый экземпляр ListingResource.
+func NewListingHandler(listingService service.ListingService, log logger.Logger) *ListingResource {
+	return &ListingResource{
+		listingService: listingService,
+		logger:         log,
+		json:           jsoniter.ConfigCompatibleWithStandardLibrary,
+	}
+}
+
+// Routes возвращает роутер для обработчика объявлений.
+func (vr ListingResource) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", vr.getListings)
+	r.Post("/", vr.createListing)
+	r.Get("/{id}", vr.getByID)
+	r.Put("/{id}", vr.updateListing)
+	r.Delete("/{id}", vr.deleteListing)
+
+	return r
+}
+
+// getListings возвращает список объявлений по фильтру.
+// @Summary Получение списка объявлений
+// @Description Получение списка объявлений
+// @Tags listings
+// @Accept json
+// @Produce json
+// @Param filter query form.ListingsGet false "Фильтр"
+// @Param near query string false "Точка в формате широта,долгота" example(43.238,76.945)
+// @Param radius_km query number false "Радиус поиска от точки near в километрах, по умолчанию 10"
+// @Param pagination query form.Pagination false "Пагинация"
+// @Success 200 {object} presenter.Envelope{data=[]presenter.Listing,meta=presenter.Meta}
+// @Failure 400 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Код ошибки"
+// @Failure 500 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Внутренняя ошибка сервера"
+// @Router /v2/listings [get]
+func (vr ListingResource) getListings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pagination, err := form.ParsePagination(r.URL.Query())
+	if err != nil {
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	}
+
+	near, err := form.ParseGeoNear(r.URL.Query())
+	if err != nil {
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	}
+
+	filter := form.ListingsGet{
+		OwnerID:    r.URL.Query().Get("ownerID"),
+		Category:   r.URL.Query().Get("category"),
+		Status:     r.URL.Query().Get("status"),
+		Tag:        r.URL.Query().Get("tag"),
+		City:       r.URL.Query().Get("city"),
+		SortBy:     r.URL.Query().Get("sort_by"),
+		Near:       near,
+		Pagination: pagination,
+	}
+
+	listings, count, err := vr.listingService.GetListings(ctx, filter)
+	if err != nil {
+		vr.logger.Errorf("Ошибка при получении списка объявлений: %v", err)
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	}
+
+	respondList(w, r, presenter.NewListings(listings), presenter.Meta{
+		Count: count,
+		Page:  max(pagination.Page, 1),
+		Limit: pagination.Limit,
+	})
+}
+
+// createListing создает новое объявление.
+// @Summary Создание объявления
+// @Description Создание объявления. Ссылка на объявление возвращается в заголовке Location
+// @Tags listings
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param listing body form.ListingCreate true "Объявление"
+// @Success 201 {object} presenter.Envelope{data=presenter.Resource}
+// @Failure 400 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Код ошибки"
+// @Failure 500 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Внутренняя ошибка сервера"
+// @Router /v2/listings [post]
+func (vr ListingResource) createListing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var createForm form.ListingCreate
+	if err := vr.json.NewDecoder(r.Body).Decode(&createForm); err != nil {
+		respondError(w, r, detector.BadRequest(err, entity.ListingDecodeCode))
+
+		return
+	}
+
+	createForm.OwnerID = r.Header.Get(v1.HeaderXUserID)
+
+	createdListing, err := vr.listingService.CreateListing(ctx, createForm, time.Now().UTC())
+	if err != nil {
+		vr.logger.Errorf("Ошибка при создании объявления: %v", err)
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	}
+
+	respondCreated(w, r, createdListing.ID)
+}
+
+// getByID возвращает объявление по его идентификатору.
+// @Summary Получение объявления по идентификатору
+// @Description Получение объявления по идентификатору
+// @Tags listings
+// @Accept json
+// @Produce json
+// @Param id path string true "Идентификатор объявления"
+// @Success 200 {object} presenter.Envelope{data=presenter.Listing}
+// @Failure 400 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Код ошибки"
+// @Failure 404 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Объявление не найдено"
+// @Failure 500 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Внутренняя ошибка сервера"
+// @Router /v2/listings/{id} [get]
+func (vr ListingResource) getByID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	listing, err := vr.listingService.GetListingByID(ctx, id)
+	if err != nil {
+		vr.logger.Errorf("Ошибка при получении объявления по идентификатору %s: %v", id, err)
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	}
+
+	respond(w, r, presenter.NewListing(listing))
+}
+
+// updateListing обновляет объявление.
+// @Summary Обновление объявления
+// @Description Обновление объявления. Доступно только владельцу
+// @Tags listings
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор объявления"
+// @Param listing body form.ListingUpdate true "Изменения объявления"
+// @Success 200 {object} presenter.Envelope{data=presenter.Listing}
+// @Failure 400 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Код ошибки"
+// @Failure 404 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Объявление не найдено"
+// @Failure 500 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Внутренняя ошибка сервера"
+// @Router /v2/listings/{id} [put]
+func (vr ListingResource) updateListing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var updateForm form.ListingUpdate
+	if err := vr.json.NewDecoder(r.Body).Decode(&updateForm); err != nil {
+		respondError(w, r, detector.BadRequest(err, entity.ListingDecodeCode))
+
+		return
+	}
+
+	updateForm.ID = chi.URLParam(r, "id")
+	updateForm.OwnerID = r.Header.Get(v1.HeaderXUserID)
+
+	listing, err := vr.listingService.UpdateListing(ctx, updateForm, time.Now().UTC())
+	if err != nil {
+		vr.logger.Errorf("Ошибка при обновлении объявления %s: %v", updateForm.ID, err)
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	}
+
+	respond(w, r, presenter.NewListing(listing))
+}
+
+// deleteListing удаляет объявление.
+// @Summary Удаление объявления
+// @Description Удаление объявления. Доступно только владельцу
+// @Tags listings
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param id path string true "Идентификатор объявления"
+// @Success 200 {object} presenter.Envelope{data=presenter.Resource}
+// @Failure 400 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Код ошибки"
+// @Failure 404 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Объявление не найдено"
+// @Failure 500 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Внутренняя ошибка сервера"
+// @Router /v2/listings/{id} [delete]
+func (vr ListingResource) deleteListing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	deleteForm := form.ListingDelete{
+		ID:      chi.URLParam(r, "id"),
+		OwnerID: r.Header.Get(v1.HeaderXUserID),
+	}
+
+	if err := vr.listingService.DeleteListing(ctx, deleteForm, time.Now().UTC()); err != nil {
+		vr.logger.Errorf("Ошибка при удалении объявления %s: %v", deleteForm.ID, err)
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	}
+
+	respond(w, r, presenter.NewResource(deleteForm.ID))
+}
@@ -0,0 +1,227 @@
+package v2
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	jsoniter "github.com/json-iterator/go"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/domain/presenter"
+	"github.com/alisher-99/LomBarter/internal/service"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
+	v1 "github.com/alisher-99/LomBarter/internal/transport/http/resources/v1"
+)
+
+// OrdersResource представляет собой обработчик для заказов.
+type OrdersResource struct {
+	ordersService service.OrdersService // Сервис для работы с заказами
+	logger        logger.Logger         // Логирование запросов и ошибок обработчиков
+	json          jsoniter.API          // JSON-парсер
+}
+
+// NewOrdersHandler создает новый экземпляр OrdersResource.
+func NewOrdersHandler(orderService service.OrdersService, log logger.Logger) *OrdersResource {
+	return &OrdersResource{
+		ordersService: orderService,
+		logger:        log,
+		json:          jsoniter.ConfigCompatibleWithStandardLibrary,
+	}
+}
+
+// Routes возвращает роутер для обработчика заказов.
+func (vr OrdersResource) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Post("/", vr.createOrder)
+	r.Get("/", vr.getOrderList)
+	r.Get("/{orderID}", vr.getOrderInfo)
+	r.Put("/{orderID}/status", vr.updateOrderStatus)
+	r.Get("/{orderID}/history", vr.getOrderHistory)
+
+	return r
+}
+
+// createOrder создает новый заказ.
+// @Summary Создание заказа
+// @Description Создание заказа. Ссылка на заказ возвращается в заголовке Location
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param order body form.OrderCreate true "Заказ"
+// @Success 201 {object} presenter.Envelope{data=presenter.Resource}
+// @Failure 400 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Код ошибки"
+// @Failure 500 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Внутренняя ошибка сервера"
+// @Router /v2/orders [post]
+func (vr OrdersResource) createOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var order form.OrderCreate
+	if err := vr.json.NewDecoder(r.Body).Decode(&order); err != nil {
+		respondError(w, r, detector.BadRequest(err, entity.OrderDecodeCode))
+
+		return
+	}
+
+	order.UserID = r.Header.Get(v1.HeaderXUserID)
+
+	createdOrder, err := vr.ordersService.CreateOrder(ctx, order, time.Now().UTC())
+	if err != nil {
+		vr.logger.Error("ошибка создания заказа", err)
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	}
+
+	respondCreated(w, r, createdOrder.ID)
+}
+
+// getOrderList возвращает список заказов.
+// @Summary Список заказов
+// @Description Список заказов
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Success 200 {object} presenter.Envelope{data=[]presenter.Order,meta=presenter.Meta}
+// @Failure 400 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Код ошибки"
+// @Failure 500 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Внутренняя ошибка сервера"
+// @Router /v2/orders [get]
+func (vr OrdersResource) getOrderList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter := form.OrdersGetForClient{
+		UserID: r.Header.Get(v1.HeaderXUserID),
+	}
+
+	if err := filter.Validate(); err != nil {
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	}
+
+	orders, err := vr.ordersService.GetOrdersForClient(ctx, filter)
+	if err != nil {
+		vr.logger.Error("ошибка получения списка заказов", err)
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	}
+
+	respondList(w, r, presenter.NewOrders(orders), presenter.Meta{Count: int64(len(orders))})
+}
+
+// getOrderInfo возвращает информацию о заказе.
+// @Summary Информация о заказе
+// @Description Информация о заказе
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param orderID path string true "Идентификатор заказа"
+// @Param expand query string false "Связанные данные через запятую" Enums(history)
+// @Success 200 {object} presenter.Envelope{data=presenter.Order}
+// @Failure 400 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Код ошибки"
+// @Failure 404 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Заказ не найден"
+// @Failure 500 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Внутренняя ошибка сервера"
+// @Router /v2/orders/{orderID} [get]
+func (vr OrdersResource) getOrderInfo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter := form.OrderGetForClient{
+		OrderID: chi.URLParam(r, "orderID"),
+		UserID:  r.Header.Get(v1.HeaderXUserID),
+		Expand:  form.ParseExpand(r.URL.Query()),
+	}
+
+	if err := filter.Validate(); err != nil {
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	}
+
+	order, err := vr.ordersService.GetOrderForClient(ctx, filter)
+	if err != nil {
+		vr.logger.Error("ошибка получения информации о заказе", err)
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	}
+
+	respond(w, r, presenter.NewOrder(order))
+}
+
+// updateOrderStatus изменяет статус заказа.
+// @Summary Изменение статуса заказа
+// @Description Изменение статуса заказа. Каждый переход записывается в историю заказа.
+// @Description Подтверждение списывает оплату заказа, отмена снимает блокировку или возвращает оплату целиком либо сумму refund
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param orderID path string true "Идентификатор заказа"
+// @Param status body form.OrderStatusUpdate true "Новый статус"
+// @Success 200 {object} presenter.Envelope{data=presenter.Order}
+// @Failure 400 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Код ошибки"
+// @Failure 404 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Заказ не найден"
+// @Failure 500 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Внутренняя ошибка сервера"
+// @Router /v2/orders/{orderID}/status [put]
+func (vr OrdersResource) updateOrderStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var updateForm form.OrderStatusUpdate
+	if err := vr.json.NewDecoder(r.Body).Decode(&updateForm); err != nil {
+		respondError(w, r, detector.BadRequest(err, entity.OrderDecodeCode))
+
+		return
+	}
+
+	updateForm.OrderID = chi.URLParam(r, "orderID")
+	updateForm.UserID = r.Header.Get(v1.HeaderXUserID)
+
+	order, err := vr.ordersService.ChangeOrderStatus(ctx, updateForm, time.Now().UTC())
+	if err != nil {
+		vr.logger.Error("ошибка изменения статуса заказа", err)
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	}
+
+	respond(w, r, presenter.NewOrder(order))
+}
+
+// getOrderHistory возвращает историю изменения статуса заказа.
+// @Summary История заказа
+// @Description История изменения статуса заказа: кто, когда и почему изменил статус
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param X-User-Id header string true "Идентификатор пользователя"
+// @Param orderID path string true "Идентификатор заказа"
+// @Success 200 {object} presenter.Envelope{data=[]presenter.OrderStatusChange,meta=presenter.Meta}
+// @Failure 400 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Код ошибки"
+// @Failure 404 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Заказ не найден"
+// @Failure 500 {object} presenter.Envelope{errors=[]presenter.EnvelopeError} "Внутренняя ошибка сервера"
+// @Router /v2/orders/{orderID}/history [get]
+func (vr OrdersResource) getOrderHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter := form.OrderGetForClient{
+		OrderID: chi.URLParam(r, "orderID"),
+		UserID:  r.Header.Get(v1.HeaderXUserID),
+	}
+
+	history, err := vr.ordersService.GetOrderHistory(ctx, filter)
+	if err != nil {
+		vr.logger.Error("ошибка получения истории заказа", err)
+		respondError(w, r, detector.ErrorResponse(err))
+
+		return
+	}
+
+	respondList(w, r, presenter.NewOrderHistory(history), presenter.Meta{Count: int64(len(history))})
+}
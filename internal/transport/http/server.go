@@ -2,12 +2,14 @@ package http
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/go-chi/chi"
 	"github.com/go-chi/chi/middleware"
 	"github.com/go-chi/cors"
+	"github.com/go-chi/render"
 	authMiddleware "gitlab.com/example/gophers/libs/auth/middleware"
 	"gitlab.com/example/gophers/libs/logger"
 	loggerMiddleware "gitlab.com/example/gophers/libs/logger/middleware"
@@ -16,9 +18,13 @@ import (
 	traceMiddleware "gitlab.com/example/gophers/libs/trace/middleware/http"
 
 	"github.com/alisher-99/LomBarter/internal/config"
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
 	"github.com/alisher-99/LomBarter/internal/service"
 	"github.com/alisher-99/LomBarter/internal/transport/http/resources"
+	"github.com/alisher-99/LomBarter/internal/transport/http/resources/detector"
 	v1 "github.com/alisher-99/LomBarter/internal/transport/http/resources/v1"
+	v2 "github.com/alisher-99/LomBarter/internal/transport/http/resources/v2"
+	"github.com/alisher-99/LomBarter/pkg/openapi"
 )
 
 const (
@@ -35,13 +41,35 @@ type Server struct {
 	FilesDir    string             // Директория с файлами
 	Environment config.Environment // Окружение
 
+	ValidateRequests bool      // Отклонять запросы, не соответствующие документу OpenAPI
+	V1DeprecatedAt   time.Time // Дата, с которой API v1 устарело
+	V1SunsetAt       time.Time // Дата отключения API v1
+
 	logger          logger.Logger        // Логирование запросов и ошибок сервера
 	tracer          trace.TracerProvider // Отслеживает запросы между слоями и микросервисами
 	idleConnsClosed chan struct{}        // Способ определить незавершенные соединения
 	version         string               // Версия приложения
 
-	userService   service.UserService   // Сервис пользователей
-	ordersService service.OrdersService // Сервис заказов
+	userService         service.UserService         // Сервис пользователей
+	ordersService       service.OrdersService       // Сервис заказов
+	listingService      service.ListingService      // Сервис объявлений
+	tradeOfferService   service.TradeOfferService   // Сервис предложений обмена
+	tradeCycleService   service.TradeCycleService   // Сервис циклов обмена
+	wishlistService     service.WishlistService     // Сервис списков желаний
+	ratingService       service.RatingService       // Сервис оценок
+	conversationService service.ConversationService // Сервис переписок
+	searchService       service.SearchService       // Сервис полнотекстового поиска
+	mediaService        service.MediaService        // Сервис медиафайлов
+	notificationService service.NotificationService // Сервис настроек и входящих уведомлений
+	walletService       service.WalletService       // Сервис кошельков кредитов
+	paymentService      service.PaymentService      // Сервис оплаты заказов
+	disputeService      service.DisputeService      // Сервис споров по заказам
+	auditService        service.AuditService        // Сервис журнала изменений
+	moderationService   service.ModerationService   // Сервис модерации пользовательских текстов
+	bulkService         service.BulkService         // Сервис массового импорта и экспорта
+
+	blobFiles resources.SignedBlobOpener // Раздача файлов локального хранилища, nil для S3
+	openAPI   *openapi.Document          // Документ OpenAPI 3, nil если документ не подключен
 }
 
 // NewServer создает новый HTTP сервер.
@@ -52,6 +80,10 @@ func NewServer(cfg *config.Config, options ...Option) *Server {
 		BasePath:    cfg.BasePath,
 		Environment: cfg.Environment,
 
+		ValidateRequests: cfg.ValidateRequests,
+		V1DeprecatedAt:   cfg.V1DeprecatedAt,
+		V1SunsetAt:       cfg.V1SunsetAt,
+
 		idleConnsClosed: make(chan struct{}),
 		version:         cfg.Version,
 	}
@@ -65,11 +97,15 @@ func NewServer(cfg *config.Config, options ...Option) *Server {
 
 // setupRouter инициализирует HTTP роутер. Функция используется для подключения middleware и маппинга ресурсов.
 func (srv *Server) setupRouter() chi.Router {
+	// ошибки отдаются в формате RFC 7807 клиентам, запросившим application/problem+json
+	render.Respond = detector.Responder
+
 	r := chi.NewRouter()
 
 	r.Use(middleware.NoCache)                  // no-cache
 	r.Use(middleware.RequestID)                // вставляет request ID в контекст каждого запроса
 	r.Use(authMiddleware.ParseUserHeaders)     // добавляет кастомные заголовки
+	r.Use(auditActor)                          // добавляет автора изменений для журнала изменений
 	r.Use(loggerMiddleware.Logger(srv.logger)) // логирует начало и окончание каждого запроса с указанием времени обработки
 	r.Use(middleware.Recoverer)                // управляемо обрабатывает паники и выдает stack trace при их возникновении
 	r.Use(middleware.RealIP)                   // устанавливает RemoteAddr для каждого запроса с заголовками X-Forwarded-For или X-Real-IP
@@ -78,29 +114,88 @@ func (srv *Server) setupRouter() chi.Router {
 		AllowedOrigins:   allowedOrigins(srv.Environment),
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
 		AllowedHeaders:   []string{"*"},
-		ExposedHeaders:   []string{"Link"},
+		ExposedHeaders:   []string{"Link", headerDeprecation, headerSunset, v2.HeaderXImportRun},
 		AllowCredentials: false,
 		MaxAge:           maxAge, // Максимальное время жизни C.O.R.S. заголовков.
 	}))
 	r.Use(multiLangMiddleware.LanguageMiddleware)
+	r.Use(language) // добавляет в контекст язык сообщений об ошибках
 	r.Use(multiLangMiddleware.SetLanguageResponseHeader)
 
 	tm := traceMiddleware.New(srv.tracer)
 	r.Use(tm.OpenTelemetryMiddleware)
 
+	// ответы API v1 отмечаются устаревшими, замена - API v2
+	r.Use(deprecated("/api/v1/", srv.V1DeprecatedAt, srv.V1SunsetAt))
+
+	if srv.ValidateRequests && srv.openAPI != nil {
+		r.Use(openapi.NewValidator(srv.openAPI).Middleware(invalidRequest)) // отклоняет запросы, не соответствующие документу OpenAPI
+	}
+
 	// монтируем дополнительные ресурсы
 	r.Mount("/version", resources.VersionResource{Version: srv.version}.Routes())
+	r.Mount("/errors", resources.ErrorsResource{}.Routes())
 	r.Mount("/api/v1/users", v1.NewUserHandler(srv.userService, srv.logger).Routes())
+	r.Mount("/api/v1/users/{id}/wishlist", v1.NewWishlistHandler(srv.wishlistService, srv.logger).Routes())
+	r.Mount("/api/v1/users/{id}/avatar", v1.NewAvatarHandler(srv.mediaService, srv.logger).Routes())
+	r.Mount("/api/v1/users/{id}/notifications", v1.NewNotificationSettingsHandler(srv.notificationService, srv.logger).Routes())
+	r.Mount("/api/v1/notifications", v1.NewNotificationHandler(srv.notificationService, srv.logger).Routes())
 	r.Mount("/api/v1/orders", v1.NewOrdersHandler(srv.ordersService, srv.logger).Routes())
+	r.Mount("/api/v1/listings", v1.NewListingHandler(srv.listingService, srv.logger).Routes())
+	r.Mount("/api/v1/offers", v1.NewTradeOfferHandler(srv.tradeOfferService, srv.logger).Routes())
+	r.Mount("/api/v1/cycles", v1.NewTradeCycleHandler(srv.tradeCycleService, srv.logger).Routes())
+	r.Mount("/api/v1/ratings", v1.NewRatingHandler(srv.ratingService, srv.logger).Routes())
+	r.Mount("/api/v1/conversations", v1.NewConversationHandler(srv.conversationService, srv.logger).Routes())
+	r.Mount("/api/v1/search", v1.NewSearchHandler(srv.searchService, srv.logger).Routes())
+	r.Mount("/api/v1/media", v1.NewMediaHandler(srv.mediaService, srv.logger).Routes())
+
+	walletHandler := v1.NewWalletHandler(srv.walletService, srv.logger)
+	r.Mount("/api/v1/wallet", walletHandler.Routes())
+	r.Mount("/api/v1/admin/wallet", walletHandler.AdminRoutes())
+
+	paymentHandler := v1.NewPaymentHandler(srv.paymentService, srv.logger)
+	r.Mount("/api/v1/orders/{orderID}/payment", paymentHandler.Routes())
+	r.Mount("/api/v1/payments/webhook", paymentHandler.WebhookRoutes())
+
+	disputeHandler := v1.NewDisputeHandler(srv.disputeService, srv.logger)
+	r.Mount("/api/v1/disputes", disputeHandler.Routes())
+	r.Mount("/api/v1/admin/disputes", disputeHandler.AdminRoutes())
+	r.Mount("/api/v1/admin/audit", v1.NewAuditHandler(srv.auditService, srv.logger).AdminRoutes())
+
+	moderationHandler := v1.NewModerationHandler(srv.moderationService, srv.logger)
+	r.Mount("/api/v1/reports", moderationHandler.Routes())
+	r.Mount("/api/v1/admin/moderation", moderationHandler.AdminRoutes())
+
+	// API v2: ответы в конверте presenter.Envelope
+	r.Mount("/api/v2/users", v2.NewUserHandler(srv.userService, srv.logger).Routes())
+	r.Mount("/api/v2/orders", v2.NewOrdersHandler(srv.ordersService, srv.logger).Routes())
+	r.Mount("/api/v2/listings", v2.NewListingHandler(srv.listingService, srv.logger).Routes())
+
+	bulkHandler := v2.NewBulkHandler(srv.bulkService, srv.logger)
+	r.Mount("/api/v2/admin/users", bulkHandler.UserRoutes())
+	r.Mount("/api/v2/admin/orders", bulkHandler.OrderRoutes())
+
+	if srv.blobFiles != nil {
+		r.Mount("/media/files", resources.BlobFilesResource{Opener: srv.blobFiles}.Routes())
+	}
 
 	if !srv.Environment.IsProduction() {
 		r.Mount("/files", resources.FilesResource{FilesDir: srv.FilesDir}.Routes())
 		r.Mount("/swagger", resources.SwaggerResource{FilesPath: "/files", BasePath: srv.BasePath}.Routes())
+
+		if srv.openAPI != nil {
+			r.Mount("/openapi.json", resources.OpenAPIResource{Document: srv.openAPI}.Routes())
+		}
 	}
 
 	return r
 }
 
+// invalidRequest отвечает ошибкой TMP_REQUEST_INVALID с нарушениями документа OpenAPI по полям.
+func invalidRequest(w http.ResponseWriter, r *http.Request, err error) {
+	_ = render.Render(w, r, detector.Error(fmt.Errorf("%w: %w", entity.ErrRequestInvalid, err)))
+}
+
 // getAllowedOrigins возвращает список хостов для C.O.R.S.
 func allowedOrigins(environment config.Environment) []string {
 	if environment.IsProduction() {
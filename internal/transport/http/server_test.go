@@ -0,0 +1,48 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alisher-99/LomBarter/internal/config"
+	"github.com/alisher-99/LomBarter/pkg/openapi"
+	docs "github.com/alisher-99/LomBarter/swagger"
+)
+
+// TestRoutes_Documented проверяет, что у каждого маршрута API есть операция в документе OpenAPI.
+// Маршрут без swag аннотаций не попадает в документ и не проверяется валидатором запросов.
+func TestRoutes_Documented(t *testing.T) {
+	t.Parallel()
+
+	doc, err := openapi.FromSwagger([]byte(docs.SwaggerInfo.ReadDoc()))
+	require.NoError(t, err)
+	require.NotEmpty(t, doc.Servers)
+
+	validator := openapi.NewValidator(doc)
+	basePath := doc.Servers[0].URL
+
+	srv := NewServer(&config.Config{}, WithOpenAPI(doc))
+
+	routes := 0
+
+	err = chi.Walk(srv.setupRouter(), func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		if !strings.HasPrefix(route, basePath+"/") {
+			return nil
+		}
+
+		routes++
+		route = strings.TrimSuffix(strings.TrimSuffix(route, "/*"), "/")
+
+		_, _, ok := validator.Find(method, route)
+		assert.True(t, ok, "маршрут %s %s не описан в документе OpenAPI", method, route)
+
+		return nil
+	})
+	require.NoError(t, err)
+	assert.NotZero(t, routes)
+}
@@ -0,0 +1,171 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	kafkago "github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/config"
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/pkg/repeatable"
+)
+
+const (
+	dialTimeout    = 10 * time.Second // Время ожидания подключения к брокеру
+	commitInterval = time.Second      // Период асинхронного коммита смещений
+	handleAttempts = 3                // Количество попыток обработки сообщения
+	handleDelay    = time.Second      // Пауза между попытками обработки сообщения
+)
+
+// HeaderXUserID заголовок сообщения с идентификатором пользователя, от имени которого сделано изменение.
+const HeaderXUserID = "X-User-Id"
+
+// Handler обработчик сообщения из топика.
+type Handler func(ctx context.Context, msg kafkago.Message) error
+
+// MessageReader читает сообщения из топика Kafka.
+type MessageReader interface {
+	// FetchMessage читает сообщение без коммита смещения.
+	FetchMessage(ctx context.Context) (kafkago.Message, error)
+	// ReadMessage читает сообщение и коммитит смещение.
+	ReadMessage(ctx context.Context) (kafkago.Message, error)
+	// CommitMessages коммитит смещения сообщений.
+	CommitMessages(ctx context.Context, msgs ...kafkago.Message) error
+	// Close закрывает соединение.
+	Close() error
+}
+
+// Option определяет функцию для настройки консюмера.
+type Option func(*Consumer)
+
+// Consumer читает сообщения из топика и передает их обработчику.
+type Consumer struct {
+	Topic string // Топик Kafka
+
+	reader       MessageReader // Источник сообщений
+	handler      Handler       // Обработчик сообщений
+	manualCommit bool          // Коммитить смещение только после обработки сообщения
+	logger       logger.Logger // Логирование ошибок консюмера
+}
+
+// NewConsumer создает консюмер топика по конфигурации Kafka.
+func NewConsumer(cfg *config.Config, topic string, options ...Option) (*Consumer, error) {
+	c := &Consumer{
+		Topic:        topic,
+		manualCommit: cfg.Kafka.IsManualCommitAfterProcess,
+	}
+
+	for _, opt := range options {
+		opt(c)
+	}
+
+	if c.reader != nil {
+		return c, nil
+	}
+
+	for _, consumer := range cfg.Kafka.Consumers {
+		if consumer.Topic != topic {
+			continue
+		}
+
+		dialer := &kafkago.Dialer{Timeout: dialTimeout, DualStack: true}
+		if cfg.Kafka.Username != "" {
+			dialer.SASLMechanism = plain.Mechanism{Username: cfg.Kafka.Username, Password: cfg.Kafka.Password}
+		}
+
+		readerCfg := kafkago.ReaderConfig{
+			Brokers: strings.Split(cfg.Kafka.Brokers, ","),
+			GroupID: consumer.Group,
+			Topic:   topic,
+			Dialer:  dialer,
+			MaxWait: cfg.Kafka.ConsumeTimeout,
+		}
+
+		if consumer.AsyncCommits {
+			readerCfg.CommitInterval = commitInterval
+		}
+
+		c.reader = kafkago.NewReader(readerCfg)
+
+		return c, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", entity.ErrConsumerNotConfigured, topic)
+}
+
+// Run читает сообщения, пока не будет отменен контекст.
+// Ошибка обработки после всех попыток логируется, и сообщение пропускается, чтобы не блокировать партицию.
+func (c *Consumer) Run(ctx context.Context) error {
+	defer func() {
+		if err := c.reader.Close(); err != nil {
+			c.logger.Errorf("закрытие консюмера %s: %v", c.Topic, err)
+		}
+	}()
+
+	for {
+		msg, err := c.read(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("чтение сообщения из %s: %w", c.Topic, err)
+		}
+
+		msgCtx := entity.WithAuditActor(ctx, messageActor(msg))
+
+		err = repeatable.DoWithTries(func() error {
+			return c.handler(msgCtx, msg)
+		}, handleAttempts, handleDelay)
+		if err != nil {
+			c.logger.WithFields(logger.Fields{
+				"topic":     msg.Topic,
+				"partition": msg.Partition,
+				"offset":    msg.Offset,
+			}).Errorf("обработка сообщения: %v", err)
+		}
+
+		if !c.manualCommit {
+			continue
+		}
+
+		if err = c.reader.CommitMessages(ctx, msg); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("коммит сообщения из %s: %w", c.Topic, err)
+		}
+	}
+}
+
+// messageActor возвращает автора изменений, сделанных при обработке сообщения.
+// Пользователь берется из заголовка сообщения, а сообщение определяется топиком, партицией и смещением.
+func messageActor(msg kafkago.Message) entity.AuditActor {
+	actor := entity.AuditActor{
+		Source:    entity.AuditSourceKafka,
+		RequestID: fmt.Sprintf("%s/%d/%d", msg.Topic, msg.Partition, msg.Offset),
+	}
+
+	for _, header := range msg.Headers {
+		if header.Key == HeaderXUserID {
+			actor.ID = string(header.Value)
+		}
+	}
+
+	return actor
+}
+
+// read читает сообщение. При ручном коммите смещение фиксируется только после обработки.
+func (c *Consumer) read(ctx context.Context) (kafkago.Message, error) {
+	if c.manualCommit {
+		return c.reader.FetchMessage(ctx)
+	}
+
+	return c.reader.ReadMessage(ctx)
+}
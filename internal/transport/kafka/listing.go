@@ -0,0 +1,75 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	kafkago "github.com/segmentio/kafka-go"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/service"
+)
+
+// NewListingEventHandler возвращает обработчик событий объявлений, который запускает поиск циклов обмена
+// и сопоставление со списками желаний, а для удаленных объявлений удаляет их фотографии.
+// Все шаги идемпотентны, поэтому при ошибке сообщение обрабатывается повторно целиком.
+func NewListingEventHandler(
+	tradeCycleService service.TradeCycleService,
+	wishlistService service.WishlistService,
+	mediaService service.MediaService,
+	log logger.Logger,
+) Handler {
+	json := jsoniter.ConfigCompatibleWithStandardLibrary
+
+	return func(ctx context.Context, msg kafkago.Message) error {
+		var event entity.ListingEvent
+		if err := json.Unmarshal(msg.Value, &event); err != nil || event.ListingID == "" {
+			// Повтор не поможет, поэтому битое сообщение только логируется.
+			log.Errorf("%v: offset %d: %v", entity.ErrListingEventDecode, msg.Offset, err)
+
+			return nil
+		}
+
+		currentTime := time.Now().UTC()
+
+		cycles, err := tradeCycleService.MatchListing(ctx, event.ListingID, currentTime)
+		if err != nil {
+			return fmt.Errorf("поиск циклов обмена для объявления %s: %w", event.ListingID, err)
+		}
+
+		if len(cycles) > 0 {
+			log.WithFields(logger.Fields{"listing_id": event.ListingID, "cycles": len(cycles)}).
+				Info("предложены циклы обмена")
+		}
+
+		// Удаленное объявление не может совпасть со списком желаний, а его фотографии больше не нужны.
+		if event.Deleted {
+			removed, err := mediaService.DeleteSubjectMedia(ctx, entity.MediaSubjectListing, event.ListingID)
+			if err != nil {
+				return fmt.Errorf("удаление фотографий объявления %s: %w", event.ListingID, err)
+			}
+
+			if removed > 0 {
+				log.WithFields(logger.Fields{"listing_id": event.ListingID, "media": removed}).
+					Info("удалены фотографии удаленного объявления")
+			}
+
+			return nil
+		}
+
+		notified, err := wishlistService.MatchListing(ctx, event.ListingID, currentTime)
+		if err != nil {
+			return fmt.Errorf("сопоставление объявления %s со списками желаний: %w", event.ListingID, err)
+		}
+
+		if notified > 0 {
+			log.WithFields(logger.Fields{"listing_id": event.ListingID, "users": notified}).
+				Info("пользователи уведомлены о совпадении со списком желаний")
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,26 @@
+package kafka
+
+import (
+	"gitlab.com/example/gophers/libs/logger"
+)
+
+// WithHandler добавляет обработчик сообщений в консюмер.
+func WithHandler(handler Handler) Option {
+	return func(c *Consumer) {
+		c.handler = handler
+	}
+}
+
+// WithReader подменяет источник сообщений консюмера.
+func WithReader(reader MessageReader) Option {
+	return func(c *Consumer) {
+		c.reader = reader
+	}
+}
+
+// WithLogger добавляет логгер в консюмер.
+func WithLogger(log logger.Logger) Option {
+	return func(c *Consumer) {
+		c.logger = log
+	}
+}
@@ -0,0 +1,37 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	kafkago "github.com/segmentio/kafka-go"
+	"gitlab.com/example/gophers/libs/logger"
+
+	"github.com/alisher-99/LomBarter/internal/domain/entity"
+	"github.com/alisher-99/LomBarter/internal/domain/form"
+	"github.com/alisher-99/LomBarter/internal/service"
+)
+
+// NewUserUpdateHandler возвращает обработчик обновлений профиля пользователя из внешних систем.
+// Автор изменения для журнала берется из заголовка сообщения.
+func NewUserUpdateHandler(userService service.UserService, log logger.Logger) Handler {
+	json := jsoniter.ConfigCompatibleWithStandardLibrary
+
+	return func(ctx context.Context, msg kafkago.Message) error {
+		var updateForm form.UserUpdate
+		if err := json.Unmarshal(msg.Value, &updateForm); err != nil || updateForm.ID == "" {
+			// Повтор не поможет, поэтому битое сообщение только логируется.
+			log.Errorf("%v: offset %d: %v", entity.ErrUserUpdateDecode, msg.Offset, err)
+
+			return nil
+		}
+
+		if err := userService.UpdateUser(ctx, updateForm, time.Now().UTC()); err != nil {
+			return fmt.Errorf("обновление пользователя %s: %w", updateForm.ID, err)
+		}
+
+		return nil
+	}
+}
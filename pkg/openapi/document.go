@@ -0,0 +1,301 @@
+// Package openapi строит документ OpenAPI 3 из документации swagger 2.0, которую генерирует swag,
+// и проверяет по нему запросы и ответы HTTP сервера.
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/spec"
+)
+
+// Version версия спецификации OpenAPI документа.
+const Version = "3.0.3"
+
+const (
+	definitionsRef = "#/definitions/"        // Начало ссылки на схему в swagger 2.0
+	schemasRef     = "#/components/schemas/" // Начало ссылки на схему в OpenAPI 3
+
+	contentTypeJSON      = "application/json"                  // Тип содержимого по умолчанию
+	contentTypeMultipart = "multipart/form-data"               // Тип содержимого формы с файлами
+	contentTypeForm      = "application/x-www-form-urlencoded" // Тип содержимого формы без файлов
+)
+
+// Document документ OpenAPI 3.
+type Document struct {
+	OpenAPI    string              `json:"openapi"`           // Версия спецификации
+	Info       *spec.Info          `json:"info,omitempty"`    // Описание API
+	Servers    []Server            `json:"servers,omitempty"` // Адреса API
+	Tags       []spec.Tag          `json:"tags,omitempty"`    // Группы операций
+	Paths      map[string]PathItem `json:"paths"`             // Операции по шаблонам путей
+	Components Components          `json:"components"`        // Общие схемы
+}
+
+// Server адрес API.
+type Server struct {
+	URL string `json:"url"` // Адрес. Относительный адрес отсчитывается от адреса документа
+}
+
+// Components общие схемы документа.
+type Components struct {
+	Schemas map[string]spec.Schema `json:"schemas,omitempty"` // Схемы по названиям
+}
+
+// PathItem операции пути по HTTP методам в нижнем регистре.
+type PathItem map[string]*Operation
+
+// Operation операция API.
+type Operation struct {
+	Tags        []string            `json:"tags,omitempty"`        // Группы операции
+	Summary     string              `json:"summary,omitempty"`     // Краткое описание
+	Description string              `json:"description,omitempty"` // Описание
+	OperationID string              `json:"operationId,omitempty"` // Идентификатор операции
+	Deprecated  bool                `json:"deprecated,omitempty"`  // Операция устарела
+	Parameters  []Parameter         `json:"parameters,omitempty"`  // Параметры пути, запроса и заголовков
+	RequestBody *RequestBody        `json:"requestBody,omitempty"` // Тело запроса
+	Responses   map[string]Response `json:"responses"`             // Ответы по HTTP статусам
+}
+
+// Parameter параметр пути, запроса или заголовка.
+type Parameter struct {
+	Name        string       `json:"name"`                  // Название
+	In          string       `json:"in"`                    // Расположение: path, query или header
+	Description string       `json:"description,omitempty"` // Описание
+	Required    bool         `json:"required,omitempty"`    // Параметр обязателен
+	Schema      *spec.Schema `json:"schema,omitempty"`      // Схема значения
+}
+
+// RequestBody тело запроса.
+type RequestBody struct {
+	Description string               `json:"description,omitempty"` // Описание
+	Required    bool                 `json:"required,omitempty"`    // Тело обязательно
+	Content     map[string]MediaType `json:"content"`               // Схемы по типам содержимого
+}
+
+// Response ответ операции.
+type Response struct {
+	Description string               `json:"description"`       // Описание
+	Content     map[string]MediaType `json:"content,omitempty"` // Схемы по типам содержимого
+}
+
+// MediaType схема содержимого одного типа.
+type MediaType struct {
+	Schema *spec.Schema `json:"schema,omitempty"` // Схема содержимого
+}
+
+// FromSwagger строит документ OpenAPI 3 из документа swagger 2.0 в формате JSON.
+// Базовый путь становится относительным адресом сервера, определения - общими схемами,
+// параметры body и formData - телом запроса.
+func FromSwagger(data []byte) (*Document, error) {
+	data = bytes.ReplaceAll(data, []byte(definitionsRef), []byte(schemasRef))
+
+	var swagger spec.Swagger
+	if err := json.Unmarshal(data, &swagger); err != nil {
+		return nil, fmt.Errorf("разбор документа swagger: %w", err)
+	}
+
+	doc := &Document{
+		OpenAPI:    Version,
+		Info:       swagger.Info,
+		Tags:       swagger.Tags,
+		Paths:      make(map[string]PathItem),
+		Components: Components{Schemas: swagger.Definitions},
+	}
+
+	if swagger.BasePath != "" {
+		doc.Servers = []Server{{URL: swagger.BasePath}}
+	}
+
+	if swagger.Paths == nil {
+		return doc, nil
+	}
+
+	for path, item := range swagger.Paths.Paths {
+		operations := map[string]*spec.Operation{
+			http.MethodGet:     item.Get,
+			http.MethodPut:     item.Put,
+			http.MethodPost:    item.Post,
+			http.MethodDelete:  item.Delete,
+			http.MethodOptions: item.Options,
+			http.MethodHead:    item.Head,
+			http.MethodPatch:   item.Patch,
+		}
+
+		pathItem := make(PathItem)
+
+		for method, operation := range operations {
+			if operation == nil {
+				continue
+			}
+
+			pathItem[strings.ToLower(method)] = convertOperation(&swagger, item.Parameters, operation)
+		}
+
+		doc.Paths[path] = pathItem
+	}
+
+	return doc, nil
+}
+
+// convertOperation переводит операцию swagger 2.0 в операцию OpenAPI 3.
+func convertOperation(swagger *spec.Swagger, common []spec.Parameter, operation *spec.Operation) *Operation {
+	consumes := firstNonEmpty(operation.Consumes, swagger.Consumes, []string{contentTypeJSON})
+	produces := firstNonEmpty(operation.Produces, swagger.Produces, []string{contentTypeJSON})
+
+	converted := &Operation{
+		Tags:        operation.Tags,
+		Summary:     operation.Summary,
+		Description: operation.Description,
+		OperationID: operation.ID,
+		Deprecated:  operation.Deprecated,
+		Responses:   make(map[string]Response),
+	}
+
+	form := &spec.Schema{SchemaProps: spec.SchemaProps{
+		Type:       spec.StringOrArray{"object"},
+		Properties: make(spec.SchemaProperties),
+	}}
+	hasFile := false
+
+	for _, param := range append(append([]spec.Parameter{}, common...), operation.Parameters...) {
+		switch param.In {
+		case "body":
+			converted.RequestBody = &RequestBody{
+				Description: param.Description,
+				Required:    param.Required,
+				Content:     content(consumes, param.Schema),
+			}
+		case "formData":
+			schema := simpleSchema(param.SimpleSchema, param.CommonValidations)
+			if param.Type == "file" {
+				schema = &spec.Schema{SchemaProps: spec.SchemaProps{Type: spec.StringOrArray{"string"}, Format: "binary"}}
+				hasFile = true
+			}
+
+			schema.Description = param.Description
+			form.Properties[param.Name] = *schema
+
+			if param.Required {
+				form.Required = append(form.Required, param.Name)
+			}
+		default:
+			converted.Parameters = append(converted.Parameters, Parameter{
+				Name:        param.Name,
+				In:          param.In,
+				Description: param.Description,
+				Required:    param.Required,
+				Schema:      simpleSchema(param.SimpleSchema, param.CommonValidations),
+			})
+		}
+	}
+
+	if len(form.Properties) > 0 {
+		contentType := contentTypeForm
+		if hasFile {
+			contentType = contentTypeMultipart
+		}
+
+		converted.RequestBody = &RequestBody{
+			Required: len(form.Required) > 0,
+			Content:  map[string]MediaType{contentType: {Schema: form}},
+		}
+	}
+
+	if operation.Responses == nil {
+		return converted
+	}
+
+	if operation.Responses.Default != nil {
+		converted.Responses["default"] = convertResponse(produces, *operation.Responses.Default)
+	}
+
+	for status, response := range operation.Responses.StatusCodeResponses {
+		converted.Responses[strconv.Itoa(status)] = convertResponse(produces, response)
+	}
+
+	return converted
+}
+
+// convertResponse переводит ответ swagger 2.0 в ответ OpenAPI 3.
+func convertResponse(produces []string, response spec.Response) Response {
+	return Response{
+		Description: response.Description,
+		Content:     content(produces, response.Schema),
+	}
+}
+
+// content возвращает одну схему для всех типов содержимого.
+func content(contentTypes []string, schema *spec.Schema) map[string]MediaType {
+	if schema == nil {
+		return nil
+	}
+
+	result := make(map[string]MediaType, len(contentTypes))
+	for _, contentType := range contentTypes {
+		result[contentType] = MediaType{Schema: schema}
+	}
+
+	return result
+}
+
+// simpleSchema переводит описание простого параметра swagger 2.0 в схему.
+func simpleSchema(simple spec.SimpleSchema, validations spec.CommonValidations) *spec.Schema {
+	schema := &spec.Schema{
+		SchemaProps: spec.SchemaProps{
+			Format:    simple.Format,
+			Default:   simple.Default,
+			Enum:      validations.Enum,
+			Maximum:   validations.Maximum,
+			Minimum:   validations.Minimum,
+			MaxLength: validations.MaxLength,
+			MinLength: validations.MinLength,
+			MaxItems:  validations.MaxItems,
+			MinItems:  validations.MinItems,
+		},
+	}
+
+	if simple.Type != "" {
+		schema.Type = spec.StringOrArray{simple.Type}
+	}
+
+	if simple.Items != nil {
+		schema.Items = &spec.SchemaOrArray{Schema: simpleSchema(simple.Items.SimpleSchema, simple.Items.CommonValidations)}
+	}
+
+	return schema
+}
+
+// firstNonEmpty возвращает первый непустой список.
+func firstNonEmpty(lists ...[]string) []string {
+	for _, list := range lists {
+		if len(list) > 0 {
+			return list
+		}
+	}
+
+	return nil
+}
+
+// Operation возвращает операцию по HTTP методу и шаблону пути документа.
+func (d *Document) Operation(method, path string) (*Operation, bool) {
+	operation, ok := d.Paths[path][strings.ToLower(method)]
+
+	return operation, ok
+}
+
+// Methods возвращает отсортированные HTTP методы пути документа.
+func (p PathItem) Methods() []string {
+	methods := make([]string, 0, len(p))
+	for method := range p {
+		methods = append(methods, strings.ToUpper(method))
+	}
+
+	sort.Strings(methods)
+
+	return methods
+}
@@ -0,0 +1,595 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-openapi/spec"
+)
+
+// Правила, нарушение которых сообщает валидатор. Названия совпадают с правилами валидации форм,
+// поэтому для ошибок подходят те же переводы.
+const (
+	RuleRequired = "required" // Значение обязательно
+	RuleType     = "type"     // Значение не соответствует типу
+	RuleOneOf    = "oneof"    // Значение не входит в перечисление
+	RuleMin      = "min"      // Значение, длина или количество элементов меньше минимума
+	RuleMax      = "max"      // Значение, длина или количество элементов больше максимума
+)
+
+// Error нарушение схемы одним значением.
+type Error struct {
+	Field string // Путь к значению: query.limit, header.X-User-Id, body.title, status
+	Rule  string // Нарушенное правило
+	Param string // Параметр правила: допустимые значения, граница или ожидаемый тип
+}
+
+// Error возвращает описание нарушения.
+func (e Error) Error() string {
+	if e.Param == "" {
+		return e.Field + ": " + e.Rule
+	}
+
+	return e.Field + ": " + e.Rule + "=" + e.Param
+}
+
+// Errors нарушения схемы запросом или ответом.
+type Errors []Error
+
+// Error возвращает описания нарушений через точку с запятой.
+func (e Errors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Validator проверяет запросы и ответы по документу OpenAPI 3.
+type Validator struct {
+	doc      *Document
+	basePath string  // Адрес первого сервера документа
+	routes   []route // Шаблоны путей документа
+}
+
+// route шаблон пути документа, разбитый на сегменты.
+type route struct {
+	path     string   // Шаблон пути документа
+	segments []string // Сегменты шаблона
+}
+
+// NewValidator создает валидатор по документу.
+func NewValidator(doc *Document) *Validator {
+	v := &Validator{doc: doc}
+
+	if len(doc.Servers) > 0 {
+		v.basePath = strings.TrimRight(doc.Servers[0].URL, "/")
+	}
+
+	for path := range doc.Paths {
+		v.routes = append(v.routes, route{path: path, segments: splitPath(path)})
+	}
+
+	// Шаблоны с большим числом постоянных сегментов проверяются раньше: /users/me раньше /users/{id}.
+	sort.Slice(v.routes, func(i, j int) bool {
+		if a, b := literals(v.routes[i].segments), literals(v.routes[j].segments); a != b {
+			return a > b
+		}
+
+		return v.routes[i].path < v.routes[j].path
+	})
+
+	return v
+}
+
+// Find возвращает операцию и значения параметров пути по HTTP методу и пути запроса с базовым путем.
+func (v *Validator) Find(method, path string) (*Operation, map[string]string, bool) {
+	if !strings.HasPrefix(path, v.basePath+"/") {
+		return nil, nil, false
+	}
+
+	segments := splitPath(strings.TrimPrefix(path, v.basePath))
+
+	for _, route := range v.routes {
+		params, ok := match(route.segments, segments)
+		if !ok {
+			continue
+		}
+
+		operation, ok := v.doc.Operation(method, route.path)
+		if !ok {
+			continue
+		}
+
+		return operation, params, true
+	}
+
+	return nil, nil, false
+}
+
+// ValidateRequest проверяет параметры и тело JSON запроса. Тело остается доступным обработчику.
+// Запросы к путям, которых нет в документе, не проверяются.
+func (v *Validator) ValidateRequest(r *http.Request) error {
+	operation, pathParams, ok := v.Find(r.Method, r.URL.Path)
+	if !ok {
+		return nil
+	}
+
+	var errs Errors
+
+	query := r.URL.Query()
+
+	for _, param := range operation.Parameters {
+		var (
+			values  []string
+			present bool
+		)
+
+		switch param.In {
+		case "path":
+			var value string
+			value, present = pathParams[param.Name]
+			values = []string{value}
+		case "query":
+			values, present = query[param.Name]
+		case "header":
+			values = r.Header.Values(param.Name)
+			present = len(values) > 0
+		default:
+			continue
+		}
+
+		field := param.In + "." + param.Name
+
+		if !present || (len(values) == 1 && values[0] == "") {
+			if param.Required {
+				errs = append(errs, Error{Field: field, Rule: RuleRequired})
+			}
+
+			continue
+		}
+
+		errs = append(errs, v.validateParam(field, param.Schema, values)...)
+	}
+
+	if operation.RequestBody != nil {
+		bodyErrs, err := v.validateRequestBody(r, operation.RequestBody)
+		if err != nil {
+			return err
+		}
+
+		errs = append(errs, bodyErrs...)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// ValidateResponse проверяет HTTP статус и тело JSON ответа на запрос.
+func (v *Validator) ValidateResponse(r *http.Request, status int, header http.Header, body []byte) error {
+	operation, _, ok := v.Find(r.Method, r.URL.Path)
+	if !ok {
+		return nil
+	}
+
+	response, ok := operation.Responses[strconv.Itoa(status)]
+	if !ok {
+		response, ok = operation.Responses["default"]
+	}
+
+	if !ok {
+		statuses := make([]string, 0, len(operation.Responses))
+		for status := range operation.Responses {
+			statuses = append(statuses, status)
+		}
+
+		sort.Strings(statuses)
+
+		return Errors{{Field: "status", Rule: RuleOneOf, Param: strings.Join(statuses, " ")}}
+	}
+
+	schema, ok := jsonSchema(response.Content, header.Get("Content-Type"))
+	if !ok || schema == nil {
+		return nil
+	}
+
+	var value interface{}
+	if err := decodeJSON(body, &value); err != nil {
+		return Errors{{Field: "body", Rule: RuleType, Param: "object"}}
+	}
+
+	if errs := v.validateSchema("body", schema, value); len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// Middleware проверяет запросы. При нарушениях обработчик не вызывается, а ответ формирует onError.
+func (v *Validator) Middleware(onError func(w http.ResponseWriter, r *http.Request, err error)) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := v.ValidateRequest(r); err != nil {
+				onError(w, r, err)
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ResponseMiddleware проверяет ответы и передает нарушения в report. Ответ копируется в память,
+// поэтому middleware предназначен для тестов.
+func (v *Validator) ResponseMiddleware(report func(r *http.Request, err error)) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body := &bytes.Buffer{}
+
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			ww.Tee(body)
+
+			next.ServeHTTP(ww, r)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			if err := v.ValidateResponse(r, status, ww.Header(), body.Bytes()); err != nil {
+				report(r, err)
+			}
+		})
+	}
+}
+
+// validateRequestBody проверяет тело запроса. Проверяется только содержимое JSON.
+func (v *Validator) validateRequestBody(r *http.Request, body *RequestBody) (Errors, error) {
+	schema, ok := jsonSchema(body.Content, r.Header.Get("Content-Type"))
+	if !ok {
+		return nil, nil
+	}
+
+	if r.Body == nil || r.Body == http.NoBody {
+		if body.Required {
+			return Errors{{Field: "body", Rule: RuleRequired}}, nil
+		}
+
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("чтение тела запроса: %w", err)
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(data))
+
+	if len(bytes.TrimSpace(data)) == 0 {
+		if body.Required {
+			return Errors{{Field: "body", Rule: RuleRequired}}, nil
+		}
+
+		return nil, nil
+	}
+
+	var value interface{}
+	if err := decodeJSON(data, &value); err != nil {
+		return Errors{{Field: "body", Rule: RuleType, Param: "object"}}, nil
+	}
+
+	if schema == nil {
+		return nil, nil
+	}
+
+	return v.validateSchema("body", schema, value), nil
+}
+
+// validateParam проверяет значения параметра пути, запроса или заголовка.
+func (v *Validator) validateParam(field string, schema *spec.Schema, values []string) Errors {
+	if schema == nil {
+		return nil
+	}
+
+	if schema.Type.Contains("array") {
+		items := make([]interface{}, 0, len(values))
+		for _, value := range values {
+			items = append(items, value)
+		}
+
+		if schema.Items != nil && schema.Items.Schema != nil {
+			for i, item := range items {
+				items[i] = parseValue(schema.Items.Schema, item.(string))
+			}
+		}
+
+		return v.validateSchema(field, schema, items)
+	}
+
+	return v.validateSchema(field, schema, parseValue(schema, values[0]))
+}
+
+// validateSchema проверяет значение JSON по схеме. Значение null допускается для любой схемы,
+// так как swag не описывает поля, которые могут быть пустыми.
+func (v *Validator) validateSchema(field string, schema *spec.Schema, value interface{}) Errors {
+	schema = v.resolve(schema)
+	if schema == nil || value == nil {
+		return nil
+	}
+
+	var errs Errors
+
+	for i := range schema.AllOf {
+		errs = append(errs, v.validateSchema(field, &schema.AllOf[i], value)...)
+	}
+
+	if len(schema.Type) > 0 && !matchesType(schema.Type, value) {
+		return append(errs, Error{Field: field, Rule: RuleType, Param: schema.Type[0]})
+	}
+
+	if len(schema.Enum) > 0 && !inEnum(schema.Enum, value) {
+		values := make([]string, 0, len(schema.Enum))
+		for _, item := range schema.Enum {
+			values = append(values, fmt.Sprint(item))
+		}
+
+		errs = append(errs, Error{Field: field, Rule: RuleOneOf, Param: strings.Join(values, " ")})
+	}
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		errs = append(errs, v.validateObject(field, schema, value)...)
+	case []interface{}:
+		errs = append(errs, bounds(field, float64(len(value)), intBound(schema.MinItems), intBound(schema.MaxItems))...)
+
+		if schema.Items != nil && schema.Items.Schema != nil {
+			for i, item := range value {
+				errs = append(errs, v.validateSchema(field+"["+strconv.Itoa(i)+"]", schema.Items.Schema, item)...)
+			}
+		}
+	case string:
+		errs = append(errs, bounds(field, float64(len([]rune(value))), intBound(schema.MinLength), intBound(schema.MaxLength))...)
+	case json.Number:
+		number, err := value.Float64()
+		if err == nil {
+			errs = append(errs, bounds(field, number, schema.Minimum, schema.Maximum)...)
+		}
+	}
+
+	return errs
+}
+
+// validateObject проверяет обязательные и описанные свойства объекта.
+func (v *Validator) validateObject(field string, schema *spec.Schema, value map[string]interface{}) Errors {
+	var errs Errors
+
+	for _, name := range schema.Required {
+		if _, ok := value[name]; !ok {
+			errs = append(errs, Error{Field: field + "." + name, Rule: RuleRequired})
+		}
+	}
+
+	names := make([]string, 0, len(value))
+	for name := range value {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		property, ok := schema.Properties[name]
+		if ok {
+			errs = append(errs, v.validateSchema(field+"."+name, &property, value[name])...)
+
+			continue
+		}
+
+		if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+			errs = append(errs, v.validateSchema(field+"."+name, schema.AdditionalProperties.Schema, value[name])...)
+		}
+	}
+
+	return errs
+}
+
+// resolve возвращает схему, на которую ссылается $ref.
+func (v *Validator) resolve(schema *spec.Schema) *spec.Schema {
+	for schema != nil {
+		ref := schema.Ref.String()
+		if ref == "" {
+			return schema
+		}
+
+		resolved, ok := v.doc.Components.Schemas[strings.TrimPrefix(ref, schemasRef)]
+		if !ok {
+			return nil
+		}
+
+		schema = &resolved
+	}
+
+	return nil
+}
+
+// jsonSchema возвращает схему JSON содержимого. Второе значение false, если содержимое
+// с таким типом не описано в формате JSON и не проверяется.
+func jsonSchema(content map[string]MediaType, contentType string) (*spec.Schema, bool) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = contentTypeJSON
+	}
+
+	if mediaType != contentTypeJSON && !strings.HasSuffix(mediaType, "+json") {
+		return nil, false
+	}
+
+	if media, ok := content[mediaType]; ok {
+		return media.Schema, true
+	}
+
+	if media, ok := content[contentTypeJSON]; ok {
+		return media.Schema, true
+	}
+
+	return nil, false
+}
+
+// decodeJSON разбирает JSON, сохраняя числа как json.Number.
+func decodeJSON(data []byte, value *interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	return decoder.Decode(value)
+}
+
+// parseValue переводит строковое значение параметра в значение JSON по типу схемы.
+// Значение, которое не удалось разобрать, остается строкой и не проходит проверку типа.
+func parseValue(schema *spec.Schema, value string) interface{} {
+	switch {
+	case schema.Type.Contains("integer"), schema.Type.Contains("number"):
+		if _, err := strconv.ParseFloat(value, 64); err == nil {
+			return json.Number(value)
+		}
+	case schema.Type.Contains("boolean"):
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			return parsed
+		}
+	}
+
+	return value
+}
+
+// matchesType проверяет, что значение JSON соответствует одному из типов схемы.
+func matchesType(types spec.StringOrArray, value interface{}) bool {
+	for _, typ := range types {
+		switch value := value.(type) {
+		case map[string]interface{}:
+			if typ == "object" {
+				return true
+			}
+		case []interface{}:
+			if typ == "array" {
+				return true
+			}
+		case string:
+			if typ == "string" {
+				return true
+			}
+		case bool:
+			if typ == "boolean" {
+				return true
+			}
+		case json.Number:
+			if typ == "number" {
+				return true
+			}
+
+			if _, err := value.Int64(); err == nil && typ == "integer" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// inEnum проверяет, что значение входит в перечисление схемы.
+func inEnum(enum []interface{}, value interface{}) bool {
+	for _, item := range enum {
+		if fmt.Sprint(item) == fmt.Sprint(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// bounds проверяет, что число не выходит за границы.
+func bounds(field string, value float64, minimum, maximum *float64) Errors {
+	var errs Errors
+
+	if minimum != nil && value < *minimum {
+		errs = append(errs, Error{Field: field, Rule: RuleMin, Param: strconv.FormatFloat(*minimum, 'f', -1, 64)})
+	}
+
+	if maximum != nil && value > *maximum {
+		errs = append(errs, Error{Field: field, Rule: RuleMax, Param: strconv.FormatFloat(*maximum, 'f', -1, 64)})
+	}
+
+	return errs
+}
+
+// intBound переводит целочисленную границу схемы в число.
+func intBound(bound *int64) *float64 {
+	if bound == nil {
+		return nil
+	}
+
+	value := float64(*bound)
+
+	return &value
+}
+
+// splitPath разбивает путь на сегменты без начального и конечного слэша.
+func splitPath(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+
+	return strings.Split(path, "/")
+}
+
+// literals возвращает число постоянных сегментов шаблона.
+func literals(segments []string) int {
+	count := 0
+
+	for _, segment := range segments {
+		if !isParam(segment) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// isParam проверяет, что сегмент шаблона является параметром пути.
+func isParam(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+// match сопоставляет сегменты пути с шаблоном и возвращает значения параметров пути.
+func match(template, segments []string) (map[string]string, bool) {
+	if len(template) != len(segments) {
+		return nil, false
+	}
+
+	params := make(map[string]string)
+
+	for i, segment := range template {
+		if isParam(segment) {
+			params[strings.Trim(segment, "{}")] = segments[i]
+
+			continue
+		}
+
+		if segment != segments[i] {
+			return nil, false
+		}
+	}
+
+	return params, true
+}
@@ -0,0 +1,318 @@
+package openapi
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const swaggerDoc = `{
+  "swagger": "2.0",
+  "info": {"title": "test", "version": "1.0"},
+  "basePath": "/api",
+  "paths": {
+    "/v1/items": {
+      "get": {
+        "parameters": [
+          {"type": "integer", "maximum": 100, "minimum": 1, "name": "limit", "in": "query"},
+          {"enum": ["new", "old"], "type": "string", "name": "sort", "in": "query"},
+          {"type": "string", "name": "X-User-Id", "in": "header", "required": true}
+        ],
+        "responses": {
+          "200": {"description": "OK", "schema": {"type": "array", "items": {"$ref": "#/definitions/item"}}}
+        }
+      },
+      "post": {
+        "parameters": [
+          {"name": "item", "in": "body", "required": true, "schema": {"$ref": "#/definitions/item"}}
+        ],
+        "responses": {
+          "201": {"description": "Created", "schema": {"$ref": "#/definitions/item"}},
+          "400": {"description": "Bad Request"}
+        }
+      }
+    },
+    "/v1/items/{id}": {
+      "get": {
+        "parameters": [{"type": "string", "name": "id", "in": "path", "required": true}],
+        "responses": {"200": {"description": "OK"}}
+      }
+    },
+    "/v1/items/recent": {
+      "get": {"responses": {"200": {"description": "OK"}}}
+    },
+    "/v1/items/{id}/photo": {
+      "post": {
+        "consumes": ["multipart/form-data"],
+        "parameters": [
+          {"type": "file", "name": "file", "in": "formData", "required": true},
+          {"type": "string", "name": "id", "in": "path", "required": true}
+        ],
+        "responses": {"200": {"description": "OK"}}
+      }
+    }
+  },
+  "definitions": {
+    "item": {
+      "type": "object",
+      "required": ["title"],
+      "properties": {
+        "title": {"type": "string", "maxLength": 5},
+        "price": {"type": "integer", "minimum": 0},
+        "tags": {"type": "array", "items": {"type": "string"}}
+      }
+    }
+  }
+}`
+
+func testValidator(t *testing.T) *Validator {
+	t.Helper()
+
+	doc, err := FromSwagger([]byte(swaggerDoc))
+	require.NoError(t, err)
+
+	return NewValidator(doc)
+}
+
+func TestFromSwagger(t *testing.T) {
+	t.Parallel()
+
+	doc, err := FromSwagger([]byte(swaggerDoc))
+	require.NoError(t, err)
+
+	assert.Equal(t, Version, doc.OpenAPI)
+	assert.Equal(t, []Server{{URL: "/api"}}, doc.Servers)
+	assert.Contains(t, doc.Components.Schemas, "item")
+	assert.Equal(t, []string{"GET", "POST"}, doc.Paths["/v1/items"].Methods())
+
+	post, ok := doc.Operation(http.MethodPost, "/v1/items")
+	require.True(t, ok)
+	require.NotNil(t, post.RequestBody)
+	assert.True(t, post.RequestBody.Required)
+	assert.Equal(t, schemasRef+"item", post.RequestBody.Content[contentTypeJSON].Schema.Ref.String())
+	assert.Contains(t, post.Responses, "201")
+
+	photo, ok := doc.Operation(http.MethodPost, "/v1/items/{id}/photo")
+	require.True(t, ok)
+	require.Len(t, photo.Parameters, 1)
+	assert.Equal(t, "path", photo.Parameters[0].In)
+
+	form := photo.RequestBody.Content[contentTypeMultipart].Schema
+	require.NotNil(t, form)
+	assert.Equal(t, []string{"file"}, form.Required)
+	assert.Equal(t, "binary", form.Properties["file"].Format)
+}
+
+func TestValidator_Find(t *testing.T) {
+	t.Parallel()
+
+	v := testValidator(t)
+
+	_, params, ok := v.Find(http.MethodGet, "/api/v1/items/42")
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{"id": "42"}, params)
+
+	_, params, ok = v.Find(http.MethodGet, "/api/v1/items/recent/")
+	require.True(t, ok)
+	assert.Empty(t, params)
+
+	_, _, ok = v.Find(http.MethodDelete, "/api/v1/items/42")
+	assert.False(t, ok)
+
+	_, _, ok = v.Find(http.MethodGet, "/v1/items")
+	assert.False(t, ok)
+}
+
+func TestValidator_ValidateRequest(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		method string
+		target string
+		header map[string]string
+		body   string
+		exp    Errors
+	}{
+		{
+			name:   "корректный запрос",
+			method: http.MethodGet,
+			target: "/api/v1/items?limit=10&sort=new",
+			header: map[string]string{"X-User-Id": "1"},
+		},
+		{
+			name:   "нарушения параметров",
+			method: http.MethodGet,
+			target: "/api/v1/items?limit=500&sort=top",
+			exp: Errors{
+				{Field: "query.limit", Rule: RuleMax, Param: "100"},
+				{Field: "query.sort", Rule: RuleOneOf, Param: "new old"},
+				{Field: "header.X-User-Id", Rule: RuleRequired},
+			},
+		},
+		{
+			name:   "не число",
+			method: http.MethodGet,
+			target: "/api/v1/items?limit=ten",
+			header: map[string]string{"X-User-Id": "1"},
+			exp:    Errors{{Field: "query.limit", Rule: RuleType, Param: "integer"}},
+		},
+		{
+			name:   "корректное тело",
+			method: http.MethodPost,
+			target: "/api/v1/items",
+			body:   `{"title": "book", "price": 10, "tags": ["a"], "extra": null}`,
+		},
+		{
+			name:   "нарушения тела",
+			method: http.MethodPost,
+			target: "/api/v1/items",
+			body:   `{"title": "notebook", "price": -1.5, "tags": [1]}`,
+			exp: Errors{
+				{Field: "body.price", Rule: RuleType, Param: "integer"},
+				{Field: "body.tags[0]", Rule: RuleType, Param: "string"},
+				{Field: "body.title", Rule: RuleMax, Param: "5"},
+			},
+		},
+		{
+			name:   "нет обязательного поля",
+			method: http.MethodPost,
+			target: "/api/v1/items",
+			body:   `{}`,
+			exp:    Errors{{Field: "body.title", Rule: RuleRequired}},
+		},
+		{
+			name:   "нет тела",
+			method: http.MethodPost,
+			target: "/api/v1/items",
+			exp:    Errors{{Field: "body", Rule: RuleRequired}},
+		},
+		{
+			name:   "не JSON",
+			method: http.MethodPost,
+			target: "/api/v1/items",
+			body:   `{"title":`,
+			exp:    Errors{{Field: "body", Rule: RuleType, Param: "object"}},
+		},
+		{
+			name:   "NDJSON не проверяется схемой JSON",
+			method: http.MethodPost,
+			target: "/api/v1/items",
+			header: map[string]string{"Content-Type": "application/x-ndjson"},
+			body:   "{\"title\": \"notebook\"}\n{\"title\": \"pen\"}\n",
+		},
+		{
+			name:   "путь не описан",
+			method: http.MethodGet,
+			target: "/api/v1/unknown",
+		},
+	}
+
+	v := testValidator(t)
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(s.method, s.target, strings.NewReader(s.body))
+			for key, value := range s.header {
+				r.Header.Set(key, value)
+			}
+
+			err := v.ValidateRequest(r)
+			if s.exp == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.Equal(t, s.exp, err)
+		})
+	}
+}
+
+func TestValidator_Middleware(t *testing.T) {
+	t.Parallel()
+
+	v := testValidator(t)
+
+	var body string
+
+	handler := v.Middleware(func(w http.ResponseWriter, r *http.Request, err error) {
+		w.WriteHeader(http.StatusBadRequest)
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+	}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/items", strings.NewReader(`{"title": "book"}`)))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"title": "book"}`, body, "тело запроса доступно обработчику")
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/items", strings.NewReader(`{}`)))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestValidator_ResponseMiddleware(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		status int
+		body   string
+		exp    Errors
+	}{
+		{name: "корректный ответ", status: http.StatusCreated, body: `{"title": "book"}`},
+		{name: "ответ без схемы", status: http.StatusBadRequest, body: `{"code": "X"}`},
+		{
+			name:   "неописанный статус",
+			status: http.StatusConflict,
+			exp:    Errors{{Field: "status", Rule: RuleOneOf, Param: "201 400"}},
+		},
+		{
+			name:   "нарушение схемы",
+			status: http.StatusCreated,
+			body:   `{"price": 1}`,
+			exp:    Errors{{Field: "body.title", Rule: RuleRequired}},
+		},
+	}
+
+	v := testValidator(t)
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			var reported error
+
+			handler := v.ResponseMiddleware(func(r *http.Request, err error) {
+				reported = err
+			})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				w.WriteHeader(s.status)
+				_, _ = w.Write([]byte(s.body))
+			}))
+
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/api/v1/items", nil))
+
+			if s.exp == nil {
+				assert.NoError(t, reported)
+
+				return
+			}
+
+			assert.Equal(t, s.exp, reported)
+		})
+	}
+}
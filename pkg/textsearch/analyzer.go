@@ -0,0 +1,168 @@
+// Package textsearch разбирает текст на слова для полнотекстового поиска.
+//
+// Слова приводятся к нижнему регистру и к основе: русские — стеммером Snowball,
+// английские — облегченным стеммером Портера. Поиск сравнивает основы, поэтому
+// «телефоны» находит «телефона», а «bikes» — «bike».
+package textsearch
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// minTermLength минимальная длина слова в символах, которое участвует в поиске.
+const minTermLength = 2
+
+// Token слово текста.
+type Token struct {
+	Term  string // Слово в нижнем регистре
+	Stem  string // Основа слова
+	Start int    // Смещение начала слова в исходном тексте в байтах
+	End   int    // Смещение конца слова в исходном тексте в байтах
+}
+
+// Tokenize разбивает текст на слова. Словом считается последовательность букв и цифр.
+func Tokenize(text string) []Token {
+	var tokens []Token
+
+	start := -1
+
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+
+			continue
+		}
+
+		if start >= 0 {
+			tokens = append(tokens, newToken(text, start, i))
+			start = -1
+		}
+	}
+
+	if start >= 0 {
+		tokens = append(tokens, newToken(text, start, len(text)))
+	}
+
+	return tokens
+}
+
+// newToken создает слово из фрагмента текста.
+func newToken(text string, start, end int) Token {
+	term := normalize(text[start:end])
+
+	return Token{
+		Term:  term,
+		Stem:  Stem(term),
+		Start: start,
+		End:   end,
+	}
+}
+
+// normalize приводит слово к нижнему регистру и заменяет «ё» на «е».
+func normalize(word string) string {
+	return strings.ReplaceAll(strings.ToLower(word), "ё", "е")
+}
+
+// Stem возвращает основу слова в нижнем регистре.
+// Алфавит определяется по первой букве, слова из цифр не меняются.
+func Stem(term string) string {
+	r, _ := utf8.DecodeRuneInString(term)
+
+	switch {
+	case unicode.Is(unicode.Cyrillic, r):
+		return stemRussian(term)
+	case unicode.Is(unicode.Latin, r):
+		return stemEnglish(term)
+	default:
+		return term
+	}
+}
+
+// IsSearchable участвует ли слово в поиске: не стоп-слово и не короче minTermLength.
+func (t Token) IsSearchable() bool {
+	if utf8.RuneCountInString(t.Term) < minTermLength {
+		return false
+	}
+
+	_, stop := stopWords[t.Term]
+
+	return !stop
+}
+
+// Analyze возвращает основы слов текста, участвующих в поиске, с повторами.
+func Analyze(text string) []string {
+	tokens := Tokenize(text)
+	stems := make([]string, 0, len(tokens))
+
+	for _, token := range tokens {
+		if token.IsSearchable() {
+			stems = append(stems, token.Stem)
+		}
+	}
+
+	return stems
+}
+
+// Terms возвращает слова текста, участвующие в поиске, без повторов в порядке появления.
+func Terms(text string) []string {
+	tokens := Tokenize(text)
+	seen := make(map[string]struct{}, len(tokens))
+	terms := make([]string, 0, len(tokens))
+
+	for _, token := range tokens {
+		if _, ok := seen[token.Term]; ok || !token.IsSearchable() {
+			continue
+		}
+
+		seen[token.Term] = struct{}{}
+		terms = append(terms, token.Term)
+	}
+
+	return terms
+}
+
+// QueryStems возвращает основы слов поискового запроса без повторов в порядке появления.
+func QueryStems(query string) []string {
+	stems := Analyze(query)
+	seen := make(map[string]struct{}, len(stems))
+	unique := stems[:0]
+
+	for _, stem := range stems {
+		if _, ok := seen[stem]; ok {
+			continue
+		}
+
+		seen[stem] = struct{}{}
+		unique = append(unique, stem)
+	}
+
+	return unique
+}
+
+// stopWords частые слова без смысловой нагрузки.
+var stopWords = toSet(
+	// Русские.
+	"и", "в", "во", "не", "что", "он", "на", "я", "с", "со", "как", "а", "то", "все", "она", "так", "его",
+	"но", "да", "ты", "к", "у", "же", "вы", "за", "бы", "по", "только", "ее", "мне", "было", "вот", "от",
+	"меня", "еще", "нет", "о", "из", "ему", "когда", "даже", "ли", "если", "уже", "или", "ни", "быть",
+	"был", "до", "вас", "там", "для", "мы", "их", "чем", "была", "без", "под", "будет", "кто", "этот",
+	"при", "об", "про", "над", "это", "эти", "тот",
+	// Английские.
+	"a", "an", "and", "are", "as", "at", "be", "but", "by", "for", "if", "in", "into", "is", "it", "no",
+	"not", "of", "on", "or", "such", "that", "the", "their", "then", "there", "these", "they", "this",
+	"to", "was", "will", "with",
+)
+
+// toSet создает множество из списка слов.
+func toSet(words ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(words))
+	for _, word := range words {
+		set[word] = struct{}{}
+	}
+
+	return set
+}
@@ -0,0 +1,98 @@
+package textsearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStem(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name  string
+		words []string
+		stem  string
+	}{
+		{name: "russian noun cases", words: []string{"телефон", "телефоны", "телефона", "телефонами"}, stem: "телефон"},
+		{name: "russian plural genitive", words: []string{"велосипед", "велосипеды", "велосипедов"}, stem: "велосипед"},
+		{name: "russian adjective", words: []string{"красивая", "красивый", "красивые"}, stem: "красив"},
+		{name: "russian verb", words: []string{"меняю", "меняет", "менять"}, stem: "меня"},
+		{name: "russian yo", words: []string{"ёлка", "елки"}, stem: "елк"},
+		{name: "english plural", words: []string{"bike", "bikes"}, stem: "bike"},
+		{name: "english ing", words: []string{"run", "running"}, stem: "run"},
+		{name: "english ies", words: []string{"study", "studies"}, stem: "studi"},
+		{name: "english ed", words: []string{"trade", "traded", "trading"}, stem: "trade"},
+		{name: "digits", words: []string{"2024"}, stem: "2024"},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, word := range s.words {
+				assert.Equal(t, s.stem, Stem(normalize(word)), word)
+			}
+		})
+	}
+}
+
+func TestQueryStems(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"красн", "велосипед", "bike"}, QueryStems("Красный велосипед и красные BIKES, велосипеды!"))
+	assert.Empty(t, QueryStems("и в на the"))
+}
+
+func TestHighlight(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		text     string
+		query    string
+		maxRunes int
+		want     string
+	}{
+		{
+			name:     "whole text",
+			text:     "Горный велосипед в хорошем состоянии",
+			query:    "велосипеды",
+			maxRunes: 100,
+			want:     "Горный <em>велосипед</em> в хорошем состоянии",
+		},
+		{
+			name:     "trimmed around match",
+			text:     "Продаю или меняю: один два три четыре пять шесть семь восемь телефон и зарядка",
+			query:    "телефоны",
+			maxRunes: 40,
+			want:     "…четыре пять шесть семь восемь <em>телефон</em> и…",
+		},
+		{
+			name:     "escapes html",
+			text:     "<b>bike</b> & helmet",
+			query:    "bikes",
+			maxRunes: 100,
+			want:     "&lt;b&gt;<em>bike</em>&lt;/b&gt; &amp; helmet",
+		},
+		{
+			name:     "no match",
+			text:     "Горный велосипед",
+			query:    "телефон",
+			maxRunes: 100,
+			want:     "",
+		},
+	}
+
+	for _, s := range cases {
+		s := s
+
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, s.want, Highlight(s.text, QueryStems(s.query), s.maxRunes))
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package textsearch
+
+import (
+	"html"
+	"strings"
+	"unicode/utf8"
+)
+
+// Теги, которыми выделяются найденные слова.
+const (
+	highlightOpen  = "<em>"
+	highlightClose = "</em>"
+	ellipsis       = "…"
+)
+
+// highlightContext количество слов перед первым найденным словом, которые попадают во фрагмент.
+const highlightContext = 5
+
+// Highlight возвращает фрагмент текста не длиннее maxRunes символов с найденными словами в тегах <em>.
+// Текст экранируется для вставки в HTML. Если в тексте нет слов с основами из stems, возвращает пустую строку.
+func Highlight(text string, stems []string, maxRunes int) string {
+	wanted := make(map[string]struct{}, len(stems))
+	for _, stem := range stems {
+		wanted[stem] = struct{}{}
+	}
+
+	tokens := Tokenize(text)
+
+	first := -1
+
+	for i, token := range tokens {
+		if _, ok := wanted[token.Stem]; ok && token.IsSearchable() {
+			first = i
+
+			break
+		}
+	}
+
+	if first < 0 {
+		return ""
+	}
+
+	from := first - highlightContext
+	if from < 0 {
+		from = 0
+	}
+
+	// Расширяем фрагмент вправо, пока он помещается в maxRunes.
+	to := first
+	for to+1 < len(tokens) && utf8.RuneCountInString(text[tokens[from].Start:tokens[to+1].End]) <= maxRunes {
+		to++
+	}
+
+	// Сдвигаем начало, если найденное слово в начале не помещается.
+	for from < first && utf8.RuneCountInString(text[tokens[from].Start:tokens[to].End]) > maxRunes {
+		from++
+	}
+
+	var b strings.Builder
+
+	pos := 0
+	if from > 0 {
+		b.WriteString(ellipsis)
+
+		pos = tokens[from].Start
+	}
+
+	for _, token := range tokens[from : to+1] {
+		b.WriteString(html.EscapeString(text[pos:token.Start]))
+
+		word := html.EscapeString(text[token.Start:token.End])
+		if _, ok := wanted[token.Stem]; ok && token.IsSearchable() {
+			word = highlightOpen + word + highlightClose
+		}
+
+		b.WriteString(word)
+		pos = token.End
+	}
+
+	if to < len(tokens)-1 {
+		b.WriteString(ellipsis)
+	} else {
+		b.WriteString(html.EscapeString(text[pos:]))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,155 @@
+package textsearch
+
+import (
+	"strings"
+)
+
+// enStep2 замены суффиксов второго шага стеммера Портера. Применяются в области R1.
+var enStep2 = []struct {
+	suffix      string
+	replacement string
+}{
+	{"ational", "ate"}, {"tional", "tion"}, {"enci", "ence"}, {"anci", "ance"}, {"abli", "able"},
+	{"entli", "ent"}, {"izer", "ize"}, {"ization", "ize"}, {"ation", "ate"}, {"ator", "ate"},
+	{"alism", "al"}, {"aliti", "al"}, {"alli", "al"}, {"fulness", "ful"}, {"ousli", "ous"},
+	{"ousness", "ous"}, {"iveness", "ive"}, {"iviti", "ive"}, {"biliti", "ble"}, {"bli", "ble"},
+	{"fulli", "ful"}, {"lessli", "less"},
+}
+
+// stemEnglish возвращает основу английского слова облегченным стеммером Портера:
+// окончания множественного числа, -ed, -ing, -ly и частые словообразовательные суффиксы.
+func stemEnglish(word string) string {
+	if len(word) <= 2 || !isASCIIWord(word) {
+		return word
+	}
+
+	w := strings.TrimSuffix(word, "'s")
+	r1 := enRegion1(w)
+
+	w = enStep1a(w)
+	w = enStep1b(w, r1)
+
+	// Шаг 1c: «y» после согласной заменяется на «i».
+	if n := len(w); n > 2 && w[n-1] == 'y' && !isEnVowel(w[n-2]) {
+		w = w[:n-1] + "i"
+	}
+
+	for _, rule := range enStep2 {
+		if strings.HasSuffix(w, rule.suffix) {
+			if len(w)-len(rule.suffix) >= r1 {
+				w = w[:len(w)-len(rule.suffix)] + rule.replacement
+			}
+
+			break
+		}
+	}
+
+	return w
+}
+
+// enStep1a убирает окончания множественного числа.
+func enStep1a(w string) string {
+	switch {
+	case strings.HasSuffix(w, "sses"):
+		return w[:len(w)-2]
+	case strings.HasSuffix(w, "ied"), strings.HasSuffix(w, "ies"):
+		if len(w) > 4 {
+			return w[:len(w)-2]
+		}
+
+		return w[:len(w)-1]
+	case strings.HasSuffix(w, "us"), strings.HasSuffix(w, "ss"):
+		return w
+	case strings.HasSuffix(w, "s"):
+		// «s» убирается, если перед предпоследней буквой есть гласная: gas остается, gaps → gap.
+		if strings.IndexFunc(w[:len(w)-2], func(r rune) bool { return isEnVowel(byte(r)) }) >= 0 {
+			return w[:len(w)-1]
+		}
+	}
+
+	return w
+}
+
+// enStep1b убирает окончания -eed, -ed, -ing и -ly.
+func enStep1b(w string, r1 int) string {
+	for _, suffix := range []string{"eedly", "eed"} {
+		if strings.HasSuffix(w, suffix) {
+			if len(w)-len(suffix) >= r1 {
+				return w[:len(w)-len(suffix)] + "ee"
+			}
+
+			return w
+		}
+	}
+
+	for _, suffix := range []string{"ingly", "edly", "ing", "ed"} {
+		if !strings.HasSuffix(w, suffix) {
+			continue
+		}
+
+		stem := w[:len(w)-len(suffix)]
+		if strings.IndexFunc(stem, func(r rune) bool { return isEnVowel(byte(r)) }) < 0 {
+			return w
+		}
+
+		switch {
+		case strings.HasSuffix(stem, "at"), strings.HasSuffix(stem, "bl"), strings.HasSuffix(stem, "iz"):
+			return stem + "e"
+		case enEndsWithDouble(stem):
+			return stem[:len(stem)-1]
+		case r1 >= len(stem) && enEndsShortSyllable(stem):
+			return stem + "e"
+		}
+
+		return stem
+	}
+
+	return w
+}
+
+// enRegion1 возвращает начало области R1: после первой согласной, следующей за гласной.
+func enRegion1(w string) int {
+	for i := 1; i < len(w); i++ {
+		if !isEnVowel(w[i]) && isEnVowel(w[i-1]) {
+			return i + 1
+		}
+	}
+
+	return len(w)
+}
+
+// enEndsWithDouble оканчивается ли основа на удвоенную согласную, которую нужно упростить.
+func enEndsWithDouble(w string) bool {
+	n := len(w)
+	if n < 2 || w[n-1] != w[n-2] {
+		return false
+	}
+
+	return strings.IndexByte("bdfgmnprt", w[n-1]) >= 0
+}
+
+// enEndsShortSyllable оканчивается ли основа на короткий слог: согласная, гласная, согласная кроме w, x, y.
+func enEndsShortSyllable(w string) bool {
+	n := len(w)
+	if n < 3 {
+		return n == 2 && isEnVowel(w[0]) && !isEnVowel(w[1])
+	}
+
+	return !isEnVowel(w[n-3]) && isEnVowel(w[n-2]) && !isEnVowel(w[n-1]) && strings.IndexByte("wxy", w[n-1]) < 0
+}
+
+// isEnVowel является ли буква английской гласной.
+func isEnVowel(b byte) bool {
+	return strings.IndexByte("aeiouy", b) >= 0
+}
+
+// isASCIIWord состоит ли слово только из ASCII-символов.
+func isASCIIWord(w string) bool {
+	for i := 0; i < len(w); i++ {
+		if w[i] >= 0x80 {
+			return false
+		}
+	}
+
+	return true
+}
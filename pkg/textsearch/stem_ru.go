@@ -0,0 +1,188 @@
+package textsearch
+
+import (
+	"sort"
+)
+
+// Окончания русского стеммера Snowball. Окончания первой группы отбрасываются,
+// только если перед ними стоит «а» или «я».
+var (
+	ruPerfectiveGerund1 = ruSuffixes("в", "вши", "вшись")
+	ruPerfectiveGerund2 = ruSuffixes("ив", "ивши", "ившись", "ыв", "ывши", "ывшись")
+	ruReflexive         = ruSuffixes("ся", "сь")
+	ruAdjective         = ruSuffixes(
+		"ее", "ие", "ые", "ое", "ими", "ыми", "ей", "ий", "ый", "ой", "ем", "им", "ым", "ом",
+		"его", "ого", "ему", "ому", "их", "ых", "ую", "юю", "ая", "яя", "ою", "ею",
+	)
+	ruParticiple1 = ruSuffixes("ем", "нн", "вш", "ющ", "щ")
+	ruParticiple2 = ruSuffixes("ивш", "ывш", "ующ")
+	ruVerb1       = ruSuffixes(
+		"ла", "на", "ете", "йте", "ли", "й", "л", "ем", "н", "ло", "но", "ет", "ют", "ны", "ть", "ешь", "нно",
+	)
+	ruVerb2 = ruSuffixes(
+		"ила", "ыла", "ена", "ейте", "уйте", "ите", "или", "ыли", "ей", "уй", "ил", "ыл", "им", "ым", "ен",
+		"ило", "ыло", "ено", "ят", "ует", "уют", "ит", "ыт", "ены", "ить", "ыть", "ишь", "ую", "ю",
+	)
+	ruNoun = ruSuffixes(
+		"а", "ев", "ов", "ие", "ье", "е", "иями", "ями", "ами", "еи", "ии", "и", "ией", "ей", "ой", "ий", "й",
+		"иям", "ям", "ием", "ем", "ам", "ом", "о", "у", "ах", "иях", "ях", "ы", "ь", "ию", "ью", "ю", "ия",
+		"ья", "я",
+	)
+	ruSuperlative  = ruSuffixes("ейш", "ейше")
+	ruDerivational = ruSuffixes("ост", "ость")
+)
+
+// stemRussian возвращает основу русского слова по алгоритму Snowball.
+func stemRussian(word string) string {
+	w := []rune(word)
+	rv, r2 := ruRegions(w)
+
+	if rv >= len(w) {
+		return word
+	}
+
+	// Шаг 1: деепричастие, иначе возвратная частица и прилагательное, глагол или существительное.
+	if n, ok := ruTrim(w, rv, ruPerfectiveGerund1, ruPerfectiveGerund2); ok {
+		w = w[:n]
+	} else {
+		if n, ok = ruTrim(w, rv, nil, ruReflexive); ok {
+			w = w[:n]
+		}
+
+		if n, ok = ruTrim(w, rv, nil, ruAdjective); ok {
+			w = w[:n]
+			if n, ok = ruTrim(w, rv, ruParticiple1, ruParticiple2); ok {
+				w = w[:n]
+			}
+		} else if n, ok = ruTrim(w, rv, ruVerb1, ruVerb2); ok {
+			w = w[:n]
+		} else if n, ok = ruTrim(w, rv, nil, ruNoun); ok {
+			w = w[:n]
+		}
+	}
+
+	// Шаг 2: «и» на конце.
+	if len(w) > rv && w[len(w)-1] == 'и' {
+		w = w[:len(w)-1]
+	}
+
+	// Шаг 3: словообразовательный суффикс в R2.
+	if n, ok := ruTrim(w, r2, nil, ruDerivational); ok {
+		w = w[:n]
+	}
+
+	// Шаг 4: превосходная степень, двойная «н» и мягкий знак.
+	if n, ok := ruTrim(w, rv, nil, ruSuperlative); ok {
+		w = w[:n]
+	}
+
+	switch {
+	case len(w)-2 >= rv && w[len(w)-1] == 'н' && w[len(w)-2] == 'н':
+		w = w[:len(w)-1]
+	case len(w) > rv && w[len(w)-1] == 'ь':
+		w = w[:len(w)-1]
+	}
+
+	return string(w)
+}
+
+// ruRegions возвращает начало области RV (после первой гласной) и R2.
+func ruRegions(w []rune) (rv, r2 int) {
+	rv = len(w)
+
+	for i, r := range w {
+		if isRuVowel(r) {
+			rv = i + 1
+
+			break
+		}
+	}
+
+	return rv, ruNextRegion(w, ruNextRegion(w, 0))
+}
+
+// ruNextRegion возвращает начало области после первой согласной, следующей за гласной, начиная с from.
+func ruNextRegion(w []rune, from int) int {
+	for i := from + 1; i < len(w); i++ {
+		if !isRuVowel(w[i]) && isRuVowel(w[i-1]) {
+			return i + 1
+		}
+	}
+
+	return len(w)
+}
+
+// ruTrim ищет самое длинное окончание из групп внутри области, начинающейся с region.
+// Окончания group1 подходят, только если перед ними в области стоит «а» или «я».
+// Возвращает длину слова без окончания.
+func ruTrim(w []rune, region int, group1, group2 [][]rune) (int, bool) {
+	best := -1
+
+	for _, suffix := range group1 {
+		n := len(w) - len(suffix)
+		if n-1 < region || !hasRuneSuffix(w, suffix) || (w[n-1] != 'а' && w[n-1] != 'я') {
+			continue
+		}
+
+		if best < 0 || n < best {
+			best = n
+		}
+
+		break
+	}
+
+	for _, suffix := range group2 {
+		n := len(w) - len(suffix)
+		if n < region || !hasRuneSuffix(w, suffix) {
+			continue
+		}
+
+		if best < 0 || n < best {
+			best = n
+		}
+
+		break
+	}
+
+	return best, best >= 0
+}
+
+// hasRuneSuffix оканчивается ли слово на suffix.
+func hasRuneSuffix(w, suffix []rune) bool {
+	if len(suffix) > len(w) {
+		return false
+	}
+
+	offset := len(w) - len(suffix)
+	for i, r := range suffix {
+		if w[offset+i] != r {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isRuVowel является ли буква русской гласной.
+func isRuVowel(r rune) bool {
+	switch r {
+	case 'а', 'е', 'и', 'о', 'у', 'ы', 'э', 'ю', 'я':
+		return true
+	default:
+		return false
+	}
+}
+
+// ruSuffixes возвращает окончания, отсортированные от длинных к коротким.
+func ruSuffixes(suffixes ...string) [][]rune {
+	result := make([][]rune, 0, len(suffixes))
+	for _, suffix := range suffixes {
+		result = append(result, []rune(suffix))
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return len(result[i]) > len(result[j])
+	})
+
+	return result
+}
@@ -0,0 +1,124 @@
+// Package thumbnail уменьшает изображения JPEG, PNG и GIF до миниатюр в формате JPEG.
+package thumbnail
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"io"
+
+	// Регистрация декодеров форматов для image.Decode.
+	_ "image/gif"
+	_ "image/png"
+)
+
+// maxSourcePixels максимальное количество пикселей исходного изображения. Защищает от изображений,
+// которые занимают мало места в файле, но требуют гигабайты памяти после декодирования.
+const maxSourcePixels = 50_000_000
+
+// jpegQuality качество сжатия миниатюры.
+const jpegQuality = 80
+
+// Ошибки генерации миниатюры.
+var (
+	ErrDecode   = errors.New("не удалось декодировать изображение")
+	ErrTooLarge = errors.New("слишком большое разрешение изображения")
+)
+
+// Result миниатюра и размеры исходного изображения.
+type Result struct {
+	Data   []byte // Миниатюра в формате JPEG
+	Width  int    // Ширина исходного изображения
+	Height int    // Высота исходного изображения
+}
+
+// Generate декодирует изображение и уменьшает его так, чтобы большая сторона не превышала maxSide.
+// Меньшие изображения не увеличиваются. Прозрачные области заливаются белым.
+func Generate(data []byte, maxSide int) (Result, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return Result{}, fmt.Errorf("%w: %s", ErrDecode, err.Error())
+	}
+
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxSourcePixels {
+		return Result{}, fmt.Errorf("%w: %dx%d", ErrTooLarge, cfg.Width, cfg.Height)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return Result{}, fmt.Errorf("%w: %s", ErrDecode, err.Error())
+	}
+
+	var buf bytes.Buffer
+	if err = encode(&buf, resize(flatten(src), maxSide)); err != nil {
+		return Result{}, err
+	}
+
+	return Result{Data: buf.Bytes(), Width: cfg.Width, Height: cfg.Height}, nil
+}
+
+// flatten рисует изображение на белом фоне в RGBA.
+func flatten(src image.Image) *image.RGBA {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+
+	draw.Draw(dst, dst.Bounds(), &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), src, bounds.Min, draw.Over)
+
+	return dst
+}
+
+// resize уменьшает изображение усреднением пикселей, попадающих в каждый пиксель результата.
+func resize(src *image.RGBA, maxSide int) *image.RGBA {
+	srcW, srcH := src.Bounds().Dx(), src.Bounds().Dy()
+	if srcW <= maxSide && srcH <= maxSide {
+		return src
+	}
+
+	dstW, dstH := maxSide, srcH*maxSide/srcW
+	if srcH > srcW {
+		dstW, dstH = srcW*maxSide/srcH, maxSide
+	}
+
+	dstW, dstH = max(dstW, 1), max(dstH, 1)
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+
+	for y := 0; y < dstH; y++ {
+		y0, y1 := y*srcH/dstH, max((y+1)*srcH/dstH, y*srcH/dstH+1)
+
+		for x := 0; x < dstW; x++ {
+			x0, x1 := x*srcW/dstW, max((x+1)*srcW/dstW, x*srcW/dstW+1)
+
+			var r, g, b, n int
+
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+
+				for sx := x0; sx < x1; sx++ {
+					r += int(row[sx*4])
+					g += int(row[sx*4+1])
+					b += int(row[sx*4+2])
+					n++
+				}
+			}
+
+			i := dst.PixOffset(x, y)
+			dst.Pix[i], dst.Pix[i+1], dst.Pix[i+2], dst.Pix[i+3] = uint8(r/n), uint8(g/n), uint8(b/n), 0xff
+		}
+	}
+
+	return dst
+}
+
+// encode сохраняет изображение в JPEG.
+func encode(w io.Writer, img image.Image) error {
+	if err := jpeg.Encode(w, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return fmt.Errorf("кодирование миниатюры: %w", err)
+	}
+
+	return nil
+}
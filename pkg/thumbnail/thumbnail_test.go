@@ -0,0 +1,67 @@
+package thumbnail
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pngImage(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 0x80, A: 0xff})
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+
+	return buf.Bytes()
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		width, height int
+		expW, expH    int
+	}{
+		{name: "landscape", width: 200, height: 100, expW: 64, expH: 32},
+		{name: "portrait", width: 90, height: 180, expW: 32, expH: 64},
+		{name: "smaller than thumbnail", width: 40, height: 20, expW: 40, expH: 20},
+	}
+
+	for _, s := range cases {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := Generate(pngImage(t, s.width, s.height), 64)
+			require.NoError(t, err)
+			assert.Equal(t, s.width, res.Width)
+			assert.Equal(t, s.height, res.Height)
+
+			thumb, format, err := image.Decode(bytes.NewReader(res.Data))
+			require.NoError(t, err)
+			assert.Equal(t, "jpeg", format)
+			assert.Equal(t, s.expW, thumb.Bounds().Dx())
+			assert.Equal(t, s.expH, thumb.Bounds().Dy())
+		})
+	}
+}
+
+func TestGenerate_NotImage(t *testing.T) {
+	t.Parallel()
+
+	_, err := Generate([]byte("definitely not an image"), 64)
+	assert.ErrorIs(t, err, ErrDecode)
+}
@@ -25,9 +25,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/v1/orders": {
+        "/v1/admin/audit": {
             "get": {
-                "description": "Список заказов",
+                "description": "Получение записей журнала изменений пользователей, заказов и объявлений, начиная с самых новых. Доступно администраторам",
                 "consumes": [
                     "application/json"
                 ],
@@ -35,16 +35,108 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "audit"
                 ],
-                "summary": "Список заказов",
+                "summary": "Журнал изменений",
+                "deprecated": true,
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Идентификатор пользователя",
+                        "description": "Идентификатор администратора",
                         "name": "X-User-Id",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "655d8a4d3afea534e56b570f",
+                        "description": "Автор изменений",
+                        "name": "actorID",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "655d8a4d3afea534e56b570e",
+                        "description": "Идентификатор сущности",
+                        "name": "entityID",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "user",
+                            "order",
+                            "listing",
+                            "payment"
+                        ],
+                        "type": "string",
+                        "example": "user",
+                        "x-enum-comments": {
+                            "AuditEntityListing": "Объявление",
+                            "AuditEntityOrder": "Заказ",
+                            "AuditEntityPayment": "Платеж",
+                            "AuditEntityUser": "Пользователь"
+                        },
+                        "x-enum-varnames": [
+                            "AuditEntityUser",
+                            "AuditEntityOrder",
+                            "AuditEntityListing",
+                            "AuditEntityPayment"
+                        ],
+                        "description": "Тип сущности",
+                        "name": "entityType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-01-01T00:00:00Z",
+                        "description": "Начало периода в RFC 3339",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "host/abcdef-000001",
+                        "description": "Идентификатор запроса",
+                        "name": "requestID",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-02-01T00:00:00Z",
+                        "description": "Конец периода в RFC 3339, не включается",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Количество элементов на странице",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Сортировка. asc - по возрастанию, desc - по убыванию",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Номер страницы. Используется для пагинации в mongo",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Состояние страницы, строка в base64. Используется для пагинации в кассандре",
+                        "name": "page_state",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -61,7 +153,7 @@ const docTemplate = `{
                                         "items": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/entity.Order"
+                                                "$ref": "#/definitions/entity.AuditEntry"
                                             }
                                         }
                                     }
@@ -82,9 +174,144 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/admin/disputes": {
+            "get": {
+                "description": "Получение нерешенных споров, начиная с тех, срок рассмотрения которых наступит раньше. Доступно администраторам",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "disputes"
+                ],
+                "summary": "Очередь споров",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор администратора",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "655d8a4d3afea534e56b570e",
+                        "description": "Модератор, которому назначены споры",
+                        "name": "moderatorID",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "example": true,
+                        "description": "Только споры с просроченным сроком",
+                        "name": "overdue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "open",
+                            "in_review",
+                            "resolved",
+                            "rejected",
+                            "withdrawn"
+                        ],
+                        "type": "string",
+                        "example": "open",
+                        "x-enum-comments": {
+                            "DisputeStatusInReview": "Рассматривается модератором",
+                            "DisputeStatusOpen": "Ожидает модератора",
+                            "DisputeStatusRejected": "Отклонен модератором",
+                            "DisputeStatusResolved": "Решен в пользу автора",
+                            "DisputeStatusWithdrawn": "Отозван автором"
+                        },
+                        "x-enum-varnames": [
+                            "DisputeStatusOpen",
+                            "DisputeStatusInReview",
+                            "DisputeStatusResolved",
+                            "DisputeStatusRejected",
+                            "DisputeStatusWithdrawn"
+                        ],
+                        "description": "Статус споров. Без него возвращаются все нерешенные споры",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Количество элементов на странице",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Сортировка. asc - по возрастанию, desc - по убыванию",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Номер страницы. Используется для пагинации в mongo",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Состояние страницы, строка в base64. Используется для пагинации в кассандре",
+                        "name": "page_state",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.List"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.Dispute"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/admin/disputes/{id}/assign": {
             "post": {
-                "description": "Создание заказа",
+                "description": "Передача спора модератору. Без модератора в теле спор берет себе автор запроса. Доступно администраторам",
                 "consumes": [
                     "application/json"
                 ],
@@ -92,24 +319,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "disputes"
                 ],
-                "summary": "Создание заказа",
+                "summary": "Назначение модератора",
+                "deprecated": true,
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Идентификатор пользователя",
+                        "description": "Идентификатор администратора",
                         "name": "X-User-Id",
                         "in": "header",
                         "required": true
                     },
                     {
-                        "description": "Заказ",
-                        "name": "order",
+                        "type": "string",
+                        "description": "Идентификатор спора",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Модератор",
+                        "name": "assign",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/form.OrderCreate"
+                            "$ref": "#/definitions/form.DisputeAssign"
                         }
                     }
                 ],
@@ -117,7 +351,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.CreatedOrder"
+                            "$ref": "#/definitions/entity.Dispute"
                         }
                     },
                     "400": {
@@ -135,9 +369,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/orders/{orderID}": {
-            "get": {
-                "description": "Информация о заказе",
+        "/v1/admin/disputes/{id}/resolve": {
+            "post": {
+                "description": "Решение назначенного модератора. В пользу автора можно вернуть оплату заказа, списать кредиты второй стороны и оштрафовать ее репутацию",
                 "consumes": [
                     "application/json"
                 ],
@@ -145,30 +379,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "disputes"
                 ],
-                "summary": "Информация о заказе",
+                "summary": "Решение по спору",
+                "deprecated": true,
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Идентификатор пользователя",
+                        "description": "Идентификатор администратора",
                         "name": "X-User-Id",
                         "in": "header",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Идентификатор заказа",
+                        "description": "Идентификатор спора",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Решение",
+                        "name": "resolution",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.DisputeResolve"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entity.Order"
+                            "$ref": "#/definitions/entity.Dispute"
                         }
                     },
                     "400": {
@@ -186,9 +430,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users": {
+        "/v1/admin/moderation": {
             "get": {
-                "description": "Получение списка пользователей",
+                "description": "Получение дел модерации, начиная с тех, что ждут решения дольше. Без статуса возвращаются открытые дела. Доступно администраторам",
                 "consumes": [
                     "application/json"
                 ],
@@ -196,18 +440,96 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "moderation"
                 ],
-                "summary": "Получение списка пользователей",
+                "summary": "Очередь модерации",
+                "deprecated": true,
                 "parameters": [
                     {
-                        "maxLength": 255,
-                        "minLength": 3,
                         "type": "string",
-                        "description": "Имя пользователя",
-                        "name": "bio",
-                        "in": "query",
+                        "description": "Идентификатор администратора",
+                        "name": "X-User-Id",
+                        "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "example": true,
+                        "description": "Только дела с жалобами пользователей",
+                        "name": "reported",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "open",
+                            "approved",
+                            "rejected"
+                        ],
+                        "type": "string",
+                        "example": "open",
+                        "x-enum-comments": {
+                            "ModerationCaseStatusApproved": "Текст одобрен",
+                            "ModerationCaseStatusOpen": "Ожидает решения",
+                            "ModerationCaseStatusRejected": "Текст отклонен"
+                        },
+                        "x-enum-varnames": [
+                            "ModerationCaseStatusOpen",
+                            "ModerationCaseStatusApproved",
+                            "ModerationCaseStatusRejected"
+                        ],
+                        "description": "Статус дел. Без него возвращаются открытые дела",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "user",
+                            "listing"
+                        ],
+                        "type": "string",
+                        "example": "listing",
+                        "x-enum-comments": {
+                            "ModerationSubjectListing": "Объявление",
+                            "ModerationSubjectUser": "Профиль пользователя"
+                        },
+                        "x-enum-varnames": [
+                            "ModerationSubjectUser",
+                            "ModerationSubjectListing"
+                        ],
+                        "description": "Тип сущности",
+                        "name": "subjectType",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Количество элементов на странице",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Сортировка. asc - по возрастанию, desc - по убыванию",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Номер страницы. Используется для пагинации в mongo",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Состояние страницы, строка в base64. Используется для пагинации в кассандре",
+                        "name": "page_state",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -224,7 +546,7 @@ const docTemplate = `{
                                         "items": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/entity.User"
+                                                "$ref": "#/definitions/entity.ModerationCase"
                                             }
                                         }
                                     }
@@ -245,9 +567,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/admin/moderation/{id}/decision": {
             "post": {
-                "description": "Создание пользователя",
+                "description": "Одобрение текста, его скрытие или скрытие с блокировкой автора. Блокировка скрывает профиль и все объявления автора. Доступно администраторам",
                 "consumes": [
                     "application/json"
                 ],
@@ -255,17 +579,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "moderation"
                 ],
-                "summary": "Создание пользователя",
+                "summary": "Решение модератора",
+                "deprecated": true,
                 "parameters": [
                     {
-                        "description": "Пользователь",
-                        "name": "user",
+                        "type": "string",
+                        "description": "Идентификатор администратора",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор дела",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Решение",
+                        "name": "decision",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/form.UserCreate"
+                            "$ref": "#/definitions/form.ModerationDecide"
                         }
                     }
                 ],
@@ -273,7 +612,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/entity.ModerationCase"
                         }
                     },
                     "400": {
@@ -282,6 +621,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.HTTPResponse400"
                         }
                     },
+                    "404": {
+                        "description": "Дело не найдено",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse404"
+                        }
+                    },
                     "500": {
                         "description": "Внутренняя ошибка сервера",
                         "schema": {
@@ -291,9 +636,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/{id}": {
-            "get": {
-                "description": "Получение пользователя по идентификатору",
+        "/v1/admin/wallet/grants": {
+            "post": {
+                "description": "Начисление кредитов пользователю администратором. Отрицательная сумма списывает кредиты",
                 "consumes": [
                     "application/json"
                 ],
@@ -301,23 +646,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "wallet"
                 ],
-                "summary": "Получение пользователя по идентификатору",
+                "summary": "Начисление кредитов",
+                "deprecated": true,
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Идентификатор пользователя",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Идентификатор администратора",
+                        "name": "X-User-Id",
+                        "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "Начисление",
+                        "name": "grant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.WalletGrant"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/entity.User"
+                            "$ref": "#/definitions/entity.LedgerTransaction"
                         }
                     },
                     "400": {
@@ -334,117 +689,9740 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "entity.List": {
-            "type": "object",
-            "properties": {
-                "count": {
-                    "description": "Количество сущностей",
-                    "type": "integer"
-                },
-                "items": {
-                    "description": "Список сущностей"
-                },
-                "state": {
-                    "description": "Состояние пагинации",
-                    "type": "string"
-                }
-            }
-        },
-        "entity.Order": {
-            "type": "object",
-            "properties": {
-                "cost": {
-                    "description": "Стоимость заказа",
-                    "type": "integer"
-                },
-                "createdAt": {
-                    "description": "Дата создания заказа",
-                    "type": "string"
-                },
-                "id": {
-                    "description": "Идентификатор заказа",
-                    "type": "string"
-                },
-                "userID": {
-                    "description": "Идентификатор пользователя",
-                    "type": "string"
-                }
-            }
         },
-        "entity.User": {
-            "type": "object",
-            "properties": {
-                "bio": {
-                    "description": "Биография пользователя",
-                    "type": "string"
+        "/v1/conversations": {
+            "get": {
+                "description": "Получение переписок пользователя. Переписки с недавними сообщениями идут первыми",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "conversations"
+                ],
+                "summary": "Получение списка переписок",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Количество элементов на странице",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Сортировка. asc - по возрастанию, desc - по убыванию",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Номер страницы. Используется для пагинации в mongo",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Состояние страницы, строка в base64. Используется для пагинации в кассандре",
+                        "name": "page_state",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.List"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.Conversation"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Открытие переписки по заказу или предложению обмена. Если переписка уже есть, возвращается она",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "conversations"
+                ],
+                "summary": "Открытие переписки",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Сделка",
+                        "name": "conversation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.ConversationCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Conversation"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/conversations/{id}": {
+            "get": {
+                "description": "Получение переписки по идентификатору. Доступно только участникам",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "conversations"
+                ],
+                "summary": "Получение переписки",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор переписки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Conversation"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/conversations/{id}/messages": {
+            "get": {
+                "description": "Получение сообщений, начиная с самых новых. Для следующей страницы передайте state из ответа в page_state",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "conversations"
+                ],
+                "summary": "Получение истории сообщений",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор переписки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Количество элементов на странице",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Сортировка. asc - по возрастанию, desc - по убыванию",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Номер страницы. Используется для пагинации в mongo",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Состояние страницы, строка в base64. Используется для пагинации в кассандре",
+                        "name": "page_state",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.List"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.Message"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Отправка сообщения с текстом и вложениями. Остальные участники получают уведомление",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "conversations"
+                ],
+                "summary": "Отправка сообщения",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор переписки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Сообщение",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.MessageCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Message"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/conversations/{id}/read": {
+            "post": {
+                "description": "Отметка всех сообщений переписки прочитанными пользователем",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "conversations"
+                ],
+                "summary": "Отметка о прочтении",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор переписки",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/cycles": {
+            "get": {
+                "description": "Получение циклов обмена, в которых участвует пользователь",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cycles"
+                ],
+                "summary": "Получение списка циклов обмена",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "proposed",
+                            "accepted",
+                            "declined",
+                            "expired"
+                        ],
+                        "type": "string",
+                        "example": "proposed",
+                        "description": "Статус цикла",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Количество элементов на странице",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Сортировка. asc - по возрастанию, desc - по убыванию",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Номер страницы. Используется для пагинации в mongo",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Состояние страницы, строка в base64. Используется для пагинации в кассандре",
+                        "name": "page_state",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.List"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.TradeCycle"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/cycles/{id}": {
+            "get": {
+                "description": "Получение цикла обмена по идентификатору. Доступно только участникам",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cycles"
+                ],
+                "summary": "Получение цикла обмена по идентификатору",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор цикла",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TradeCycle"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/cycles/{id}/accept": {
+            "post": {
+                "description": "Согласие участника. Когда согласны все, объявления цикла резервируются",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cycles"
+                ],
+                "summary": "Согласие с циклом обмена",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор цикла",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TradeCycle"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/cycles/{id}/decline": {
+            "post": {
+                "description": "Отказ участника от цикла обмена",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cycles"
+                ],
+                "summary": "Отказ от цикла обмена",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор цикла",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TradeCycle"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/disputes": {
+            "get": {
+                "description": "Получение споров, которые пользователь открыл или которые открыты против него, начиная с самых новых",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "disputes"
+                ],
+                "summary": "Получение списка споров",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Количество элементов на странице",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Сортировка. asc - по возрастанию, desc - по убыванию",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Номер страницы. Используется для пагинации в mongo",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Состояние страницы, строка в base64. Используется для пагинации в кассандре",
+                        "name": "page_state",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.List"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.Dispute"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Открытие спора участником заказа против второй стороны. По заказу открывается только один спор",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "disputes"
+                ],
+                "summary": "Открытие спора",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Спор",
+                        "name": "dispute",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.DisputeCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Dispute"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/disputes/{id}": {
+            "get": {
+                "description": "Получение спора с доказательствами и решением. Доступно сторонам спора и модераторам",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "disputes"
+                ],
+                "summary": "Получение спора",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор спора",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Dispute"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "404": {
+                        "description": "Спор не найден",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse404"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/disputes/{id}/evidence": {
+            "post": {
+                "description": "Добавление к спору медиафайла, заранее загруженного стороной спора. Файл прикрепляется к спору и больше не может быть удален",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "disputes"
+                ],
+                "summary": "Добавление доказательства",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор спора",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Доказательство",
+                        "name": "evidence",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.DisputeEvidenceAdd"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Dispute"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/disputes/{id}/messages": {
+            "get": {
+                "description": "Получение сообщений сторон и модератора, начиная с самых старых",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "disputes"
+                ],
+                "summary": "Получение переписки по спору",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор спора",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Количество элементов на странице",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Сортировка. asc - по возрастанию, desc - по убыванию",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Номер страницы. Используется для пагинации в mongo",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Состояние страницы, строка в base64. Используется для пагинации в кассандре",
+                        "name": "page_state",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.List"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.DisputeMessage"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Отправка сообщения стороной спора или модератором, пока спор не решен",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "disputes"
+                ],
+                "summary": "Отправка сообщения по спору",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор спора",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Сообщение",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.DisputeMessageCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.DisputeMessage"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/disputes/{id}/withdraw": {
+            "post": {
+                "description": "Отзыв спора автором до решения модератора",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "disputes"
+                ],
+                "summary": "Отзыв спора",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор спора",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Dispute"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/listings": {
+            "get": {
+                "description": "Получение списка объявлений",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "listings"
+                ],
+                "summary": "Получение списка объявлений",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "maxLength": 50,
+                        "type": "string",
+                        "example": "sports",
+                        "description": "Категория",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 100,
+                        "type": "string",
+                        "example": "Алматы",
+                        "description": "Город",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "655d8a4d3afea534e56b570e",
+                        "description": "Идентификатор владельца",
+                        "name": "ownerID",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "distance"
+                        ],
+                        "type": "string",
+                        "example": "distance",
+                        "description": "Поле сортировки: дата создания или расстояние до near",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "active",
+                            "reserved",
+                            "exchanged",
+                            "archived"
+                        ],
+                        "type": "string",
+                        "description": "Статус объявления",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 50,
+                        "type": "string",
+                        "example": "ноутбук",
+                        "description": "Желаемый в обмен тег",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "43.238,76.945",
+                        "description": "Точка в формате широта,долгота",
+                        "name": "near",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Радиус поиска от точки near в километрах, по умолчанию 10",
+                        "name": "radius_km",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Количество элементов на странице",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Сортировка. asc - по возрастанию, desc - по убыванию",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Номер страницы. Используется для пагинации в mongo",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Состояние страницы, строка в base64. Используется для пагинации в кассандре",
+                        "name": "page_state",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.List"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.Listing"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Создание объявления",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "listings"
+                ],
+                "summary": "Создание объявления",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Объявление",
+                        "name": "listing",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.ListingCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.CreatedListing"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/listings/{id}": {
+            "get": {
+                "description": "Получение объявления по идентификатору",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "listings"
+                ],
+                "summary": "Получение объявления по идентификатору",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор объявления",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Listing"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Обновление объявления. Доступно только владельцу",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "listings"
+                ],
+                "summary": "Обновление объявления",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор объявления",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Изменения объявления",
+                        "name": "listing",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.ListingUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Listing"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Удаление объявления. Доступно только владельцу",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "listings"
+                ],
+                "summary": "Удаление объявления",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор объявления",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/media": {
+            "get": {
+                "description": "Получение медиафайлов, прикрепленных к пользователю или объявлению, с подписанными ссылками",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Получение медиафайлов сущности",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "enum": [
+                            "user",
+                            "listing"
+                        ],
+                        "type": "string",
+                        "description": "Тип сущности",
+                        "name": "subjectType",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор сущности",
+                        "name": "subjectID",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.List"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.Media"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Загрузка изображения в формате JPEG, PNG или GIF. Тип определяется по содержимому файла, для изображения строится миниатюра",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Загрузка изображения",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Изображение",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Media"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/media/{id}": {
+            "get": {
+                "description": "Получение медиафайла с подписанными ссылками на файл и миниатюру",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Получение медиафайла",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор медиафайла",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Media"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Удаление медиафайла вместе с файлами в хранилище. Доступно только владельцу",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Удаление медиафайла",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор медиафайла",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/media/{id}/attach": {
+            "post": {
+                "description": "Прикрепление загруженного медиафайла к объявлению. Доступно только владельцу файла и объявления",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Прикрепление медиафайла",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор медиафайла",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Сущность, к которой прикрепляется файл",
+                        "name": "attach",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.MediaAttach"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Media"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/notifications": {
+            "get": {
+                "description": "Получение уведомлений пользователя, начиная с самых новых. Уведомления старше срока хранения удаляются",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Получение входящих уведомлений",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "listings",
+                            "messages",
+                            "account"
+                        ],
+                        "type": "string",
+                        "description": "Категория уведомлений",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Только непрочитанные",
+                        "name": "unread",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Количество элементов на странице",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Сортировка. asc - по возрастанию, desc - по убыванию",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Номер страницы. Используется для пагинации в mongo",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Состояние страницы, строка в base64. Используется для пагинации в кассандре",
+                        "name": "page_state",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.List"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.InboxNotification"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/notifications/read-all": {
+            "post": {
+                "description": "Отметка всех входящих уведомлений пользователя прочитанными",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Отметка о прочтении всех уведомлений",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/notifications/unread-count": {
+            "get": {
+                "description": "Получение количества непрочитанных уведомлений пользователя. Счетчик хранится в кэше, эндпоинт можно опрашивать",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Количество непрочитанных уведомлений",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.UnreadCount"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/notifications/{id}/read": {
+            "post": {
+                "description": "Отметка уведомления из входящих пользователя прочитанным. Повторная отметка не меняет дату прочтения",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Отметка о прочтении уведомления",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор уведомления",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/offers": {
+            "get": {
+                "description": "Получение входящих и исходящих предложений обмена пользователя",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "offers"
+                ],
+                "summary": "Получение списка предложений обмена",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "incoming",
+                            "outgoing"
+                        ],
+                        "type": "string",
+                        "example": "incoming",
+                        "description": "Роль пользователя в предложении",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "accepted",
+                            "rejected",
+                            "countered",
+                            "cancelled",
+                            "completed"
+                        ],
+                        "type": "string",
+                        "description": "Статус предложения",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Количество элементов на странице",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Сортировка. asc - по возрастанию, desc - по убыванию",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Номер страницы. Используется для пагинации в mongo",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Состояние страницы, строка в base64. Используется для пагинации в кассандре",
+                        "name": "page_state",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.List"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.TradeOffer"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Создание предложения обмена своих объявлений на объявления другого пользователя",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "offers"
+                ],
+                "summary": "Создание предложения обмена",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Предложение обмена",
+                        "name": "offer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.TradeOfferCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TradeOffer"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/offers/{id}": {
+            "get": {
+                "description": "Получение предложения обмена по идентификатору. Доступно только участникам",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "offers"
+                ],
+                "summary": "Получение предложения обмена по идентификатору",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор предложения",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TradeOffer"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/offers/{id}/accept": {
+            "post": {
+                "description": "Принятие предложения обмена получателем. Объявления обеих сторон резервируются",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "offers"
+                ],
+                "summary": "Принятие предложения обмена",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор предложения",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TradeOffer"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/offers/{id}/cancel": {
+            "post": {
+                "description": "Отзыв ожидающего предложения обмена автором или отмена принятого любым участником.\nОтмена принятого предложения возвращает объявления в статус active, отменяет заказ обмена и снимает блокировку доплаты",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "offers"
+                ],
+                "summary": "Отзыв предложения обмена",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор предложения",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TradeOffer"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/offers/{id}/complete": {
+            "post": {
+                "description": "Отметка принятого обмена состоявшимся любым участником. Объявления обеих сторон переходят в статус exchanged,\nзаказ обмена завершается, а заблокированная доплата списывается",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "offers"
+                ],
+                "summary": "Завершение обмена",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор предложения",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TradeOffer"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/offers/{id}/counter": {
+            "post": {
+                "description": "Создание встречного предложения получателем. Исходное предложение переходит в статус countered",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "offers"
+                ],
+                "summary": "Встречное предложение обмена",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор исходного предложения",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Встречное предложение",
+                        "name": "offer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.TradeOfferCounter"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TradeOffer"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/offers/{id}/reject": {
+            "post": {
+                "description": "Отклонение предложения обмена получателем",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "offers"
+                ],
+                "summary": "Отклонение предложения обмена",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор предложения",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.TradeOffer"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/orders": {
+            "get": {
+                "description": "Список заказов",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Список заказов",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.List"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.Order"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Создание заказа",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Создание заказа",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Заказ",
+                        "name": "order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.OrderCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.CreatedOrder"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/orders/{orderID}": {
+            "get": {
+                "description": "Информация о заказе",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Информация о заказе",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор заказа",
+                        "name": "orderID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "history"
+                        ],
+                        "type": "string",
+                        "description": "Связанные данные через запятую",
+                        "name": "expand",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Order"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/orders/{orderID}/history": {
+            "get": {
+                "description": "История изменения статуса заказа: кто, когда и почему изменил статус",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "История заказа",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор заказа",
+                        "name": "orderID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.List"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.OrderStatusChange"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/orders/{orderID}/payment": {
+            "get": {
+                "description": "Получение платежа по заказу вместе с возвратами. Доступно участникам заказа",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "Получение платежа по заказу",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор заказа",
+                        "name": "orderID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Payment"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Блокировка стоимости заказа на счете покупателя. Деньги списываются при подтверждении заказа\nи возвращаются при отмене. Подтвержденный заказ оплачивается сразу",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "Оплата заказа",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор покупателя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор заказа",
+                        "name": "orderID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Payment"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/orders/{orderID}/status": {
+            "put": {
+                "description": "Изменение статуса заказа. Каждый переход записывается в историю заказа.\nПодтверждение списывает оплату заказа, отмена снимает блокировку или возвращает оплату целиком либо сумму refund",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Изменение статуса заказа",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор заказа",
+                        "name": "orderID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новый статус",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.OrderStatusUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Order"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payments/webhook": {
+            "post": {
+                "description": "Изменение платежа на стороне провайдера. Тело подписывается HMAC-SHA256 вместе со временем отправки:\nX-Payment-Signature: t=\u003cunix\u003e,v1=\u003chex\u003e. Устаревшие и повторные уведомления не применяются",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "Уведомление платежного провайдера",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Подпись уведомления",
+                        "name": "X-Payment-Signature",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Уведомление",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/entity.PaymentWebhookEvent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/ratings": {
+            "get": {
+                "description": "Получение оценок, которые пользователь получил по завершенным заказам",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ratings"
+                ],
+                "summary": "Получение оценок пользователя",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор оцененного пользователя",
+                        "name": "userID",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Количество элементов на странице",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Сортировка. asc - по возрастанию, desc - по убыванию",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Номер страницы. Используется для пагинации в mongo",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Состояние страницы, строка в base64. Используется для пагинации в кассандре",
+                        "name": "page_state",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.List"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.Rating"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Оценка второй стороны завершенного заказа. Каждая сторона оценивает заказ один раз",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ratings"
+                ],
+                "summary": "Оценка сделки",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Оценка",
+                        "name": "rating",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.RatingCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Rating"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/reports": {
+            "post": {
+                "description": "Жалоба на профиль или объявление. Текст попадает в очередь модерации и остается виден до решения модератора",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "moderation"
+                ],
+                "summary": "Жалоба на текст",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Жалоба",
+                        "name": "report",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.ModerationReportCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.ModerationCase"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "404": {
+                        "description": "Профиль или объявление не найдены",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse404"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/search": {
+            "get": {
+                "description": "Поиск пользователей и доступных для обмена объявлений с учетом словоформ на русском и английском.\nРезультаты отсортированы по релевантности, найденные слова выделены тегом em.\nФасеты считаются без учета фильтров kind и category. Пролистать можно первые 1000 результатов",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "search"
+                ],
+                "summary": "Полнотекстовый поиск",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Поисковый запрос",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "user",
+                            "listing"
+                        ],
+                        "type": "string",
+                        "description": "Тип документов",
+                        "name": "kind",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Категория объявлений",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "43.238,76.945",
+                        "description": "Только документы рядом с точкой в формате широта,долгота",
+                        "name": "near",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Радиус поиска от точки near в километрах, по умолчанию 10",
+                        "name": "radius_km",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Количество элементов на странице",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Сортировка. asc - по возрастанию, desc - по убыванию",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Номер страницы. Используется для пагинации в mongo",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Состояние страницы, строка в base64. Используется для пагинации в кассандре",
+                        "name": "page_state",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.SearchResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users": {
+            "get": {
+                "description": "Получение списка пользователей",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Получение списка пользователей",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "maxLength": 255,
+                        "minLength": 3,
+                        "type": "string",
+                        "description": "Имя пользователя",
+                        "name": "bio",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.List"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.User"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Создание пользователя",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Создание пользователя",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "description": "Пользователь",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.UserCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}": {
+            "get": {
+                "description": "Получение пользователя по идентификатору",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Получение пользователя по идентификатору",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}/avatar": {
+            "get": {
+                "description": "Перенаправление на подписанную ссылку на аватар пользователя. С size=thumb — на миниатюру",
+                "tags": [
+                    "media"
+                ],
+                "summary": "Получение аватара",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "thumb"
+                        ],
+                        "type": "string",
+                        "description": "Размер аватара",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Found"
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Установка загруженного пользователем медиафайла аватаром. Прежний аватар удаляется. Доступно только самому пользователю",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Установка аватара",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Медиафайл аватара",
+                        "name": "avatar",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.AvatarSet"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Media"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Удаление аватара пользователя вместе с файлами в хранилище. Доступно только самому пользователю",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Удаление аватара",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}/notifications/devices": {
+            "post": {
+                "description": "Регистрация токена устройства для push-уведомлений. Повторная регистрация токена обновляет его владельца",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Регистрация устройства",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Устройство",
+                        "name": "device",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.DeviceRegister"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.DeviceToken"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}/notifications/devices/{token}": {
+            "delete": {
+                "description": "Удаление токена устройства. На устройство перестанут приходить push-уведомления",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Удаление устройства",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Токен устройства",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}/notifications/preferences": {
+            "get": {
+                "description": "Получение каналов доставки по типам уведомлений и адреса для писем. Доступно только самому пользователю",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Получение настроек уведомлений",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.NotificationPreferences"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Сохранение каналов доставки по типам уведомлений и адреса для писем. Настройки заменяются целиком, для типов без настройки используются все каналы",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Сохранение настроек уведомлений",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Настройки уведомлений",
+                        "name": "preferences",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.NotificationPreferencesUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.NotificationPreferences"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}/wishlist": {
+            "get": {
+                "description": "Получение списка желаний пользователя. Доступно только владельцу",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist"
+                ],
+                "summary": "Получение списка желаний",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Количество элементов на странице",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Сортировка. asc - по возрастанию, desc - по убыванию",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Номер страницы. Используется для пагинации в mongo",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Состояние страницы, строка в base64. Используется для пагинации в кассандре",
+                        "name": "page_state",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.List"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.WishlistItem"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Добавление позиции в список желаний. О новых подходящих объявлениях пользователь получит уведомление",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist"
+                ],
+                "summary": "Добавление позиции в список желаний",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Позиция списка желаний",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.WishlistItemCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.WishlistItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{id}/wishlist/{itemID}": {
+            "delete": {
+                "description": "Удаление позиции из списка желаний. Доступно только владельцу",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wishlist"
+                ],
+                "summary": "Удаление позиции из списка желаний",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор позиции",
+                        "name": "itemID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/wallet": {
+            "get": {
+                "description": "Получение баланса кредитов пользователя. До первой проводки баланс нулевой",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wallet"
+                ],
+                "summary": "Получение баланса кредитов",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.LedgerAccount"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/wallet/statement": {
+            "get": {
+                "description": "Получение проводок по счету пользователя, начиная с самых новых, с балансом после каждой проводки",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wallet"
+                ],
+                "summary": "Получение выписки по кредитам",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Количество элементов на странице",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Сортировка. asc - по возрастанию, desc - по убыванию",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Номер страницы. Используется для пагинации в mongo",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Состояние страницы, строка в base64. Используется для пагинации в кассандре",
+                        "name": "page_state",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/entity.List"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/entity.LedgerPosting"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/wallet/transfers": {
+            "post": {
+                "description": "Перевод кредитов другому пользователю, например доплата за неравноценный обмен. Баланс отправителя не может стать отрицательным",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "wallet"
+                ],
+                "summary": "Перевод кредитов",
+                "deprecated": true,
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Перевод",
+                        "name": "transfer",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.WalletTransfer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/entity.LedgerTransaction"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse400"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HTTPResponse500"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/admin/orders/export": {
+            "get": {
+                "description": "Выгрузка заказов по фильтру в порядке создания в CSV или NDJSON без истории статусов. Выгрузка отдается по мере чтения из базы.\nКолонки CSV: id, userID, counterpartyID, amount, currency, status, createdAt. Файл выгрузки можно импортировать обратно. Доступно администраторам",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "bulk"
+                ],
+                "summary": "Экспорт заказов",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор администратора",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Формат выгрузки, по умолчанию ndjson",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-01-01T00:00:00Z",
+                        "description": "Начало периода создания в RFC 3339",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created",
+                            "confirmed",
+                            "completed",
+                            "cancelled"
+                        ],
+                        "type": "string",
+                        "example": "confirmed",
+                        "x-enum-comments": {
+                            "OrderStatusCancelled": "Заказ отменен",
+                            "OrderStatusCompleted": "Заказ завершен",
+                            "OrderStatusConfirmed": "Заказ подтвержден",
+                            "OrderStatusCreated": "Заказ создан"
+                        },
+                        "x-enum-varnames": [
+                            "OrderStatusCreated",
+                            "OrderStatusConfirmed",
+                            "OrderStatusCompleted",
+                            "OrderStatusCancelled"
+                        ],
+                        "description": "Статус заказа",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-02-01T00:00:00Z",
+                        "description": "Конец периода создания в RFC 3339, не включается",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "655d8a4d3afea534e56b570f",
+                        "description": "Покупатель",
+                        "name": "userID",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Строка выгрузки",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.Order"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/admin/orders/import": {
+            "post": {
+                "description": "Импорт заказов из CSV (колонки userID, counterpartyID, amount, currency) или NDJSON (объекты form.OrderImport).\nФормат задается заголовком Content-Type. Каждая строка проверяется как при создании заказа, строки сохраняются пачками.\nОтчет отдается в NDJSON по мере сохранения пачек: результат каждой строки, в последней строке итог запуска.\nИдентификатор запуска возвращается в заголовке X-Import-Run. Прерванный импорт продолжается повторной отправкой того же файла с параметром run. Доступно администраторам",
+                "consumes": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "produces": [
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "bulk"
+                ],
+                "summary": "Импорт заказов",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор администратора",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор прерванного запуска",
+                        "name": "run",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Строки CSV с заголовком или NDJSON",
+                        "name": "rows",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Строка отчета",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ImportReport"
+                        },
+                        "headers": {
+                            "X-Import-Run": {
+                                "type": "string",
+                                "description": "Идентификатор запуска импорта"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Запуск импорта не найден",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/admin/users/export": {
+            "get": {
+                "description": "Выгрузка пользователей по фильтру в порядке создания в CSV или NDJSON. Выгрузка отдается по мере чтения из базы.\nКолонки CSV: id, name, bio, city, lat, lon, moderation, createdAt. Файл выгрузки можно импортировать обратно. Доступно администраторам",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "bulk"
+                ],
+                "summary": "Экспорт пользователей",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор администратора",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "ndjson"
+                        ],
+                        "type": "string",
+                        "description": "Формат выгрузки, по умолчанию ndjson",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 100,
+                        "type": "string",
+                        "example": "Алматы",
+                        "description": "Город",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-01-01T00:00:00Z",
+                        "description": "Начало периода создания в RFC 3339",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-02-01T00:00:00Z",
+                        "description": "Конец периода создания в RFC 3339, не включается",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Строка выгрузки",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/admin/users/import": {
+            "post": {
+                "description": "Импорт пользователей из CSV (колонки name, bio, city, lat, lon) или NDJSON (объекты form.UserCreate).\nФормат задается заголовком Content-Type. Каждая строка проверяется как при создании пользователя, строки сохраняются пачками.\nОтчет отдается в NDJSON по мере сохранения пачек: результат каждой строки, в последней строке итог запуска.\nИдентификатор запуска возвращается в заголовке X-Import-Run. Прерванный импорт продолжается повторной отправкой того же файла с параметром run. Доступно администраторам",
+                "consumes": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "produces": [
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "bulk"
+                ],
+                "summary": "Импорт пользователей",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор администратора",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор прерванного запуска",
+                        "name": "run",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Строки CSV с заголовком или NDJSON",
+                        "name": "rows",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Строка отчета",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ImportReport"
+                        },
+                        "headers": {
+                            "X-Import-Run": {
+                                "type": "string",
+                                "description": "Идентификатор запуска импорта"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Запуск импорта не найден",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/listings": {
+            "get": {
+                "description": "Получение списка объявлений",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "listings"
+                ],
+                "summary": "Получение списка объявлений",
+                "parameters": [
+                    {
+                        "maxLength": 50,
+                        "type": "string",
+                        "example": "sports",
+                        "description": "Категория",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 100,
+                        "type": "string",
+                        "example": "Алматы",
+                        "description": "Город",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "655d8a4d3afea534e56b570e",
+                        "description": "Идентификатор владельца",
+                        "name": "ownerID",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at",
+                            "distance"
+                        ],
+                        "type": "string",
+                        "example": "distance",
+                        "description": "Поле сортировки: дата создания или расстояние до near",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "active",
+                            "reserved",
+                            "exchanged",
+                            "archived"
+                        ],
+                        "type": "string",
+                        "description": "Статус объявления",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 50,
+                        "type": "string",
+                        "example": "ноутбук",
+                        "description": "Желаемый в обмен тег",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "43.238,76.945",
+                        "description": "Точка в формате широта,долгота",
+                        "name": "near",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Радиус поиска от точки near в километрах, по умолчанию 10",
+                        "name": "radius_km",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Количество элементов на странице",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Сортировка. asc - по возрастанию, desc - по убыванию",
+                        "name": "order_by",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Номер страницы. Используется для пагинации в mongo",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Состояние страницы, строка в base64. Используется для пагинации в кассандре",
+                        "name": "page_state",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.Listing"
+                                            }
+                                        },
+                                        "meta": {
+                                            "$ref": "#/definitions/presenter.Meta"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Создание объявления. Ссылка на объявление возвращается в заголовке Location",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "listings"
+                ],
+                "summary": "Создание объявления",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Объявление",
+                        "name": "listing",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.ListingCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/presenter.Resource"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/listings/{id}": {
+            "get": {
+                "description": "Получение объявления по идентификатору",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "listings"
+                ],
+                "summary": "Получение объявления по идентификатору",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор объявления",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/presenter.Listing"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Объявление не найдено",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Обновление объявления. Доступно только владельцу",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "listings"
+                ],
+                "summary": "Обновление объявления",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор объявления",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Изменения объявления",
+                        "name": "listing",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.ListingUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/presenter.Listing"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Объявление не найдено",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Удаление объявления. Доступно только владельцу",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "listings"
+                ],
+                "summary": "Удаление объявления",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор объявления",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/presenter.Resource"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Объявление не найдено",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/orders": {
+            "get": {
+                "description": "Список заказов",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Список заказов",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.Order"
+                                            }
+                                        },
+                                        "meta": {
+                                            "$ref": "#/definitions/presenter.Meta"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Создание заказа. Ссылка на заказ возвращается в заголовке Location",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Создание заказа",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Заказ",
+                        "name": "order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.OrderCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/presenter.Resource"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/orders/{orderID}": {
+            "get": {
+                "description": "Информация о заказе",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Информация о заказе",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор заказа",
+                        "name": "orderID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "history"
+                        ],
+                        "type": "string",
+                        "description": "Связанные данные через запятую",
+                        "name": "expand",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/presenter.Order"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Заказ не найден",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/orders/{orderID}/history": {
+            "get": {
+                "description": "История изменения статуса заказа: кто, когда и почему изменил статус",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "История заказа",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор заказа",
+                        "name": "orderID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.OrderStatusChange"
+                                            }
+                                        },
+                                        "meta": {
+                                            "$ref": "#/definitions/presenter.Meta"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Заказ не найден",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/orders/{orderID}/status": {
+            "put": {
+                "description": "Изменение статуса заказа. Каждый переход записывается в историю заказа.\nПодтверждение списывает оплату заказа, отмена снимает блокировку или возвращает оплату целиком либо сумму refund",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Изменение статуса заказа",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "X-User-Id",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Идентификатор заказа",
+                        "name": "orderID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новый статус",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.OrderStatusUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/presenter.Order"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Заказ не найден",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/users": {
+            "get": {
+                "description": "Получение списка пользователей",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Получение списка пользователей",
+                "parameters": [
+                    {
+                        "maxLength": 255,
+                        "minLength": 3,
+                        "type": "string",
+                        "description": "Имя пользователя",
+                        "name": "bio",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.User"
+                                            }
+                                        },
+                                        "meta": {
+                                            "$ref": "#/definitions/presenter.Meta"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Создание пользователя. Ссылка на пользователя возвращается в заголовке Location",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Создание пользователя",
+                "parameters": [
+                    {
+                        "description": "Пользователь",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/form.UserCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/presenter.Resource"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/users/{id}": {
+            "get": {
+                "description": "Получение пользователя по идентификатору",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Получение пользователя по идентификатору",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Идентификатор пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/presenter.User"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Код ошибки",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/presenter.Envelope"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "errors": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/presenter.EnvelopeError"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "entity.Attachment": {
+            "type": "object",
+            "properties": {
+                "contentType": {
+                    "description": "MIME-тип файла",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Имя файла",
+                    "type": "string"
+                },
+                "size": {
+                    "description": "Размер файла в байтах",
+                    "type": "integer"
+                },
+                "url": {
+                    "description": "Ссылка на файл",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.AuditAction": {
+            "type": "string",
+            "enum": [
+                "create",
+                "update",
+                "delete"
+            ],
+            "x-enum-comments": {
+                "AuditActionCreate": "Создание",
+                "AuditActionDelete": "Удаление",
+                "AuditActionUpdate": "Изменение"
+            },
+            "x-enum-varnames": [
+                "AuditActionCreate",
+                "AuditActionUpdate",
+                "AuditActionDelete"
+            ]
+        },
+        "entity.AuditChange": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "description": "Поле сущности в JSON-представлении",
+                    "type": "string"
+                },
+                "new": {
+                    "description": "Значение после изменения",
+                    "type": "object"
+                },
+                "old": {
+                    "description": "Значение до изменения",
+                    "type": "object"
+                }
+            }
+        },
+        "entity.AuditEntityType": {
+            "type": "string",
+            "enum": [
+                "user",
+                "order",
+                "listing",
+                "payment"
+            ],
+            "x-enum-comments": {
+                "AuditEntityListing": "Объявление",
+                "AuditEntityOrder": "Заказ",
+                "AuditEntityPayment": "Платеж",
+                "AuditEntityUser": "Пользователь"
+            },
+            "x-enum-varnames": [
+                "AuditEntityUser",
+                "AuditEntityOrder",
+                "AuditEntityListing",
+                "AuditEntityPayment"
+            ]
+        },
+        "entity.AuditEntry": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Действие",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.AuditAction"
+                        }
+                    ]
+                },
+                "actorID": {
+                    "description": "Автор изменения",
+                    "type": "string"
+                },
+                "changes": {
+                    "description": "Измененные поля",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.AuditChange"
+                    }
+                },
+                "createdAt": {
+                    "description": "Дата изменения",
+                    "type": "string"
+                },
+                "entityID": {
+                    "description": "Идентификатор сущности",
+                    "type": "string"
+                },
+                "entityType": {
+                    "description": "Тип сущности",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.AuditEntityType"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "Идентификатор записи",
+                    "type": "string"
+                },
+                "requestID": {
+                    "description": "Идентификатор запроса или сообщения",
+                    "type": "string"
+                },
+                "source": {
+                    "description": "Источник изменения",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.AuditSource"
+                        }
+                    ]
+                }
+            }
+        },
+        "entity.AuditSource": {
+            "type": "string",
+            "enum": [
+                "http",
+                "kafka",
+                "system",
+                "cli"
+            ],
+            "x-enum-comments": {
+                "AuditSourceCLI": "Команды массового импорта",
+                "AuditSourceHTTP": "Запрос к HTTP API",
+                "AuditSourceKafka": "Сообщение из топика Kafka",
+                "AuditSourceSystem": "Фоновые процессы сервиса"
+            },
+            "x-enum-varnames": [
+                "AuditSourceHTTP",
+                "AuditSourceKafka",
+                "AuditSourceSystem",
+                "AuditSourceCLI"
+            ]
+        },
+        "entity.BulkKind": {
+            "type": "string",
+            "enum": [
+                "users",
+                "orders"
+            ],
+            "x-enum-comments": {
+                "BulkKindOrders": "Заказы",
+                "BulkKindUsers": "Пользователи"
+            },
+            "x-enum-varnames": [
+                "BulkKindUsers",
+                "BulkKindOrders"
+            ]
+        },
+        "entity.Conversation": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "description": "Дата создания",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Идентификатор переписки",
+                    "type": "string"
+                },
+                "lastMessageAt": {
+                    "description": "Дата последнего сообщения",
+                    "type": "string"
+                },
+                "participantIDs": {
+                    "description": "Участники переписки",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "readAt": {
+                    "description": "Когда участник последний раз прочитал переписку",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "subjectID": {
+                    "description": "Идентификатор сделки",
+                    "type": "string"
+                },
+                "subjectType": {
+                    "description": "Тип сделки",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ConversationSubject"
+                        }
+                    ]
+                },
+                "updatedAt": {
+                    "description": "Дата обновления",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.ConversationSubject": {
+            "type": "string",
+            "enum": [
+                "order",
+                "trade_offer"
+            ],
+            "x-enum-comments": {
+                "ConversationSubjectOrder": "Заказ",
+                "ConversationSubjectTradeOffer": "Предложение обмена"
+            },
+            "x-enum-varnames": [
+                "ConversationSubjectOrder",
+                "ConversationSubjectTradeOffer"
+            ]
+        },
+        "entity.Currency": {
+            "type": "string",
+            "enum": [
+                "KZT",
+                "RUB",
+                "USD",
+                "EUR",
+                "KZT"
+            ],
+            "x-enum-comments": {
+                "CurrencyEUR": "Евро",
+                "CurrencyKZT": "Казахстанский тенге",
+                "CurrencyRUB": "Российский рубль",
+                "CurrencyUSD": "Доллар США"
+            },
+            "x-enum-varnames": [
+                "CurrencyKZT",
+                "CurrencyRUB",
+                "CurrencyUSD",
+                "CurrencyEUR",
+                "LegacyCurrency"
+            ]
+        },
+        "entity.DevicePlatform": {
+            "type": "string",
+            "enum": [
+                "android",
+                "ios",
+                "web"
+            ],
+            "x-enum-comments": {
+                "DevicePlatformAndroid": "Android",
+                "DevicePlatformIOS": "iOS",
+                "DevicePlatformWeb": "Браузер"
+            },
+            "x-enum-varnames": [
+                "DevicePlatformAndroid",
+                "DevicePlatformIOS",
+                "DevicePlatformWeb"
+            ]
+        },
+        "entity.DeviceToken": {
+            "type": "object",
+            "properties": {
+                "platform": {
+                    "description": "Платформа устройства",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.DevicePlatform"
+                        }
+                    ]
+                },
+                "token": {
+                    "description": "Токен устройства",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "description": "Дата последней регистрации",
+                    "type": "string"
+                },
+                "userID": {
+                    "description": "Владелец устройства",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Dispute": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "description": "Дата создания",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Описание проблемы",
+                    "type": "string"
+                },
+                "dueAt": {
+                    "description": "Ближайший срок текущего этапа. У закрытого спора отсутствует",
+                    "type": "string"
+                },
+                "evidence": {
+                    "description": "Доказательства сторон",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.DisputeEvidence"
+                    }
+                },
+                "id": {
+                    "description": "Идентификатор спора",
+                    "type": "string"
+                },
+                "moderatorID": {
+                    "description": "Модератор, рассматривающий спор",
+                    "type": "string"
+                },
+                "openerID": {
+                    "description": "Автор спора",
+                    "type": "string"
+                },
+                "orderID": {
+                    "description": "Заказ, по которому открыт спор",
+                    "type": "string"
+                },
+                "overdue": {
+                    "description": "Просрочен ли срок текущего этапа",
+                    "type": "boolean"
+                },
+                "reason": {
+                    "description": "Причина спора",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.DisputeReason"
+                        }
+                    ]
+                },
+                "resolution": {
+                    "description": "Решение модератора",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.DisputeResolution"
+                        }
+                    ]
+                },
+                "resolutionDueAt": {
+                    "description": "Срок, до которого спор должен быть решен",
+                    "type": "string"
+                },
+                "respondentID": {
+                    "description": "Вторая сторона заказа",
+                    "type": "string"
+                },
+                "responseDueAt": {
+                    "description": "Срок, до которого модератор должен взять спор в работу",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Статус спора",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.DisputeStatus"
+                        }
+                    ]
+                },
+                "updatedAt": {
+                    "description": "Дата обновления",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.DisputeEvidence": {
+            "type": "object",
+            "properties": {
+                "authorID": {
+                    "description": "Кто приложил доказательство",
+                    "type": "string"
+                },
+                "comment": {
+                    "description": "Пояснение",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "description": "Дата добавления",
+                    "type": "string"
+                },
+                "mediaID": {
+                    "description": "Идентификатор медиафайла",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.DisputeMessage": {
+            "type": "object",
+            "properties": {
+                "authorID": {
+                    "description": "Автор сообщения",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "description": "Дата отправки",
+                    "type": "string"
+                },
+                "disputeID": {
+                    "description": "Идентификатор спора",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Идентификатор сообщения",
+                    "type": "string"
+                },
+                "moderator": {
+                    "description": "Написано ли сообщение модератором",
+                    "type": "boolean"
+                },
+                "text": {
+                    "description": "Текст сообщения",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.DisputeReason": {
+            "type": "string",
+            "enum": [
+                "not_as_described",
+                "no_show",
+                "damaged",
+                "other"
+            ],
+            "x-enum-comments": {
+                "DisputeReasonDamaged": "Предмет поврежден",
+                "DisputeReasonNoShow": "Вторая сторона не пришла на обмен",
+                "DisputeReasonNotAsDescribed": "Предмет не соответствует описанию",
+                "DisputeReasonOther": "Другая причина"
+            },
+            "x-enum-varnames": [
+                "DisputeReasonNotAsDescribed",
+                "DisputeReasonNoShow",
+                "DisputeReasonDamaged",
+                "DisputeReasonOther"
+            ]
+        },
+        "entity.DisputeResolution": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "Обоснование решения",
+                    "type": "string"
+                },
+                "creditReversal": {
+                    "description": "Кредиты, списанные со второй стороны в пользу автора",
+                    "type": "integer"
+                },
+                "moderatorID": {
+                    "description": "Модератор, принявший решение",
+                    "type": "string"
+                },
+                "refund": {
+                    "description": "Возврат оплаты заказа автору",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                },
+                "reputationPenalty": {
+                    "description": "Штраф к репутации второй стороны",
+                    "type": "integer"
+                },
+                "resolvedAt": {
+                    "description": "Дата решения",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.DisputeStatus": {
+            "type": "string",
+            "enum": [
+                "open",
+                "in_review",
+                "resolved",
+                "rejected",
+                "withdrawn"
+            ],
+            "x-enum-comments": {
+                "DisputeStatusInReview": "Рассматривается модератором",
+                "DisputeStatusOpen": "Ожидает модератора",
+                "DisputeStatusRejected": "Отклонен модератором",
+                "DisputeStatusResolved": "Решен в пользу автора",
+                "DisputeStatusWithdrawn": "Отозван автором"
+            },
+            "x-enum-varnames": [
+                "DisputeStatusOpen",
+                "DisputeStatusInReview",
+                "DisputeStatusResolved",
+                "DisputeStatusRejected",
+                "DisputeStatusWithdrawn"
+            ]
+        },
+        "entity.GeoPoint": {
+            "type": "object",
+            "properties": {
+                "lat": {
+                    "description": "Широта",
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90,
+                    "example": 43.238949
+                },
+                "lon": {
+                    "description": "Долгота",
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180,
+                    "example": 76.889709
+                }
+            }
+        },
+        "entity.ImportRowStatus": {
+            "type": "string",
+            "enum": [
+                "created",
+                "exists",
+                "failed"
+            ],
+            "x-enum-comments": {
+                "ImportRowCreated": "Сущность создана",
+                "ImportRowExists": "Сущность уже создана прерванной попыткой",
+                "ImportRowFailed": "Строка не прошла разбор, проверку или сохранение"
+            },
+            "x-enum-varnames": [
+                "ImportRowCreated",
+                "ImportRowExists",
+                "ImportRowFailed"
+            ]
+        },
+        "entity.ImportRunStatus": {
+            "type": "string",
+            "enum": [
+                "running",
+                "completed"
+            ],
+            "x-enum-comments": {
+                "ImportRunStatusCompleted": "Прочитаны все строки",
+                "ImportRunStatusRunning": "Строки еще читаются или запуск прерван"
+            },
+            "x-enum-varnames": [
+                "ImportRunStatusRunning",
+                "ImportRunStatusCompleted"
+            ]
+        },
+        "entity.InboxNotification": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "description": "Текст",
+                    "type": "string"
+                },
+                "category": {
+                    "description": "Категория уведомления",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.NotificationCategory"
+                        }
+                    ]
+                },
+                "createdAt": {
+                    "description": "Дата создания",
+                    "type": "string"
+                },
+                "data": {
+                    "description": "Данные для перехода в приложении",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "description": "Идентификатор уведомления",
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "Тип уведомления",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.NotificationKind"
+                        }
+                    ]
+                },
+                "readAt": {
+                    "description": "Дата прочтения",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "Заголовок",
+                    "type": "string"
+                },
+                "userID": {
+                    "description": "Идентификатор получателя",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.LedgerAccount": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "description": "Баланс в кредитах",
+                    "type": "integer",
+                    "example": 1500
+                },
+                "id": {
+                    "description": "Идентификатор счета. Для пользователя совпадает с его идентификатором",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Тип счета",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.LedgerAccountType"
+                        }
+                    ]
+                },
+                "updatedAt": {
+                    "description": "Дата последней проводки",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.LedgerAccountType": {
+            "type": "string",
+            "enum": [
+                "user",
+                "system"
+            ],
+            "x-enum-comments": {
+                "LedgerAccountTypeSystem": "Системный счет",
+                "LedgerAccountTypeUser": "Счет пользователя"
+            },
+            "x-enum-varnames": [
+                "LedgerAccountTypeUser",
+                "LedgerAccountTypeSystem"
+            ]
+        },
+        "entity.LedgerPosting": {
+            "type": "object",
+            "properties": {
+                "accountID": {
+                    "description": "Идентификатор счета",
+                    "type": "string"
+                },
+                "amount": {
+                    "description": "Сумма. Положительная зачисляет, отрицательная списывает",
+                    "type": "integer",
+                    "example": -500
+                },
+                "balanceAfter": {
+                    "description": "Баланс счета после проводки",
+                    "type": "integer"
+                },
+                "comment": {
+                    "description": "Комментарий к транзакции",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "description": "Дата проводки",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Идентификатор проводки",
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "Тип транзакции",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.LedgerTransactionKind"
+                        }
+                    ]
+                },
+                "transactionID": {
+                    "description": "Идентификатор транзакции",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.LedgerTransaction": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "Комментарий",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "description": "Дата транзакции",
+                    "type": "string"
+                },
+                "createdBy": {
+                    "description": "Автор транзакции",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Идентификатор транзакции",
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "Тип транзакции",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.LedgerTransactionKind"
+                        }
+                    ]
+                },
+                "postings": {
+                    "description": "Проводки",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.LedgerPosting"
+                    }
+                }
+            }
+        },
+        "entity.LedgerTransactionKind": {
+            "type": "string",
+            "enum": [
+                "grant",
+                "transfer",
+                "dispute"
+            ],
+            "x-enum-comments": {
+                "LedgerTransactionKindDispute": "Списание по решению спора",
+                "LedgerTransactionKindGrant": "Начисление администратором",
+                "LedgerTransactionKindTransfer": "Перевод между пользователями"
+            },
+            "x-enum-varnames": [
+                "LedgerTransactionKindGrant",
+                "LedgerTransactionKindTransfer",
+                "LedgerTransactionKindDispute"
+            ]
+        },
+        "entity.List": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Количество сущностей",
+                    "type": "integer"
+                },
+                "items": {
+                    "description": "Список сущностей"
+                },
+                "state": {
+                    "description": "Состояние пагинации",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Listing": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Категория",
+                    "type": "string"
+                },
+                "city": {
+                    "description": "Город",
+                    "type": "string"
+                },
+                "condition": {
+                    "description": "Состояние предмета",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ListingCondition"
+                        }
+                    ]
+                },
+                "createdAt": {
+                    "description": "Дата создания",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Описание",
+                    "type": "string"
+                },
+                "desiredTags": {
+                    "description": "Что владелец хочет получить взамен",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "distanceKm": {
+                    "description": "Расстояние до точки поиска в километрах",
+                    "type": "number"
+                },
+                "id": {
+                    "description": "Идентификатор объявления",
+                    "type": "string"
+                },
+                "location": {
+                    "description": "Местоположение предмета",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.GeoPoint"
+                        }
+                    ]
+                },
+                "moderation": {
+                    "description": "Статус модерации текста",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ModerationStatus"
+                        }
+                    ]
+                },
+                "ownerID": {
+                    "description": "Идентификатор владельца",
+                    "type": "string"
+                },
+                "photos": {
+                    "description": "Ссылки на фотографии",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "description": "Статус объявления",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ListingStatus"
+                        }
+                    ]
+                },
+                "title": {
+                    "description": "Заголовок",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "description": "Дата обновления",
+                    "type": "string"
+                },
+                "valuation": {
+                    "description": "Оценка стоимости предмета владельцем",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                }
+            }
+        },
+        "entity.ListingCondition": {
+            "type": "string",
+            "enum": [
+                "new",
+                "like_new",
+                "good",
+                "fair",
+                "poor"
+            ],
+            "x-enum-comments": {
+                "ListingConditionFair": "Удовлетворительное",
+                "ListingConditionGood": "Хорошее",
+                "ListingConditionLikeNew": "Как новый",
+                "ListingConditionNew": "Новый",
+                "ListingConditionPoor": "Плохое"
+            },
+            "x-enum-varnames": [
+                "ListingConditionNew",
+                "ListingConditionLikeNew",
+                "ListingConditionGood",
+                "ListingConditionFair",
+                "ListingConditionPoor"
+            ]
+        },
+        "entity.ListingStatus": {
+            "type": "string",
+            "enum": [
+                "active",
+                "reserved",
+                "exchanged",
+                "archived"
+            ],
+            "x-enum-comments": {
+                "ListingStatusActive": "Объявление доступно для обмена",
+                "ListingStatusArchived": "Объявление снято владельцем",
+                "ListingStatusExchanged": "Обмен состоялся",
+                "ListingStatusReserved": "Объявление участвует в сделке"
+            },
+            "x-enum-varnames": [
+                "ListingStatusActive",
+                "ListingStatusReserved",
+                "ListingStatusExchanged",
+                "ListingStatusArchived"
+            ]
+        },
+        "entity.Media": {
+            "type": "object",
+            "properties": {
+                "contentType": {
+                    "description": "Тип файла",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "description": "Дата загрузки",
+                    "type": "string"
+                },
+                "height": {
+                    "description": "Высота изображения",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "Идентификатор файла",
+                    "type": "string"
+                },
+                "ownerID": {
+                    "description": "Пользователь, загрузивший файл",
+                    "type": "string"
+                },
+                "size": {
+                    "description": "Размер файла в байтах",
+                    "type": "integer"
+                },
+                "subjectID": {
+                    "description": "Идентификатор сущности",
+                    "type": "string"
+                },
+                "subjectType": {
+                    "description": "Тип сущности, к которой прикреплен файл",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.MediaSubject"
+                        }
+                    ]
+                },
+                "thumbnailUrl": {
+                    "description": "Подписанная ссылка на миниатюру",
+                    "type": "string"
+                },
+                "url": {
+                    "description": "Подписанная ссылка на файл",
+                    "type": "string"
+                },
+                "width": {
+                    "description": "Ширина изображения",
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.MediaSubject": {
+            "type": "string",
+            "enum": [
+                "user",
+                "listing",
+                "dispute"
+            ],
+            "x-enum-comments": {
+                "MediaSubjectDispute": "Доказательство по спору",
+                "MediaSubjectListing": "Фотография объявления",
+                "MediaSubjectUser": "Аватар пользователя"
+            },
+            "x-enum-varnames": [
+                "MediaSubjectUser",
+                "MediaSubjectListing",
+                "MediaSubjectDispute"
+            ]
+        },
+        "entity.Message": {
+            "type": "object",
+            "properties": {
+                "attachments": {
+                    "description": "Вложения",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.Attachment"
+                    }
+                },
+                "conversationID": {
+                    "description": "Идентификатор переписки",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "description": "Дата отправки",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Идентификатор сообщения",
+                    "type": "string"
+                },
+                "readBy": {
+                    "description": "Кто из получателей прочитал сообщение",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "senderID": {
+                    "description": "Идентификатор отправителя",
+                    "type": "string"
+                },
+                "text": {
+                    "description": "Текст сообщения",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.ModerationAction": {
+            "type": "string",
+            "enum": [
+                "approve",
+                "reject",
+                "ban"
+            ],
+            "x-enum-comments": {
+                "ModerationActionApprove": "Одобрить текст",
+                "ModerationActionBan": "Скрыть текст и заблокировать автора",
+                "ModerationActionReject": "Скрыть текст"
+            },
+            "x-enum-varnames": [
+                "ModerationActionApprove",
+                "ModerationActionReject",
+                "ModerationActionBan"
+            ]
+        },
+        "entity.ModerationCase": {
+            "type": "object",
+            "properties": {
+                "authorID": {
+                    "description": "Автор текста",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "description": "Дата создания",
+                    "type": "string"
+                },
+                "decision": {
+                    "description": "Решение модератора",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ModerationDecision"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "Идентификатор дела",
+                    "type": "string"
+                },
+                "reports": {
+                    "description": "Жалобы пользователей",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.ModerationReport"
+                    }
+                },
+                "status": {
+                    "description": "Статус дела",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ModerationCaseStatus"
+                        }
+                    ]
+                },
+                "subjectID": {
+                    "description": "Идентификатор сущности",
+                    "type": "string"
+                },
+                "subjectType": {
+                    "description": "Тип сущности",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ModerationSubjectType"
+                        }
+                    ]
+                },
+                "text": {
+                    "description": "Текст на момент последней проверки или жалобы",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "description": "Дата обновления",
+                    "type": "string"
+                },
+                "violations": {
+                    "description": "Нарушения, найденные правилами",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.ModerationViolation"
+                    }
+                }
+            }
+        },
+        "entity.ModerationCaseStatus": {
+            "type": "string",
+            "enum": [
+                "open",
+                "approved",
+                "rejected"
+            ],
+            "x-enum-comments": {
+                "ModerationCaseStatusApproved": "Текст одобрен",
+                "ModerationCaseStatusOpen": "Ожидает решения",
+                "ModerationCaseStatusRejected": "Текст отклонен"
+            },
+            "x-enum-varnames": [
+                "ModerationCaseStatusOpen",
+                "ModerationCaseStatusApproved",
+                "ModerationCaseStatusRejected"
+            ]
+        },
+        "entity.ModerationDecision": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Решение",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ModerationAction"
+                        }
+                    ]
+                },
+                "comment": {
+                    "description": "Обоснование",
+                    "type": "string"
+                },
+                "decidedAt": {
+                    "description": "Дата решения",
+                    "type": "string"
+                },
+                "moderatorID": {
+                    "description": "Модератор",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.ModerationReport": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "Пояснение",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "description": "Дата жалобы",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "Причина",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ModerationReportReason"
+                        }
+                    ]
+                },
+                "reporterID": {
+                    "description": "Автор жалобы",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.ModerationReportReason": {
+            "type": "string",
+            "enum": [
+                "spam",
+                "abuse",
+                "fraud",
+                "other"
+            ],
+            "x-enum-comments": {
+                "ModerationReportReasonAbuse": "Оскорбления",
+                "ModerationReportReasonFraud": "Мошенничество",
+                "ModerationReportReasonOther": "Другое",
+                "ModerationReportReasonSpam": "Спам или реклама"
+            },
+            "x-enum-varnames": [
+                "ModerationReportReasonSpam",
+                "ModerationReportReasonAbuse",
+                "ModerationReportReasonFraud",
+                "ModerationReportReasonOther"
+            ]
+        },
+        "entity.ModerationStatus": {
+            "type": "string",
+            "enum": [
+                "approved",
+                "pending",
+                "rejected"
+            ],
+            "x-enum-comments": {
+                "ModerationStatusApproved": "Проверен или не вызвал подозрений",
+                "ModerationStatusPending": "Ожидает решения модератора, пока виден всем",
+                "ModerationStatusRejected": "Отклонен модератором и скрыт из публичных ответов"
+            },
+            "x-enum-varnames": [
+                "ModerationStatusApproved",
+                "ModerationStatusPending",
+                "ModerationStatusRejected"
+            ]
+        },
+        "entity.ModerationSubjectType": {
+            "type": "string",
+            "enum": [
+                "user",
+                "listing"
+            ],
+            "x-enum-comments": {
+                "ModerationSubjectListing": "Объявление",
+                "ModerationSubjectUser": "Профиль пользователя"
+            },
+            "x-enum-varnames": [
+                "ModerationSubjectUser",
+                "ModerationSubjectListing"
+            ]
+        },
+        "entity.ModerationViolation": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "description": "Что именно нашло правило",
+                    "type": "string"
+                },
+                "rule": {
+                    "description": "Название правила",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Money": {
+            "type": "object",
+            "required": [
+                "currency"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "Сумма в минорных единицах (тиын, копейки, центы)",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 39900
+                },
+                "currency": {
+                    "description": "Код валюты ISO 4217",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Currency"
+                        }
+                    ],
+                    "example": "KZT"
+                }
+            }
+        },
+        "entity.NotificationCategory": {
+            "type": "string",
+            "enum": [
+                "listings",
+                "messages",
+                "account"
+            ],
+            "x-enum-comments": {
+                "NotificationCategoryAccount": "Профиль пользователя",
+                "NotificationCategoryListings": "Объявления",
+                "NotificationCategoryMessages": "Переписки"
+            },
+            "x-enum-varnames": [
+                "NotificationCategoryListings",
+                "NotificationCategoryMessages",
+                "NotificationCategoryAccount"
+            ]
+        },
+        "entity.NotificationChannel": {
+            "type": "string",
+            "enum": [
+                "push",
+                "email",
+                "inapp"
+            ],
+            "x-enum-comments": {
+                "NotificationChannelEmail": "Письмо на адрес из настроек",
+                "NotificationChannelInApp": "Входящие уведомления в приложении",
+                "NotificationChannelPush": "Push-уведомление на устройства пользователя"
+            },
+            "x-enum-varnames": [
+                "NotificationChannelPush",
+                "NotificationChannelEmail",
+                "NotificationChannelInApp"
+            ]
+        },
+        "entity.NotificationKind": {
+            "type": "string",
+            "enum": [
+                "wishlist_match",
+                "message",
+                "profile_updated"
+            ],
+            "x-enum-comments": {
+                "NotificationKindMessage": "Новое сообщение в переписке",
+                "NotificationKindProfileUpdated": "Профиль пользователя изменен",
+                "NotificationKindWishlistMatch": "Появилось объявление из списка желаний"
+            },
+            "x-enum-varnames": [
+                "NotificationKindWishlistMatch",
+                "NotificationKindMessage",
+                "NotificationKindProfileUpdated"
+            ]
+        },
+        "entity.NotificationPreferences": {
+            "type": "object",
+            "properties": {
+                "channels": {
+                    "description": "Каналы по типам уведомлений. Для типов без настройки используются все каналы",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/entity.NotificationChannel"
+                        }
+                    }
+                },
+                "email": {
+                    "description": "Адрес для писем. Без адреса письма не отправляются",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "description": "Дата обновления настроек",
+                    "type": "string"
+                },
+                "userID": {
+                    "description": "Идентификатор пользователя",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Order": {
+            "type": "object",
+            "properties": {
+                "cost": {
+                    "description": "Стоимость заказа",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                },
+                "counterpartyID": {
+                    "description": "Идентификатор второй стороны сделки",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "description": "Дата создания заказа",
+                    "type": "string"
+                },
+                "history": {
+                    "description": "История изменения статуса заказа",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.OrderStatusChange"
+                    }
+                },
+                "id": {
+                    "description": "Идентификатор заказа",
+                    "type": "string"
+                },
+                "legs": {
+                    "description": "Части сделки обмена: передаваемые предметы и доплата",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.OrderLeg"
+                    }
+                },
+                "status": {
+                    "description": "Статус заказа",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.OrderStatus"
+                        }
+                    ]
+                },
+                "tradeOfferID": {
+                    "description": "Предложение обмена, по которому создан заказ",
+                    "type": "string"
+                },
+                "userID": {
+                    "description": "Идентификатор пользователя",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.OrderLeg": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Оценка предмета или сумма доплаты",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                },
+                "fromUserID": {
+                    "description": "Кто отдает",
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "Тип части сделки",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.OrderLegKind"
+                        }
+                    ]
+                },
+                "listingID": {
+                    "description": "Объявление передаваемого предмета",
+                    "type": "string"
+                },
+                "paymentID": {
+                    "description": "Платеж доплаты",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "Заголовок объявления на момент сделки",
+                    "type": "string"
+                },
+                "toUserID": {
+                    "description": "Кто получает",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.OrderLegKind": {
+            "type": "string",
+            "enum": [
+                "goods",
+                "cash"
+            ],
+            "x-enum-comments": {
+                "OrderLegKindCash": "Денежная доплата",
+                "OrderLegKindGoods": "Передача предмета"
+            },
+            "x-enum-varnames": [
+                "OrderLegKindGoods",
+                "OrderLegKindCash"
+            ]
+        },
+        "entity.OrderStatus": {
+            "type": "string",
+            "enum": [
+                "created",
+                "confirmed",
+                "completed",
+                "cancelled"
+            ],
+            "x-enum-comments": {
+                "OrderStatusCancelled": "Заказ отменен",
+                "OrderStatusCompleted": "Заказ завершен",
+                "OrderStatusConfirmed": "Заказ подтвержден",
+                "OrderStatusCreated": "Заказ создан"
+            },
+            "x-enum-varnames": [
+                "OrderStatusCreated",
+                "OrderStatusConfirmed",
+                "OrderStatusCompleted",
+                "OrderStatusCancelled"
+            ]
+        },
+        "entity.OrderStatusChange": {
+            "type": "object",
+            "properties": {
+                "actorID": {
+                    "description": "Идентификатор пользователя, изменившего статус",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "description": "Дата изменения",
+                    "type": "string"
+                },
+                "from": {
+                    "description": "Предыдущий статус",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.OrderStatus"
+                        }
+                    ]
+                },
+                "reason": {
+                    "description": "Причина изменения",
+                    "type": "string"
+                },
+                "to": {
+                    "description": "Новый статус",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.OrderStatus"
+                        }
+                    ]
+                }
+            }
+        },
+        "entity.Payment": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Сумма платежа",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                },
+                "createdAt": {
+                    "description": "Дата создания",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Идентификатор платежа",
+                    "type": "string"
+                },
+                "orderID": {
+                    "description": "Заказ, который оплачивается",
+                    "type": "string"
+                },
+                "payeeID": {
+                    "description": "Идентификатор получателя",
+                    "type": "string"
+                },
+                "payerID": {
+                    "description": "Идентификатор плательщика",
+                    "type": "string"
+                },
+                "providerPaymentID": {
+                    "description": "Идентификатор платежа у провайдера",
+                    "type": "string"
+                },
+                "refunded": {
+                    "description": "Сумма возвратов",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                },
+                "refunds": {
+                    "description": "Возвраты",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.PaymentRefund"
+                    }
+                },
+                "settlement": {
+                    "description": "Операция, ожидающая проведения у провайдера",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.PaymentSettlement"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "Статус платежа",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.PaymentStatus"
+                        }
+                    ]
+                },
+                "updatedAt": {
+                    "description": "Дата обновления",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.PaymentRefund": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Сумма возврата",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                },
+                "createdAt": {
+                    "description": "Дата возврата",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Идентификатор возврата у провайдера",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "Причина возврата",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.PaymentSettlement": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "description": "Количество неудачных попыток",
+                    "type": "integer"
+                },
+                "lastError": {
+                    "description": "Ошибка последней попытки",
+                    "type": "string"
+                },
+                "nextAttemptAt": {
+                    "description": "Время следующей попытки",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "Причина операции",
+                    "type": "string"
+                },
+                "refund": {
+                    "description": "Сумма возврата",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "Статус, к которому ведет операция: списание, снятие блокировки или возврат",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.PaymentStatus"
+                        }
+                    ]
+                }
+            }
+        },
+        "entity.PaymentStatus": {
+            "type": "string",
+            "enum": [
+                "authorized",
+                "captured",
+                "partially_refunded",
+                "refunded",
+                "voided",
+                "failed"
+            ],
+            "x-enum-comments": {
+                "PaymentStatusAuthorized": "Сумма заблокирована на счете плательщика",
+                "PaymentStatusCaptured": "Деньги списаны с плательщика",
+                "PaymentStatusFailed": "Провайдер не смог провести платеж",
+                "PaymentStatusPartiallyRefunded": "Часть денег возвращена плательщику",
+                "PaymentStatusRefunded": "Деньги возвращены плательщику полностью",
+                "PaymentStatusVoided": "Блокировка снята без списания"
+            },
+            "x-enum-varnames": [
+                "PaymentStatusAuthorized",
+                "PaymentStatusCaptured",
+                "PaymentStatusPartiallyRefunded",
+                "PaymentStatusRefunded",
+                "PaymentStatusVoided",
+                "PaymentStatusFailed"
+            ]
+        },
+        "entity.PaymentWebhookEvent": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "Идентификатор уведомления у провайдера",
+                    "type": "string"
+                },
+                "occurredAt": {
+                    "description": "Дата изменения",
+                    "type": "string"
+                },
+                "paymentID": {
+                    "description": "Идентификатор платежа у провайдера",
+                    "type": "string"
+                },
+                "refunded": {
+                    "description": "Сумма всех возвратов по платежу",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "Статус платежа у провайдера",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.PaymentStatus"
+                        }
+                    ]
+                }
+            }
+        },
+        "entity.Rating": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "description": "Комментарий",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "description": "Дата оценки",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Идентификатор оценки",
+                    "type": "string"
+                },
+                "orderID": {
+                    "description": "Идентификатор заказа",
+                    "type": "string"
+                },
+                "rateeID": {
+                    "description": "Кого оценили",
+                    "type": "string"
+                },
+                "raterID": {
+                    "description": "Кто оценил",
+                    "type": "string"
+                },
+                "score": {
+                    "description": "Оценка от 1 до 5",
+                    "type": "integer"
+                }
+            }
+        },
+        "entity.Reputation": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Количество оценок",
+                    "type": "integer"
+                },
+                "penalties": {
+                    "description": "Сумма штрафов по решениям споров",
+                    "type": "integer"
+                },
+                "score": {
+                    "description": "Взвешенная средняя оценка",
+                    "type": "number"
+                },
+                "trend": {
+                    "description": "Разница между недавней и общей средней оценкой",
+                    "type": "number"
+                },
+                "updatedAt": {
+                    "description": "Дата последней оценки",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.Response": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "description": "Детальное описание ответа",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.SearchFacets": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "description": "Объявления по категориям",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "kinds": {
+                    "description": "По типам документов",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "entity.SearchHit": {
+            "type": "object",
+            "properties": {
+                "distanceKm": {
+                    "description": "Расстояние до точки near в километрах",
+                    "type": "number"
+                },
+                "highlights": {
+                    "description": "Фрагменты полей с выделенными найденными словами",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "description": "Идентификатор документа",
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "Тип документа",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.SearchKind"
+                        }
+                    ]
+                },
+                "listing": {
+                    "description": "Объявление, если документ - объявление",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Listing"
+                        }
+                    ]
+                },
+                "score": {
+                    "description": "Релевантность, чем больше, тем выше в выдаче",
+                    "type": "number"
+                },
+                "user": {
+                    "description": "Пользователь, если документ - пользователь",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.User"
+                        }
+                    ]
+                }
+            }
+        },
+        "entity.SearchKind": {
+            "type": "string",
+            "enum": [
+                "user",
+                "listing"
+            ],
+            "x-enum-comments": {
+                "SearchKindListing": "Объявление",
+                "SearchKindUser": "Пользователь"
+            },
+            "x-enum-varnames": [
+                "SearchKindUser",
+                "SearchKindListing"
+            ]
+        },
+        "entity.SearchResult": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Общее количество найденных документов с учетом фильтров",
+                    "type": "integer"
+                },
+                "facets": {
+                    "description": "Фасеты",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.SearchFacets"
+                        }
+                    ]
+                },
+                "items": {
+                    "description": "Страница найденных документов",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.SearchHit"
+                    }
+                }
+            }
+        },
+        "entity.TradeCash": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Сумма доплаты",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                },
+                "payer": {
+                    "description": "Сторона, которая доплачивает",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.TradeSide"
+                        }
+                    ]
+                },
+                "paymentID": {
+                    "description": "Платеж, которым заблокирована доплата",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.TradeCycle": {
+            "type": "object",
+            "properties": {
+                "acceptedBy": {
+                    "description": "Участники, давшие согласие",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "createdAt": {
+                    "description": "Дата создания",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Идентификатор цикла",
+                    "type": "string"
+                },
+                "key": {
+                    "description": "Канонический ключ цикла для исключения повторов",
+                    "type": "string"
+                },
+                "legs": {
+                    "description": "Звенья цикла в порядке передачи",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.TradeCycleLeg"
+                    }
+                },
+                "participantIDs": {
+                    "description": "Участники цикла",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "score": {
+                    "description": "Качество цикла от 0 до 1",
+                    "type": "number"
+                },
+                "status": {
+                    "description": "Статус цикла",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.TradeCycleStatus"
+                        }
+                    ]
+                },
+                "updatedAt": {
+                    "description": "Дата обновления",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.TradeCycleLeg": {
+            "type": "object",
+            "properties": {
+                "giverID": {
+                    "description": "Владелец объявления",
+                    "type": "string"
+                },
+                "listingID": {
+                    "description": "Передаваемое объявление",
+                    "type": "string"
+                },
+                "matchedTags": {
+                    "description": "Желаемые теги получателя, которым соответствует объявление",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "receiverID": {
+                    "description": "Получатель объявления",
+                    "type": "string"
+                },
+                "score": {
+                    "description": "Качество совпадения звена от 0 до 1",
+                    "type": "number"
+                }
+            }
+        },
+        "entity.TradeCycleStatus": {
+            "type": "string",
+            "enum": [
+                "proposed",
+                "accepted",
+                "declined",
+                "expired"
+            ],
+            "x-enum-comments": {
+                "TradeCycleStatusAccepted": "Все участники согласились, объявления зарезервированы",
+                "TradeCycleStatusDeclined": "Один из участников отказался",
+                "TradeCycleStatusExpired": "Одно из объявлений стало недоступно",
+                "TradeCycleStatusProposed": "Предложен участникам и ожидает согласия всех"
+            },
+            "x-enum-varnames": [
+                "TradeCycleStatusProposed",
+                "TradeCycleStatusAccepted",
+                "TradeCycleStatusDeclined",
+                "TradeCycleStatusExpired"
+            ]
+        },
+        "entity.TradeFairness": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "description": "Перевес автора: положительный, если автор отдает больше",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                },
+                "currency": {
+                    "description": "Валюта оценки",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Currency"
+                        }
+                    ]
+                },
+                "fair": {
+                    "description": "Укладывается ли перевес в допустимое отклонение",
+                    "type": "boolean"
+                },
+                "proposerGives": {
+                    "description": "Оценка объявлений автора вместе с его доплатой",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                },
+                "recipientGives": {
+                    "description": "Оценка объявлений получателя вместе с его доплатой",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                },
+                "unvaluedListingIDs": {
+                    "description": "Объявления без оценки в валюте обмена",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "entity.TradeOffer": {
+            "type": "object",
+            "properties": {
+                "cash": {
+                    "description": "Денежная доплата к обмену",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.TradeCash"
+                        }
+                    ]
+                },
+                "counterOfferID": {
+                    "description": "Встречное предложение",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "description": "Дата создания",
+                    "type": "string"
+                },
+                "fairness": {
+                    "description": "Оценка равноценности обмена на момент создания",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.TradeFairness"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "Идентификатор предложения",
+                    "type": "string"
+                },
+                "message": {
+                    "description": "Сообщение автора",
+                    "type": "string"
+                },
+                "offeredListingIDs": {
+                    "description": "Объявления автора",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "orderID": {
+                    "description": "Заказ, созданный при принятии предложения",
+                    "type": "string"
+                },
+                "parentID": {
+                    "description": "Предложение, на которое это является встречным",
+                    "type": "string"
+                },
+                "proposerID": {
+                    "description": "Идентификатор автора предложения",
+                    "type": "string"
+                },
+                "recipientID": {
+                    "description": "Идентификатор получателя предложения",
+                    "type": "string"
+                },
+                "requestedListingIDs": {
+                    "description": "Объявления получателя",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "description": "Статус предложения",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.TradeOfferStatus"
+                        }
+                    ]
+                },
+                "updatedAt": {
+                    "description": "Дата обновления",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.TradeOfferStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "accepted",
+                "rejected",
+                "countered",
+                "cancelled",
+                "completed"
+            ],
+            "x-enum-comments": {
+                "TradeOfferStatusAccepted": "Принято получателем",
+                "TradeOfferStatusCancelled": "Отозвано автором или отменено участником после принятия",
+                "TradeOfferStatusCompleted": "Обмен состоялся",
+                "TradeOfferStatusCountered": "Получатель предложил встречный обмен",
+                "TradeOfferStatusPending": "Ожидает ответа получателя",
+                "TradeOfferStatusRejected": "Отклонено получателем"
+            },
+            "x-enum-varnames": [
+                "TradeOfferStatusPending",
+                "TradeOfferStatusAccepted",
+                "TradeOfferStatusRejected",
+                "TradeOfferStatusCountered",
+                "TradeOfferStatusCancelled",
+                "TradeOfferStatusCompleted"
+            ]
+        },
+        "entity.TradeSide": {
+            "type": "string",
+            "enum": [
+                "proposer",
+                "recipient"
+            ],
+            "x-enum-comments": {
+                "TradeSideProposer": "Автор предложения",
+                "TradeSideRecipient": "Получатель предложения"
+            },
+            "x-enum-varnames": [
+                "TradeSideProposer",
+                "TradeSideRecipient"
+            ]
+        },
+        "entity.UnreadCount": {
+            "type": "object",
+            "properties": {
+                "unread": {
+                    "description": "Количество непрочитанных уведомлений",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "entity.User": {
+            "type": "object",
+            "properties": {
+                "avatarID": {
+                    "description": "Идентификатор медиафайла аватара",
+                    "type": "string"
+                },
+                "bio": {
+                    "description": "Биография пользователя",
+                    "type": "string"
+                },
+                "city": {
+                    "description": "Город",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "description": "Дата создания пользователя",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Идентификатор пользователя",
+                    "type": "string"
+                },
+                "location": {
+                    "description": "Местоположение",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.GeoPoint"
+                        }
+                    ]
+                },
+                "moderation": {
+                    "description": "Статус модерации биографии",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ModerationStatus"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Имя пользователя",
+                    "type": "string"
+                },
+                "reputation": {
+                    "description": "Репутация по оценкам после сделок",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Reputation"
+                        }
+                    ]
+                },
+                "updatedAt": {
+                    "description": "Дата обновления пользователя",
+                    "type": "string"
+                }
+            }
+        },
+        "entity.WishlistItem": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Категория. Пустая - любая",
+                    "type": "string"
+                },
+                "conditions": {
+                    "description": "Допустимые состояния предмета. Пустой список - любое",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.ListingCondition"
+                    }
+                },
+                "createdAt": {
+                    "description": "Дата создания",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Идентификатор позиции",
+                    "type": "string"
+                },
+                "location": {
+                    "description": "Точка поиска",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.GeoPoint"
+                        }
+                    ]
+                },
+                "query": {
+                    "description": "Свободный текст: что пользователь ищет",
+                    "type": "string"
+                },
+                "radiusKm": {
+                    "description": "Радиус поиска вокруг точки в километрах",
+                    "type": "number"
+                },
+                "updatedAt": {
+                    "description": "Дата обновления",
+                    "type": "string"
+                },
+                "userID": {
+                    "description": "Идентификатор пользователя",
+                    "type": "string"
+                }
+            }
+        },
+        "form.AttachmentCreate": {
+            "type": "object",
+            "required": [
+                "contentType",
+                "name",
+                "size",
+                "url"
+            ],
+            "properties": {
+                "contentType": {
+                    "description": "MIME-тип файла",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "image/jpeg"
+                },
+                "name": {
+                    "description": "Имя файла",
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "фото.jpg"
+                },
+                "size": {
+                    "description": "Размер файла в байтах, не больше 10 МБ",
+                    "type": "integer",
+                    "maximum": 10485760,
+                    "minimum": 1,
+                    "example": 204800
+                },
+                "url": {
+                    "description": "Ссылка на загруженный файл",
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://cdn.example.com/1.jpg"
+                }
+            }
+        },
+        "form.AvatarSet": {
+            "type": "object",
+            "required": [
+                "mediaID"
+            ],
+            "properties": {
+                "mediaID": {
+                    "description": "Идентификатор медиафайла",
+                    "type": "string",
+                    "example": "655d8a3577a0a79c69a7cdfc"
+                }
+            }
+        },
+        "form.ConversationCreate": {
+            "type": "object",
+            "required": [
+                "subjectID",
+                "subjectType"
+            ],
+            "properties": {
+                "subjectID": {
+                    "description": "Идентификатор сделки",
+                    "type": "string",
+                    "example": "5f8b9b1b3afea534e56b570e"
+                },
+                "subjectType": {
+                    "description": "Тип сделки",
+                    "enum": [
+                        "order",
+                        "trade_offer"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ConversationSubject"
+                        }
+                    ],
+                    "example": "order"
+                }
+            }
+        },
+        "form.DeviceRegister": {
+            "type": "object",
+            "required": [
+                "platform",
+                "token"
+            ],
+            "properties": {
+                "platform": {
+                    "description": "Платформа устройства",
+                    "enum": [
+                        "android",
+                        "ios",
+                        "web"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.DevicePlatform"
+                        }
+                    ],
+                    "example": "android"
+                },
+                "token": {
+                    "description": "Токен устройства",
+                    "type": "string",
+                    "maxLength": 4096,
+                    "example": "fcm-token"
+                }
+            }
+        },
+        "form.DisputeAssign": {
+            "type": "object",
+            "properties": {
+                "moderatorID": {
+                    "description": "Модератор, которому передается спор. Без него спор берет автор запроса",
+                    "type": "string",
+                    "example": "655d8a4d3afea534e56b570f"
+                }
+            }
+        },
+        "form.DisputeCreate": {
+            "type": "object",
+            "required": [
+                "description",
+                "orderID",
+                "reason"
+            ],
+            "properties": {
+                "description": {
+                    "description": "Описание проблемы",
+                    "type": "string",
+                    "maxLength": 2000,
+                    "example": "Велосипед оказался с погнутой рамой, в объявлении этого нет"
+                },
+                "orderID": {
+                    "description": "Идентификатор заказа",
+                    "type": "string",
+                    "example": "5f8b9b1b3afea534e56b570e"
+                },
+                "reason": {
+                    "description": "Причина спора",
+                    "enum": [
+                        "not_as_described",
+                        "no_show",
+                        "damaged",
+                        "other"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.DisputeReason"
+                        }
+                    ],
+                    "example": "not_as_described"
+                }
+            }
+        },
+        "form.DisputeEvidenceAdd": {
+            "type": "object",
+            "required": [
+                "mediaID"
+            ],
+            "properties": {
+                "comment": {
+                    "description": "Пояснение",
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Фото рамы при получении"
+                },
+                "mediaID": {
+                    "description": "Идентификатор загруженного медиафайла",
+                    "type": "string",
+                    "example": "665d8a4d3afea534e56b5711"
+                }
+            }
+        },
+        "form.DisputeMessageCreate": {
+            "type": "object",
+            "required": [
+                "text"
+            ],
+            "properties": {
+                "text": {
+                    "description": "Текст сообщения",
+                    "type": "string",
+                    "maxLength": 2000,
+                    "example": "Прикладываю фото рамы при получении"
+                }
+            }
+        },
+        "form.DisputeResolve": {
+            "type": "object",
+            "required": [
+                "comment",
+                "status"
+            ],
+            "properties": {
+                "comment": {
+                    "description": "Обоснование решения",
+                    "type": "string",
+                    "maxLength": 2000,
+                    "example": "Повреждение подтверждено фотографиями"
+                },
+                "creditReversal": {
+                    "description": "Кредиты, которые списываются со второй стороны в пользу автора",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 500
+                },
+                "refund": {
+                    "description": "Возврат оплаты заказа автору спора",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                },
+                "reputationPenalty": {
+                    "description": "Штраф к репутации второй стороны в минимальных оценках",
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 0,
+                    "example": 2
+                },
+                "status": {
+                    "description": "Решение: resolved в пользу автора, rejected отклонить",
+                    "enum": [
+                        "resolved",
+                        "rejected"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.DisputeStatus"
+                        }
+                    ],
+                    "example": "resolved"
+                }
+            }
+        },
+        "form.ListingCreate": {
+            "type": "object",
+            "required": [
+                "category",
+                "condition",
+                "title"
+            ],
+            "properties": {
+                "category": {
+                    "description": "Категория",
+                    "type": "string",
+                    "enum": [
+                        "electronics",
+                        "clothing",
+                        "home",
+                        "books",
+                        "sports",
+                        "kids",
+                        "hobby",
+                        "other"
+                    ],
+                    "example": "sports"
+                },
+                "city": {
+                    "description": "Город",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Алматы"
+                },
+                "condition": {
+                    "description": "Состояние предмета",
+                    "enum": [
+                        "new",
+                        "like_new",
+                        "good",
+                        "fair",
+                        "poor"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ListingCondition"
+                        }
+                    ],
+                    "example": "good"
+                },
+                "description": {
+                    "description": "Описание",
+                    "type": "string",
+                    "maxLength": 2000,
+                    "example": "Горный велосипед, 21 скорость"
+                },
+                "desiredTags": {
+                    "description": "Что владелец хочет получить взамен",
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ноутбук"
+                    ]
+                },
+                "location": {
+                    "description": "Местоположение предмета",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.GeoPoint"
+                        }
+                    ]
+                },
+                "photos": {
+                    "description": "Ссылки на фотографии",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "https://cdn.example.com/1.jpg"
+                    ]
+                },
+                "title": {
+                    "description": "Заголовок",
+                    "type": "string",
+                    "maxLength": 120,
+                    "minLength": 3,
+                    "example": "Велосипед Stels"
+                },
+                "valuation": {
+                    "description": "Оценка предмета владельцем",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                }
+            }
+        },
+        "form.ListingUpdate": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Категория",
+                    "type": "string",
+                    "enum": [
+                        "electronics",
+                        "clothing",
+                        "home",
+                        "books",
+                        "sports",
+                        "kids",
+                        "hobby",
+                        "other"
+                    ],
+                    "example": "sports"
+                },
+                "city": {
+                    "description": "Город",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Алматы"
+                },
+                "condition": {
+                    "description": "Состояние предмета",
+                    "enum": [
+                        "new",
+                        "like_new",
+                        "good",
+                        "fair",
+                        "poor"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ListingCondition"
+                        }
+                    ],
+                    "example": "good"
+                },
+                "description": {
+                    "description": "Описание",
+                    "type": "string",
+                    "maxLength": 2000,
+                    "example": "Горный велосипед, 21 скорость"
+                },
+                "desiredTags": {
+                    "description": "Что владелец хочет получить взамен",
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ноутбук"
+                    ]
+                },
+                "location": {
+                    "description": "Местоположение предмета",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.GeoPoint"
+                        }
+                    ]
+                },
+                "photos": {
+                    "description": "Ссылки на фотографии",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "https://cdn.example.com/1.jpg"
+                    ]
+                },
+                "status": {
+                    "description": "Статус объявления. Владелец может только снять или вернуть объявление",
+                    "enum": [
+                        "active",
+                        "archived"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ListingStatus"
+                        }
+                    ],
+                    "example": "archived"
+                },
+                "title": {
+                    "description": "Заголовок",
+                    "type": "string",
+                    "maxLength": 120,
+                    "minLength": 3,
+                    "example": "Велосипед Stels"
+                },
+                "valuation": {
+                    "description": "Оценка предмета владельцем",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                }
+            }
+        },
+        "form.MediaAttach": {
+            "type": "object",
+            "required": [
+                "subjectID",
+                "subjectType"
+            ],
+            "properties": {
+                "subjectID": {
+                    "description": "Идентификатор сущности",
+                    "type": "string",
+                    "example": "5f8b9b1b3afea534e56b570e"
+                },
+                "subjectType": {
+                    "description": "Тип сущности",
+                    "enum": [
+                        "listing"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.MediaSubject"
+                        }
+                    ],
+                    "example": "listing"
+                }
+            }
+        },
+        "form.MessageCreate": {
+            "type": "object",
+            "properties": {
+                "attachments": {
+                    "description": "Вложения",
+                    "type": "array",
+                    "maxItems": 5,
+                    "items": {
+                        "$ref": "#/definitions/form.AttachmentCreate"
+                    }
+                },
+                "text": {
+                    "description": "Текст сообщения",
+                    "type": "string",
+                    "maxLength": 4000,
+                    "example": "Когда удобно встретиться?"
+                }
+            }
+        },
+        "form.ModerationDecide": {
+            "type": "object",
+            "required": [
+                "action"
+            ],
+            "properties": {
+                "action": {
+                    "description": "Решение: approve одобрить, reject скрыть, ban скрыть и заблокировать автора",
+                    "enum": [
+                        "approve",
+                        "reject",
+                        "ban"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ModerationAction"
+                        }
+                    ],
+                    "example": "reject"
+                },
+                "comment": {
+                    "description": "Обоснование",
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Контакты для сделки вне площадки"
+                }
+            }
+        },
+        "form.ModerationReportCreate": {
+            "type": "object",
+            "required": [
+                "reason",
+                "subjectID",
+                "subjectType"
+            ],
+            "properties": {
+                "comment": {
+                    "description": "Пояснение",
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "В описании ссылка на сторонний сайт"
+                },
+                "reason": {
+                    "description": "Причина жалобы",
+                    "enum": [
+                        "spam",
+                        "abuse",
+                        "fraud",
+                        "other"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ModerationReportReason"
+                        }
+                    ],
+                    "example": "spam"
+                },
+                "subjectID": {
+                    "description": "Идентификатор сущности",
+                    "type": "string",
+                    "example": "5f8b9b1b3afea534e56b570e"
+                },
+                "subjectType": {
+                    "description": "Тип сущности",
+                    "enum": [
+                        "user",
+                        "listing"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ModerationSubjectType"
+                        }
+                    ],
+                    "example": "listing"
+                }
+            }
+        },
+        "form.NotificationPreferencesUpdate": {
+            "type": "object",
+            "properties": {
+                "channels": {
+                    "description": "Каналы по типам уведомлений. Пустой список отключает уведомления типа",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/entity.NotificationChannel"
+                        }
+                    }
+                },
+                "email": {
+                    "description": "Адрес для писем. Пустой адрес отключает письма",
+                    "type": "string",
+                    "maxLength": 254,
+                    "example": "user@example.com"
+                }
+            }
+        },
+        "form.OrderCreate": {
+            "type": "object",
+            "required": [
+                "cost"
+            ],
+            "properties": {
+                "cost": {
+                    "description": "Стоимость заказа",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                },
+                "counterpartyID": {
+                    "description": "Идентификатор второй стороны сделки",
+                    "type": "string",
+                    "example": "655d8a4d3afea534e56b570f"
+                }
+            }
+        },
+        "form.OrderStatusUpdate": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "reason": {
+                    "description": "Причина изменения статуса",
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Покупатель подтвердил обмен"
+                },
+                "refund": {
+                    "description": "Сумма возврата при отмене оплаченного заказа. Без нее возвращается вся оплата",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "Новый статус заказа",
+                    "enum": [
+                        "created",
+                        "confirmed",
+                        "completed",
+                        "cancelled"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.OrderStatus"
+                        }
+                    ],
+                    "example": "confirmed"
+                }
+            }
+        },
+        "form.RatingCreate": {
+            "type": "object",
+            "required": [
+                "orderID",
+                "score"
+            ],
+            "properties": {
+                "comment": {
+                    "description": "Комментарий",
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Быстрый и честный обмен"
+                },
+                "orderID": {
+                    "description": "Идентификатор завершенного заказа",
+                    "type": "string",
+                    "example": "5f8b9b1b3afea534e56b570e"
+                },
+                "score": {
+                    "description": "Оценка от 1 до 5",
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1,
+                    "example": 5
+                }
+            }
+        },
+        "form.TradeCash": {
+            "type": "object",
+            "required": [
+                "amount",
+                "payer"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "Сумма доплаты",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                },
+                "payer": {
+                    "description": "Сторона, которая доплачивает",
+                    "enum": [
+                        "proposer",
+                        "recipient"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.TradeSide"
+                        }
+                    ],
+                    "example": "proposer"
+                }
+            }
+        },
+        "form.TradeOfferCounter": {
+            "type": "object",
+            "required": [
+                "offeredListingIDs",
+                "requestedListingIDs"
+            ],
+            "properties": {
+                "cash": {
+                    "description": "Денежная доплата к встречному обмену",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/form.TradeCash"
+                        }
+                    ]
+                },
+                "message": {
+                    "description": "Сообщение автору исходного предложения",
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Могу предложить другое"
+                },
+                "offeredListingIDs": {
+                    "description": "Объявления, которые получатель готов отдать",
+                    "type": "array",
+                    "maxItems": 10,
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "655d8a3577a0a79c69a7cdfd"
+                    ]
+                },
+                "requestedListingIDs": {
+                    "description": "Объявления автора исходного предложения",
+                    "type": "array",
+                    "maxItems": 10,
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "655d8a3577a0a79c69a7cdfe"
+                    ]
+                }
+            }
+        },
+        "form.TradeOfferCreate": {
+            "type": "object",
+            "required": [
+                "offeredListingIDs",
+                "requestedListingIDs"
+            ],
+            "properties": {
+                "cash": {
+                    "description": "Денежная доплата к обмену",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/form.TradeCash"
+                        }
+                    ]
+                },
+                "message": {
+                    "description": "Сообщение получателю",
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Меняю на ваш велосипед"
+                },
+                "offeredListingIDs": {
+                    "description": "Объявления автора",
+                    "type": "array",
+                    "maxItems": 10,
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "655d8a3577a0a79c69a7cdfc"
+                    ]
+                },
+                "requestedListingIDs": {
+                    "description": "Объявления получателя",
+                    "type": "array",
+                    "maxItems": 10,
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "655d8a3577a0a79c69a7cdfd"
+                    ]
+                }
+            }
+        },
+        "form.UserCreate": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "bio": {
+                    "description": "Биография пользователя",
+                    "type": "string",
+                    "maxLength": 500,
+                    "minLength": 3
+                },
+                "city": {
+                    "description": "Город",
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Алматы"
+                },
+                "location": {
+                    "description": "Местоположение",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.GeoPoint"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Имя пользователя",
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 3
+                }
+            }
+        },
+        "form.WalletGrant": {
+            "type": "object",
+            "required": [
+                "amount",
+                "comment",
+                "userID"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "Сумма начисления в кредитах",
+                    "type": "integer",
+                    "example": 1000
+                },
+                "comment": {
+                    "description": "Причина начисления",
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Компенсация за сорванный обмен"
+                },
+                "userID": {
+                    "description": "Идентификатор пользователя",
+                    "type": "string",
+                    "example": "655d8a4d3afea534e56b570f"
+                }
+            }
+        },
+        "form.WalletTransfer": {
+            "type": "object",
+            "required": [
+                "amount",
+                "toUserID"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "Сумма перевода в кредитах",
+                    "type": "integer",
+                    "example": 500
+                },
+                "comment": {
+                    "description": "Комментарий",
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Доплата за обмен велосипеда"
+                },
+                "toUserID": {
+                    "description": "Идентификатор получателя",
+                    "type": "string",
+                    "example": "655d8a4d3afea534e56b570f"
+                }
+            }
+        },
+        "form.WishlistItemCreate": {
+            "type": "object",
+            "required": [
+                "query"
+            ],
+            "properties": {
+                "category": {
+                    "description": "Категория",
+                    "type": "string",
+                    "enum": [
+                        "electronics",
+                        "clothing",
+                        "home",
+                        "books",
+                        "sports",
+                        "kids",
+                        "hobby",
+                        "other"
+                    ],
+                    "example": "sports"
+                },
+                "conditions": {
+                    "description": "Допустимые состояния предмета",
+                    "type": "array",
+                    "maxItems": 5,
+                    "uniqueItems": true,
+                    "items": {
+                        "$ref": "#/definitions/entity.ListingCondition"
+                    },
+                    "example": [
+                        "good"
+                    ]
+                },
+                "location": {
+                    "description": "Точка поиска",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.GeoPoint"
+                        }
+                    ]
+                },
+                "query": {
+                    "description": "Что пользователь ищет",
+                    "type": "string",
+                    "maxLength": 200,
+                    "minLength": 3,
+                    "example": "велосипед горный"
+                },
+                "radiusKm": {
+                    "description": "Радиус поиска вокруг точки в километрах",
+                    "type": "number",
+                    "maximum": 500,
+                    "example": 10
+                }
+            }
+        },
+        "presenter.CreatedListing": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "Идентификатор объявления",
+                    "type": "string",
+                    "example": "655d8a3577a0a79c69a7cdfc"
+                }
+            }
+        },
+        "presenter.CreatedOrder": {
+            "type": "object",
+            "properties": {
+                "cost": {
+                    "description": "Стоимость заказа",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "Идентификатор заказа",
+                    "type": "string",
+                    "example": "655d8a3577a0a79c69a7cdfc"
+                },
+                "userID": {
+                    "description": "Идентификатор пользователя",
+                    "type": "string",
+                    "example": "655d8a4d3afea534e56b570e"
+                }
+            }
+        },
+        "presenter.Envelope": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Данные ответа, null в ответе с ошибкой"
+                },
+                "errors": {
+                    "description": "Ошибки запроса",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.EnvelopeError"
+                    }
+                },
+                "meta": {
+                    "description": "Состояние пагинации списка",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/presenter.Meta"
+                        }
+                    ]
+                }
+            }
+        },
+        "presenter.EnvelopeError": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Код ошибки",
+                    "type": "string",
+                    "example": "TMP_USER_NOT_FOUND"
+                },
+                "field": {
+                    "description": "Поле формы, к которому относится ошибка",
+                    "type": "string",
+                    "example": "name"
+                },
+                "message": {
+                    "description": "Сообщение на языке запроса",
+                    "type": "string",
+                    "example": "Пользователь не найден"
+                }
+            }
+        },
+        "presenter.ImportReport": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "description": "Ошибка, прервавшая импорт",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.EnvelopeError"
+                    }
+                },
+                "result": {
+                    "description": "Результат строки файла",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/presenter.ImportRow"
+                        }
+                    ]
+                },
+                "run": {
+                    "description": "Итог запуска",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/presenter.ImportRun"
+                        }
+                    ]
+                }
+            }
+        },
+        "presenter.ImportRow": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "description": "Ошибки строки",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.EnvelopeError"
+                    }
+                },
+                "id": {
+                    "description": "Идентификатор созданной сущности",
+                    "type": "string",
+                    "example": "655d8a4d3afea534e56b570e"
+                },
+                "row": {
+                    "description": "Номер строки данных, начиная с 1",
+                    "type": "integer",
+                    "example": 3
+                },
+                "status": {
+                    "description": "Результат",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ImportRowStatus"
+                        }
+                    ],
+                    "example": "created"
+                }
+            }
+        },
+        "presenter.ImportRun": {
+            "type": "object",
+            "properties": {
+                "checkpoint": {
+                    "description": "Номер последней обработанной строки",
+                    "type": "integer",
+                    "example": 1500
+                },
+                "created": {
+                    "description": "Количество созданных сущностей",
+                    "type": "integer",
+                    "example": 1480
                 },
                 "createdAt": {
-                    "description": "Дата создания пользователя",
+                    "description": "Дата первой попытки",
                     "type": "string"
                 },
+                "existed": {
+                    "description": "Количество строк, сохраненных прерванной попыткой",
+                    "type": "integer",
+                    "example": 0
+                },
+                "failed": {
+                    "description": "Количество строк с ошибками",
+                    "type": "integer",
+                    "example": 20
+                },
                 "id": {
-                    "description": "Идентификатор пользователя",
-                    "type": "string"
+                    "description": "Идентификатор запуска",
+                    "type": "string",
+                    "example": "655d8a4d3afea534e56b570f"
                 },
-                "name": {
-                    "description": "Имя пользователя",
-                    "type": "string"
+                "kind": {
+                    "description": "Вид импортируемых сущностей",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.BulkKind"
+                        }
+                    ],
+                    "example": "users"
+                },
+                "status": {
+                    "description": "Статус запуска",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ImportRunStatus"
+                        }
+                    ],
+                    "example": "completed"
                 },
                 "updatedAt": {
-                    "description": "Дата обновления пользователя",
+                    "description": "Дата сохранения последней пачки",
                     "type": "string"
                 }
             }
         },
-        "form.OrderCreate": {
+        "presenter.Listing": {
             "type": "object",
-            "required": [
-                "cost"
-            ],
             "properties": {
-                "cost": {
-                    "description": "Стоимость заказа",
-                    "type": "integer",
-                    "example": 39900
+                "category": {
+                    "description": "Категория",
+                    "type": "string",
+                    "example": "sport"
+                },
+                "city": {
+                    "description": "Город",
+                    "type": "string",
+                    "example": "Алматы"
+                },
+                "condition": {
+                    "description": "Состояние предмета",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ListingCondition"
+                        }
+                    ],
+                    "example": "good"
+                },
+                "createdAt": {
+                    "description": "Дата создания",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Описание",
+                    "type": "string"
+                },
+                "desiredTags": {
+                    "description": "Что владелец хочет получить взамен",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "distanceKm": {
+                    "description": "Расстояние до точки поиска в километрах",
+                    "type": "number",
+                    "example": 2.5
+                },
+                "id": {
+                    "description": "Идентификатор объявления",
+                    "type": "string",
+                    "example": "655d8a3577a0a79c69a7cdfc"
+                },
+                "location": {
+                    "description": "Местоположение предмета",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.GeoPoint"
+                        }
+                    ]
+                },
+                "moderation": {
+                    "description": "Статус модерации текста",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ModerationStatus"
+                        }
+                    ],
+                    "example": "approved"
+                },
+                "ownerID": {
+                    "description": "Идентификатор владельца",
+                    "type": "string",
+                    "example": "655d8a4d3afea534e56b570e"
+                },
+                "photos": {
+                    "description": "Ссылки на фотографии",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "description": "Статус объявления",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ListingStatus"
+                        }
+                    ],
+                    "example": "active"
+                },
+                "title": {
+                    "description": "Заголовок",
+                    "type": "string",
+                    "example": "Велосипед"
+                },
+                "updatedAt": {
+                    "description": "Дата обновления",
+                    "type": "string"
+                },
+                "valuation": {
+                    "description": "Оценка стоимости предмета владельцем",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
                 }
             }
         },
-        "form.UserCreate": {
+        "presenter.Meta": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "bio": {
-                    "description": "Биография пользователя",
-                    "type": "string",
-                    "maxLength": 500,
-                    "minLength": 3
+                "count": {
+                    "description": "Общее количество элементов",
+                    "type": "integer",
+                    "example": 42
                 },
-                "name": {
-                    "description": "Имя пользователя",
+                "limit": {
+                    "description": "Количество элементов на странице",
+                    "type": "integer",
+                    "example": 10
+                },
+                "page": {
+                    "description": "Номер страницы",
+                    "type": "integer",
+                    "example": 1
+                },
+                "state": {
+                    "description": "Состояние следующей страницы для курсорной пагинации",
                     "type": "string",
-                    "maxLength": 255,
-                    "minLength": 3
+                    "example": "AAAAAQ=="
                 }
             }
         },
-        "presenter.CreatedOrder": {
+        "presenter.Order": {
             "type": "object",
             "properties": {
                 "cost": {
                     "description": "Стоимость заказа",
-                    "type": "integer",
-                    "example": 39900
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                },
+                "counterpartyID": {
+                    "description": "Идентификатор второй стороны сделки",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "description": "Дата создания заказа",
+                    "type": "string"
+                },
+                "history": {
+                    "description": "История изменения статуса, если запрошена",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.OrderStatusChange"
+                    }
                 },
                 "id": {
                     "description": "Идентификатор заказа",
                     "type": "string",
                     "example": "655d8a3577a0a79c69a7cdfc"
                 },
+                "legs": {
+                    "description": "Части сделки обмена",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.OrderLeg"
+                    }
+                },
+                "status": {
+                    "description": "Статус заказа",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.OrderStatus"
+                        }
+                    ],
+                    "example": "created"
+                },
+                "tradeOfferID": {
+                    "description": "Предложение обмена, по которому создан заказ",
+                    "type": "string"
+                },
                 "userID": {
                     "description": "Идентификатор пользователя",
                     "type": "string",
@@ -452,6 +10430,174 @@ const docTemplate = `{
                 }
             }
         },
+        "presenter.OrderLeg": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Оценка предмета или сумма доплаты",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Money"
+                        }
+                    ]
+                },
+                "fromUserID": {
+                    "description": "Кто отдает",
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "Тип части сделки",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.OrderLegKind"
+                        }
+                    ],
+                    "example": "goods"
+                },
+                "listingID": {
+                    "description": "Объявление передаваемого предмета",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "Заголовок объявления на момент сделки",
+                    "type": "string"
+                },
+                "toUserID": {
+                    "description": "Кто получает",
+                    "type": "string"
+                }
+            }
+        },
+        "presenter.OrderStatusChange": {
+            "type": "object",
+            "properties": {
+                "actorID": {
+                    "description": "Идентификатор пользователя, изменившего статус",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "description": "Дата изменения",
+                    "type": "string"
+                },
+                "from": {
+                    "description": "Предыдущий статус",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.OrderStatus"
+                        }
+                    ]
+                },
+                "reason": {
+                    "description": "Причина изменения",
+                    "type": "string"
+                },
+                "to": {
+                    "description": "Новый статус",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.OrderStatus"
+                        }
+                    ]
+                }
+            }
+        },
+        "presenter.Reputation": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Количество оценок",
+                    "type": "integer",
+                    "example": 12
+                },
+                "penalties": {
+                    "description": "Сумма штрафов по решениям споров",
+                    "type": "integer",
+                    "example": 0
+                },
+                "score": {
+                    "description": "Взвешенная средняя оценка",
+                    "type": "number",
+                    "example": 4.7
+                },
+                "trend": {
+                    "description": "Разница между недавней и общей средней оценкой",
+                    "type": "number",
+                    "example": 0.1
+                }
+            }
+        },
+        "presenter.Resource": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "Идентификатор ресурса",
+                    "type": "string",
+                    "example": "655d8a3577a0a79c69a7cdfc"
+                }
+            }
+        },
+        "presenter.User": {
+            "type": "object",
+            "properties": {
+                "avatarID": {
+                    "description": "Идентификатор медиафайла аватара",
+                    "type": "string"
+                },
+                "bio": {
+                    "description": "Биография, пустая если отклонена модератором",
+                    "type": "string",
+                    "example": "Меняю книги"
+                },
+                "city": {
+                    "description": "Город",
+                    "type": "string",
+                    "example": "Алматы"
+                },
+                "createdAt": {
+                    "description": "Дата создания",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Идентификатор пользователя",
+                    "type": "string",
+                    "example": "5f8b9b1b3afea534e56b570e"
+                },
+                "location": {
+                    "description": "Местоположение",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.GeoPoint"
+                        }
+                    ]
+                },
+                "moderation": {
+                    "description": "Статус модерации биографии",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ModerationStatus"
+                        }
+                    ],
+                    "example": "approved"
+                },
+                "name": {
+                    "description": "Имя пользователя",
+                    "type": "string",
+                    "example": "Алиса"
+                },
+                "reputation": {
+                    "description": "Репутация по оценкам после сделок",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/presenter.Reputation"
+                        }
+                    ]
+                },
+                "updatedAt": {
+                    "description": "Дата обновления",
+                    "type": "string"
+                }
+            }
+        },
         "swagger.HTTPResponse400": {
             "type": "object",
             "properties": {
@@ -459,6 +10605,33 @@ const docTemplate = `{
                     "description": "Код ошибки.",
                     "type": "string",
                     "example": "TMP_INVALID_USER"
+                },
+                "fields": {
+                    "description": "Ошибки полей формы.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.HTTPResponseField"
+                    }
+                },
+                "message": {
+                    "description": "Сообщение на языке из заголовка Accept-Language.",
+                    "type": "string",
+                    "example": "Неверные параметры запроса"
+                }
+            }
+        },
+        "swagger.HTTPResponse404": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Код ошибки.",
+                    "type": "string",
+                    "example": "TMP_USER_NOT_FOUND"
+                },
+                "message": {
+                    "description": "Сообщение на языке из заголовка Accept-Language.",
+                    "type": "string",
+                    "example": "Пользователь не найден"
                 }
             }
         },
@@ -469,6 +10642,26 @@ const docTemplate = `{
                     "description": "Код ошибки.",
                     "type": "string",
                     "example": "TMP_INTERNAL"
+                },
+                "message": {
+                    "description": "Сообщение на языке из заголовка Accept-Language.",
+                    "type": "string",
+                    "example": "Внутренняя ошибка сервера"
+                }
+            }
+        },
+        "swagger.HTTPResponseField": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "description": "Поле формы.",
+                    "type": "string",
+                    "example": "subjectID"
+                },
+                "message": {
+                    "description": "Сообщение на языке из заголовка Accept-Language.",
+                    "type": "string",
+                    "example": "Обязательное поле"
                 }
             }
         }